@@ -11,9 +11,11 @@ import (
 	"github.com/idoall/gocryptotrader/config"
 	"github.com/idoall/gocryptotrader/core"
 	"github.com/idoall/gocryptotrader/database"
+	dbmysql "github.com/idoall/gocryptotrader/database/drivers/mysql"
 	dbPSQL "github.com/idoall/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/idoall/gocryptotrader/database/drivers/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/idoall/gocryptotrader/database/repository/partition"
 	"github.com/thrasher-corp/goose"
 )
 
@@ -39,6 +41,12 @@ func openDBConnection(driver string) (err error) {
 			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
 		}
 		return nil
+	} else if driver == database.DBMySQL {
+		dbConn, err = dbmysql.Connect()
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
+		}
+		return nil
 	}
 	return errors.New("no connection established")
 }
@@ -48,7 +56,7 @@ func main() {
 	fmt.Println(core.Copyright)
 	fmt.Println()
 
-	flag.StringVar(&command, "command", "", "command to run status|up|up-by-one|up-to|down|create")
+	flag.StringVar(&command, "command", "", "command to run status|up|up-by-one|up-to|down|create|partition")
 	flag.StringVar(&args, "args", "", "arguments to pass to goose")
 	flag.StringVar(&configFile, "config", config.DefaultFilePath(), "config file to load")
 	flag.StringVar(&defaultDataDir, "datadir", common.GetDefaultDataDir(runtime.GOOS), "default data directory for GoCryptoTrader files")
@@ -89,6 +97,17 @@ func main() {
 		return
 	}
 
+	if command == "partition" {
+		fmt.Println("Converting candle and trade tables to time partitioned tables, this may take a while on large tables")
+		mode, err := partition.Enable(partition.DefaultMonthsAhead)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Printf("Candle and trade tables time partitioned using %s partitioning\n", mode)
+		return
+	}
+
 	if err = goose.Run(command, dbConn.SQL, drv, migrationDir, args); err != nil {
 		fmt.Println(err)
 	}
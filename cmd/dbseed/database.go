@@ -6,6 +6,7 @@ import (
 
 	"github.com/idoall/gocryptotrader/config"
 	"github.com/idoall/gocryptotrader/database"
+	dbmysql "github.com/idoall/gocryptotrader/database/drivers/mysql"
 	dbPSQL "github.com/idoall/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/idoall/gocryptotrader/database/drivers/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -59,6 +60,12 @@ func openDBConnection(c *cli.Context, driver string) (err error) {
 			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
 		}
 		return nil
+	} else if driver == database.DBMySQL {
+		dbConn, err = dbmysql.Connect()
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
+		}
+		return nil
 	}
 	return errors.New("no connection established")
 }
@@ -30,6 +30,8 @@ var (
 		Commands: []*cli.Command{
 			seedExchangeCommand,
 			seedCandleCommand,
+			exportCommand,
+			importCommand,
 		},
 	}
 )
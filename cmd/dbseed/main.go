@@ -32,6 +32,8 @@ var (
 		Commands: []*cli.Command{
 			seedExchangeCommand,
 			seedCandleCommand,
+			exportCommand,
+			importCommand,
 		},
 	}
 	workingDir string
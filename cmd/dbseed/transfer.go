@@ -0,0 +1,191 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database/transfer"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/urfave/cli/v2"
+)
+
+var errFilenameUnset = errors.New("filename must be set")
+
+var transferFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "exchange name of the data",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "asset type of the data (spot/margin/futures for example)",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "currency pair of the data using a - delimiter (BTC-USDT for example)",
+	},
+	&cli.Int64Flag{
+		Name:  "interval",
+		Usage: "candle interval in seconds",
+	},
+	&cli.StringFlag{
+		Name:  "format",
+		Usage: "csv, jsonl or parquet, determined by the file extension when unset",
+	},
+	&cli.StringFlag{
+		Name:  "timezone",
+		Usage: "IANA time zone for timestamps (Australia/Sydney for example)",
+		Value: "UTC",
+	},
+	&cli.StringFlag{
+		Name:      "filename",
+		Usage:     "file to export to or import from",
+		TakesFile: true,
+	},
+}
+
+var exportCommand = &cli.Command{
+	Name:      "export",
+	Usage:     "export stored candles, trades or withdrawal history to a csv, jsonl or parquet file",
+	ArgsUsage: "<candles|trades|withdrawals>",
+	Action:    exportData,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "start date of data to export",
+			Value: time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "end date of data to export",
+			Value: time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+		},
+		&cli.StringFlag{
+			Name:  "columns",
+			Usage: "comma separated list of columns to export in order, exports all columns when unset",
+		},
+	}, transferFlags...),
+}
+
+var importCommand = &cli.Command{
+	Name:      "import",
+	Usage:     "import candles or trades from a csv, jsonl or parquet file",
+	ArgsUsage: "<candles|trades>",
+	Action:    importData,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "duplicates",
+			Usage: "how to handle records which are already stored: skip, overwrite or error",
+			Value: transfer.DuplicateSkip,
+		},
+	}, transferFlags...),
+}
+
+// transferDetails parses the flags shared by import and export
+func transferDetails(c *cli.Context) (a asset.Item, p currency.Pair, loc *time.Location, err error) {
+	if c.String("filename") == "" {
+		return a, p, nil, errFilenameUnset
+	}
+	if c.IsSet("asset") {
+		a, err = asset.New(c.String("asset"))
+		if err != nil {
+			return a, p, nil, err
+		}
+	}
+	if c.IsSet("pair") {
+		p, err = currency.NewPairDelimiter(c.String("pair"), "-")
+		if err != nil {
+			return a, p, nil, err
+		}
+	}
+	loc, err = time.LoadLocation(c.String("timezone"))
+	return a, p, loc, err
+}
+
+func exportData(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	a, p, loc, err := transferDetails(c)
+	if err != nil {
+		return err
+	}
+	start, err := time.ParseInLocation(common.SimpleTimeFormat, c.String("start"), loc)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	end, err := time.ParseInLocation(common.SimpleTimeFormat, c.String("end"), loc)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	var columns []string
+	if c.IsSet("columns") {
+		columns = strings.Split(c.String("columns"), ",")
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	count, err := transfer.ExportToFile(c.String("filename"), &transfer.ExportRequest{
+		DataType: c.Args().First(),
+		Format:   c.String("format"),
+		Exchange: c.String("exchange"),
+		Asset:    a,
+		Pair:     p,
+		Interval: kline.Interval(time.Duration(c.Int64("interval")) * time.Second),
+		Start:    start,
+		End:      end,
+		Columns:  columns,
+		Location: loc,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Exported: %v records to %v", count, c.String("filename"))
+	return nil
+}
+
+func importData(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	a, p, loc, err := transferDetails(c)
+	if err != nil {
+		return err
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := transfer.ImportFromFile(c.String("filename"), &transfer.ImportRequest{
+		DataType:   c.Args().First(),
+		Format:     c.String("format"),
+		Exchange:   c.String("exchange"),
+		Asset:      a,
+		Pair:       p,
+		Interval:   kline.Interval(time.Duration(c.Int64("interval")) * time.Second),
+		Location:   loc,
+		Duplicates: c.String("duplicates"),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Read: %v records, imported: %v, overwritten: %v, skipped: %v",
+		result.Records,
+		result.Imported,
+		result.Overwritten,
+		result.Skipped)
+	return nil
+}
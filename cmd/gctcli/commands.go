@@ -722,6 +722,296 @@ func getOrderbooks(_ *cli.Context) error {
 	return nil
 }
 
+var getTickerHistoryCommand = cli.Command{
+	Name:      "gettickerhistory",
+	Usage:     "gets the stored ticker history for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset> <limit> <since>",
+	Action:    getTickerHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the ticker history for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the ticker history for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair to get the ticker history for",
+		},
+		cli.Int64Flag{
+			Name:  "limit",
+			Usage: "the maximum amount of most recent entries to return, 0 returns all",
+		},
+		cli.StringFlag{
+			Name:  "since",
+			Usage: "only return entries updated at or after this time <since>",
+		},
+	},
+}
+
+func getTickerHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "gettickerhistory")
+	}
+
+	exchangeName, p, assetType, limit, since, err := parseMarketHistoryArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTickerHistory(context.Background(),
+		&gctrpc.GetTickerHistoryRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Limit:     limit,
+			Since:     since,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getOrderbookHistoryCommand = cli.Command{
+	Name:      "getorderbookhistory",
+	Usage:     "gets the stored top of book history for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset> <limit> <since>",
+	Action:    getOrderbookHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the orderbook history for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the orderbook history for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair to get the orderbook history for",
+		},
+		cli.Int64Flag{
+			Name:  "limit",
+			Usage: "the maximum amount of most recent entries to return, 0 returns all",
+		},
+		cli.StringFlag{
+			Name:  "since",
+			Usage: "only return entries updated at or after this time <since>",
+		},
+	},
+}
+
+func getOrderbookHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorderbookhistory")
+	}
+
+	exchangeName, p, assetType, limit, since, err := parseMarketHistoryArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookHistory(context.Background(),
+		&gctrpc.GetOrderbookHistoryRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Limit:     limit,
+			Since:     since,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getOrderExecutionLimitsCommand = cli.Command{
+	Name:      "getorderexecutionlimits",
+	Usage:     "gets the trading rules such as price and amount step sizes for a currency pair",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getOrderExecutionLimits,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the trading rules for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the trading rules for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair to get the trading rules for",
+		},
+	},
+}
+
+func getOrderExecutionLimits(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorderexecutionlimits")
+	}
+
+	var exchangeName string
+	var currencyPair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderExecutionLimits(context.Background(),
+		&gctrpc.GetOrderExecutionLimitsRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// parseMarketHistoryArgs parses the shared arguments of the ticker and
+// orderbook history commands
+func parseMarketHistoryArgs(c *cli.Context) (exchangeName string, p currency.Pair, assetType string, limit int64, since string, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		err = errInvalidExchange
+		return
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		err = errInvalidPair
+		return
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		err = errInvalidAsset
+		return
+	}
+
+	p, err = currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return
+	}
+
+	if c.IsSet("limit") {
+		limit = c.Int64("limit")
+	} else if c.Args().Get(3) != "" {
+		limit, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return
+		}
+	}
+
+	if c.IsSet("since") {
+		since = c.String("since")
+	} else {
+		since = c.Args().Get(4)
+	}
+
+	if since != "" {
+		_, err = time.Parse(common.SimpleTimeFormat, since)
+	}
+	return
+}
+
 var getAccountInfoCommand = cli.Command{
 	Name:      "getaccountinfo",
 	Usage:     "gets the exchange account balance info",
@@ -3358,44 +3648,102 @@ func getExchangeTickerStream(c *cli.Context) error {
 	}
 }
 
-var getAuditEventCommand = cli.Command{
-	Name:      "getauditevent",
-	Usage:     "gets audit events matching query parameters",
-	ArgsUsage: "<starttime> <endtime> <orderby> <limit>",
-	Action:    getAuditEvent,
+var getLiquidationStreamCommand = cli.Command{
+	Name:      "getliquidationstream",
+	Usage:     "gets a stream of forced liquidations for an exchange or all exchanges",
+	ArgsUsage: "<exchange>",
+	Action:    getLiquidationStream,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:        "start, s",
-			Usage:       "start date to search",
-			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
-			Destination: &startTime,
-		},
-		cli.StringFlag{
-			Name:        "end, e",
-			Usage:       "end time to search",
-			Value:       time.Now().Format(common.SimpleTimeFormat),
-			Destination: &endTime,
-		},
-		cli.StringFlag{
-			Name:        "order, o",
-			Usage:       "order results by ascending/descending",
-			Value:       "asc",
-			Destination: &order,
-		},
-		cli.IntFlag{
-			Name:        "limit, l",
-			Usage:       "how many results to retrieve",
-			Value:       100,
-			Destination: &limit,
+			Name:  "exchange",
+			Usage: "the exchange to get liquidations from, leave blank for all exchanges",
 		},
 	},
 }
 
-func getAuditEvent(c *cli.Context) error {
-	if !c.IsSet("start") {
-		if c.Args().Get(0) != "" {
-			startTime = c.Args().Get(0)
-		}
+func getLiquidationStream(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLiquidationStream(context.Background(),
+		&gctrpc.GetLiquidationStreamRequest{
+			Exchange: exchangeName,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Liquidation %s %s %s: SIDE: %s PRICE: %f AMOUNT: %f TIMESTAMP: %d\n",
+			resp.Exchange,
+			resp.Pair.String(),
+			resp.Asset,
+			resp.Side,
+			resp.Price,
+			resp.Amount,
+			resp.Timestamp)
+	}
+}
+
+var getAuditEventCommand = cli.Command{
+	Name:      "getauditevent",
+	Usage:     "gets audit events matching query parameters",
+	ArgsUsage: "<starttime> <endtime> <orderby> <limit>",
+	Action:    getAuditEvent,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:        "order, o",
+			Usage:       "order results by ascending/descending",
+			Value:       "asc",
+			Destination: &order,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getAuditEvent(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
 	}
 
 	if !c.IsSet("end") {
@@ -3550,6 +3898,34 @@ var gctScriptCommand = cli.Command{
 					Name:  "archived",
 					Usage: "<true/false>",
 				},
+				cli.Int64Flag{
+					Name:  "maxallocs",
+					Usage: "the maximum number of objects the script may allocate per run",
+				},
+				cli.Int64Flag{
+					Name:  "maxruntime",
+					Usage: "the maximum runtime of a single run in seconds",
+				},
+				cli.Int64Flag{
+					Name:  "maxordersperminute",
+					Usage: "the maximum number of orders the script may submit per minute",
+				},
+				cli.Float64Flag{
+					Name:  "maxnotional",
+					Usage: "the maximum notional value of a single order",
+				},
+				cli.StringFlag{
+					Name:  "exchanges",
+					Usage: "comma separated list of exchanges the script may use",
+				},
+				cli.StringFlag{
+					Name:  "pairs",
+					Usage: "comma separated list of currency pairs the script may use",
+				},
+				cli.BoolFlag{
+					Name:  "allowwithdrawals",
+					Usage: "permit the script to call withdrawal functions",
+				},
 			},
 			Action: gctScriptUpload,
 		},
@@ -3568,9 +3944,275 @@ var gctScriptCommand = cli.Command{
 			},
 			Action: gctScriptAutoload,
 		},
+		{
+			Name:      "getstate",
+			Usage:     "get the state saved by a script",
+			ArgsUsage: "<script>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "script",
+					Usage: "<script name>",
+				},
+			},
+			Action: gctScriptGetState,
+		},
+		{
+			Name:      "clearstate",
+			Usage:     "clear the state saved by a script, all values are cleared when no key is set",
+			ArgsUsage: "<script> <key>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "script",
+					Usage: "<script name>",
+				},
+				cli.StringFlag{
+					Name:  "key",
+					Usage: "<state key>",
+				},
+			},
+			Action: gctScriptClearState,
+		},
+		{
+			Name:      "backtest",
+			Usage:     "runs a script once per candle against stored candles and reports its performance",
+			ArgsUsage: "<script> <exchange> <pair> <asset> <interval> <start> <end>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "script",
+					Usage: "<script name>",
+				},
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to backtest against",
+				},
+				cli.StringFlag{
+					Name:  "pair, p",
+					Usage: "the currency pair to backtest against",
+				},
+				cli.StringFlag{
+					Name:  "asset, a",
+					Usage: "the asset type of the currency pair",
+				},
+				cli.Int64Flag{
+					Name:        "interval, i",
+					Usage:       fmt.Sprintf(klineMessage, "interval"),
+					Value:       86400,
+					Destination: &candleGranularity,
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+				cli.Float64Flag{
+					Name:  "basefunds",
+					Usage: "the starting balance of the base currency",
+				},
+				cli.Float64Flag{
+					Name:  "quotefunds",
+					Usage: "the starting balance of the quote currency",
+				},
+				cli.Float64Flag{
+					Name:  "fee",
+					Usage: "the fee rate charged on each fill, 0.001 is 0.1%",
+				},
+			},
+			Action: gctScriptBacktest,
+		},
 	},
 }
 
+func gctScriptGetState(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var script string
+	if c.IsSet("script") {
+		script = c.String("script")
+	} else {
+		script = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptGetState(context.Background(),
+		&gctrpc.GCTScriptStateRequest{
+			Script: script,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func gctScriptBacktest(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var script string
+	if c.IsSet("script") {
+		script = c.String("script")
+	} else {
+		script = c.Args().First()
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("interval") {
+		candleGranularity = c.Int64("interval")
+	} else if c.Args().Get(4) != "" {
+		candleGranularity, err = strconv.ParseInt(c.Args().Get(4), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(5) != "" {
+			startTime = c.Args().Get(5)
+		}
+	}
+	if !c.IsSet("end") {
+		if c.Args().Get(6) != "" {
+			endTime = c.Args().Get(6)
+		}
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if !s.Before(e) {
+		return errors.New("start must be before end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptBacktest(context.Background(),
+		&gctrpc.GCTScriptBacktestRequest{
+			Script: &gctrpc.GCTScript{
+				Name: script,
+			},
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:  assetType,
+			Interval:   int64(time.Duration(candleGranularity) * time.Second),
+			Start:      negateLocalOffset(s),
+			End:        negateLocalOffset(e),
+			BaseFunds:  c.Float64("basefunds"),
+			QuoteFunds: c.Float64("quotefunds"),
+			Fee:        c.Float64("fee"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func gctScriptClearState(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var script string
+	if c.IsSet("script") {
+		script = c.String("script")
+	} else {
+		script = c.Args().First()
+	}
+
+	var key string
+	if c.IsSet("key") {
+		key = c.String("key")
+	} else {
+		key = c.Args().Get(1)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptClearState(context.Background(),
+		&gctrpc.GCTScriptClearStateRequest{
+			Script: script,
+			Key:    key,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 func gctScriptAutoload(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		_ = cli.ShowSubcommandHelp(c)
@@ -3881,6 +4523,7 @@ func gctScriptUpload(c *cli.Context) error {
 			Data:       data,
 			Archived:   archived,
 			Overwrite:  overwrite,
+			Limits:     gctScriptLimits(c),
 		})
 
 	if err != nil {
@@ -3933,6 +4576,37 @@ var getHistoricCandlesCommand = cli.Command{
 	},
 }
 
+// gctScriptLimits returns the sandbox limits set via upload flags or nil when
+// no limits were requested
+func gctScriptLimits(c *cli.Context) *gctrpc.GCTScriptLimits {
+	flags := []string{"maxallocs", "maxruntime", "maxordersperminute",
+		"maxnotional", "exchanges", "pairs", "allowwithdrawals"}
+	var set bool
+	for x := range flags {
+		if c.IsSet(flags[x]) {
+			set = true
+			break
+		}
+	}
+	if !set {
+		return nil
+	}
+
+	l := &gctrpc.GCTScriptLimits{
+		MaxAllocs:          c.Int64("maxallocs"),
+		MaxRuntime:         c.Int64("maxruntime"),
+		MaxOrdersPerMinute: c.Int64("maxordersperminute"),
+		MaxNotional:        c.Float64("maxnotional"),
+		AllowWithdrawals:   c.Bool("allowwithdrawals"),
+	}
+	if c.String("exchanges") != "" {
+		l.Exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+	if c.String("pairs") != "" {
+		l.Pairs = strings.Split(c.String("pairs"), ",")
+	}
+	return l
+}
 func getHistoricCandles(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowCommandHelp(c, "gethistoriccandles")
@@ -4372,6 +5046,192 @@ func findMissingSavedCandleIntervals(c *cli.Context) error {
 	return nil
 }
 
+var validateSavedCandlesCommand = cli.Command{
+	Name:      "validatesavedcandles",
+	Usage:     "compares saved candles against candles built from saved trades or re-fetched from the exchange",
+	ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end> <source> <tolerance> <repair>",
+	Action:    validateSavedCandles,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange of the saved candles",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.Int64Flag{
+			Name:        "interval, i",
+			Usage:       fmt.Sprintf(klineMessage, "interval"),
+			Value:       86400,
+			Destination: &candleGranularity,
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start> rounded down to the nearest hour",
+			Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end> rounded down to the nearest hour",
+			Value:       time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:  "source, s",
+			Usage: "where to source the validation candles, <trades> or <exchange>",
+			Value: "trades",
+		},
+		cli.Float64Flag{
+			Name:  "tolerance, t",
+			Usage: "the allowed percentage difference before a candle is flagged",
+		},
+		cli.BoolFlag{
+			Name:  "repair, r",
+			Usage: "overwrites flagged and missing candles with the validation candles",
+		},
+	},
+}
+
+func validateSavedCandles(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "validatesavedcandles")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("interval") {
+		candleGranularity = c.Int64("interval")
+	} else if c.Args().Get(3) != "" {
+		candleGranularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(4) != "" {
+			startTime = c.Args().Get(4)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(5) != "" {
+			endTime = c.Args().Get(5)
+		}
+	}
+
+	source := c.String("source")
+	if !c.IsSet("source") && c.Args().Get(6) != "" {
+		source = c.Args().Get(6)
+	}
+
+	tolerance := c.Float64("tolerance")
+	if !c.IsSet("tolerance") && c.Args().Get(7) != "" {
+		tolerance, err = strconv.ParseFloat(c.Args().Get(7), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	repair := c.Bool("repair")
+	if !c.IsSet("repair") && c.Args().Get(8) != "" {
+		repair, err = strconv.ParseBool(c.Args().Get(8))
+		if err != nil {
+			return err
+		}
+	}
+
+	candleInterval := time.Duration(candleGranularity) * time.Second
+	var s, e time.Time
+	s, err = time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err = time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ValidateSavedCandles(context.Background(),
+		&gctrpc.ValidateSavedCandlesRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:           assetType,
+			Interval:            int64(candleInterval),
+			Start:               negateLocalOffset(s),
+			End:                 negateLocalOffset(e),
+			Source:              source,
+			TolerancePercentage: tolerance,
+			Repair:              repair,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 // negateLocalOffset helps negate the offset of time generation
 // when the unix time gets to rpcserver, it no longer is the same time
 // that was sent as it handles it as a UTC value, even though when
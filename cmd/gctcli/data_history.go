@@ -0,0 +1,410 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var errNicknameUnset = errors.New("job nickname unset")
+
+var dataHistoryCommand = cli.Command{
+	Name:      "datahistory",
+	Usage:     "manage data history jobs to retrieve historic trade or candle data over time",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:   "getactivejobs",
+			Usage:  "returns all jobs that are currently being processed",
+			Action: getActiveDataHistoryJobs,
+		},
+		{
+			Name:      "getjobdetails",
+			Usage:     "returns a job's details via its nickname",
+			ArgsUsage: "<nickname>",
+			Action:    getDataHistoryJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "nickname, n",
+					Usage: "the nickname of the job",
+				},
+				cli.BoolFlag{
+					Name:  "fulldetails, f",
+					Usage: "includes the results of every processed interval",
+				},
+			},
+		},
+		{
+			Name:      "getjobsbetween",
+			Usage:     "returns all jobs created between two dates",
+			ArgsUsage: "<start> <end>",
+			Action:    getDataHistoryJobsBetween,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+			},
+		},
+		{
+			Name:      "upsertjob",
+			Usage:     "adds a new job, or updates an existing job with the same nickname",
+			ArgsUsage: "<nickname> <exchange> <asset> <pair> <start> <end> <interval> <datatype>",
+			Action:    upsertDataHistoryJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "nickname, n",
+					Usage: "a unique nickname for the job",
+				},
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to fetch data from",
+				},
+				cli.StringFlag{
+					Name:  "asset, a",
+					Usage: "the asset type of the currency pair",
+				},
+				cli.StringFlag{
+					Name:  "pair, p",
+					Usage: "the currency pair",
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start> rounded down to the nearest interval",
+					Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end> rounded down to the nearest interval",
+					Value:       time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+				cli.Int64Flag{
+					Name:  "interval, i",
+					Usage: fmt.Sprintf(klineMessage, "interval"),
+					Value: 3600,
+				},
+				cli.Int64Flag{
+					Name:  "datatype, d",
+					Usage: "0 for candles, 1 for trades, 2 for open interest, 3 for long/short ratios",
+				},
+				cli.Int64Flag{
+					Name:  "request_size_limit",
+					Usage: "the maximum amount of candles to retrieve in a single request, defaults to 500",
+				},
+				cli.Int64Flag{
+					Name:  "batch_size",
+					Usage: "the amount of requests to make each time the data history manager runs, defaults to 3",
+				},
+				cli.Int64Flag{
+					Name:  "max_retry_attempts",
+					Usage: "the maximum amount of attempts to retrieve an interval before it is marked as failed, defaults to 3",
+				},
+			},
+		},
+		{
+			Name:      "deletejob",
+			Usage:     "stops processing a job and flags it as removed",
+			ArgsUsage: "<nickname>",
+			Action:    deleteDataHistoryJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "nickname, n",
+					Usage: "the nickname of the job",
+				},
+			},
+		},
+	},
+}
+
+func getActiveDataHistoryJobs(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetActiveDataHistoryJobs(context.Background(),
+		&gctrpc.GetActiveDataHistoryJobsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getjobdetails")
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+	if nickname == "" {
+		return errNicknameUnset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobDetails(context.Background(),
+		&gctrpc.GetDataHistoryJobDetailsRequest{
+			Nickname:    nickname,
+			FullDetails: c.Bool("fulldetails"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJobsBetween(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobsBetween(context.Background(),
+		&gctrpc.GetDataHistoryJobsBetweenRequest{
+			StartDate: negateLocalOffset(s),
+			EndDate:   negateLocalOffset(e),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func upsertDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "upsertjob")
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+	if nickname == "" {
+		return errNicknameUnset
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(3)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(4) != "" {
+			startTime = c.Args().Get(4)
+		}
+	}
+	if !c.IsSet("end") {
+		if c.Args().Get(5) != "" {
+			endTime = c.Args().Get(5)
+		}
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	interval := c.Int64("interval")
+	if !c.IsSet("interval") && c.Args().Get(6) != "" {
+		interval, err = strconv.ParseInt(c.Args().Get(6), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+	dataType := c.Int64("datatype")
+	if !c.IsSet("datatype") && c.Args().Get(7) != "" {
+		dataType, err = strconv.ParseInt(c.Args().Get(7), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.UpsertDataHistoryJob(context.Background(),
+		&gctrpc.UpsertDataHistoryJobRequest{
+			Nickname: nickname,
+			Exchange: exchangeName,
+			Asset:    assetType,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			StartDate:        negateLocalOffset(s),
+			EndDate:          negateLocalOffset(e),
+			Interval:         int64(time.Duration(interval) * time.Second),
+			RequestSizeLimit: c.Int64("request_size_limit"),
+			DataType:         dataType,
+			MaxRetryAttempts: c.Int64("max_retry_attempts"),
+			BatchSize:        c.Int64("batch_size"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func deleteDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "deletejob")
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+	if nickname == "" {
+		return errNicknameUnset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.DeleteDataHistoryJob(context.Background(),
+		&gctrpc.GetDataHistoryJobDetailsRequest{
+			Nickname: nickname,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
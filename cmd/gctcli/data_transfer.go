@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var errDataTypeUnset = errors.New("data type unset, must be candles, trades or withdrawals")
+
+var dataTransferFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "exchange, e",
+		Usage: "the exchange of the data",
+	},
+	cli.StringFlag{
+		Name:  "asset, a",
+		Usage: "the asset type of the currency pair",
+	},
+	cli.StringFlag{
+		Name:  "pair, p",
+		Usage: "the currency pair",
+	},
+	cli.Int64Flag{
+		Name:  "interval, i",
+		Usage: fmt.Sprintf(klineMessage, "interval"),
+	},
+	cli.StringFlag{
+		Name:  "format",
+		Usage: "csv, jsonl or parquet, determined by the file extension when unset",
+	},
+	cli.StringFlag{
+		Name:  "timezone",
+		Usage: "IANA time zone for timestamps, defaults to UTC",
+	},
+	cli.StringFlag{
+		Name:  "path",
+		Usage: "the file path relative to the exports folder in the GoCryptoTrader server's data directory",
+	},
+}
+
+var exportDataCommand = cli.Command{
+	Name:      "exportdata",
+	Usage:     "exports stored candles, trades or withdrawal history to a csv, jsonl or parquet file",
+	ArgsUsage: "<candles|trades|withdrawals>",
+	Action:    exportData,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:  "columns",
+			Usage: "comma separated list of columns to export in order, exports all columns when unset",
+		},
+	}, dataTransferFlags...),
+}
+
+var importDataCommand = cli.Command{
+	Name:      "importdata",
+	Usage:     "imports candles or trades from a csv, jsonl or parquet file",
+	ArgsUsage: "<candles|trades>",
+	Action:    importData,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "duplicates",
+			Usage: "how to handle records which are already stored: skip, overwrite or error",
+			Value: "skip",
+		},
+	}, dataTransferFlags...),
+}
+
+// dataTransferPair parses the optional pair flag
+func dataTransferPair(c *cli.Context) (*gctrpc.CurrencyPair, error) {
+	if !c.IsSet("pair") {
+		return nil, nil
+	}
+	if !validPair(c.String("pair")) {
+		return nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(c.String("pair"), pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
+
+func exportData(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "exportdata")
+	}
+	dataType := c.Args().First()
+	if dataType == "" {
+		return errDataTypeUnset
+	}
+	if c.IsSet("asset") && !validAsset(c.String("asset")) {
+		return errInvalidAsset
+	}
+	p, err := dataTransferPair(c)
+	if err != nil {
+		return err
+	}
+	var columns []string
+	if c.IsSet("columns") {
+		columns = strings.Split(c.String("columns"), ",")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ExportData(context.Background(),
+		&gctrpc.ExportDataRequest{
+			DataType:  dataType,
+			Format:    c.String("format"),
+			Exchange:  c.String("exchange"),
+			AssetType: c.String("asset"),
+			Pair:      p,
+			Interval:  int64(time.Duration(c.Int64("interval")) * time.Second),
+			Start:     startTime,
+			End:       endTime,
+			Columns:   columns,
+			Timezone:  c.String("timezone"),
+			FilePath:  c.String("path"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func importData(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "importdata")
+	}
+	dataType := c.Args().First()
+	if dataType == "" {
+		return errDataTypeUnset
+	}
+	if c.IsSet("asset") && !validAsset(c.String("asset")) {
+		return errInvalidAsset
+	}
+	p, err := dataTransferPair(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ImportData(context.Background(),
+		&gctrpc.ImportDataRequest{
+			DataType:   dataType,
+			Format:     c.String("format"),
+			Exchange:   c.String("exchange"),
+			AssetType:  c.String("asset"),
+			Pair:       p,
+			Interval:   int64(time.Duration(c.Int64("interval")) * time.Second),
+			Timezone:   c.String("timezone"),
+			Duplicates: c.String("duplicates"),
+			FilePath:   c.String("path"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
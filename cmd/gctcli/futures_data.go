@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var futuresDataCommand = cli.Command{
+	Name:      "futuresdata",
+	Usage:     "gets derivatives market data such as open interest and long/short ratios",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "getopeninteresthistory",
+			Usage:     "gets open interest for the specified pair, asset, interval & date range",
+			ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end>",
+			Action:    getOpenInterestHistory,
+			Flags:     futuresDataFlags(),
+		},
+		{
+			Name:      "getlongshortratiohistory",
+			Usage:     "gets long/short ratios for the specified pair, asset, interval & date range",
+			ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end> <ratiotype>",
+			Action:    getLongShortRatioHistory,
+			Flags: append(futuresDataFlags(),
+				cli.StringFlag{
+					Name:  "ratiotype, r",
+					Usage: "<global_account>, <top_account> or <top_position>, defaults to global_account",
+				},
+			),
+		},
+	},
+}
+
+func futuresDataFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the data from",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair to get the data for",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type of the currency pair",
+		},
+		cli.Int64Flag{
+			Name:        "interval, i",
+			Usage:       fmt.Sprintf(klineMessage, "interval"),
+			Value:       3600,
+			Destination: &candleGranularity,
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.BoolFlag{
+			Name:  "db",
+			Usage: "source data from database <true/false>",
+		},
+	}
+}
+
+// futuresDataArgs holds the arguments shared by the futures data commands
+type futuresDataArgs struct {
+	exchange  string
+	pair      *gctrpc.CurrencyPair
+	assetType string
+	interval  int64
+	start     string
+	end       string
+	useDB     bool
+}
+
+func parseFuturesDataArgs(c *cli.Context) (*futuresDataArgs, error) {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return nil, errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	// derivatives asset types are exchange specific so are only checked
+	// for presence
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if assetType == "" {
+		return nil, errInvalidAsset
+	}
+
+	if c.IsSet("interval") {
+		candleGranularity = c.Int64("interval")
+	} else if c.Args().Get(3) != "" {
+		candleGranularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(4) != "" {
+			startTime = c.Args().Get(4)
+		}
+	}
+	if !c.IsSet("end") {
+		if c.Args().Get(5) != "" {
+			endTime = c.Args().Get(5)
+		}
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if !s.Before(e) {
+		return nil, errors.New("start must be before end")
+	}
+
+	return &futuresDataArgs{
+		exchange: exchangeName,
+		pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		assetType: assetType,
+		interval:  int64(time.Duration(candleGranularity) * time.Second),
+		start:     negateLocalOffset(s),
+		end:       negateLocalOffset(e),
+		useDB:     c.Bool("db"),
+	}, nil
+}
+
+func getOpenInterestHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getopeninteresthistory")
+	}
+
+	args, err := parseFuturesDataArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOpenInterestHistory(context.Background(),
+		&gctrpc.GetOpenInterestHistoryRequest{
+			Exchange:  args.exchange,
+			Pair:      args.pair,
+			AssetType: args.assetType,
+			Start:     args.start,
+			End:       args.end,
+			Interval:  args.interval,
+			UseDb:     args.useDB,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getLongShortRatioHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getlongshortratiohistory")
+	}
+
+	args, err := parseFuturesDataArgs(c)
+	if err != nil {
+		return err
+	}
+
+	var ratioType string
+	if c.IsSet("ratiotype") {
+		ratioType = c.String("ratiotype")
+	} else {
+		ratioType = c.Args().Get(6)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLongShortRatioHistory(context.Background(),
+		&gctrpc.GetLongShortRatioHistoryRequest{
+			Exchange:  args.exchange,
+			Pair:      args.pair,
+			AssetType: args.assetType,
+			RatioType: ratioType,
+			Start:     args.start,
+			End:       args.end,
+			Interval:  args.interval,
+			UseDb:     args.useDB,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
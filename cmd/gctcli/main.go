@@ -107,6 +107,9 @@ func main() {
 		getTickersCommand,
 		getOrderbookCommand,
 		getOrderbooksCommand,
+		getTickerHistoryCommand,
+		getOrderbookHistoryCommand,
+		getOrderExecutionLimitsCommand,
 		getAccountInfoCommand,
 		getAccountInfoStreamCommand,
 		getConfigCommand,
@@ -139,10 +142,16 @@ func main() {
 		getExchangeOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getLiquidationStreamCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
 		findMissingSavedCandleIntervalsCommand,
+		validateSavedCandlesCommand,
+		dataHistoryCommand,
+		futuresDataCommand,
+		exportDataCommand,
+		importDataCommand,
 		gctScriptCommand,
 		websocketManagerCommand,
 		tradeCommand,
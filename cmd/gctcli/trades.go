@@ -196,6 +196,11 @@ var tradeCommand = cli.Command{
 				},
 			},
 		},
+		{
+			Name:   "getprocessorstats",
+			Usage:  "gets trade processor throughput and backpressure metrics",
+			Action: getTradeProcessorStats,
+		},
 	},
 }
 
@@ -763,3 +768,26 @@ func convertSavedTradesToCandles(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+func getTradeProcessorStats(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTradeProcessorStats(context.Background(),
+		&gctrpc.GetTradeProcessorStatsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -1048,6 +1048,13 @@ func (c *Config) CheckExchangeConfigValues() error {
 					defaultWebsocketOrderbookBufferLimit)
 				c.Exchanges[i].WebsocketOrderbookBufferLimit = defaultWebsocketOrderbookBufferLimit
 			}
+			if c.Exchanges[i].OrderbookStaleThreshold <= 0 {
+				log.Warnf(log.ConfigMgr,
+					"Exchange %s orderbook stale threshold value not set, defaulting to %v.",
+					c.Exchanges[i].Name,
+					defaultOrderbookStaleThreshold)
+				c.Exchanges[i].OrderbookStaleThreshold = defaultOrderbookStaleThreshold
+			}
 			err := c.CheckPairConsistency(c.Exchanges[i].Name)
 			if err != nil {
 				log.Errorf(log.ConfigMgr,
@@ -1340,6 +1347,13 @@ func (c *Config) checkGCTScriptConfig() error {
 
 	gctscript.ScriptPath = scriptPath
 
+	schedulePath := c.GetDataPath("gctscript")
+	err = common.CreateDir(schedulePath)
+	if err != nil {
+		return err
+	}
+	gctscript.SchedulePath = schedulePath
+
 	return nil
 }
 
@@ -1361,6 +1375,11 @@ func (c *Config) checkDatabaseConfig() error {
 		return fmt.Errorf("unsupported database driver %v, database disabled", c.Database.Driver)
 	}
 
+	if c.Database.TimePartitioning && c.Database.Driver != database.DBPostgreSQL {
+		log.Warnf(log.ConfigMgr, "Time partitioning is only supported by %s, disabling.\n", database.DBPostgreSQL)
+		c.Database.TimePartitioning = false
+	}
+
 	if c.Database.Driver == database.DBSQLite || c.Database.Driver == database.DBSQLite3 {
 		databaseDir := c.GetDataPath("database")
 		err := common.CreateDir(databaseDir)
@@ -1453,6 +1472,25 @@ func (c *Config) CheckConnectionMonitorConfig() {
 	}
 }
 
+// CheckMarketHistoryConfig checks the market history buffer sizes and resets
+// invalid values
+func (c *Config) CheckMarketHistoryConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.MarketHistory.TickerBufferSize < 0 {
+		log.Warnf(log.ConfigMgr,
+			"Market history ticker buffer size cannot be negative, disabling ticker history.\n")
+		c.MarketHistory.TickerBufferSize = 0
+	}
+
+	if c.MarketHistory.OrderbookBufferSize < 0 {
+		log.Warnf(log.ConfigMgr,
+			"Market history orderbook buffer size cannot be negative, disabling orderbook history.\n")
+		c.MarketHistory.OrderbookBufferSize = 0
+	}
+}
+
 // DefaultFilePath returns the default config file path
 // MacOS/Linux: $HOME/.gocryptotrader/config.json or config.dat
 // Windows: %APPDATA%\GoCryptoTrader\config.json or config.dat
@@ -1778,6 +1816,7 @@ func (c *Config) CheckConfig() error {
 	}
 
 	c.CheckConnectionMonitorConfig()
+	c.CheckMarketHistoryConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -1461,6 +1461,7 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 	cfg.Exchanges[0].WebsocketResponseCheckTimeout = 0
 	cfg.Exchanges[0].WebsocketOrderbookBufferLimit = 0
 	cfg.Exchanges[0].WebsocketTrafficTimeout = 0
+	cfg.Exchanges[0].OrderbookStaleThreshold = 0
 	cfg.Exchanges[0].HTTPTimeout = 0
 	err = cfg.CheckExchangeConfigValues()
 	if err != nil {
@@ -1479,6 +1480,10 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 		t.Errorf("expected exchange %s to have updated WebsocketTrafficTimeout value",
 			cfg.Exchanges[0].Name)
 	}
+	if cfg.Exchanges[0].OrderbookStaleThreshold == 0 {
+		t.Errorf("expected exchange %s to have updated OrderbookStaleThreshold value",
+			cfg.Exchanges[0].Name)
+	}
 	if cfg.Exchanges[0].HTTPTimeout == 0 {
 		t.Errorf("expected exchange %s to have updated HTTPTimeout value",
 			cfg.Exchanges[0].Name)
@@ -1727,6 +1732,20 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckMarketHistoryConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.MarketHistory.TickerBufferSize = -1
+	c.MarketHistory.OrderbookBufferSize = 50
+	c.CheckMarketHistoryConfig()
+
+	if c.MarketHistory.TickerBufferSize != 0 ||
+		c.MarketHistory.OrderbookBufferSize != 50 {
+		t.Error("unexpected values")
+	}
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -1961,6 +1980,14 @@ func TestCheckDatabaseConfig(t *testing.T) {
 	if err := c.checkDatabaseConfig(); err != nil {
 		t.Error(err)
 	}
+
+	c.Database.TimePartitioning = true
+	if err := c.checkDatabaseConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.Database.TimePartitioning {
+		t.Error("expected time partitioning to be disabled for sqlite3")
+	}
 }
 
 func TestCheckNTPConfig(t *testing.T) {
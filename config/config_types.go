@@ -28,6 +28,7 @@ const (
 	defaultWebsocketResponseMaxLimit     = time.Second * 7
 	defaultWebsocketOrderbookBufferLimit = 5
 	defaultWebsocketTrafficTimeout       = time.Second * 30
+	defaultOrderbookStaleThreshold       = time.Minute
 	maxAuthFailures                      = 3
 	defaultNTPAllowedDifference          = 50000000
 	defaultNTPAllowedNegativeDifference  = 50000000
@@ -85,6 +86,7 @@ type Config struct {
 	Profiler          Profiler                `json:"profiler"`
 	NTPClient         NTPClientConfig         `json:"ntpclient"`
 	GCTScript         gctscript.Config        `json:"gctscript"`
+	MarketHistory     MarketHistoryConfig     `json:"marketHistory"`
 	Currency          CurrencyConfig          `json:"currencyConfig"`
 	Communications    CommunicationsConfig    `json:"communications"`
 	RemoteControl     RemoteControlConfig     `json:"remoteControl"`
@@ -111,6 +113,13 @@ type ConnectionMonitorConfig struct {
 	CheckInterval    time.Duration `json:"checkInterval"`
 }
 
+// MarketHistoryConfig defines the amount of ticker and top of book snapshots
+// retained in memory for each exchange, pair and asset, zero disables storage
+type MarketHistoryConfig struct {
+	TickerBufferSize    int `json:"tickerBufferSize"`
+	OrderbookBufferSize int `json:"orderbookBufferSize"`
+}
+
 // ExchangeConfig holds all the information needed for each enabled Exchange.
 type ExchangeConfig struct {
 	Name                          string                 `json:"name"`
@@ -124,6 +133,7 @@ type ExchangeConfig struct {
 	WebsocketResponseMaxLimit     time.Duration          `json:"websocketResponseMaxLimit"`
 	WebsocketTrafficTimeout       time.Duration          `json:"websocketTrafficTimeout"`
 	WebsocketOrderbookBufferLimit int                    `json:"websocketOrderbookBufferLimit"`
+	OrderbookStaleThreshold       time.Duration          `json:"orderbookStaleThreshold"`
 	ProxyAddress                  string                 `json:"proxyAddress,omitempty"`
 	BaseCurrencies                currency.Currencies    `json:"baseCurrencies"`
 	CurrencyPairs                 *currency.PairsManager `json:"currencyPairs"`
@@ -331,9 +341,10 @@ type FeaturesSupportedConfig struct {
 
 // FeaturesEnabledConfig stores the exchanges enabled features
 type FeaturesEnabledConfig struct {
-	AutoPairUpdates bool `json:"autoPairUpdates"`
-	Websocket       bool `json:"websocketAPI"`
-	SaveTradeData   bool `json:"saveTradeData"`
+	AutoPairUpdates     bool `json:"autoPairUpdates"`
+	Websocket           bool `json:"websocketAPI"`
+	SaveTradeData       bool `json:"saveTradeData"`
+	SaveLiquidationData bool `json:"saveLiquidationData"`
 }
 
 // FeaturesConfig stores the exchanges supported and enabled features
@@ -11,6 +11,8 @@ IF NOT DEFINED DRIVER (
 
 IF %DRIVER%==psql (
     IF NOT DEFINED MODEL (SET MODEL=postgres)
+) ELSE IF %DRIVER%==mysql (
+    IF NOT DEFINED MODEL (SET MODEL=mysql)
 ) ELSE (
     IF NOT DEFINED MODEL (SET MODEL=sqlite3)
 )
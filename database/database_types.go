@@ -5,6 +5,7 @@ import (
 	"errors"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/idoall/gocryptotrader/database/drivers"
 )
@@ -24,6 +25,40 @@ type Config struct {
 	Verbose                   bool   `json:"verbose"`
 	Driver                    string `json:"driver"`
 	drivers.ConnectionDetails `json:"connectionDetails"`
+	TimePartitioning          bool             `json:"timePartitioning,omitempty"`
+	Retention                 *RetentionConfig `json:"retention,omitempty"`
+}
+
+// RetentionConfig holds how long each type of stored data is kept before it
+// is removed or downsampled
+type RetentionConfig struct {
+	Enabled          bool              `json:"enabled"`
+	CheckInterval    time.Duration     `json:"checkInterval"`
+	Trades           TradeRetention    `json:"trades"`
+	Candles          []CandleRetention `json:"candles"`
+	AuditEvents      RecordRetention   `json:"auditEvents"`
+	ScriptExecutions RecordRetention   `json:"scriptExecutions"`
+}
+
+// TradeRetention defines how long trades are kept. When DownsampleInterval is
+// set, expired trades are converted to candles of that interval before removal
+type TradeRetention struct {
+	MaxAge             time.Duration `json:"maxAge"`
+	DownsampleInterval time.Duration `json:"downsampleInterval"`
+}
+
+// CandleRetention defines how long candles of an interval are kept. When
+// DownsampleInterval is set, expired candles are merged into candles of that
+// interval before removal
+type CandleRetention struct {
+	Interval           time.Duration `json:"interval"`
+	MaxAge             time.Duration `json:"maxAge"`
+	DownsampleInterval time.Duration `json:"downsampleInterval"`
+}
+
+// RecordRetention defines how long records are kept
+type RecordRetention struct {
+	MaxAge time.Duration `json:"maxAge"`
 }
 
 var (
@@ -40,7 +75,7 @@ var (
 	ErrDatabaseSupportDisabled = errors.New("database support is disabled")
 
 	// SupportedDrivers slice of supported database driver types
-	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL}
+	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL, DBMySQL}
 
 	// DefaultSQLiteDatabase is the default sqlite3 database name to use
 	DefaultSQLiteDatabase = "gocryptotrader.db"
@@ -53,6 +88,8 @@ const (
 	DBSQLite3 = "sqlite3"
 	// DBPostgreSQL const string for PostgreSQL across code base
 	DBPostgreSQL = "postgres"
+	// DBMySQL const string for MySQL across code base
+	DBMySQL = "mysql"
 	// DBInvalidDriver const string for invalid driver
 	DBInvalidDriver = "invalid driver"
 )
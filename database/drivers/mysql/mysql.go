@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/idoall/gocryptotrader/database"
+)
+
+// errDuplicateEntry is the MySQL ER_DUP_ENTRY error number
+const errDuplicateEntry = 1062
+
+// Connect opens a connection to MySQL database and returns a pointer to database.DB
+func Connect() (*database.Instance, error) {
+	if database.DB.Config.SSLMode == "" {
+		database.DB.Config.SSLMode = "false"
+	}
+
+	cfg := mysql.NewConfig()
+	cfg.User = database.DB.Config.Username
+	cfg.Passwd = database.DB.Config.Password
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s:%d", database.DB.Config.Host, database.DB.Config.Port)
+	cfg.DBName = database.DB.Config.Database
+	cfg.TLSConfig = database.DB.Config.SSLMode
+	// scan DATETIME columns into time.Time and store them as UTC
+	cfg.ParseTime = true
+	cfg.Loc = time.UTC
+	cfg.Params = map[string]string{
+		// column defaults such as CURRENT_TIMESTAMP use the session time zone
+		"time_zone": "'+00:00'",
+	}
+
+	db, err := sql.Open(database.DBMySQL, cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Ping()
+	if err != nil {
+		return nil, err
+	}
+
+	database.DB.SQL = db
+	database.DB.SQL.SetMaxOpenConns(2)
+	database.DB.SQL.SetMaxIdleConns(1)
+	database.DB.SQL.SetConnMaxLifetime(time.Hour)
+
+	return database.DB, nil
+}
+
+// IsDuplicateEntry returns true when err is a MySQL duplicate key error, MySQL
+// only rolls back the failing statement so the transaction remains usable
+func IsDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == errDuplicateEntry
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS audit_event
+(
+    id bigint PRIMARY KEY NOT NULL AUTO_INCREMENT,
+    type       varchar(255)  NOT NULL,
+    identifier varchar(255)  NOT NULL,
+    message    text          NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE audit_event;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS script
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    script_id varchar(255) NOT NULL,
+    script_name varchar(255) NOT NULL,
+    script_path varchar(1024) NOT NULL,
+    script_data longblob NULL,
+    last_executed_at DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT script_event_uniq UNIQUE (script_id)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE script;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS withdrawal_fiat
+(
+    id bigint PRIMARY KEY NOT NULL AUTO_INCREMENT,
+    withdrawal_fiat_id        char(36) NULL,
+    bank_name                 text NOT NULL,
+    bank_address              text NOT NULL,
+    bank_account_name         text NOT NULL,
+    bank_account_number       text NOT NULL,
+    bsb                       varchar(255) NOT NULL DEFAULT '',
+    swift_code                varchar(255) NOT NULL DEFAULT '',
+    iban                      varchar(255) NOT NULL DEFAULT '',
+    bank_code                 DOUBLE NOT NULL,
+    FOREIGN KEY (withdrawal_fiat_id) REFERENCES withdrawal_history(id) ON DELETE RESTRICT
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS withdrawal_fiat;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS withdrawal_crypto
+(
+    id bigint PRIMARY KEY NOT NULL AUTO_INCREMENT,
+    withdrawal_crypto_id      char(36) NULL,
+    address                   text NOT NULL,
+    address_tag               text NULL,
+    fee                       DOUBLE NOT NULL,
+    FOREIGN KEY (withdrawal_crypto_id) REFERENCES withdrawal_history(id) ON DELETE RESTRICT
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS withdrawal_crypto;
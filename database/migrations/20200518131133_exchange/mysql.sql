@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS exchange
+(
+    id char(36)    PRIMARY KEY NOT NULL,
+    name       varchar(255)  NOT NULL,
+    CONSTRAINT index_name_on_exchange UNIQUE (name)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE exchange;
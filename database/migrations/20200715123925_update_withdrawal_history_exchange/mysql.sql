@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+INSERT INTO exchange(id, name)
+SELECT UUID(), h.exchange FROM (SELECT DISTINCT exchange FROM withdrawal_history) AS h
+WHERE NOT EXISTS (SELECT 1 FROM exchange WHERE exchange.name = h.exchange);
+ALTER TABLE withdrawal_history ADD COLUMN exchange_name_id char(36) NULL;
+ALTER TABLE withdrawal_history ADD CONSTRAINT withdrawal_history_exchange_name_id_fkey
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id);
+UPDATE withdrawal_history INNER JOIN exchange ON withdrawal_history.exchange = exchange.name
+SET withdrawal_history.exchange_name_id = exchange.id;
+ALTER TABLE withdrawal_history DROP COLUMN exchange;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE withdrawal_history ADD COLUMN exchange varchar(255) NULL;
+UPDATE withdrawal_history INNER JOIN exchange ON withdrawal_history.exchange_name_id = exchange.id
+SET withdrawal_history.exchange = exchange.name;
+ALTER TABLE withdrawal_history MODIFY exchange varchar(255) NOT NULL;
+ALTER TABLE withdrawal_history DROP FOREIGN KEY withdrawal_history_exchange_name_id_fkey;
+ALTER TABLE withdrawal_history DROP COLUMN exchange_name_id;
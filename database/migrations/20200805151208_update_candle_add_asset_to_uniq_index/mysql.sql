@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE candle DROP INDEX candle_timestamp_exchange_id_base_quote_interval_key;
+ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_asset_key UNIQUE (timestamp, exchange_id, base, quote, `interval`, asset);
+-- +goose Down
+ALTER TABLE candle DROP INDEX candle_timestamp_exchange_id_base_quote_interval_asset_key;
+ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_key UNIQUE (timestamp, exchange_id, base, quote, `interval`);
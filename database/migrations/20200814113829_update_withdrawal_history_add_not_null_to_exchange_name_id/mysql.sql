@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE withdrawal_history MODIFY exchange_name_id char(36) NOT NULL;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE withdrawal_history MODIFY exchange_name_id char(36) NULL;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE candle DROP INDEX candle_timestamp_exchange_id_base_quote_interval_asset_key;
+ALTER TABLE candle RENAME COLUMN exchange_id TO exchange_name_id;
+ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_asset_key UNIQUE (timestamp, exchange_name_id, base, quote, `interval`, asset);
+-- +goose Down
+ALTER TABLE candle DROP INDEX candle_timestamp_exchange_id_base_quote_interval_asset_key;
+ALTER TABLE candle RENAME COLUMN exchange_name_id TO exchange_id;
+ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_asset_key UNIQUE (timestamp, exchange_id, base, quote, `interval`);
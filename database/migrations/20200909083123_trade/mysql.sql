@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    exchange_name_id char(36) NOT NULL,
+    tid varchar(255),
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(64) NOT NULL,
+    price DOUBLE NOT NULL,
+    amount DOUBLE NOT NULL,
+    side varchar(64) NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    INDEX trade_exchange_name_id_idx (exchange_name_id),
+    CONSTRAINT uniquetradeid
+        UNIQUE (exchange_name_id, tid),
+    CONSTRAINT uniquetrade
+        UNIQUE (exchange_name_id, base, quote, asset, price, amount, side, timestamp),
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE trade;
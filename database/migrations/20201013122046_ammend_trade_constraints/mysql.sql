@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trade DROP INDEX uniquetrade;
+-- +goose StatementEnd
+-- +goose StatementBegin
+-- MySQL has no partial indexes, so the key is only non null for trades
+-- without an ID, which leaves trades with an ID unconstrained by it
+CREATE UNIQUE INDEX unique_trade_no_id ON trade
+    ((IF(tid IS NULL, CONCAT_WS('|', base, quote, asset, price, amount, side, timestamp), NULL)));
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX unique_trade_no_id ON trade;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE trade ADD CONSTRAINT uniquetrade
+    UNIQUE (exchange_name_id, base, quote, asset, price, amount, side, timestamp);
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trade MODIFY side varchar(64) NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP INDEX unique_trade_no_id ON trade;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE UNIQUE INDEX unique_trade_no_id ON trade
+    ((IF(tid IS NULL, CONCAT_WS('|', base, quote, asset, price, amount, timestamp), NULL)));
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE trade SET side = NULL WHERE side = 'UNKNOWN' OR side = '';
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+UPDATE trade SET side = '' WHERE side IS NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE trade MODIFY side varchar(64) NOT NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP INDEX unique_trade_no_id ON trade;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE UNIQUE INDEX unique_trade_no_id ON trade
+    ((IF(tid IS NULL, CONCAT_WS('|', base, quote, asset, price, amount, side, timestamp), NULL)));
+-- +goose StatementEnd
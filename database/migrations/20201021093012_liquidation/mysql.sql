@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS liquidation
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(64) NOT NULL,
+    side varchar(64) NOT NULL,
+    price DOUBLE NOT NULL,
+    amount DOUBLE NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    CONSTRAINT uniqueliquidation
+        UNIQUE (exchange_name_id, base, quote, asset, side, price, amount, timestamp),
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE liquidation;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS liquidation
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    side TEXT NOT NULL,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniqueliquidation
+        unique(exchange_name_id, base, quote, asset, side, price, amount, timestamp) ON CONFLICT IGNORE
+);
+-- +goose Down
+DROP TABLE liquidation;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjob
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    nickname varchar(255) NOT NULL,
+    exchange_name_id char(36) NOT NULL,
+    asset varchar(64) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    start_time DATETIME NOT NULL,
+    end_time DATETIME NOT NULL,
+    `interval` bigint NOT NULL,
+    data_type integer NOT NULL,
+    request_size integer NOT NULL,
+    max_retries integer NOT NULL,
+    batch_count integer NOT NULL,
+    status integer NOT NULL,
+    created DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquenickname
+        UNIQUE (nickname),
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE datahistoryjob;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjob
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    nickname varchar(255) NOT NULL,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    interval bigint NOT NULL,
+    data_type integer NOT NULL,
+    request_size integer NOT NULL,
+    max_retries integer NOT NULL,
+    batch_count integer NOT NULL,
+    status integer NOT NULL,
+    created TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquenickname
+        unique(nickname)
+);
+-- +goose Down
+DROP TABLE datahistoryjob;
\ No newline at end of file
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjob
+(
+    id text not null primary key,
+    nickname text NOT NULL,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset TEXT NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    interval INTEGER NOT NULL,
+    data_type INTEGER NOT NULL,
+    request_size INTEGER NOT NULL,
+    max_retries INTEGER NOT NULL,
+    batch_count INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    created TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquenickname
+        unique(nickname)
+);
+-- +goose Down
+DROP TABLE datahistoryjob;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjobresult
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    job_id char(36) NOT NULL,
+    result TEXT NULL,
+    status integer NOT NULL,
+    interval_start_time DATETIME NOT NULL,
+    interval_end_time DATETIME NOT NULL,
+    run_time DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (job_id) REFERENCES datahistoryjob(id) ON DELETE CASCADE
+);
+-- +goose Down
+DROP TABLE datahistoryjobresult;
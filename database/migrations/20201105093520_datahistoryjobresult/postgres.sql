@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjobresult
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id uuid REFERENCES datahistoryjob(id) ON DELETE CASCADE NOT NULL,
+    result TEXT NULL,
+    status integer NOT NULL,
+    interval_start_time TIMESTAMPTZ NOT NULL,
+    interval_end_time TIMESTAMPTZ NOT NULL,
+    run_time TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose Down
+DROP TABLE datahistoryjobresult;
\ No newline at end of file
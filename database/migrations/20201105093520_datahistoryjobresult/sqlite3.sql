@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjobresult
+(
+    id text not null primary key,
+    job_id text REFERENCES datahistoryjob(id) ON DELETE CASCADE NOT NULL,
+    result TEXT NULL,
+    status INTEGER NOT NULL,
+    interval_start_time TIMESTAMP NOT NULL,
+    interval_end_time TIMESTAMP NOT NULL,
+    run_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose Down
+DROP TABLE datahistoryjobresult;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+-- time partitioning is only supported by postgres
+SELECT 'up SQL query';
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
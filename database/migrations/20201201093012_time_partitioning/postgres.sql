@@ -0,0 +1,219 @@
+-- +goose Up
+-- Time partitioning is opt in. The tables are only converted when
+-- gct_enable_time_partitioning is run by the dbmigrate partition command, the
+-- database manager only creates upcoming partitions once converted.
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_month_start(ts TIMESTAMPTZ) RETURNS TIMESTAMPTZ AS $$
+BEGIN
+    RETURN date_trunc('month', ts AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+-- unique keys on time partitioned tables must include the partition key, so
+-- trade IDs of partitioned trade tables are kept unique per exchange in their
+-- own table by a trigger. Trades with an already stored trade ID are skipped
+CREATE TABLE IF NOT EXISTS trade_tid (
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    tid varchar NOT NULL,
+    PRIMARY KEY (exchange_name_id, tid)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_trade_tid_unique() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        IF OLD.tid IS NOT NULL THEN
+            DELETE FROM trade_tid WHERE exchange_name_id = OLD.exchange_name_id AND tid = OLD.tid;
+        END IF;
+        RETURN OLD;
+    END IF;
+    IF NEW.tid IS NOT NULL THEN
+        INSERT INTO trade_tid (exchange_name_id, tid) VALUES (NEW.exchange_name_id, NEW.tid)
+            ON CONFLICT DO NOTHING;
+        IF NOT FOUND THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_add_trade_tid_trigger(target TEXT) RETURNS void AS $$
+BEGIN
+    EXECUTE format('CREATE TRIGGER trade_tid_unique BEFORE INSERT OR DELETE ON %I FOR EACH ROW EXECUTE PROCEDURE gct_trade_tid_unique()', target);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_create_monthly_partition(parent TEXT, month TIMESTAMPTZ) RETURNS void AS $$
+DECLARE
+    start_time TIMESTAMPTZ := gct_month_start(month);
+    end_time TIMESTAMPTZ := gct_month_start(month) + interval '1 month';
+    partition_name TEXT := parent || '_' || to_char(start_time AT TIME ZONE 'UTC', 'YYYY_MM');
+BEGIN
+    IF to_regclass(partition_name) IS NOT NULL THEN
+        RETURN;
+    END IF;
+    EXECUTE format('CREATE TABLE %I (LIKE %I INCLUDING DEFAULTS)', partition_name, parent);
+    -- row triggers are added to each trade partition as postgres versions
+    -- before 13 do not support them on partitioned tables
+    IF parent = 'trade' THEN
+        PERFORM gct_add_trade_tid_trigger(partition_name);
+    END IF;
+    -- rows stored in the default partition before this month's partition
+    -- existed are moved so the partition can be attached
+    EXECUTE format('WITH moved AS (DELETE FROM %I WHERE timestamp >= $1 AND timestamp < $2 RETURNING *) INSERT INTO %I SELECT * FROM moved',
+        parent || '_default', partition_name) USING start_time, end_time;
+    EXECUTE format('ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)',
+        parent, partition_name, start_time, end_time);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_add_time_partitioned_keys(parent TEXT) RETURNS void AS $$
+BEGIN
+    EXECUTE format('ALTER TABLE %I ADD PRIMARY KEY (id, timestamp)', parent);
+    IF parent = 'candle' THEN
+        ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_asset_key
+            UNIQUE(timestamp, exchange_name_id, base, quote, interval, asset);
+    ELSIF parent = 'trade' THEN
+        CREATE UNIQUE INDEX unique_trade_no_id ON trade (base,quote,asset,price,amount,timestamp)
+            WHERE tid IS NULL;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- gct_extend_time_partitions creates upcoming monthly partitions for natively
+-- partitioned tables and returns the partitioning mode of the table, none is
+-- returned when the table has not been converted
+CREATE OR REPLACE FUNCTION gct_extend_time_partitions(parent TEXT, months_ahead INTEGER) RETURNS TEXT AS $$
+DECLARE
+    month TIMESTAMPTZ := gct_month_start(now());
+    last_month TIMESTAMPTZ := gct_month_start(now()) + make_interval(months => months_ahead);
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'timescaledb') AND
+        EXISTS (SELECT 1 FROM _timescaledb_catalog.hypertable WHERE table_name = parent) THEN
+        RETURN 'timescaledb';
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = to_regclass(parent)) THEN
+        RETURN 'none';
+    END IF;
+    WHILE month <= last_month LOOP
+        PERFORM gct_create_monthly_partition(parent, month);
+        month := month + interval '1 month';
+    END LOOP;
+    RETURN 'native';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- gct_time_partition_table converts a table to a time partitioned table,
+-- copying all of its rows. It locks the table for the duration of the copy
+CREATE OR REPLACE FUNCTION gct_time_partition_table(parent TEXT, months_ahead INTEGER) RETURNS TEXT AS $$
+DECLARE
+    old_table TEXT := parent || '_unpartitioned';
+    idx RECORD;
+    month TIMESTAMPTZ;
+    last_month TIMESTAMPTZ := gct_month_start(now()) + make_interval(months => months_ahead);
+    mode TEXT := gct_extend_time_partitions(parent, months_ahead);
+BEGIN
+    IF mode <> 'none' THEN
+        RETURN mode;
+    END IF;
+
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'timescaledb') THEN
+        EXECUTE format('ALTER TABLE %I DROP CONSTRAINT %I', parent, parent || '_pkey');
+        IF parent = 'trade' THEN
+            INSERT INTO trade_tid (exchange_name_id, tid)
+                SELECT exchange_name_id, tid FROM trade WHERE tid IS NOT NULL;
+            ALTER TABLE trade DROP CONSTRAINT uniquetradeid;
+            PERFORM gct_add_trade_tid_trigger(parent);
+        END IF;
+        EXECUTE format('ALTER TABLE %I ADD PRIMARY KEY (id, timestamp)', parent);
+        EXECUTE format('SELECT create_hypertable(%L, %L, chunk_time_interval => interval %L, migrate_data => true)',
+            parent, 'timestamp', '1 month');
+        RETURN 'timescaledb';
+    END IF;
+
+    EXECUTE format('ALTER TABLE %I RENAME TO %I', parent, old_table);
+    -- index names are unique per schema so the existing names are freed
+    -- for the partitioned table
+    FOR idx IN SELECT indexrelid::regclass::text AS name FROM pg_index WHERE indrelid = to_regclass(old_table) LOOP
+        EXECUTE format('ALTER INDEX %I RENAME TO %I', idx.name, idx.name || '_unpartitioned');
+    END LOOP;
+    EXECUTE format('CREATE TABLE %I (LIKE %I INCLUDING DEFAULTS) PARTITION BY RANGE ("timestamp")', parent, old_table);
+    PERFORM gct_add_time_partitioned_keys(parent);
+    EXECUTE format('ALTER TABLE %I ADD FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)', parent);
+    EXECUTE format('CREATE TABLE %I PARTITION OF %I DEFAULT', parent || '_default', parent);
+    IF parent = 'trade' THEN
+        PERFORM gct_add_trade_tid_trigger(parent || '_default');
+    END IF;
+    EXECUTE format('SELECT min(timestamp) FROM %I', old_table) INTO month;
+    month := gct_month_start(COALESCE(month, now()));
+    WHILE month <= last_month LOOP
+        PERFORM gct_create_monthly_partition(parent, month);
+        month := month + interval '1 month';
+    END LOOP;
+    -- the trade triggers fill trade_tid as the rows are copied
+    EXECUTE format('INSERT INTO %I SELECT * FROM %I', parent, old_table);
+    EXECUTE format('DROP TABLE %I', old_table);
+    RETURN 'native';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_enable_time_partitioning(months_ahead INTEGER) RETURNS TEXT AS $$
+DECLARE
+    mode TEXT;
+BEGIN
+    PERFORM gct_time_partition_table('candle', months_ahead);
+    SELECT gct_time_partition_table('trade', months_ahead) INTO mode;
+    RETURN mode;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION gct_extend_time_partitioning(months_ahead INTEGER) RETURNS TEXT AS $$
+DECLARE
+    mode TEXT;
+BEGIN
+    PERFORM gct_extend_time_partitions('candle', months_ahead);
+    SELECT gct_extend_time_partitions('trade', months_ahead) INTO mode;
+    RETURN mode;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- Tables which have been partitioned are left partitioned
+DROP FUNCTION IF EXISTS gct_extend_time_partitioning(INTEGER);
+DROP FUNCTION IF EXISTS gct_enable_time_partitioning(INTEGER);
+DROP FUNCTION IF EXISTS gct_time_partition_table(TEXT, INTEGER);
+DROP FUNCTION IF EXISTS gct_extend_time_partitions(TEXT, INTEGER);
+DROP FUNCTION IF EXISTS gct_add_time_partitioned_keys(TEXT);
+DROP FUNCTION IF EXISTS gct_create_monthly_partition(TEXT, TIMESTAMPTZ);
+DROP FUNCTION IF EXISTS gct_add_trade_tid_trigger(TEXT);
+DROP FUNCTION IF EXISTS gct_month_start(TIMESTAMPTZ);
+-- trade_tid and its trigger function are still used by partitioned trade
+-- tables, so they are only removed when the trade table was never converted
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trade_tid_unique') THEN
+        DROP FUNCTION IF EXISTS gct_trade_tid_unique();
+        DROP TABLE IF EXISTS trade_tid;
+    END IF;
+END
+$$;
+-- +goose StatementEnd
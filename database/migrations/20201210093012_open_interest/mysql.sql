@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS open_interest
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(64) NOT NULL,
+    `interval` bigint NOT NULL,
+    open_interest DOUBLE NOT NULL,
+    open_interest_value DOUBLE NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    CONSTRAINT uniqueopeninterest
+        UNIQUE (exchange_name_id, base, quote, asset, `interval`, timestamp),
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE open_interest;
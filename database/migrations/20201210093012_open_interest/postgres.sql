@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS open_interest
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    interval bigint NOT NULL,
+    open_interest DOUBLE PRECISION NOT NULL,
+    open_interest_value DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueopeninterest
+        unique(exchange_name_id, base, quote, asset, interval, timestamp)
+);
+-- +goose Down
+DROP TABLE open_interest;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS open_interest
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    interval INTEGER NOT NULL,
+    open_interest REAL NOT NULL,
+    open_interest_value REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniqueopeninterest
+        unique(exchange_name_id, base, quote, asset, interval, timestamp) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE open_interest;
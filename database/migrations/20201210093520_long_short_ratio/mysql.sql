@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS long_short_ratio
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(64) NOT NULL,
+    `interval` bigint NOT NULL,
+    ratio_type varchar(64) NOT NULL,
+    long_short_ratio DOUBLE NOT NULL,
+    long_ratio DOUBLE NOT NULL,
+    short_ratio DOUBLE NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    CONSTRAINT uniquelongshortratio
+        UNIQUE (exchange_name_id, base, quote, asset, `interval`, ratio_type, timestamp),
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE long_short_ratio;
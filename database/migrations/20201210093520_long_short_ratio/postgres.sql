@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS long_short_ratio
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    interval bigint NOT NULL,
+    ratio_type varchar NOT NULL,
+    long_short_ratio DOUBLE PRECISION NOT NULL,
+    long_ratio DOUBLE PRECISION NOT NULL,
+    short_ratio DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquelongshortratio
+        unique(exchange_name_id, base, quote, asset, interval, ratio_type, timestamp)
+);
+-- +goose Down
+DROP TABLE long_short_ratio;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS long_short_ratio
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    interval INTEGER NOT NULL,
+    ratio_type TEXT NOT NULL,
+    long_short_ratio REAL NOT NULL,
+    long_ratio REAL NOT NULL,
+    short_ratio REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquelongshortratio
+        unique(exchange_name_id, base, quote, asset, interval, ratio_type, timestamp) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE long_short_ratio;
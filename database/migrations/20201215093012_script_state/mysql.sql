@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_state
+(
+    id char(36) PRIMARY KEY NOT NULL,
+    script_name varchar(255) NOT NULL,
+    state_key varchar(255) NOT NULL,
+    state_value MEDIUMTEXT NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    CONSTRAINT uniquescriptstate
+        UNIQUE (script_name, state_key)
+);
+-- +goose Down
+DROP TABLE script_state;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_state
+(
+    id text not null primary key,
+    script_name text NOT NULL,
+    state_key text NOT NULL,
+    state_value text NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT uniquescriptstate
+        unique(script_name, state_key) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE script_state;
@@ -0,0 +1,433 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import "testing"
+
+// This test suite runs each operation test in parallel.
+// Example, if your database has 3 tables, the suite will run:
+// table1, table2 and table3 Delete in parallel
+// table1, table2 and table3 Insert in parallel, and so forth.
+// It does NOT run each operation group in parallel.
+// Separating the tests thusly grants avoidance of Postgres deadlocks.
+func TestParent(t *testing.T) {
+	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Candles", testCandles)
+	t.Run("Datahistoryjobs", testDatahistoryjobs)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
+	t.Run("Exchanges", testExchanges)
+	t.Run("Liquidations", testLiquidations)
+	t.Run("LongShortRatios", testLongShortRatios)
+	t.Run("OpenInterests", testOpenInterests)
+	t.Run("Scripts", testScripts)
+	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("ScriptStates", testScriptStates)
+	t.Run("Trades", testTrades)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
+	t.Run("WithdrawalFiats", testWithdrawalFiats)
+	t.Run("WithdrawalHistories", testWithdrawalHistories)
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Candles", testCandlesDelete)
+	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
+	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Liquidations", testLiquidationsDelete)
+	t.Run("LongShortRatios", testLongShortRatiosDelete)
+	t.Run("OpenInterests", testOpenInterestsDelete)
+	t.Run("Scripts", testScriptsDelete)
+	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("ScriptStates", testScriptStatesDelete)
+	t.Run("Trades", testTradesDelete)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesDelete)
+}
+
+func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
+	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Liquidations", testLiquidationsQueryDeleteAll)
+	t.Run("LongShortRatios", testLongShortRatiosQueryDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsQueryDeleteAll)
+	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("ScriptStates", testScriptStatesQueryDeleteAll)
+	t.Run("Trades", testTradesQueryDeleteAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesQueryDeleteAll)
+}
+
+func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
+	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Liquidations", testLiquidationsSliceDeleteAll)
+	t.Run("LongShortRatios", testLongShortRatiosSliceDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsSliceDeleteAll)
+	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("ScriptStates", testScriptStatesSliceDeleteAll)
+	t.Run("Trades", testTradesSliceDeleteAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceDeleteAll)
+}
+
+func TestExists(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Candles", testCandlesExists)
+	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
+	t.Run("Exchanges", testExchangesExists)
+	t.Run("Liquidations", testLiquidationsExists)
+	t.Run("LongShortRatios", testLongShortRatiosExists)
+	t.Run("OpenInterests", testOpenInterestsExists)
+	t.Run("Scripts", testScriptsExists)
+	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("ScriptStates", testScriptStatesExists)
+	t.Run("Trades", testTradesExists)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesExists)
+}
+
+func TestFind(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Candles", testCandlesFind)
+	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
+	t.Run("Exchanges", testExchangesFind)
+	t.Run("Liquidations", testLiquidationsFind)
+	t.Run("LongShortRatios", testLongShortRatiosFind)
+	t.Run("OpenInterests", testOpenInterestsFind)
+	t.Run("Scripts", testScriptsFind)
+	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("ScriptStates", testScriptStatesFind)
+	t.Run("Trades", testTradesFind)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesFind)
+}
+
+func TestBind(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Candles", testCandlesBind)
+	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
+	t.Run("Exchanges", testExchangesBind)
+	t.Run("Liquidations", testLiquidationsBind)
+	t.Run("LongShortRatios", testLongShortRatiosBind)
+	t.Run("OpenInterests", testOpenInterestsBind)
+	t.Run("Scripts", testScriptsBind)
+	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("ScriptStates", testScriptStatesBind)
+	t.Run("Trades", testTradesBind)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesBind)
+}
+
+func TestOne(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Candles", testCandlesOne)
+	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
+	t.Run("Exchanges", testExchangesOne)
+	t.Run("Liquidations", testLiquidationsOne)
+	t.Run("LongShortRatios", testLongShortRatiosOne)
+	t.Run("OpenInterests", testOpenInterestsOne)
+	t.Run("Scripts", testScriptsOne)
+	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("ScriptStates", testScriptStatesOne)
+	t.Run("Trades", testTradesOne)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesOne)
+}
+
+func TestAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Candles", testCandlesAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
+	t.Run("Exchanges", testExchangesAll)
+	t.Run("Liquidations", testLiquidationsAll)
+	t.Run("LongShortRatios", testLongShortRatiosAll)
+	t.Run("OpenInterests", testOpenInterestsAll)
+	t.Run("Scripts", testScriptsAll)
+	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("ScriptStates", testScriptStatesAll)
+	t.Run("Trades", testTradesAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesAll)
+}
+
+func TestCount(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Candles", testCandlesCount)
+	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
+	t.Run("Exchanges", testExchangesCount)
+	t.Run("Liquidations", testLiquidationsCount)
+	t.Run("LongShortRatios", testLongShortRatiosCount)
+	t.Run("OpenInterests", testOpenInterestsCount)
+	t.Run("Scripts", testScriptsCount)
+	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("ScriptStates", testScriptStatesCount)
+	t.Run("Trades", testTradesCount)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesCount)
+}
+
+func TestHooks(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Candles", testCandlesHooks)
+	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
+	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Liquidations", testLiquidationsHooks)
+	t.Run("LongShortRatios", testLongShortRatiosHooks)
+	t.Run("OpenInterests", testOpenInterestsHooks)
+	t.Run("Scripts", testScriptsHooks)
+	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("ScriptStates", testScriptStatesHooks)
+	t.Run("Trades", testTradesHooks)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesHooks)
+}
+
+func TestInsert(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsInsert)
+	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Candles", testCandlesInsert)
+	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
+	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
+	t.Run("Exchanges", testExchangesInsert)
+	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Liquidations", testLiquidationsInsert)
+	t.Run("Liquidations", testLiquidationsInsertWhitelist)
+	t.Run("LongShortRatios", testLongShortRatiosInsert)
+	t.Run("LongShortRatios", testLongShortRatiosInsertWhitelist)
+	t.Run("OpenInterests", testOpenInterestsInsert)
+	t.Run("OpenInterests", testOpenInterestsInsertWhitelist)
+	t.Run("Scripts", testScriptsInsert)
+	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("ScriptExecutions", testScriptExecutionsInsert)
+	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("ScriptStates", testScriptStatesInsert)
+	t.Run("ScriptStates", testScriptStatesInsertWhitelist)
+	t.Run("Trades", testTradesInsert)
+	t.Run("Trades", testTradesInsertWhitelist)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsertWhitelist)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsInsert)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsInsertWhitelist)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesInsert)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesInsertWhitelist)
+}
+
+// TestToOne tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToOne(t *testing.T) {
+	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
+	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
+	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("LiquidationToExchangeUsingExchangeName", testLiquidationToOneExchangeUsingExchangeName)
+	t.Run("LongShortRatioToExchangeUsingExchangeName", testLongShortRatioToOneExchangeUsingExchangeName)
+	t.Run("OpenInterestToExchangeUsingExchangeName", testOpenInterestToOneExchangeUsingExchangeName)
+	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
+	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
+	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCrypto", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalCrypto)
+	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiat", testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalFiat)
+	t.Run("WithdrawalHistoryToExchangeUsingExchangeName", testWithdrawalHistoryToOneExchangeUsingExchangeName)
+}
+
+// TestOneToOne tests cannot be run in parallel
+// or deadlocks can occur.
+func TestOneToOne(t *testing.T) {}
+
+// TestToMany tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToMany(t *testing.T) {
+	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
+	t.Run("ExchangeToExchangeNameCandles", testExchangeToManyExchangeNameCandles)
+	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameLiquidations", testExchangeToManyExchangeNameLiquidations)
+	t.Run("ExchangeToExchangeNameLongShortRatios", testExchangeToManyExchangeNameLongShortRatios)
+	t.Run("ExchangeToExchangeNameOpenInterests", testExchangeToManyExchangeNameOpenInterests)
+	t.Run("ExchangeToExchangeNameTrades", testExchangeToManyExchangeNameTrades)
+	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
+	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
+	t.Run("WithdrawalHistoryToWithdrawalCryptoWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptoWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiatWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiatWithdrawalFiats)
+}
+
+// TestToOneSet tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToOneSet(t *testing.T) {
+	t.Run("CandleToExchangeUsingExchangeNameCandles", testCandleToOneSetOpExchangeUsingExchangeName)
+	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
+	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("LiquidationToExchangeUsingExchangeNameLiquidations", testLiquidationToOneSetOpExchangeUsingExchangeName)
+	t.Run("LongShortRatioToExchangeUsingExchangeNameLongShortRatios", testLongShortRatioToOneSetOpExchangeUsingExchangeName)
+	t.Run("OpenInterestToExchangeUsingExchangeNameOpenInterests", testOpenInterestToOneSetOpExchangeUsingExchangeName)
+	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
+	t.Run("TradeToExchangeUsingExchangeNameTrades", testTradeToOneSetOpExchangeUsingExchangeName)
+	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptoWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalCrypto)
+	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiatWithdrawalFiats", testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalFiat)
+	t.Run("WithdrawalHistoryToExchangeUsingExchangeNameWithdrawalHistories", testWithdrawalHistoryToOneSetOpExchangeUsingExchangeName)
+}
+
+// TestToOneRemove tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToOneRemove(t *testing.T) {
+	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneRemoveOpScriptUsingScript)
+	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptoWithdrawalCryptos", testWithdrawalCryptoToOneRemoveOpWithdrawalHistoryUsingWithdrawalCrypto)
+	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiatWithdrawalFiats", testWithdrawalFiatToOneRemoveOpWithdrawalHistoryUsingWithdrawalFiat)
+}
+
+// TestOneToOneSet tests cannot be run in parallel
+// or deadlocks can occur.
+func TestOneToOneSet(t *testing.T) {}
+
+// TestOneToOneRemove tests cannot be run in parallel
+// or deadlocks can occur.
+func TestOneToOneRemove(t *testing.T) {}
+
+// TestToManyAdd tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToManyAdd(t *testing.T) {
+	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
+	t.Run("ExchangeToExchangeNameCandles", testExchangeToManyAddOpExchangeNameCandles)
+	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameLiquidations", testExchangeToManyAddOpExchangeNameLiquidations)
+	t.Run("ExchangeToExchangeNameLongShortRatios", testExchangeToManyAddOpExchangeNameLongShortRatios)
+	t.Run("ExchangeToExchangeNameOpenInterests", testExchangeToManyAddOpExchangeNameOpenInterests)
+	t.Run("ExchangeToExchangeNameTrades", testExchangeToManyAddOpExchangeNameTrades)
+	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
+	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
+	t.Run("WithdrawalHistoryToWithdrawalCryptoWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptoWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiatWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiatWithdrawalFiats)
+}
+
+// TestToManySet tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToManySet(t *testing.T) {
+	t.Run("ScriptToScriptExecutions", testScriptToManySetOpScriptExecutions)
+	t.Run("WithdrawalHistoryToWithdrawalCryptoWithdrawalCryptos", testWithdrawalHistoryToManySetOpWithdrawalCryptoWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiatWithdrawalFiats", testWithdrawalHistoryToManySetOpWithdrawalFiatWithdrawalFiats)
+}
+
+// TestToManyRemove tests cannot be run in parallel
+// or deadlocks can occur.
+func TestToManyRemove(t *testing.T) {
+	t.Run("ScriptToScriptExecutions", testScriptToManyRemoveOpScriptExecutions)
+	t.Run("WithdrawalHistoryToWithdrawalCryptoWithdrawalCryptos", testWithdrawalHistoryToManyRemoveOpWithdrawalCryptoWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiatWithdrawalFiats", testWithdrawalHistoryToManyRemoveOpWithdrawalFiatWithdrawalFiats)
+}
+
+func TestReload(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Candles", testCandlesReload)
+	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
+	t.Run("Exchanges", testExchangesReload)
+	t.Run("Liquidations", testLiquidationsReload)
+	t.Run("LongShortRatios", testLongShortRatiosReload)
+	t.Run("OpenInterests", testOpenInterestsReload)
+	t.Run("Scripts", testScriptsReload)
+	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("ScriptStates", testScriptStatesReload)
+	t.Run("Trades", testTradesReload)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesReload)
+}
+
+func TestReloadAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Candles", testCandlesReloadAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
+	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Liquidations", testLiquidationsReloadAll)
+	t.Run("LongShortRatios", testLongShortRatiosReloadAll)
+	t.Run("OpenInterests", testOpenInterestsReloadAll)
+	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("ScriptStates", testScriptStatesReloadAll)
+	t.Run("Trades", testTradesReloadAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesReloadAll)
+}
+
+func TestSelect(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Candles", testCandlesSelect)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
+	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Liquidations", testLiquidationsSelect)
+	t.Run("LongShortRatios", testLongShortRatiosSelect)
+	t.Run("OpenInterests", testOpenInterestsSelect)
+	t.Run("Scripts", testScriptsSelect)
+	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("ScriptStates", testScriptStatesSelect)
+	t.Run("Trades", testTradesSelect)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesSelect)
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Candles", testCandlesUpdate)
+	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
+	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Liquidations", testLiquidationsUpdate)
+	t.Run("LongShortRatios", testLongShortRatiosUpdate)
+	t.Run("OpenInterests", testOpenInterestsUpdate)
+	t.Run("Scripts", testScriptsUpdate)
+	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("ScriptStates", testScriptStatesUpdate)
+	t.Run("Trades", testTradesUpdate)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpdate)
+}
+
+func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
+	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Liquidations", testLiquidationsSliceUpdateAll)
+	t.Run("LongShortRatios", testLongShortRatiosSliceUpdateAll)
+	t.Run("OpenInterests", testOpenInterestsSliceUpdateAll)
+	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("ScriptStates", testScriptStatesSliceUpdateAll)
+	t.Run("Trades", testTradesSliceUpdateAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceUpdateAll)
+}
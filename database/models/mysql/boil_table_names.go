@@ -0,0 +1,38 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+var TableNames = struct {
+	AuditEvent           string
+	Candle               string
+	Datahistoryjob       string
+	Datahistoryjobresult string
+	Exchange             string
+	Liquidation          string
+	LongShortRatio       string
+	OpenInterest         string
+	Script               string
+	ScriptExecution      string
+	ScriptState          string
+	Trade                string
+	WithdrawalCrypto     string
+	WithdrawalFiat       string
+	WithdrawalHistory    string
+}{
+	AuditEvent:           "audit_event",
+	Candle:               "candle",
+	Datahistoryjob:       "datahistoryjob",
+	Datahistoryjobresult: "datahistoryjobresult",
+	Exchange:             "exchange",
+	Liquidation:          "liquidation",
+	LongShortRatio:       "long_short_ratio",
+	OpenInterest:         "open_interest",
+	Script:               "script",
+	ScriptExecution:      "script_execution",
+	ScriptState:          "script_state",
+	Trade:                "trade",
+	WithdrawalCrypto:     "withdrawal_crypto",
+	WithdrawalFiat:       "withdrawal_fiat",
+	WithdrawalHistory:    "withdrawal_history",
+}
@@ -0,0 +1,1114 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// LongShortRatio is an object representing the database table.
+type LongShortRatio struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Base           string    `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote          string    `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Asset          string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Interval       int64     `boil:"interval" json:"interval" toml:"interval" yaml:"interval"`
+	RatioType      string    `boil:"ratio_type" json:"ratio_type" toml:"ratio_type" yaml:"ratio_type"`
+	LongShortRatio float64   `boil:"long_short_ratio" json:"long_short_ratio" toml:"long_short_ratio" yaml:"long_short_ratio"`
+	LongRatio      float64   `boil:"long_ratio" json:"long_ratio" toml:"long_ratio" yaml:"long_ratio"`
+	ShortRatio     float64   `boil:"short_ratio" json:"short_ratio" toml:"short_ratio" yaml:"short_ratio"`
+	Timestamp      time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *longShortRatioR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L longShortRatioL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LongShortRatioColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	Asset          string
+	Interval       string
+	RatioType      string
+	LongShortRatio string
+	LongRatio      string
+	ShortRatio     string
+	Timestamp      string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Base:           "base",
+	Quote:          "quote",
+	Asset:          "asset",
+	Interval:       "interval",
+	RatioType:      "ratio_type",
+	LongShortRatio: "long_short_ratio",
+	LongRatio:      "long_ratio",
+	ShortRatio:     "short_ratio",
+	Timestamp:      "timestamp",
+}
+
+// Generated where
+
+var LongShortRatioWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Base           whereHelperstring
+	Quote          whereHelperstring
+	Asset          whereHelperstring
+	Interval       whereHelperint64
+	RatioType      whereHelperstring
+	LongShortRatio whereHelperfloat64
+	LongRatio      whereHelperfloat64
+	ShortRatio     whereHelperfloat64
+	Timestamp      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "`long_short_ratio`.`id`"},
+	ExchangeNameID: whereHelperstring{field: "`long_short_ratio`.`exchange_name_id`"},
+	Base:           whereHelperstring{field: "`long_short_ratio`.`base`"},
+	Quote:          whereHelperstring{field: "`long_short_ratio`.`quote`"},
+	Asset:          whereHelperstring{field: "`long_short_ratio`.`asset`"},
+	Interval:       whereHelperint64{field: "`long_short_ratio`.`interval`"},
+	RatioType:      whereHelperstring{field: "`long_short_ratio`.`ratio_type`"},
+	LongShortRatio: whereHelperfloat64{field: "`long_short_ratio`.`long_short_ratio`"},
+	LongRatio:      whereHelperfloat64{field: "`long_short_ratio`.`long_ratio`"},
+	ShortRatio:     whereHelperfloat64{field: "`long_short_ratio`.`short_ratio`"},
+	Timestamp:      whereHelpertime_Time{field: "`long_short_ratio`.`timestamp`"},
+}
+
+// LongShortRatioRels is where relationship names are stored.
+var LongShortRatioRels = struct {
+	ExchangeName string
+}{
+	ExchangeName: "ExchangeName",
+}
+
+// longShortRatioR is where relationships are stored.
+type longShortRatioR struct {
+	ExchangeName *Exchange
+}
+
+// NewStruct creates a new relationship struct
+func (*longShortRatioR) NewStruct() *longShortRatioR {
+	return &longShortRatioR{}
+}
+
+// longShortRatioL is where Load methods for each relationship are stored.
+type longShortRatioL struct{}
+
+var (
+	longShortRatioAllColumns            = []string{"id", "exchange_name_id", "base", "quote", "asset", "interval", "ratio_type", "long_short_ratio", "long_ratio", "short_ratio", "timestamp"}
+	longShortRatioColumnsWithoutDefault = []string{"id", "exchange_name_id", "base", "quote", "asset", "interval", "ratio_type", "long_short_ratio", "long_ratio", "short_ratio", "timestamp"}
+	longShortRatioColumnsWithDefault    = []string{}
+	longShortRatioPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// LongShortRatioSlice is an alias for a slice of pointers to LongShortRatio.
+	// This should generally be used opposed to []LongShortRatio.
+	LongShortRatioSlice []*LongShortRatio
+	// LongShortRatioHook is the signature for custom LongShortRatio hook methods
+	LongShortRatioHook func(context.Context, boil.ContextExecutor, *LongShortRatio) error
+
+	longShortRatioQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	longShortRatioType                 = reflect.TypeOf(&LongShortRatio{})
+	longShortRatioMapping              = queries.MakeStructMapping(longShortRatioType)
+	longShortRatioPrimaryKeyMapping, _ = queries.BindMapping(longShortRatioType, longShortRatioMapping, longShortRatioPrimaryKeyColumns)
+	longShortRatioInsertCacheMut       sync.RWMutex
+	longShortRatioInsertCache          = make(map[string]insertCache)
+	longShortRatioUpdateCacheMut       sync.RWMutex
+	longShortRatioUpdateCache          = make(map[string]updateCache)
+	longShortRatioUpsertCacheMut       sync.RWMutex
+	longShortRatioUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var longShortRatioBeforeInsertHooks []LongShortRatioHook
+var longShortRatioBeforeUpdateHooks []LongShortRatioHook
+var longShortRatioBeforeDeleteHooks []LongShortRatioHook
+var longShortRatioBeforeUpsertHooks []LongShortRatioHook
+
+var longShortRatioAfterInsertHooks []LongShortRatioHook
+var longShortRatioAfterSelectHooks []LongShortRatioHook
+var longShortRatioAfterUpdateHooks []LongShortRatioHook
+var longShortRatioAfterDeleteHooks []LongShortRatioHook
+var longShortRatioAfterUpsertHooks []LongShortRatioHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LongShortRatio) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LongShortRatio) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LongShortRatio) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LongShortRatio) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LongShortRatio) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LongShortRatio) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LongShortRatio) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LongShortRatio) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LongShortRatio) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range longShortRatioAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLongShortRatioHook registers your hook function for all future operations.
+func AddLongShortRatioHook(hookPoint boil.HookPoint, longShortRatioHook LongShortRatioHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		longShortRatioBeforeInsertHooks = append(longShortRatioBeforeInsertHooks, longShortRatioHook)
+	case boil.BeforeUpdateHook:
+		longShortRatioBeforeUpdateHooks = append(longShortRatioBeforeUpdateHooks, longShortRatioHook)
+	case boil.BeforeDeleteHook:
+		longShortRatioBeforeDeleteHooks = append(longShortRatioBeforeDeleteHooks, longShortRatioHook)
+	case boil.BeforeUpsertHook:
+		longShortRatioBeforeUpsertHooks = append(longShortRatioBeforeUpsertHooks, longShortRatioHook)
+	case boil.AfterInsertHook:
+		longShortRatioAfterInsertHooks = append(longShortRatioAfterInsertHooks, longShortRatioHook)
+	case boil.AfterSelectHook:
+		longShortRatioAfterSelectHooks = append(longShortRatioAfterSelectHooks, longShortRatioHook)
+	case boil.AfterUpdateHook:
+		longShortRatioAfterUpdateHooks = append(longShortRatioAfterUpdateHooks, longShortRatioHook)
+	case boil.AfterDeleteHook:
+		longShortRatioAfterDeleteHooks = append(longShortRatioAfterDeleteHooks, longShortRatioHook)
+	case boil.AfterUpsertHook:
+		longShortRatioAfterUpsertHooks = append(longShortRatioAfterUpsertHooks, longShortRatioHook)
+	}
+}
+
+// One returns a single longShortRatio record from the query.
+func (q longShortRatioQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LongShortRatio, error) {
+	o := &LongShortRatio{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "mysql: failed to execute a one query for long_short_ratio")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LongShortRatio records from the query.
+func (q longShortRatioQuery) All(ctx context.Context, exec boil.ContextExecutor) (LongShortRatioSlice, error) {
+	var o []*LongShortRatio
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "mysql: failed to assign all query results to LongShortRatio slice")
+	}
+
+	if len(longShortRatioAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LongShortRatio records in the query.
+func (q longShortRatioQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to count long_short_ratio rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q longShortRatioQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "mysql: failed to check if long_short_ratio exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *LongShortRatio) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "`exchange`")
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (longShortRatioL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLongShortRatio interface{}, mods queries.Applicator) error {
+	var slice []*LongShortRatio
+	var object *LongShortRatio
+
+	if singular {
+		object = maybeLongShortRatio.(*LongShortRatio)
+	} else {
+		slice = *maybeLongShortRatio.(*[]*LongShortRatio)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &longShortRatioR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &longShortRatioR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(longShortRatioAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameLongShortRatios = append(foreign.R.ExchangeNameLongShortRatios, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameLongShortRatios = append(foreign.R.ExchangeNameLongShortRatios, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the longShortRatio to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameLongShortRatios.
+func (o *LongShortRatio) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `long_short_ratio` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("`", "`", 0, longShortRatioPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &longShortRatioR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameLongShortRatios: LongShortRatioSlice{o},
+		}
+	} else {
+		related.R.ExchangeNameLongShortRatios = append(related.R.ExchangeNameLongShortRatios, o)
+	}
+
+	return nil
+}
+
+// LongShortRatios retrieves all the records using an executor.
+func LongShortRatios(mods ...qm.QueryMod) longShortRatioQuery {
+	mods = append(mods, qm.From("`long_short_ratio`"))
+	return longShortRatioQuery{NewQuery(mods...)}
+}
+
+// FindLongShortRatio retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLongShortRatio(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*LongShortRatio, error) {
+	longShortRatioObj := &LongShortRatio{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `long_short_ratio` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, longShortRatioObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "mysql: unable to select from long_short_ratio")
+	}
+
+	return longShortRatioObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LongShortRatio) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("mysql: no long_short_ratio provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(longShortRatioColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	longShortRatioInsertCacheMut.RLock()
+	cache, cached := longShortRatioInsertCache[key]
+	longShortRatioInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			longShortRatioAllColumns,
+			longShortRatioColumnsWithDefault,
+			longShortRatioColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(longShortRatioType, longShortRatioMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(longShortRatioType, longShortRatioMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `long_short_ratio` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `long_short_ratio` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `long_short_ratio` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, longShortRatioPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to insert into long_short_ratio")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to populate default values for long_short_ratio")
+	}
+
+CacheNoHooks:
+	if !cached {
+		longShortRatioInsertCacheMut.Lock()
+		longShortRatioInsertCache[key] = cache
+		longShortRatioInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LongShortRatio.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LongShortRatio) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	longShortRatioUpdateCacheMut.RLock()
+	cache, cached := longShortRatioUpdateCache[key]
+	longShortRatioUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			longShortRatioAllColumns,
+			longShortRatioPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("mysql: unable to update long_short_ratio, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `long_short_ratio` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, longShortRatioPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(longShortRatioType, longShortRatioMapping, append(wl, longShortRatioPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to update long_short_ratio row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by update for long_short_ratio")
+	}
+
+	if !cached {
+		longShortRatioUpdateCacheMut.Lock()
+		longShortRatioUpdateCache[key] = cache
+		longShortRatioUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q longShortRatioQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to update all for long_short_ratio")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to retrieve rows affected for long_short_ratio")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LongShortRatioSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("mysql: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), longShortRatioPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `long_short_ratio` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, longShortRatioPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to update all in longShortRatio slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to retrieve rows affected all in update all longShortRatio")
+	}
+	return rowsAff, nil
+}
+
+var mySQLLongShortRatioUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LongShortRatio) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("mysql: no long_short_ratio provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(longShortRatioColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLLongShortRatioUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	longShortRatioUpsertCacheMut.RLock()
+	cache, cached := longShortRatioUpsertCache[key]
+	longShortRatioUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			longShortRatioAllColumns,
+			longShortRatioColumnsWithDefault,
+			longShortRatioColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			longShortRatioAllColumns,
+			longShortRatioPrimaryKeyColumns,
+		)
+
+		if len(update) == 0 {
+			return errors.New("mysql: unable to upsert long_short_ratio, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "long_short_ratio", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `long_short_ratio` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(longShortRatioType, longShortRatioMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(longShortRatioType, longShortRatioMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to upsert for long_short_ratio")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(longShortRatioType, longShortRatioMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to retrieve unique values for long_short_ratio")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to populate default values for long_short_ratio")
+	}
+
+CacheNoHooks:
+	if !cached {
+		longShortRatioUpsertCacheMut.Lock()
+		longShortRatioUpsertCache[key] = cache
+		longShortRatioUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LongShortRatio record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LongShortRatio) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("mysql: no LongShortRatio provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), longShortRatioPrimaryKeyMapping)
+	sql := "DELETE FROM `long_short_ratio` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to delete from long_short_ratio")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by delete for long_short_ratio")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q longShortRatioQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("mysql: no longShortRatioQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to delete all from long_short_ratio")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by deleteall for long_short_ratio")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LongShortRatioSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(longShortRatioBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), longShortRatioPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `long_short_ratio` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, longShortRatioPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to delete all from longShortRatio slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by deleteall for long_short_ratio")
+	}
+
+	if len(longShortRatioAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LongShortRatio) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLongShortRatio(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LongShortRatioSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LongShortRatioSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), longShortRatioPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `long_short_ratio`.* FROM `long_short_ratio` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, longShortRatioPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to reload all in LongShortRatioSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LongShortRatioExists checks if the LongShortRatio row exists.
+func LongShortRatioExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `long_short_ratio` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "mysql: unable to check if long_short_ratio exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import "testing"
+
+func TestUpsert(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsUpsert)
+
+	t.Run("Candles", testCandlesUpsert)
+
+	t.Run("Datahistoryjobs", testDatahistoryjobsUpsert)
+
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpsert)
+
+	t.Run("Exchanges", testExchangesUpsert)
+
+	t.Run("Liquidations", testLiquidationsUpsert)
+	t.Run("LongShortRatios", testLongShortRatiosUpsert)
+	t.Run("OpenInterests", testOpenInterestsUpsert)
+
+	t.Run("Scripts", testScriptsUpsert)
+	t.Run("ScriptStates", testScriptStatesUpsert)
+
+	t.Run("ScriptExecutions", testScriptExecutionsUpsert)
+
+	t.Run("Trades", testTradesUpsert)
+
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpsert)
+
+	t.Run("WithdrawalFiats", testWithdrawalFiatsUpsert)
+
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpsert)
+}
@@ -13,77 +13,155 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Datahistoryjobs", testDatahistoryjobs)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Liquidations", testLiquidations)
+	t.Run("LongShortRatios", testLongShortRatios)
+	t.Run("OpenInterests", testOpenInterests)
 	t.Run("Scripts", testScripts)
+	t.Run("ScriptStates", testScriptStates)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Liquidations", testLiquidationsDelete)
+	t.Run("LongShortRatios", testLongShortRatiosDelete)
+	t.Run("OpenInterests", testOpenInterestsDelete)
 	t.Run("Scripts", testScriptsDelete)
+	t.Run("ScriptStates", testScriptStatesDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Liquidations", testLiquidationsQueryDeleteAll)
+	t.Run("LongShortRatios", testLongShortRatiosQueryDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("ScriptStates", testScriptStatesQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Liquidations", testLiquidationsSliceDeleteAll)
+	t.Run("LongShortRatios", testLongShortRatiosSliceDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("ScriptStates", testScriptStatesSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Liquidations", testLiquidationsExists)
+	t.Run("LongShortRatios", testLongShortRatiosExists)
+	t.Run("OpenInterests", testOpenInterestsExists)
 	t.Run("Scripts", testScriptsExists)
+	t.Run("ScriptStates", testScriptStatesExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Liquidations", testLiquidationsFind)
+	t.Run("LongShortRatios", testLongShortRatiosFind)
+	t.Run("OpenInterests", testOpenInterestsFind)
 	t.Run("Scripts", testScriptsFind)
+	t.Run("ScriptStates", testScriptStatesFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Liquidations", testLiquidationsBind)
+	t.Run("LongShortRatios", testLongShortRatiosBind)
+	t.Run("OpenInterests", testOpenInterestsBind)
 	t.Run("Scripts", testScriptsBind)
+	t.Run("ScriptStates", testScriptStatesBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Liquidations", testLiquidationsOne)
+	t.Run("LongShortRatios", testLongShortRatiosOne)
+	t.Run("OpenInterests", testOpenInterestsOne)
 	t.Run("Scripts", testScriptsOne)
+	t.Run("ScriptStates", testScriptStatesOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Liquidations", testLiquidationsAll)
+	t.Run("LongShortRatios", testLongShortRatiosAll)
+	t.Run("OpenInterests", testOpenInterestsAll)
 	t.Run("Scripts", testScriptsAll)
+	t.Run("ScriptStates", testScriptStatesAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Liquidations", testLiquidationsCount)
+	t.Run("LongShortRatios", testLongShortRatiosCount)
+	t.Run("OpenInterests", testOpenInterestsCount)
 	t.Run("Scripts", testScriptsCount)
+	t.Run("ScriptStates", testScriptStatesCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Liquidations", testLiquidationsHooks)
+	t.Run("LongShortRatios", testLongShortRatiosHooks)
+	t.Run("OpenInterests", testOpenInterestsHooks)
 	t.Run("Scripts", testScriptsHooks)
+	t.Run("ScriptStates", testScriptStatesHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
+	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Liquidations", testLiquidationsInsert)
+	t.Run("Liquidations", testLiquidationsInsertWhitelist)
+	t.Run("LongShortRatios", testLongShortRatiosInsert)
+	t.Run("LongShortRatios", testLongShortRatiosInsertWhitelist)
+	t.Run("OpenInterests", testOpenInterestsInsert)
+	t.Run("OpenInterests", testOpenInterestsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("ScriptStates", testScriptStatesInsert)
+	t.Run("ScriptStates", testScriptStatesInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -128,29 +206,59 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Liquidations", testLiquidationsReload)
+	t.Run("LongShortRatios", testLongShortRatiosReload)
+	t.Run("OpenInterests", testOpenInterestsReload)
+	t.Run("ScriptStates", testScriptStatesReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Liquidations", testLiquidationsReloadAll)
+	t.Run("LongShortRatios", testLongShortRatiosReloadAll)
+	t.Run("OpenInterests", testOpenInterestsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("ScriptStates", testScriptStatesReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Liquidations", testLiquidationsSelect)
+	t.Run("LongShortRatios", testLongShortRatiosSelect)
+	t.Run("OpenInterests", testOpenInterestsSelect)
 	t.Run("Scripts", testScriptsSelect)
+	t.Run("ScriptStates", testScriptStatesSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Liquidations", testLiquidationsUpdate)
+	t.Run("LongShortRatios", testLongShortRatiosUpdate)
+	t.Run("OpenInterests", testOpenInterestsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
+	t.Run("ScriptStates", testScriptStatesUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Liquidations", testLiquidationsSliceUpdateAll)
+	t.Run("LongShortRatios", testLongShortRatiosSliceUpdateAll)
+	t.Run("OpenInterests", testOpenInterestsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("ScriptStates", testScriptStatesSliceUpdateAll)
 }
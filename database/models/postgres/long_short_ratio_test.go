@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLongShortRatios(t *testing.T) {
+	t.Parallel()
+
+	query := LongShortRatios()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLongShortRatiosDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLongShortRatiosQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LongShortRatios().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLongShortRatiosSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LongShortRatioSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLongShortRatiosExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LongShortRatioExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LongShortRatio exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LongShortRatioExists to return true, but got false.")
+	}
+}
+
+func testLongShortRatiosFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	longShortRatioFound, err := FindLongShortRatio(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if longShortRatioFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLongShortRatiosBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LongShortRatios().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLongShortRatiosOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LongShortRatios().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLongShortRatiosAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	longShortRatioOne := &LongShortRatio{}
+	longShortRatioTwo := &LongShortRatio{}
+	if err = randomize.Struct(seed, longShortRatioOne, longShortRatioDBTypes, false, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+	if err = randomize.Struct(seed, longShortRatioTwo, longShortRatioDBTypes, false, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = longShortRatioOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = longShortRatioTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LongShortRatios().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLongShortRatiosCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	longShortRatioOne := &LongShortRatio{}
+	longShortRatioTwo := &LongShortRatio{}
+	if err = randomize.Struct(seed, longShortRatioOne, longShortRatioDBTypes, false, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+	if err = randomize.Struct(seed, longShortRatioTwo, longShortRatioDBTypes, false, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = longShortRatioOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = longShortRatioTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func longShortRatioBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func longShortRatioAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LongShortRatio) error {
+	*o = LongShortRatio{}
+	return nil
+}
+
+func testLongShortRatiosHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LongShortRatio{}
+	o := &LongShortRatio{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio object: %s", err)
+	}
+
+	AddLongShortRatioHook(boil.BeforeInsertHook, longShortRatioBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioBeforeInsertHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.AfterInsertHook, longShortRatioAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioAfterInsertHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.AfterSelectHook, longShortRatioAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioAfterSelectHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.BeforeUpdateHook, longShortRatioBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioBeforeUpdateHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.AfterUpdateHook, longShortRatioAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioAfterUpdateHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.BeforeDeleteHook, longShortRatioBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioBeforeDeleteHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.AfterDeleteHook, longShortRatioAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioAfterDeleteHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.BeforeUpsertHook, longShortRatioBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioBeforeUpsertHooks = []LongShortRatioHook{}
+
+	AddLongShortRatioHook(boil.AfterUpsertHook, longShortRatioAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	longShortRatioAfterUpsertHooks = []LongShortRatioHook{}
+}
+
+func testLongShortRatiosInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLongShortRatiosInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(longShortRatioColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLongShortRatioToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LongShortRatio
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, longShortRatioDBTypes, false, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := LongShortRatioSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*LongShortRatio)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testLongShortRatioToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LongShortRatio
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, longShortRatioDBTypes, false, strmangle.SetComplement(longShortRatioPrimaryKeyColumns, longShortRatioColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameLongShortRatios[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+
+func testLongShortRatiosReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLongShortRatiosReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LongShortRatioSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLongShortRatiosSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LongShortRatios().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	longShortRatioDBTypes = map[string]string{`ID`: `uuid`, `ExchangeNameID`: `uuid`, `Base`: `character varying`, `Quote`: `character varying`, `Asset`: `character varying`, `Interval`: `bigint`, `RatioType`: `character varying`, `LongShortRatio`: `double precision`, `LongRatio`: `double precision`, `ShortRatio`: `double precision`, `Timestamp`: `timestamp with time zone`}
+	_                     = bytes.MinRead
+)
+
+func testLongShortRatiosUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(longShortRatioPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(longShortRatioAllColumns) == len(longShortRatioPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLongShortRatiosSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(longShortRatioAllColumns) == len(longShortRatioPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LongShortRatio{}
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, longShortRatioDBTypes, true, longShortRatioPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(longShortRatioAllColumns, longShortRatioPrimaryKeyColumns) {
+		fields = longShortRatioAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			longShortRatioAllColumns,
+			longShortRatioPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LongShortRatioSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLongShortRatiosUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(longShortRatioAllColumns) == len(longShortRatioPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LongShortRatio{}
+	if err = randomize.Struct(seed, &o, longShortRatioDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LongShortRatio: %s", err)
+	}
+
+	count, err := LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, longShortRatioDBTypes, false, longShortRatioPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LongShortRatio struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LongShortRatio: %s", err)
+	}
+
+	count, err = LongShortRatios().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -7,6 +7,12 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Datahistoryjobs", testDatahistoryjobsUpsert)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpsert)
 	t.Run("Exchanges", testExchangesUpsert)
+	t.Run("Liquidations", testLiquidationsUpsert)
+	t.Run("LongShortRatios", testLongShortRatiosUpsert)
+	t.Run("OpenInterests", testOpenInterestsUpsert)
 	t.Run("Scripts", testScriptsUpsert)
+	t.Run("ScriptStates", testScriptStatesUpsert)
 }
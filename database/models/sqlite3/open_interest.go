@@ -0,0 +1,966 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// OpenInterest is an object representing the database table.
+type OpenInterest struct {
+	ID                string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID    string  `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Base              string  `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote             string  `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Asset             string  `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Interval          int64   `boil:"interval" json:"interval" toml:"interval" yaml:"interval"`
+	OpenInterest      float64 `boil:"open_interest" json:"open_interest" toml:"open_interest" yaml:"open_interest"`
+	OpenInterestValue float64 `boil:"open_interest_value" json:"open_interest_value" toml:"open_interest_value" yaml:"open_interest_value"`
+	Timestamp         string  `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *openInterestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L openInterestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OpenInterestColumns = struct {
+	ID                string
+	ExchangeNameID    string
+	Base              string
+	Quote             string
+	Asset             string
+	Interval          string
+	OpenInterest      string
+	OpenInterestValue string
+	Timestamp         string
+}{
+	ID:                "id",
+	ExchangeNameID:    "exchange_name_id",
+	Base:              "base",
+	Quote:             "quote",
+	Asset:             "asset",
+	Interval:          "interval",
+	OpenInterest:      "open_interest",
+	OpenInterestValue: "open_interest_value",
+	Timestamp:         "timestamp",
+}
+
+// Generated where
+
+var OpenInterestWhere = struct {
+	ID                whereHelperstring
+	ExchangeNameID    whereHelperstring
+	Base              whereHelperstring
+	Quote             whereHelperstring
+	Asset             whereHelperstring
+	Interval          whereHelperint64
+	OpenInterest      whereHelperfloat64
+	OpenInterestValue whereHelperfloat64
+	Timestamp         whereHelperstring
+}{
+	ID:                whereHelperstring{field: "\"open_interest\".\"id\""},
+	ExchangeNameID:    whereHelperstring{field: "\"open_interest\".\"exchange_name_id\""},
+	Base:              whereHelperstring{field: "\"open_interest\".\"base\""},
+	Quote:             whereHelperstring{field: "\"open_interest\".\"quote\""},
+	Asset:             whereHelperstring{field: "\"open_interest\".\"asset\""},
+	Interval:          whereHelperint64{field: "\"open_interest\".\"interval\""},
+	OpenInterest:      whereHelperfloat64{field: "\"open_interest\".\"open_interest\""},
+	OpenInterestValue: whereHelperfloat64{field: "\"open_interest\".\"open_interest_value\""},
+	Timestamp:         whereHelperstring{field: "\"open_interest\".\"timestamp\""},
+}
+
+// OpenInterestRels is where relationship names are stored.
+var OpenInterestRels = struct {
+	ExchangeName string
+}{
+	ExchangeName: "ExchangeName",
+}
+
+// openInterestR is where relationships are stored.
+type openInterestR struct {
+	ExchangeName *Exchange
+}
+
+// NewStruct creates a new relationship struct
+func (*openInterestR) NewStruct() *openInterestR {
+	return &openInterestR{}
+}
+
+// openInterestL is where Load methods for each relationship are stored.
+type openInterestL struct{}
+
+var (
+	openInterestAllColumns            = []string{"id", "exchange_name_id", "base", "quote", "asset", "interval", "open_interest", "open_interest_value", "timestamp"}
+	openInterestColumnsWithoutDefault = []string{"id", "exchange_name_id", "base", "quote", "asset", "interval", "open_interest", "open_interest_value", "timestamp"}
+	openInterestColumnsWithDefault    = []string{}
+	openInterestPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OpenInterestSlice is an alias for a slice of pointers to OpenInterest.
+	// This should generally be used opposed to []OpenInterest.
+	OpenInterestSlice []*OpenInterest
+	// OpenInterestHook is the signature for custom OpenInterest hook methods
+	OpenInterestHook func(context.Context, boil.ContextExecutor, *OpenInterest) error
+
+	openInterestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	openInterestType                 = reflect.TypeOf(&OpenInterest{})
+	openInterestMapping              = queries.MakeStructMapping(openInterestType)
+	openInterestPrimaryKeyMapping, _ = queries.BindMapping(openInterestType, openInterestMapping, openInterestPrimaryKeyColumns)
+	openInterestInsertCacheMut       sync.RWMutex
+	openInterestInsertCache          = make(map[string]insertCache)
+	openInterestUpdateCacheMut       sync.RWMutex
+	openInterestUpdateCache          = make(map[string]updateCache)
+	openInterestUpsertCacheMut       sync.RWMutex
+	openInterestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var openInterestBeforeInsertHooks []OpenInterestHook
+var openInterestBeforeUpdateHooks []OpenInterestHook
+var openInterestBeforeDeleteHooks []OpenInterestHook
+var openInterestBeforeUpsertHooks []OpenInterestHook
+
+var openInterestAfterInsertHooks []OpenInterestHook
+var openInterestAfterSelectHooks []OpenInterestHook
+var openInterestAfterUpdateHooks []OpenInterestHook
+var openInterestAfterDeleteHooks []OpenInterestHook
+var openInterestAfterUpsertHooks []OpenInterestHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OpenInterest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OpenInterest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OpenInterest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OpenInterest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OpenInterest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OpenInterest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OpenInterest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OpenInterest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OpenInterest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range openInterestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOpenInterestHook registers your hook function for all future operations.
+func AddOpenInterestHook(hookPoint boil.HookPoint, openInterestHook OpenInterestHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		openInterestBeforeInsertHooks = append(openInterestBeforeInsertHooks, openInterestHook)
+	case boil.BeforeUpdateHook:
+		openInterestBeforeUpdateHooks = append(openInterestBeforeUpdateHooks, openInterestHook)
+	case boil.BeforeDeleteHook:
+		openInterestBeforeDeleteHooks = append(openInterestBeforeDeleteHooks, openInterestHook)
+	case boil.BeforeUpsertHook:
+		openInterestBeforeUpsertHooks = append(openInterestBeforeUpsertHooks, openInterestHook)
+	case boil.AfterInsertHook:
+		openInterestAfterInsertHooks = append(openInterestAfterInsertHooks, openInterestHook)
+	case boil.AfterSelectHook:
+		openInterestAfterSelectHooks = append(openInterestAfterSelectHooks, openInterestHook)
+	case boil.AfterUpdateHook:
+		openInterestAfterUpdateHooks = append(openInterestAfterUpdateHooks, openInterestHook)
+	case boil.AfterDeleteHook:
+		openInterestAfterDeleteHooks = append(openInterestAfterDeleteHooks, openInterestHook)
+	case boil.AfterUpsertHook:
+		openInterestAfterUpsertHooks = append(openInterestAfterUpsertHooks, openInterestHook)
+	}
+}
+
+// One returns a single openInterest record from the query.
+func (q openInterestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OpenInterest, error) {
+	o := &OpenInterest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for open_interest")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OpenInterest records from the query.
+func (q openInterestQuery) All(ctx context.Context, exec boil.ContextExecutor) (OpenInterestSlice, error) {
+	var o []*OpenInterest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to OpenInterest slice")
+	}
+
+	if len(openInterestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OpenInterest records in the query.
+func (q openInterestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count open_interest rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q openInterestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if open_interest exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *OpenInterest) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (openInterestL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOpenInterest interface{}, mods queries.Applicator) error {
+	var slice []*OpenInterest
+	var object *OpenInterest
+
+	if singular {
+		object = maybeOpenInterest.(*OpenInterest)
+	} else {
+		slice = *maybeOpenInterest.(*[]*OpenInterest)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &openInterestR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &openInterestR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(openInterestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameOpenInterest = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameOpenInterest = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the openInterest to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameOpenInterest.
+func (o *OpenInterest) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"open_interest\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 0, openInterestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &openInterestR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameOpenInterest: o,
+		}
+	} else {
+		related.R.ExchangeNameOpenInterest = o
+	}
+
+	return nil
+}
+
+// OpenInterests retrieves all the records using an executor.
+func OpenInterests(mods ...qm.QueryMod) openInterestQuery {
+	mods = append(mods, qm.From("\"open_interest\""))
+	return openInterestQuery{NewQuery(mods...)}
+}
+
+// FindOpenInterest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOpenInterest(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OpenInterest, error) {
+	openInterestObj := &OpenInterest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"open_interest\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, openInterestObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from open_interest")
+	}
+
+	return openInterestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OpenInterest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no open_interest provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(openInterestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	openInterestInsertCacheMut.RLock()
+	cache, cached := openInterestInsertCache[key]
+	openInterestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			openInterestAllColumns,
+			openInterestColumnsWithDefault,
+			openInterestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(openInterestType, openInterestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(openInterestType, openInterestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"open_interest\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"open_interest\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"open_interest\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, openInterestPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into open_interest")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for open_interest")
+	}
+
+CacheNoHooks:
+	if !cached {
+		openInterestInsertCacheMut.Lock()
+		openInterestInsertCache[key] = cache
+		openInterestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OpenInterest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OpenInterest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	openInterestUpdateCacheMut.RLock()
+	cache, cached := openInterestUpdateCache[key]
+	openInterestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			openInterestAllColumns,
+			openInterestPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update open_interest, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"open_interest\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, openInterestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(openInterestType, openInterestMapping, append(wl, openInterestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update open_interest row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for open_interest")
+	}
+
+	if !cached {
+		openInterestUpdateCacheMut.Lock()
+		openInterestUpdateCache[key] = cache
+		openInterestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q openInterestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for open_interest")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for open_interest")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OpenInterestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), openInterestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"open_interest\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, openInterestPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in openInterest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all openInterest")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single OpenInterest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OpenInterest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no OpenInterest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), openInterestPrimaryKeyMapping)
+	sql := "DELETE FROM \"open_interest\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from open_interest")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for open_interest")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q openInterestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no openInterestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from open_interest")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for open_interest")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OpenInterestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(openInterestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), openInterestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"open_interest\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, openInterestPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from openInterest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for open_interest")
+	}
+
+	if len(openInterestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OpenInterest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOpenInterest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OpenInterestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OpenInterestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), openInterestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"open_interest\".* FROM \"open_interest\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, openInterestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in OpenInterestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OpenInterestExists checks if the OpenInterest row exists.
+func OpenInterestExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"open_interest\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if open_interest exists")
+	}
+
+	return exists, nil
+}
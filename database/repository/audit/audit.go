@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
 	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -35,6 +36,13 @@ func Event(id, msgtype, message string) {
 			Message:    message,
 		}
 		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		var tempEvent = modelMySQL.AuditEvent{
+			Type:       msgtype,
+			Identifier: id,
+			Message:    message,
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
 	} else {
 		var tempEvent = modelPSQL.AuditEvent{
 			Type:       msgtype,
@@ -80,6 +88,40 @@ func GetEvent(startTime, endTime time.Time, order string, limit int) (interface{
 	if repository.GetSQLDialect() == database.DBSQLite3 {
 		return modelSQLite.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
 	}
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return modelMySQL.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
+	}
 
 	return modelPSQL.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
 }
+
+// DeleteEventsBefore removes every audit event created before the supplied
+// time
+func DeleteEventsBefore(before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var totalDeleted int64
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		// sqlite3 stores the default timestamp without a time zone
+		totalDeleted, err = modelSQLite.AuditEvents(qm.Where("created_at < ?", before.UTC().Format("2006-01-02 15:04:05"))).DeleteAll(ctx, tx)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		totalDeleted, err = modelMySQL.AuditEvents(qm.Where("created_at < ?", before.UTC())).DeleteAll(ctx, tx)
+	} else {
+		totalDeleted, err = modelPSQL.AuditEvents(qm.Where("created_at < ?", before.UTC())).DeleteAll(ctx, tx)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "DeleteEventsBefore rollback failed: %v", errRB)
+		}
+		return 0, err
+	}
+	return totalDeleted, tx.Commit()
+}
@@ -16,6 +16,7 @@ import (
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -61,6 +62,17 @@ func TestAudit(t *testing.T) {
 			testhelpers.CloseDatabase,
 			nil,
 		},
+		{
+			"SQLite-Delete",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			deleteHelper,
+			testhelpers.CloseDatabase,
+			nil,
+		},
 		{
 			"Postgres-Write",
 			testhelpers.PostgresTestDatabase,
@@ -68,6 +80,13 @@ func TestAudit(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"MySQL-Write",
+			testhelpers.MySQLTestDatabase,
+			writeAudit,
+			nil,
+			nil,
+		},
 		{
 			"Postgres-Read",
 			testhelpers.PostgresTestDatabase,
@@ -75,6 +94,27 @@ func TestAudit(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"MySQL-Read",
+			testhelpers.MySQLTestDatabase,
+			readHelper,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Delete",
+			testhelpers.PostgresTestDatabase,
+			deleteHelper,
+			nil,
+			nil,
+		},
+		{
+			"MySQL-Delete",
+			testhelpers.MySQLTestDatabase,
+			deleteHelper,
+			nil,
+			nil,
+		},
 	}
 
 	for _, tests := range testCases {
@@ -128,3 +168,23 @@ func readHelper(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func deleteHelper(t *testing.T) {
+	t.Helper()
+	writeAudit(t)
+
+	deleted, err := DeleteEventsBefore(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Error(err)
+	}
+	if deleted != 0 {
+		t.Errorf("expected no events deleted, received %v", deleted)
+	}
+	deleted, err = DeleteEventsBefore(time.Now().Add(time.Minute))
+	if err != nil {
+		t.Error(err)
+	}
+	if deleted < 20 {
+		t.Errorf("expected at least 20 events deleted, received %v", deleted)
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
 	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -25,6 +26,9 @@ import (
 
 // Series returns candle data
 func Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (out Item, err error) {
+	if database.DB.SQL == nil {
+		return out, database.ErrDatabaseSupportDisabled
+	}
 	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
 		return out, errInvalidInput
 	}
@@ -32,7 +36,7 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 	queries := []qm.QueryMod{
 		qm.Where("base = ?", strings.ToUpper(base)),
 		qm.Where("quote = ?", strings.ToUpper(quote)),
-		qm.Where("interval = ?", interval),
+		qm.Where(intervalColumn()+" = ?", interval),
 		qm.Where("asset = ?", strings.ToLower(asset)),
 	}
 
@@ -61,8 +65,31 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 				Volume:    retCandle[x].Volume,
 			})
 		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		queries = append(queries,
+			qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()),
+			qm.OrderBy("timestamp"))
+		retCandle, errC := modelMySQL.Candles(queries...).All(context.Background(), database.DB.SQL)
+		if errC != nil {
+			return out, errC
+		}
+
+		for x := range retCandle {
+			out.Candles = append(out.Candles, Candle{
+				Timestamp: retCandle[x].Timestamp,
+				Open:      retCandle[x].Open,
+				High:      retCandle[x].High,
+				Low:       retCandle[x].Low,
+				Close:     retCandle[x].Close,
+				Volume:    retCandle[x].Volume,
+			})
+		}
 	} else {
-		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()))
+		// a typed timestamp range lets postgres prune time partitions and
+		// ordering by the partition key reads partitions in sequence
+		queries = append(queries,
+			qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()),
+			qm.OrderBy("timestamp"))
 		retCandle, errC := modelPSQL.Candles(queries...).All(context.Background(), database.DB.SQL)
 		if errC != nil {
 			return out, errC
@@ -80,7 +107,7 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 		}
 	}
 	if len(out.Candles) < 1 {
-		return out, fmt.Errorf(errNoCandleDataFound, exchangeName, base, quote, interval, asset)
+		return out, fmt.Errorf("%w: %v %v %v %v %v", ErrNoCandleDataFound, exchangeName, base, quote, interval, asset)
 	}
 
 	out.ExchangeID = exchangeName
@@ -104,7 +131,7 @@ func DeleteCandles(in *Item) (int64, error) {
 	queries := []qm.QueryMod{
 		qm.Where("base = ?", strings.ToUpper(in.Base)),
 		qm.Where("quote = ?", strings.ToUpper(in.Quote)),
-		qm.Where("interval = ?", in.Interval),
+		qm.Where(intervalColumn()+" = ?", in.Interval),
 		qm.Where("asset = ?", strings.ToLower(in.Asset)),
 		qm.Where("exchange_name_id = ?", in.ExchangeID),
 	}
@@ -114,9 +141,36 @@ func DeleteCandles(in *Item) (int64, error) {
 	}
 
 	queries = append(queries, qm.Where("timestamp between ? and ?", in.Candles[0].Timestamp.UTC(), in.Candles[len(in.Candles)-1].Timestamp.UTC()))
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return deleteMySQL(ctx, queries)
+	}
 	return deletePostgres(ctx, queries)
 }
 
+// DeleteCandlesTx deletes all existing matching candles within an existing
+// transaction
+func DeleteCandlesTx(ctx context.Context, tx *sql.Tx, in *Item) (int64, error) {
+	if len(in.Candles) < 1 {
+		return 0, errNoCandleData
+	}
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(in.Base)),
+		qm.Where("quote = ?", strings.ToUpper(in.Quote)),
+		qm.Where(intervalColumn()+" = ?", in.Interval),
+		qm.Where("asset = ?", strings.ToLower(in.Asset)),
+		qm.Where("exchange_name_id = ?", in.ExchangeID),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp between ? and ?", in.Candles[0].Timestamp.UTC().Format(time.RFC3339), in.Candles[len(in.Candles)-1].Timestamp.UTC().Format(time.RFC3339)))
+		return modelSQLite.Candles(queries...).DeleteAll(ctx, tx)
+	}
+	queries = append(queries, qm.Where("timestamp between ? and ?", in.Candles[0].Timestamp.UTC(), in.Candles[len(in.Candles)-1].Timestamp.UTC()))
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return modelMySQL.Candles(queries...).DeleteAll(ctx, tx)
+	}
+	return modelPSQL.Candles(queries...).DeleteAll(ctx, tx)
+}
+
 func deleteSQLite(ctx context.Context, queries []qm.QueryMod) (int64, error) {
 	retCandle, err := modelSQLite.Candles(queries...).All(context.Background(), database.DB.SQL)
 	if err != nil {
@@ -171,6 +225,33 @@ func deletePostgres(ctx context.Context, queries []qm.QueryMod) (int64, error) {
 	return totalDeleted, nil
 }
 
+func deleteMySQL(ctx context.Context, queries []qm.QueryMod) (int64, error) {
+	retCandle, err := modelMySQL.Candles(queries...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return 0, err
+	}
+	var tx *sql.Tx
+	tx, err = database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var totalDeleted int64
+	totalDeleted, err = retCandle.DeleteAll(ctx, tx)
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorln(log.DatabaseMgr, errRB)
+		}
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return totalDeleted, nil
+}
+
 // Insert series of candles
 func Insert(in *Item) (uint64, error) {
 	if database.DB.SQL == nil {
@@ -187,12 +268,7 @@ func Insert(in *Item) (uint64, error) {
 		return 0, err
 	}
 
-	var totalInserted uint64
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		totalInserted, err = insertSQLite(ctx, tx, in)
-	} else {
-		totalInserted, err = insertPostgresSQL(ctx, tx, in)
-	}
+	totalInserted, err := InsertTx(ctx, tx, in)
 	if err != nil {
 		errRB := tx.Rollback()
 		if errRB != nil {
@@ -208,6 +284,19 @@ func Insert(in *Item) (uint64, error) {
 	return totalInserted, nil
 }
 
+// InsertTx inserts a series of candles within an existing transaction
+func InsertTx(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
+	if len(in.Candles) < 1 {
+		return 0, errNoCandleData
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		return insertSQLite(ctx, tx, in)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		return insertMySQL(ctx, tx, in)
+	}
+	return insertPostgresSQL(ctx, tx, in)
+}
+
 func insertSQLite(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
 	var totalInserted uint64
 	for x := range in.Candles {
@@ -267,6 +356,39 @@ func insertPostgresSQL(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error
 	return totalInserted, nil
 }
 
+func insertMySQL(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
+	var totalInserted uint64
+	for x := range in.Candles {
+		tempUUID, err := uuid.NewV4()
+		if err != nil {
+			return 0, err
+		}
+		var tempCandle = modelMySQL.Candle{
+			ID:             tempUUID.String(),
+			ExchangeNameID: in.ExchangeID,
+			Base:           strings.ToUpper(in.Base),
+			Quote:          strings.ToUpper(in.Quote),
+			Interval:       in.Interval,
+			Asset:          strings.ToLower(in.Asset),
+			Timestamp:      in.Candles[x].Timestamp.UTC(),
+			Open:           in.Candles[x].Open,
+			High:           in.Candles[x].High,
+			Low:            in.Candles[x].Low,
+			Close:          in.Candles[x].Close,
+			Volume:         in.Candles[x].Volume,
+		}
+		// conflicts on the candle unique key keep the existing ID
+		err = tempCandle.Upsert(ctx, tx, boil.Whitelist("open", "high", "low", "close", "volume"), boil.Infer())
+		if err != nil {
+			return 0, err
+		}
+		if totalInserted < math.MaxUint64 {
+			totalInserted++
+		}
+	}
+	return totalInserted, nil
+}
+
 // InsertFromCSV load a CSV list of candle data and insert into database
 func InsertFromCSV(exchangeName, base, quote string, interval int64, asset, file string) (uint64, error) {
 	csvFile, err := os.Open(file)
@@ -348,3 +470,142 @@ func InsertFromCSV(exchangeName, base, quote string, interval int64, asset, file
 
 	return Insert(tempCandle)
 }
+
+// DeleteCandlesBefore removes every stored candle of an interval with a
+// timestamp before the supplied time
+func DeleteCandlesBefore(interval int64, before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	if interval <= 0 {
+		return 0, errInvalidInterval
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var totalDeleted int64
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		totalDeleted, err = modelSQLite.Candles(
+			qm.Where(intervalColumn()+" = ?", interval),
+			qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)),
+		).DeleteAll(ctx, tx)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		totalDeleted, err = modelMySQL.Candles(
+			qm.Where(intervalColumn()+" = ?", interval),
+			qm.Where("timestamp < ?", before.UTC()),
+		).DeleteAll(ctx, tx)
+	} else {
+		totalDeleted, err = modelPSQL.Candles(
+			qm.Where(intervalColumn()+" = ?", interval),
+			qm.Where("timestamp < ?", before.UTC()),
+		).DeleteAll(ctx, tx)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorln(log.DatabaseMgr, errRB)
+		}
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return totalDeleted, nil
+}
+
+// SeriesBefore returns every stored candle series of an interval which has
+// candles before the supplied time, along with the series' oldest candle time
+func SeriesBefore(interval int64, before time.Time) ([]SeriesDetails, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if interval <= 0 {
+		return nil, errInvalidInterval
+	}
+
+	ctx := context.Background()
+	// columns are aliased as sqlite3 reports the casing used when the
+	// candle table was created
+	selectQuery := qm.Select("exchange_name_id", "asset as asset", "base as base", "quote as quote", "min(timestamp) as oldest")
+	groupQuery := qm.GroupBy("exchange_name_id, asset, base, quote")
+	var resp []SeriesDetails
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var rows []struct {
+			ExchangeNameID string `boil:"exchange_name_id"`
+			Asset          string `boil:"asset"`
+			Base           string `boil:"base"`
+			Quote          string `boil:"quote"`
+			Oldest         string `boil:"oldest"`
+		}
+		err := modelSQLite.Candles(selectQuery,
+			qm.Where(intervalColumn()+" = ?", interval),
+			qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)),
+			groupQuery,
+		).Bind(ctx, database.DB.SQL, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			oldest, err := time.Parse(time.RFC3339, rows[i].Oldest)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, SeriesDetails{
+				ExchangeID: rows[i].ExchangeNameID,
+				Base:       rows[i].Base,
+				Quote:      rows[i].Quote,
+				Interval:   interval,
+				Asset:      rows[i].Asset,
+				Oldest:     oldest,
+			})
+		}
+		return resp, nil
+	}
+
+	var rows []struct {
+		ExchangeNameID string    `boil:"exchange_name_id"`
+		Asset          string    `boil:"asset"`
+		Base           string    `boil:"base"`
+		Quote          string    `boil:"quote"`
+		Oldest         time.Time `boil:"oldest"`
+	}
+	mods := []qm.QueryMod{selectQuery,
+		qm.Where(intervalColumn()+" = ?", interval),
+		qm.Where("timestamp < ?", before.UTC()),
+		groupQuery,
+	}
+	var err error
+	if repository.GetSQLDialect() == database.DBMySQL {
+		err = modelMySQL.Candles(mods...).Bind(ctx, database.DB.SQL, &rows)
+	} else {
+		err = modelPSQL.Candles(mods...).Bind(ctx, database.DB.SQL, &rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		resp = append(resp, SeriesDetails{
+			ExchangeID: rows[i].ExchangeNameID,
+			Base:       rows[i].Base,
+			Quote:      rows[i].Quote,
+			Interval:   interval,
+			Asset:      rows[i].Asset,
+			Oldest:     rows[i].Oldest.UTC(),
+		})
+	}
+	return resp, nil
+}
+
+// intervalColumn returns the quoted interval column name as interval is a
+// reserved word in mysql
+func intervalColumn() string {
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return "`interval`"
+	}
+	return "interval"
+}
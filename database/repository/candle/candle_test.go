@@ -35,6 +35,7 @@ func TestMain(m *testing.M) {
 
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -64,6 +65,11 @@ func TestInsert(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -137,6 +143,11 @@ func TestInsertFromCSV(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -198,6 +209,11 @@ func TestSeries(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -253,12 +269,8 @@ func TestSeries(t *testing.T) {
 				864000, "spot",
 				start, end)
 			if err != nil {
-				if !errors.Is(err, errInvalidInput) {
-					if err.Error() != fmt.Errorf(errNoCandleDataFound, testExchanges[0].Name,
-						"BTC", "MOON",
-						"864000", "spot").Error() {
-						t.Fatal(err)
-					}
+				if !errors.Is(err, errInvalidInput) && !errors.Is(err, ErrNoCandleDataFound) {
+					t.Fatal(err)
 				}
 			}
 
@@ -270,6 +282,103 @@ func TestSeries(t *testing.T) {
 	}
 }
 
+func TestDeleteCandlesBefore(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func(includeOHLCVData bool) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = test.seedDB(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			before := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+			_, err = SeriesBefore(0, before)
+			if !errors.Is(err, errInvalidInterval) {
+				t.Errorf("received %v, expected %v", err, errInvalidInterval)
+			}
+			series, err := SeriesBefore(86400, before)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(series) != 1 {
+				t.Fatalf("expected 1 series, received %v", len(series))
+			}
+			if !series[0].Oldest.Equal(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("unexpected oldest candle %v", series[0].Oldest)
+			}
+			if series[0].Base != "BTC" || series[0].Quote != "USDT" || series[0].Asset != "spot" {
+				t.Errorf("unexpected series %+v", series[0])
+			}
+
+			_, err = DeleteCandlesBefore(0, before)
+			if !errors.Is(err, errInvalidInterval) {
+				t.Errorf("received %v, expected %v", err, errInvalidInterval)
+			}
+			deleted, err := DeleteCandlesBefore(3600, before)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if deleted != 0 {
+				t.Errorf("expected no candles of another interval deleted, received %v", deleted)
+			}
+			deleted, err = DeleteCandlesBefore(86400, before)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if deleted != 181 {
+				t.Errorf("unexpected number deleted: %v", deleted)
+			}
+			series, err = SeriesBefore(86400, before)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(series) != 0 {
+				t.Errorf("expected no series before %v, received %v", before, len(series))
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func seedDB(includeOHLCVData bool) error {
 	err := exchange.InsertMany(testExchanges)
 	if err != nil {
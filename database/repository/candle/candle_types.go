@@ -5,13 +5,13 @@ import (
 	"time"
 )
 
-const (
-	errNoCandleDataFound = "no candle data found: %v %v %v %v %v"
-)
-
 var (
-	errInvalidInput = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
-	errNoCandleData = errors.New("no candle data provided")
+	// ErrNoCandleDataFound is returned when no candles are stored for a series
+	ErrNoCandleDataFound = errors.New("no candle data found")
+
+	errInvalidInput    = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
+	errNoCandleData    = errors.New("no candle data provided")
+	errInvalidInterval = errors.New("interval must be greater than zero")
 )
 
 // Item generic candle holder for modelPSQL & modelSQLite
@@ -34,3 +34,14 @@ type Candle struct {
 	Close     float64
 	Volume    float64
 }
+
+// SeriesDetails holds the details of a stored candle series and the time of its
+// oldest candle
+type SeriesDetails struct {
+	ExchangeID string
+	Base       string
+	Quote      string
+	Interval   int64
+	Asset      string
+	Oldest     time.Time
+}
@@ -0,0 +1,474 @@
+package datahistoryjob
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
+	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/idoall/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var errNicknameNotSet = errors.New("data history job nickname not set")
+
+// Upsert inserts or updates jobs into the database
+func Upsert(jobs ...*DataHistoryJob) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	for i := range jobs {
+		if jobs[i].Nickname == "" {
+			return errNicknameNotSet
+		}
+		if jobs[i].ExchangeID == "" {
+			if jobs[i].ExchangeName == "" {
+				return errors.New("exchange name/uuid not set, cannot upsert")
+			}
+			exchangeUUID, err := exchange.UUIDByName(jobs[i].ExchangeName)
+			if err != nil {
+				return err
+			}
+			jobs[i].ExchangeID = exchangeUUID.String()
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = upsertSQLite(ctx, tx, jobs...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = upsertMySQL(ctx, tx, jobs...)
+	} else {
+		err = upsertPostgres(ctx, tx, jobs...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) error {
+	for i := range jobs {
+		existing, err := modelSQLite.Datahistoryjobs(
+			qm.Where("nickname = ?", strings.ToLower(jobs[i].Nickname)),
+		).One(ctx, tx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		insert := existing == nil
+		if insert {
+			if jobs[i].ID == "" {
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				jobs[i].ID = freshUUID.String()
+			}
+			if jobs[i].CreatedDate.IsZero() {
+				jobs[i].CreatedDate = time.Now()
+			}
+		} else {
+			jobs[i].ID = existing.ID
+			jobs[i].CreatedDate, err = time.Parse(time.RFC3339, existing.Created)
+			if err != nil {
+				return err
+			}
+		}
+		var tempEvent = modelSQLite.Datahistoryjob{
+			ID:             jobs[i].ID,
+			Nickname:       strings.ToLower(jobs[i].Nickname),
+			ExchangeNameID: jobs[i].ExchangeID,
+			Asset:          strings.ToLower(jobs[i].Asset),
+			Base:           strings.ToUpper(jobs[i].Base),
+			Quote:          strings.ToUpper(jobs[i].Quote),
+			StartTime:      jobs[i].StartDate.UTC().Format(time.RFC3339),
+			EndTime:        jobs[i].EndDate.UTC().Format(time.RFC3339),
+			Interval:       jobs[i].Interval,
+			DataType:       jobs[i].DataType,
+			RequestSize:    jobs[i].RequestSizeLimit,
+			MaxRetries:     jobs[i].MaxRetryAttempts,
+			BatchCount:     jobs[i].BatchSize,
+			Status:         jobs[i].Status,
+			Created:        jobs[i].CreatedDate.UTC().Format(time.RFC3339),
+		}
+		if insert {
+			err = tempEvent.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempEvent.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) error {
+	for i := range jobs {
+		if jobs[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			jobs[i].ID = freshUUID.String()
+		}
+		if jobs[i].CreatedDate.IsZero() {
+			jobs[i].CreatedDate = time.Now()
+		}
+		var tempEvent = modelPSQL.Datahistoryjob{
+			ID:             jobs[i].ID,
+			Nickname:       strings.ToLower(jobs[i].Nickname),
+			ExchangeNameID: jobs[i].ExchangeID,
+			Asset:          strings.ToLower(jobs[i].Asset),
+			Base:           strings.ToUpper(jobs[i].Base),
+			Quote:          strings.ToUpper(jobs[i].Quote),
+			StartTime:      jobs[i].StartDate.UTC(),
+			EndTime:        jobs[i].EndDate.UTC(),
+			Interval:       jobs[i].Interval,
+			DataType:       int(jobs[i].DataType),
+			RequestSize:    int(jobs[i].RequestSizeLimit),
+			MaxRetries:     int(jobs[i].MaxRetryAttempts),
+			BatchCount:     int(jobs[i].BatchSize),
+			Status:         int(jobs[i].Status),
+			Created:        jobs[i].CreatedDate.UTC(),
+		}
+		// the id and created date of an existing job are preserved on conflict
+		err := tempEvent.Upsert(ctx, tx, true, []string{"nickname"},
+			boil.Blacklist("id", "created"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsertMySQL looks up existing jobs by nickname as a mysql upsert cannot
+// report the id of the row it updated
+func upsertMySQL(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) error {
+	for i := range jobs {
+		existing, err := modelMySQL.Datahistoryjobs(
+			qm.Where("nickname = ?", strings.ToLower(jobs[i].Nickname)),
+		).One(ctx, tx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		insert := existing == nil
+		if insert {
+			if jobs[i].ID == "" {
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				jobs[i].ID = freshUUID.String()
+			}
+			if jobs[i].CreatedDate.IsZero() {
+				jobs[i].CreatedDate = time.Now()
+			}
+		} else {
+			jobs[i].ID = existing.ID
+			jobs[i].CreatedDate = existing.Created
+		}
+		var tempEvent = modelMySQL.Datahistoryjob{
+			ID:             jobs[i].ID,
+			Nickname:       strings.ToLower(jobs[i].Nickname),
+			ExchangeNameID: jobs[i].ExchangeID,
+			Asset:          strings.ToLower(jobs[i].Asset),
+			Base:           strings.ToUpper(jobs[i].Base),
+			Quote:          strings.ToUpper(jobs[i].Quote),
+			StartTime:      jobs[i].StartDate.UTC(),
+			EndTime:        jobs[i].EndDate.UTC(),
+			Interval:       jobs[i].Interval,
+			DataType:       int(jobs[i].DataType),
+			RequestSize:    int(jobs[i].RequestSizeLimit),
+			MaxRetries:     int(jobs[i].MaxRetryAttempts),
+			BatchCount:     int(jobs[i].BatchSize),
+			Status:         int(jobs[i].Status),
+			Created:        jobs[i].CreatedDate.UTC(),
+		}
+		if insert {
+			err = tempEvent.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempEvent.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByNickName returns a job by its nickname
+func GetByNickName(nickname string) (*DataHistoryJob, error) {
+	return getOne(false, qm.Where("nickname = ?", strings.ToLower(nickname)))
+}
+
+// GetByID returns a job by its id
+func GetByID(id string) (*DataHistoryJob, error) {
+	return getOne(false, qm.Where("id = ?", id))
+}
+
+// GetJobAndAllResults returns a job by its nickname along with all of its
+// results
+func GetJobAndAllResults(nickname string) (*DataHistoryJob, error) {
+	return getOne(true, qm.Where("nickname = ?", strings.ToLower(nickname)))
+}
+
+// GetJobsBetween returns all jobs created between a set of dates
+func GetJobsBetween(startDate, endDate time.Time) ([]DataHistoryJob, error) {
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return getMany(false, qm.Where("created BETWEEN ? AND ?",
+			startDate.UTC(),
+			endDate.UTC()))
+	}
+	return getMany(false, qm.Where("created BETWEEN ? AND ?",
+		startDate.UTC().Format(time.RFC3339),
+		endDate.UTC().Format(time.RFC3339)))
+}
+
+// GetJobsByStatus returns all jobs with a status along with all of their
+// results
+func GetJobsByStatus(status int64) ([]DataHistoryJob, error) {
+	return getMany(true, qm.Where("status = ?", status))
+}
+
+func getOne(withResults bool, where qm.QueryMod) (*DataHistoryJob, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	var job DataHistoryJob
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		var result *modelSQLite.Datahistoryjob
+		result, err = modelSQLite.Datahistoryjobs(sqliteQuery(withResults, where)...).One(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		job, err = sqliteToJob(result)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		var result *modelMySQL.Datahistoryjob
+		result, err = modelMySQL.Datahistoryjobs(mysqlQuery(withResults, where)...).One(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		job = mysqlToJob(result)
+	} else {
+		var result *modelPSQL.Datahistoryjob
+		result, err = modelPSQL.Datahistoryjobs(postgresQuery(withResults, where)...).One(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		job = postgresToJob(result)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func getMany(withResults bool, where qm.QueryMod) ([]DataHistoryJob, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	var jobs []DataHistoryJob
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		results, err := modelSQLite.Datahistoryjobs(sqliteQuery(withResults, where)...).All(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			job, err := sqliteToJob(results[i])
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, job)
+		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		results, err := modelMySQL.Datahistoryjobs(mysqlQuery(withResults, where)...).All(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			jobs = append(jobs, mysqlToJob(results[i]))
+		}
+	} else {
+		results, err := modelPSQL.Datahistoryjobs(postgresQuery(withResults, where)...).All(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			jobs = append(jobs, postgresToJob(results[i]))
+		}
+	}
+	return jobs, nil
+}
+
+func sqliteQuery(withResults bool, where qm.QueryMod) []qm.QueryMod {
+	query := []qm.QueryMod{where, qm.Load(modelSQLite.DatahistoryjobRels.ExchangeName)}
+	if withResults {
+		query = append(query, qm.Load(modelSQLite.DatahistoryjobRels.JobDatahistoryjobresults,
+			qm.OrderBy("interval_start_time")))
+	}
+	return query
+}
+
+func postgresQuery(withResults bool, where qm.QueryMod) []qm.QueryMod {
+	query := []qm.QueryMod{where, qm.Load(modelPSQL.DatahistoryjobRels.ExchangeName)}
+	if withResults {
+		query = append(query, qm.Load(modelPSQL.DatahistoryjobRels.JobDatahistoryjobresults,
+			qm.OrderBy("interval_start_time")))
+	}
+	return query
+}
+
+func mysqlQuery(withResults bool, where qm.QueryMod) []qm.QueryMod {
+	query := []qm.QueryMod{where, qm.Load(modelMySQL.DatahistoryjobRels.ExchangeName)}
+	if withResults {
+		query = append(query, qm.Load(modelMySQL.DatahistoryjobRels.JobDatahistoryjobresults,
+			qm.OrderBy("interval_start_time")))
+	}
+	return query
+}
+
+func sqliteToJob(r *modelSQLite.Datahistoryjob) (DataHistoryJob, error) {
+	start, err := time.Parse(time.RFC3339, r.StartTime)
+	if err != nil {
+		return DataHistoryJob{}, err
+	}
+	end, err := time.Parse(time.RFC3339, r.EndTime)
+	if err != nil {
+		return DataHistoryJob{}, err
+	}
+	created, err := time.Parse(time.RFC3339, r.Created)
+	if err != nil {
+		return DataHistoryJob{}, err
+	}
+	job := DataHistoryJob{
+		ID:               r.ID,
+		Nickname:         r.Nickname,
+		ExchangeID:       r.ExchangeNameID,
+		Asset:            r.Asset,
+		Base:             r.Base,
+		Quote:            r.Quote,
+		StartDate:        start,
+		EndDate:          end,
+		Interval:         r.Interval,
+		RequestSizeLimit: r.RequestSize,
+		DataType:         r.DataType,
+		MaxRetryAttempts: r.MaxRetries,
+		BatchSize:        r.BatchCount,
+		Status:           r.Status,
+		CreatedDate:      created,
+	}
+	if r.R == nil {
+		return job, nil
+	}
+	if r.R.ExchangeName != nil {
+		job.ExchangeName = r.R.ExchangeName.Name
+	}
+	for i := range r.R.JobDatahistoryjobresults {
+		var result datahistoryjobresult.DataHistoryJobResult
+		result, err = datahistoryjobresult.SQLiteToResult(r.R.JobDatahistoryjobresults[i])
+		if err != nil {
+			return DataHistoryJob{}, err
+		}
+		job.Results = append(job.Results, result)
+	}
+	return job, nil
+}
+
+func postgresToJob(r *modelPSQL.Datahistoryjob) DataHistoryJob {
+	job := DataHistoryJob{
+		ID:               r.ID,
+		Nickname:         r.Nickname,
+		ExchangeID:       r.ExchangeNameID,
+		Asset:            r.Asset,
+		Base:             r.Base,
+		Quote:            r.Quote,
+		StartDate:        r.StartTime,
+		EndDate:          r.EndTime,
+		Interval:         r.Interval,
+		RequestSizeLimit: int64(r.RequestSize),
+		DataType:         int64(r.DataType),
+		MaxRetryAttempts: int64(r.MaxRetries),
+		BatchSize:        int64(r.BatchCount),
+		Status:           int64(r.Status),
+		CreatedDate:      r.Created,
+	}
+	if r.R == nil {
+		return job
+	}
+	if r.R.ExchangeName != nil {
+		job.ExchangeName = r.R.ExchangeName.Name
+	}
+	for i := range r.R.JobDatahistoryjobresults {
+		job.Results = append(job.Results,
+			datahistoryjobresult.PostgresToResult(r.R.JobDatahistoryjobresults[i]))
+	}
+	return job
+}
+
+func mysqlToJob(r *modelMySQL.Datahistoryjob) DataHistoryJob {
+	job := DataHistoryJob{
+		ID:               r.ID,
+		Nickname:         r.Nickname,
+		ExchangeID:       r.ExchangeNameID,
+		Asset:            r.Asset,
+		Base:             r.Base,
+		Quote:            r.Quote,
+		StartDate:        r.StartTime,
+		EndDate:          r.EndTime,
+		Interval:         r.Interval,
+		RequestSizeLimit: int64(r.RequestSize),
+		DataType:         int64(r.DataType),
+		MaxRetryAttempts: int64(r.MaxRetries),
+		BatchSize:        int64(r.BatchCount),
+		Status:           int64(r.Status),
+		CreatedDate:      r.Created,
+	}
+	if r.R == nil {
+		return job
+	}
+	if r.R.ExchangeName != nil {
+		job.ExchangeName = r.R.ExchangeName.Name
+	}
+	for i := range r.R.JobDatahistoryjobresults {
+		job.Results = append(job.Results,
+			datahistoryjobresult.MySQLToResult(r.R.JobDatahistoryjobresults[i]))
+	}
+	return job
+}
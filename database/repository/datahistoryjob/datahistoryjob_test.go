@@ -0,0 +1,174 @@
+package datahistoryjob
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestDataHistoryJob(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			dataHistoryJobSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func dataHistoryJobSQLTester(t *testing.T) {
+	start := time.Now().Add(-time.Hour * 24).Truncate(time.Hour)
+	job := &DataHistoryJob{
+		Nickname:         "TestDataHistoryJob",
+		ExchangeName:     testExchanges[0].Name,
+		Asset:            asset.Spot.String(),
+		Base:             currency.BTC.String(),
+		Quote:            currency.USDT.String(),
+		StartDate:        start,
+		EndDate:          start.Add(time.Hour * 12),
+		Interval:         int64(time.Hour.Seconds()),
+		RequestSizeLimit: 500,
+		MaxRetryAttempts: 3,
+		BatchSize:        1,
+	}
+	err := Upsert(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := job.ID
+
+	// upserting the same nickname should update rather than insert
+	job.ID = ""
+	job.Status = 1
+	err = Upsert(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetByNickName("testdatahistoryjob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != id {
+		t.Errorf("expected id %v received %v", id, resp.ID)
+	}
+	if resp.Status != 1 {
+		t.Errorf("expected status 1 received %v", resp.Status)
+	}
+	if resp.ExchangeName != testExchanges[0].Name {
+		t.Errorf("expected exchange %v received %v", testExchanges[0].Name, resp.ExchangeName)
+	}
+	if !resp.StartDate.Equal(start) {
+		t.Errorf("expected start %v received %v", start, resp.StartDate)
+	}
+
+	_, err = GetByID(id)
+	if err != nil {
+		t.Error(err)
+	}
+
+	jobs, err := GetJobsBetween(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 {
+		t.Errorf("expected 1 job received %v", len(jobs))
+	}
+
+	jobs, err = GetJobsByStatus(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 {
+		t.Errorf("expected 1 job received %v", len(jobs))
+	}
+
+	_, err = GetJobAndAllResults("TestDataHistoryJob")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
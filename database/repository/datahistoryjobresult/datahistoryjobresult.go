@@ -0,0 +1,301 @@
+package datahistoryjobresult
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
+	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/idoall/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+var errJobIDNotSet = errors.New("data history job id not set")
+
+// Upsert inserts or updates data history job results
+func Upsert(results ...*DataHistoryJobResult) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	for i := range results {
+		if results[i].JobID == "" {
+			return errJobIDNotSet
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = upsertSQLite(ctx, tx, results...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = upsertMySQL(ctx, tx, results...)
+	} else {
+		err = upsertPostgres(ctx, tx, results...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobResult) error {
+	for i := range results {
+		insert := results[i].ID == ""
+		if insert {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			results[i].ID = freshUUID.String()
+		}
+		if results[i].Date.IsZero() {
+			results[i].Date = time.Now()
+		}
+		var tempEvent = modelSQLite.Datahistoryjobresult{
+			ID:                results[i].ID,
+			JobID:             results[i].JobID,
+			Result:            null.NewString(results[i].Result, results[i].Result != ""),
+			Status:            results[i].Status,
+			IntervalStartTime: results[i].IntervalStartDate.UTC().Format(time.RFC3339),
+			IntervalEndTime:   results[i].IntervalEndDate.UTC().Format(time.RFC3339),
+			RunTime:           results[i].Date.UTC().Format(time.RFC3339),
+		}
+		var err error
+		if insert {
+			err = tempEvent.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempEvent.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobResult) error {
+	for i := range results {
+		if results[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			results[i].ID = freshUUID.String()
+		}
+		if results[i].Date.IsZero() {
+			results[i].Date = time.Now()
+		}
+		var tempEvent = modelPSQL.Datahistoryjobresult{
+			ID:                results[i].ID,
+			JobID:             results[i].JobID,
+			Result:            null.NewString(results[i].Result, results[i].Result != ""),
+			Status:            int(results[i].Status),
+			IntervalStartTime: results[i].IntervalStartDate.UTC(),
+			IntervalEndTime:   results[i].IntervalEndDate.UTC(),
+			RunTime:           results[i].Date.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertMySQL(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobResult) error {
+	for i := range results {
+		if results[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			results[i].ID = freshUUID.String()
+		}
+		if results[i].Date.IsZero() {
+			results[i].Date = time.Now()
+		}
+		var tempEvent = modelMySQL.Datahistoryjobresult{
+			ID:                results[i].ID,
+			JobID:             results[i].JobID,
+			Result:            null.NewString(results[i].Result, results[i].Result != ""),
+			Status:            int(results[i].Status),
+			IntervalStartTime: results[i].IntervalStartDate.UTC(),
+			IntervalEndTime:   results[i].IntervalEndDate.UTC(),
+			RunTime:           results[i].Date.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByJobID returns all results for a data history job
+func GetByJobID(jobID string) ([]DataHistoryJobResult, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if jobID == "" {
+		return nil, errJobIDNotSet
+	}
+	query := []qm.QueryMod{
+		qm.Where("job_id = ?", jobID),
+		qm.OrderBy("interval_start_time"),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return getSQLite(query)
+	}
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return getMySQL(query)
+	}
+	return getPostgres(query)
+}
+
+// GetInRange returns the results for a data history job with an interval
+// start time between a set of dates
+func GetInRange(jobID string, startDate, endDate time.Time) ([]DataHistoryJobResult, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if jobID == "" {
+		return nil, errJobIDNotSet
+	}
+	query := []qm.QueryMod{
+		qm.Where("job_id = ?", jobID),
+		qm.OrderBy("interval_start_time"),
+	}
+	if repository.GetSQLDialect() == database.DBMySQL {
+		query = append(query, qm.Where("interval_start_time BETWEEN ? AND ?",
+			startDate.UTC(),
+			endDate.UTC()))
+		return getMySQL(query)
+	}
+	query = append(query, qm.Where("interval_start_time BETWEEN ? AND ?",
+		startDate.UTC().Format(time.RFC3339),
+		endDate.UTC().Format(time.RFC3339)))
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return getSQLite(query)
+	}
+	return getPostgres(query)
+}
+
+func getSQLite(query []qm.QueryMod) ([]DataHistoryJobResult, error) {
+	results, err := modelSQLite.Datahistoryjobresults(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]DataHistoryJobResult, len(results))
+	for i := range results {
+		resp[i], err = SQLiteToResult(results[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func getPostgres(query []qm.QueryMod) ([]DataHistoryJobResult, error) {
+	results, err := modelPSQL.Datahistoryjobresults(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]DataHistoryJobResult, len(results))
+	for i := range results {
+		resp[i] = PostgresToResult(results[i])
+	}
+	return resp, nil
+}
+
+func getMySQL(query []qm.QueryMod) ([]DataHistoryJobResult, error) {
+	results, err := modelMySQL.Datahistoryjobresults(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]DataHistoryJobResult, len(results))
+	for i := range results {
+		resp[i] = MySQLToResult(results[i])
+	}
+	return resp, nil
+}
+
+// SQLiteToResult converts a sqlite model to a data history job result
+func SQLiteToResult(r *modelSQLite.Datahistoryjobresult) (DataHistoryJobResult, error) {
+	start, err := time.Parse(time.RFC3339, r.IntervalStartTime)
+	if err != nil {
+		return DataHistoryJobResult{}, err
+	}
+	end, err := time.Parse(time.RFC3339, r.IntervalEndTime)
+	if err != nil {
+		return DataHistoryJobResult{}, err
+	}
+	runTime, err := time.Parse(time.RFC3339, r.RunTime)
+	if err != nil {
+		return DataHistoryJobResult{}, err
+	}
+	return DataHistoryJobResult{
+		ID:                r.ID,
+		JobID:             r.JobID,
+		IntervalStartDate: start,
+		IntervalEndDate:   end,
+		Status:            r.Status,
+		Result:            r.Result.String,
+		Date:              runTime,
+	}, nil
+}
+
+// PostgresToResult converts a postgres model to a data history job result
+func PostgresToResult(r *modelPSQL.Datahistoryjobresult) DataHistoryJobResult {
+	return DataHistoryJobResult{
+		ID:                r.ID,
+		JobID:             r.JobID,
+		IntervalStartDate: r.IntervalStartTime,
+		IntervalEndDate:   r.IntervalEndTime,
+		Status:            int64(r.Status),
+		Result:            r.Result.String,
+		Date:              r.RunTime,
+	}
+}
+
+// MySQLToResult converts a mysql model to a data history job result
+func MySQLToResult(r *modelMySQL.Datahistoryjobresult) DataHistoryJobResult {
+	return DataHistoryJobResult{
+		ID:                r.ID,
+		JobID:             r.JobID,
+		IntervalStartDate: r.IntervalStartTime,
+		IntervalEndDate:   r.IntervalEndTime,
+		Status:            int64(r.Status),
+		Result:            r.Result.String,
+		Date:              r.RunTime,
+	}
+}
@@ -0,0 +1,173 @@
+package datahistoryjobresult_test
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/repository/datahistoryjob"
+	"github.com/idoall/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+	testJob = &datahistoryjob.DataHistoryJob{
+		Nickname:         "TestDataHistoryJobResult",
+		ExchangeName:     "one",
+		Asset:            asset.Spot.String(),
+		Base:             currency.BTC.String(),
+		Quote:            currency.USDT.String(),
+		StartDate:        time.Now().Add(-time.Hour * 24).Truncate(time.Hour),
+		EndDate:          time.Now().Truncate(time.Hour),
+		Interval:         int64(time.Hour.Seconds()),
+		RequestSizeLimit: 10,
+		MaxRetryAttempts: 3,
+		BatchSize:        1,
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestDataHistoryJobResult(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			dataHistoryJobResultSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func dataHistoryJobResultSQLTester(t *testing.T) {
+	var results []*datahistoryjobresult.DataHistoryJobResult
+	for i := 0; i < 10; i++ {
+		start := testJob.StartDate.Add(time.Hour * time.Duration(i))
+		results = append(results, &datahistoryjobresult.DataHistoryJobResult{
+			JobID:             testJob.ID,
+			IntervalStartDate: start,
+			IntervalEndDate:   start.Add(time.Hour),
+			Status:            1,
+		})
+	}
+	err := datahistoryjobresult.Upsert(results...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// update an existing result
+	results[0].Status = 2
+	results[0].Result = "failed"
+	err = datahistoryjobresult.Upsert(results[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := datahistoryjobresult.GetByJobID(testJob.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Fatalf("expected 10 results received %v", len(resp))
+	}
+	if resp[0].Status != 2 || resp[0].Result != "failed" {
+		t.Errorf("expected updated result, received %+v", resp[0])
+	}
+
+	resp, err = datahistoryjobresult.GetInRange(testJob.ID,
+		testJob.StartDate,
+		testJob.StartDate.Add(time.Hour*4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 5 {
+		t.Errorf("expected 5 results received %v", len(resp))
+	}
+
+	job, err := datahistoryjob.GetJobAndAllResults(testJob.Nickname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(job.Results) != 10 {
+		t.Errorf("expected 10 job results received %v", len(job.Results))
+	}
+}
+
+func seedDB() error {
+	err := exchange.InsertMany(testExchanges)
+	if err != nil {
+		return err
+	}
+	testJob.ID = ""
+	testJob.ExchangeID = ""
+	return datahistoryjob.Upsert(testJob)
+}
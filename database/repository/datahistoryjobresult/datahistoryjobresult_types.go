@@ -0,0 +1,15 @@
+package datahistoryjobresult
+
+import "time"
+
+// DataHistoryJobResult defines the result of a single batch run of a data
+// history job in its simplest db friendly form
+type DataHistoryJobResult struct {
+	ID                string
+	JobID             string
+	IntervalStartDate time.Time
+	IntervalEndDate   time.Time
+	Status            int64
+	Result            string
+	Date              time.Time
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/common/cache"
 	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
 	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -46,6 +47,16 @@ func one(in, clause string) (out Details, err error) {
 		if errS != nil {
 			return out, errS
 		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		ret, errS := modelMySQL.Exchanges(whereQM).One(context.Background(), database.DB.SQL)
+		if errS != nil {
+			return out, errS
+		}
+		out.Name = ret.Name
+		out.UUID, errS = uuid.FromString(ret.ID)
+		if errS != nil {
+			return out, errS
+		}
 	} else {
 		ret, errS := modelPSQL.Exchanges(whereQM).One(context.Background(), database.DB.SQL)
 		if errS != nil {
@@ -74,6 +85,8 @@ func Insert(in Details) error {
 	}
 	if repository.GetSQLDialect() == database.DBSQLite3 {
 		err = insertSQLite(ctx, tx, []Details{in})
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = insertMySQL(ctx, tx, []Details{in})
 	} else {
 		err = insertPostgresql(ctx, tx, []Details{in})
 	}
@@ -108,6 +121,8 @@ func InsertMany(in []Details) error {
 
 	if repository.GetSQLDialect() == database.DBSQLite3 {
 		err = insertSQLite(ctx, tx, in)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = insertMySQL(ctx, tx, in)
 	} else {
 		err = insertPostgresql(ctx, tx, in)
 	}
@@ -173,6 +188,31 @@ func insertPostgresql(ctx context.Context, tx *sql.Tx, in []Details) (err error)
 	return nil
 }
 
+func insertMySQL(ctx context.Context, tx *sql.Tx, in []Details) (err error) {
+	for x := range in {
+		// mysql has no uuid column default so one is supplied, an existing
+		// exchange keeps its ID as only the name is updated on conflict
+		tempUUID, errUUID := uuid.NewV4()
+		if errUUID != nil {
+			return errUUID
+		}
+		var tempInsert = modelMySQL.Exchange{
+			Name: strings.ToLower(in[x].Name),
+			ID:   tempUUID.String(),
+		}
+
+		err = tempInsert.Upsert(ctx, tx, boil.Whitelist("name"), boil.Infer())
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorln(log.DatabaseMgr, errRB)
+			}
+			return
+		}
+	}
+	return nil
+}
+
 // UUIDByName returns UUID of exchange
 func UUIDByName(exchange string) (uuid.UUID, error) {
 	exchange = strings.ToLower(exchange)
@@ -35,6 +35,7 @@ func TestMain(m *testing.M) {
 
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -64,6 +65,11 @@ func TestInsertMany(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seed,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seed,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -120,6 +126,11 @@ func TestOneAndOneByUUID(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seed,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seed,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -0,0 +1,313 @@
+package liquidation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/database"
+	dbmysql "github.com/idoall/gocryptotrader/database/drivers/mysql"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
+	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves liquidation data to the database
+func Insert(liquidations ...Data) error {
+	for i := range liquidations {
+		if liquidations[i].ExchangeNameID == "" && liquidations[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(liquidations[i].Exchange)
+			if err != nil {
+				return err
+			}
+			liquidations[i].ExchangeNameID = exchangeUUID.String()
+		} else if liquidations[i].ExchangeNameID == "" && liquidations[i].Exchange == "" {
+			return errors.New("exchange name/uuid not set, cannot insert")
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, liquidations...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = insertMySQL(ctx, tx, liquidations...)
+	} else {
+		err = insertPostgres(ctx, tx, liquidations...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, liquidations ...Data) error {
+	for i := range liquidations {
+		if liquidations[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			liquidations[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.Liquidation{
+			ID:             liquidations[i].ID,
+			ExchangeNameID: liquidations[i].ExchangeNameID,
+			Base:           strings.ToUpper(liquidations[i].Base),
+			Quote:          strings.ToUpper(liquidations[i].Quote),
+			Asset:          strings.ToLower(liquidations[i].AssetType),
+			Side:           strings.ToUpper(liquidations[i].Side),
+			Price:          liquidations[i].Price,
+			Amount:         liquidations[i].Amount,
+			Timestamp:      liquidations[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, liquidations ...Data) error {
+	var err error
+	for i := range liquidations {
+		if liquidations[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			liquidations[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.Liquidation{
+			ID:             liquidations[i].ID,
+			ExchangeNameID: liquidations[i].ExchangeNameID,
+			Base:           strings.ToUpper(liquidations[i].Base),
+			Quote:          strings.ToUpper(liquidations[i].Quote),
+			Asset:          strings.ToLower(liquidations[i].AssetType),
+			Side:           strings.ToUpper(liquidations[i].Side),
+			Price:          liquidations[i].Price,
+			Amount:         liquidations[i].Amount,
+			Timestamp:      liquidations[i].Timestamp.UTC(),
+		}
+
+		err = tempEvent.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertMySQL(ctx context.Context, tx *sql.Tx, liquidations ...Data) error {
+	for i := range liquidations {
+		if liquidations[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			liquidations[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelMySQL.Liquidation{
+			ID:             liquidations[i].ID,
+			ExchangeNameID: liquidations[i].ExchangeNameID,
+			Base:           strings.ToUpper(liquidations[i].Base),
+			Quote:          strings.ToUpper(liquidations[i].Quote),
+			Asset:          strings.ToLower(liquidations[i].AssetType),
+			Side:           strings.ToUpper(liquidations[i].Side),
+			Price:          liquidations[i].Price,
+			Amount:         liquidations[i].Amount,
+			Timestamp:      liquidations[i].Timestamp.UTC(),
+		}
+
+		// matches the postgres upsert which ignores conflicts
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil && !dbmysql.IsDuplicateEntry(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all liquidations by an exchange in a date range
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (ld []Data, err error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		ld, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return ld, fmt.Errorf("liquidation.GetInRange getInRangeSQLite %w", err)
+		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		ld, err = getInRangeMySQL(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return ld, fmt.Errorf("liquidation.GetInRange getInRangeMySQL %w", err)
+		}
+	} else {
+		ld, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return ld, fmt.Errorf("liquidation.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return ld, nil
+}
+
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (ld []Data, err error) {
+	var exchangeUUID uuid.UUID
+	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]interface{}{
+		"exchange_name_id": exchangeUUID,
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+	}
+	q := generateQuery(wheres, startDate, endDate)
+	query := modelSQLite.Liquidations(q...)
+	var result []*modelSQLite.Liquidation
+	result, err = query.All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return ld, err
+	}
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return ld, err
+		}
+		ld = append(ld, Data{
+			ID:        result[i].ID,
+			Timestamp: ts,
+			Exchange:  strings.ToLower(exchangeName),
+			Base:      strings.ToUpper(result[i].Base),
+			Quote:     strings.ToUpper(result[i].Quote),
+			AssetType: strings.ToLower(result[i].Asset),
+			Side:      result[i].Side,
+			Price:     result[i].Price,
+			Amount:    result[i].Amount,
+		})
+	}
+	return ld, nil
+}
+
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (ld []Data, err error) {
+	var exchangeUUID uuid.UUID
+	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]interface{}{
+		"exchange_name_id": exchangeUUID,
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+	}
+	q := generateQuery(wheres, startDate, endDate)
+	query := modelPSQL.Liquidations(q...)
+	var result []*modelPSQL.Liquidation
+	result, err = query.All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return ld, err
+	}
+	for i := range result {
+		ld = append(ld, Data{
+			ID:        result[i].ID,
+			Timestamp: result[i].Timestamp,
+			Exchange:  strings.ToLower(exchangeName),
+			Base:      strings.ToUpper(result[i].Base),
+			Quote:     strings.ToUpper(result[i].Quote),
+			AssetType: strings.ToLower(result[i].Asset),
+			Side:      result[i].Side,
+			Price:     result[i].Price,
+			Amount:    result[i].Amount,
+		})
+	}
+	return ld, nil
+}
+
+func getInRangeMySQL(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (ld []Data, err error) {
+	var exchangeUUID uuid.UUID
+	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]interface{}{
+		"exchange_name_id": exchangeUUID,
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+	}
+	q := generateMySQLQuery(wheres, startDate, endDate)
+	query := modelMySQL.Liquidations(q...)
+	var result []*modelMySQL.Liquidation
+	result, err = query.All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return ld, err
+	}
+	for i := range result {
+		ld = append(ld, Data{
+			ID:        result[i].ID,
+			Timestamp: result[i].Timestamp,
+			Exchange:  strings.ToLower(exchangeName),
+			Base:      strings.ToUpper(result[i].Base),
+			Quote:     strings.ToUpper(result[i].Quote),
+			AssetType: strings.ToLower(result[i].Asset),
+			Side:      result[i].Side,
+			Price:     result[i].Price,
+			Amount:    result[i].Amount,
+		})
+	}
+	return ld, nil
+}
+
+func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
+
+// generateMySQLQuery uses a typed timestamp range as mysql does not parse the
+// RFC3339 time zone designator
+func generateMySQLQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
@@ -0,0 +1,168 @@
+package liquidation
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestLiquidations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+		runner func(t *testing.T)
+		closer func(dbConn *database.Instance) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			liquidationSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func liquidationSQLTester(t *testing.T) {
+	var liquidations []Data
+	for i := 1; i <= 20; i++ {
+		liquidations = append(liquidations, Data{
+			Timestamp: time.Now(),
+			Exchange:  testExchanges[0].Name,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USDT.String(),
+			AssetType: asset.Future.String(),
+			Side:      order.Sell.String(),
+			Price:     float64(i * (i + 3)),
+			Amount:    float64(i * (i + 2)),
+		})
+	}
+	err := Insert(liquidations...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// insert the same liquidations with fresh IDs to test conflict resolution
+	for i := range liquidations {
+		liquidations[i].ID = ""
+	}
+	err = Insert(liquidations...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetInRange(
+		testExchanges[0].Name,
+		asset.Future.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		time.Now().Add(-time.Hour),
+		time.Now().Add(time.Hour),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 20 {
+		t.Fatalf("unique constraints failing, expected 20 received %v", len(resp))
+	}
+	if resp[0].Side != order.Sell.String() {
+		t.Errorf("expected side %v received %v", order.Sell, resp[0].Side)
+	}
+
+	resp, err = GetInRange(
+		testExchanges[1].Name,
+		asset.Future.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		time.Now().Add(-time.Hour),
+		time.Now().Add(time.Hour),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 0 {
+		t.Errorf("expected no liquidations for %v received %v",
+			testExchanges[1].Name,
+			len(resp))
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
@@ -0,0 +1,18 @@
+package liquidation
+
+import "time"
+
+// Data defines liquidation data in its simplest
+// db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Side           string
+	Price          float64
+	Amount         float64
+	Timestamp      time.Time
+}
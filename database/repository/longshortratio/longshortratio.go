@@ -0,0 +1,325 @@
+package longshortratio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
+	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves long/short ratios to the database, updating the values of any
+// existing entries for the same interval, ratio type and time
+func Insert(ratios ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(ratios) == 0 {
+		return errNoLongShortRatioData
+	}
+	for i := range ratios {
+		if ratios[i].ExchangeNameID == "" && ratios[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(ratios[i].Exchange)
+			if err != nil {
+				return err
+			}
+			ratios[i].ExchangeNameID = exchangeUUID.String()
+		} else if ratios[i].ExchangeNameID == "" && ratios[i].Exchange == "" {
+			return errors.New("exchange name/uuid not set, cannot insert")
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, ratios...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = insertMySQL(ctx, tx, ratios...)
+	} else {
+		err = insertPostgres(ctx, tx, ratios...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, ratios ...Data) error {
+	for i := range ratios {
+		if ratios[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			ratios[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.LongShortRatio{
+			ID:             ratios[i].ID,
+			ExchangeNameID: ratios[i].ExchangeNameID,
+			Base:           strings.ToUpper(ratios[i].Base),
+			Quote:          strings.ToUpper(ratios[i].Quote),
+			Asset:          strings.ToLower(ratios[i].AssetType),
+			Interval:       ratios[i].Interval,
+			RatioType:      strings.ToLower(ratios[i].RatioType),
+			LongShortRatio: ratios[i].LongShortRatio,
+			LongRatio:      ratios[i].LongRatio,
+			ShortRatio:     ratios[i].ShortRatio,
+			Timestamp:      ratios[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, ratios ...Data) error {
+	for i := range ratios {
+		if ratios[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			ratios[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.LongShortRatio{
+			ID:             ratios[i].ID,
+			ExchangeNameID: ratios[i].ExchangeNameID,
+			Base:           strings.ToUpper(ratios[i].Base),
+			Quote:          strings.ToUpper(ratios[i].Quote),
+			Asset:          strings.ToLower(ratios[i].AssetType),
+			Interval:       ratios[i].Interval,
+			RatioType:      strings.ToLower(ratios[i].RatioType),
+			LongShortRatio: ratios[i].LongShortRatio,
+			LongRatio:      ratios[i].LongRatio,
+			ShortRatio:     ratios[i].ShortRatio,
+			Timestamp:      ratios[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Upsert(ctx,
+			tx,
+			true,
+			[]string{"exchange_name_id", "base", "quote", "asset", "interval", "ratio_type", "timestamp"},
+			boil.Whitelist("long_short_ratio", "long_ratio", "short_ratio"),
+			boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertMySQL(ctx context.Context, tx *sql.Tx, ratios ...Data) error {
+	for i := range ratios {
+		if ratios[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			ratios[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelMySQL.LongShortRatio{
+			ID:             ratios[i].ID,
+			ExchangeNameID: ratios[i].ExchangeNameID,
+			Base:           strings.ToUpper(ratios[i].Base),
+			Quote:          strings.ToUpper(ratios[i].Quote),
+			Asset:          strings.ToLower(ratios[i].AssetType),
+			Interval:       ratios[i].Interval,
+			RatioType:      strings.ToLower(ratios[i].RatioType),
+			LongShortRatio: ratios[i].LongShortRatio,
+			LongRatio:      ratios[i].LongRatio,
+			ShortRatio:     ratios[i].ShortRatio,
+			Timestamp:      ratios[i].Timestamp.UTC(),
+		}
+		// conflicts on the long/short ratio unique key keep the existing ID
+		err := tempEvent.Upsert(ctx, tx, boil.Whitelist("long_short_ratio", "long_ratio", "short_ratio"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all long/short ratios of a ratio type for an exchange,
+// pair, asset and interval in a date range
+func GetInRange(exchangeName, assetType, base, quote, ratioType string, interval int64, startDate, endDate time.Time) (lsr []Data, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	var exchangeUUID uuid.UUID
+	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]interface{}{
+		"exchange_name_id": exchangeUUID.String(),
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+		"ratio_type":       strings.ToLower(ratioType),
+		intervalColumn():   interval,
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		lsr, err = getInRangeSQLite(exchangeName, wheres, startDate, endDate)
+		if err != nil {
+			return lsr, fmt.Errorf("longshortratio.GetInRange getInRangeSQLite %w", err)
+		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		lsr, err = getInRangeMySQL(exchangeName, wheres, startDate, endDate)
+		if err != nil {
+			return lsr, fmt.Errorf("longshortratio.GetInRange getInRangeMySQL %w", err)
+		}
+	} else {
+		lsr, err = getInRangePostgres(exchangeName, wheres, startDate, endDate)
+		if err != nil {
+			return lsr, fmt.Errorf("longshortratio.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return lsr, nil
+}
+
+func getInRangeSQLite(exchangeName string, wheres map[string]interface{}, startDate, endDate time.Time) (lsr []Data, err error) {
+	q := generateQuery(wheres, startDate, endDate)
+	var result []*modelSQLite.LongShortRatio
+	result, err = modelSQLite.LongShortRatios(q...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return lsr, err
+	}
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return lsr, err
+		}
+		lsr = append(lsr, Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Interval:       result[i].Interval,
+			RatioType:      result[i].RatioType,
+			LongShortRatio: result[i].LongShortRatio,
+			LongRatio:      result[i].LongRatio,
+			ShortRatio:     result[i].ShortRatio,
+			Timestamp:      ts,
+		})
+	}
+	return lsr, nil
+}
+
+func getInRangePostgres(exchangeName string, wheres map[string]interface{}, startDate, endDate time.Time) (lsr []Data, err error) {
+	q := generateQuery(wheres, startDate, endDate)
+	var result []*modelPSQL.LongShortRatio
+	result, err = modelPSQL.LongShortRatios(q...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return lsr, err
+	}
+	for i := range result {
+		lsr = append(lsr, Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Interval:       result[i].Interval,
+			RatioType:      result[i].RatioType,
+			LongShortRatio: result[i].LongShortRatio,
+			LongRatio:      result[i].LongRatio,
+			ShortRatio:     result[i].ShortRatio,
+			Timestamp:      result[i].Timestamp,
+		})
+	}
+	return lsr, nil
+}
+
+func getInRangeMySQL(exchangeName string, wheres map[string]interface{}, startDate, endDate time.Time) (lsr []Data, err error) {
+	q := generateMySQLQuery(wheres, startDate, endDate)
+	var result []*modelMySQL.LongShortRatio
+	result, err = modelMySQL.LongShortRatios(q...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return lsr, err
+	}
+	for i := range result {
+		lsr = append(lsr, Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Interval:       result[i].Interval,
+			RatioType:      result[i].RatioType,
+			LongShortRatio: result[i].LongShortRatio,
+			LongRatio:      result[i].LongRatio,
+			ShortRatio:     result[i].ShortRatio,
+			Timestamp:      result[i].Timestamp,
+		})
+	}
+	return lsr, nil
+}
+
+func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
+
+// generateMySQLQuery uses a typed timestamp range as mysql does not parse the
+// RFC3339 time zone designator
+func generateMySQLQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
+
+// intervalColumn returns the quoted interval column name as interval is a
+// reserved word in mysql
+func intervalColumn() string {
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return "`interval`"
+	}
+	return "interval"
+}
@@ -0,0 +1,183 @@
+package longshortratio
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestLongShortRatios(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+		runner func(t *testing.T)
+		closer func(dbConn *database.Instance) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			longShortRatioSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func longShortRatioSQLTester(t *testing.T) {
+	start := time.Now().UTC().Truncate(time.Hour)
+	var ratios []Data
+	for i := 0; i < 20; i++ {
+		for _, ratioType := range []string{"global_account", "top_position"} {
+			ratios = append(ratios, Data{
+				Timestamp:      start.Add(time.Duration(i) * kline.FiveMin.Duration()),
+				Exchange:       testExchanges[0].Name,
+				Base:           currency.BTC.String(),
+				Quote:          currency.USDT.String(),
+				AssetType:      asset.Future.String(),
+				Interval:       int64(kline.FiveMin.Duration().Seconds()),
+				RatioType:      ratioType,
+				LongShortRatio: 1.5,
+				LongRatio:      0.6,
+				ShortRatio:     0.4,
+			})
+		}
+	}
+	err := Insert(ratios...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// insert updated ratios with fresh IDs to test conflict resolution
+	for i := range ratios {
+		ratios[i].ID = ""
+		ratios[i].LongShortRatio = 1
+		ratios[i].LongRatio = 0.5
+		ratios[i].ShortRatio = 0.5
+	}
+	err = Insert(ratios...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetInRange(
+		testExchanges[0].Name,
+		asset.Future.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		"global_account",
+		int64(kline.FiveMin.Duration().Seconds()),
+		start.Add(-time.Hour),
+		start.Add(time.Hour*2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 20 {
+		t.Fatalf("unique constraints failing, expected 20 received %v", len(resp))
+	}
+	if resp[0].LongShortRatio != 1 || resp[0].LongRatio != 0.5 || resp[0].ShortRatio != 0.5 {
+		t.Errorf("expected updated ratios received %+v", resp[0])
+	}
+	if resp[0].RatioType != "global_account" {
+		t.Errorf("expected ratio type global_account received %v", resp[0].RatioType)
+	}
+
+	resp, err = GetInRange(
+		testExchanges[1].Name,
+		asset.Future.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		"global_account",
+		int64(kline.FiveMin.Duration().Seconds()),
+		start.Add(-time.Hour),
+		start.Add(time.Hour*2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 0 {
+		t.Errorf("expected no long/short ratios for %v received %v",
+			testExchanges[1].Name,
+			len(resp))
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
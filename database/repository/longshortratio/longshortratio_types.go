@@ -0,0 +1,25 @@
+package longshortratio
+
+import (
+	"errors"
+	"time"
+)
+
+var errNoLongShortRatioData = errors.New("no long/short ratio data to insert")
+
+// Data defines long/short ratio data in its simplest
+// db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Interval       int64
+	RatioType      string
+	LongShortRatio float64
+	LongRatio      float64
+	ShortRatio     float64
+	Timestamp      time.Time
+}
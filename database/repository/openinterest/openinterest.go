@@ -0,0 +1,312 @@
+package openinterest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
+	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves open interest data to the database, updating the values of
+// any existing entries for the same interval and time
+func Insert(openInterest ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(openInterest) == 0 {
+		return errNoOpenInterestData
+	}
+	for i := range openInterest {
+		if openInterest[i].ExchangeNameID == "" && openInterest[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(openInterest[i].Exchange)
+			if err != nil {
+				return err
+			}
+			openInterest[i].ExchangeNameID = exchangeUUID.String()
+		} else if openInterest[i].ExchangeNameID == "" && openInterest[i].Exchange == "" {
+			return errors.New("exchange name/uuid not set, cannot insert")
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, openInterest...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = insertMySQL(ctx, tx, openInterest...)
+	} else {
+		err = insertPostgres(ctx, tx, openInterest...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, openInterest ...Data) error {
+	for i := range openInterest {
+		if openInterest[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			openInterest[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.OpenInterest{
+			ID:                openInterest[i].ID,
+			ExchangeNameID:    openInterest[i].ExchangeNameID,
+			Base:              strings.ToUpper(openInterest[i].Base),
+			Quote:             strings.ToUpper(openInterest[i].Quote),
+			Asset:             strings.ToLower(openInterest[i].AssetType),
+			Interval:          openInterest[i].Interval,
+			OpenInterest:      openInterest[i].OpenInterest,
+			OpenInterestValue: openInterest[i].OpenInterestValue,
+			Timestamp:         openInterest[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, openInterest ...Data) error {
+	for i := range openInterest {
+		if openInterest[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			openInterest[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.OpenInterest{
+			ID:                openInterest[i].ID,
+			ExchangeNameID:    openInterest[i].ExchangeNameID,
+			Base:              strings.ToUpper(openInterest[i].Base),
+			Quote:             strings.ToUpper(openInterest[i].Quote),
+			Asset:             strings.ToLower(openInterest[i].AssetType),
+			Interval:          openInterest[i].Interval,
+			OpenInterest:      openInterest[i].OpenInterest,
+			OpenInterestValue: openInterest[i].OpenInterestValue,
+			Timestamp:         openInterest[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Upsert(ctx,
+			tx,
+			true,
+			[]string{"exchange_name_id", "base", "quote", "asset", "interval", "timestamp"},
+			boil.Whitelist("open_interest", "open_interest_value"),
+			boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertMySQL(ctx context.Context, tx *sql.Tx, openInterest ...Data) error {
+	for i := range openInterest {
+		if openInterest[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			openInterest[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelMySQL.OpenInterest{
+			ID:                openInterest[i].ID,
+			ExchangeNameID:    openInterest[i].ExchangeNameID,
+			Base:              strings.ToUpper(openInterest[i].Base),
+			Quote:             strings.ToUpper(openInterest[i].Quote),
+			Asset:             strings.ToLower(openInterest[i].AssetType),
+			Interval:          openInterest[i].Interval,
+			OpenInterest:      openInterest[i].OpenInterest,
+			OpenInterestValue: openInterest[i].OpenInterestValue,
+			Timestamp:         openInterest[i].Timestamp.UTC(),
+		}
+		// conflicts on the open interest unique key keep the existing ID
+		err := tempEvent.Upsert(ctx, tx, boil.Whitelist("open_interest", "open_interest_value"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all open interest for an exchange, pair, asset and
+// interval in a date range
+func GetInRange(exchangeName, assetType, base, quote string, interval int64, startDate, endDate time.Time) (oi []Data, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	var exchangeUUID uuid.UUID
+	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]interface{}{
+		"exchange_name_id": exchangeUUID.String(),
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+		intervalColumn():   interval,
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		oi, err = getInRangeSQLite(exchangeName, wheres, startDate, endDate)
+		if err != nil {
+			return oi, fmt.Errorf("openinterest.GetInRange getInRangeSQLite %w", err)
+		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		oi, err = getInRangeMySQL(exchangeName, wheres, startDate, endDate)
+		if err != nil {
+			return oi, fmt.Errorf("openinterest.GetInRange getInRangeMySQL %w", err)
+		}
+	} else {
+		oi, err = getInRangePostgres(exchangeName, wheres, startDate, endDate)
+		if err != nil {
+			return oi, fmt.Errorf("openinterest.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return oi, nil
+}
+
+func getInRangeSQLite(exchangeName string, wheres map[string]interface{}, startDate, endDate time.Time) (oi []Data, err error) {
+	q := generateQuery(wheres, startDate, endDate)
+	var result []*modelSQLite.OpenInterest
+	result, err = modelSQLite.OpenInterests(q...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return oi, err
+	}
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return oi, err
+		}
+		oi = append(oi, Data{
+			ID:                result[i].ID,
+			Exchange:          strings.ToLower(exchangeName),
+			ExchangeNameID:    result[i].ExchangeNameID,
+			Base:              strings.ToUpper(result[i].Base),
+			Quote:             strings.ToUpper(result[i].Quote),
+			AssetType:         strings.ToLower(result[i].Asset),
+			Interval:          result[i].Interval,
+			OpenInterest:      result[i].OpenInterest,
+			OpenInterestValue: result[i].OpenInterestValue,
+			Timestamp:         ts,
+		})
+	}
+	return oi, nil
+}
+
+func getInRangePostgres(exchangeName string, wheres map[string]interface{}, startDate, endDate time.Time) (oi []Data, err error) {
+	q := generateQuery(wheres, startDate, endDate)
+	var result []*modelPSQL.OpenInterest
+	result, err = modelPSQL.OpenInterests(q...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return oi, err
+	}
+	for i := range result {
+		oi = append(oi, Data{
+			ID:                result[i].ID,
+			Exchange:          strings.ToLower(exchangeName),
+			ExchangeNameID:    result[i].ExchangeNameID,
+			Base:              strings.ToUpper(result[i].Base),
+			Quote:             strings.ToUpper(result[i].Quote),
+			AssetType:         strings.ToLower(result[i].Asset),
+			Interval:          result[i].Interval,
+			OpenInterest:      result[i].OpenInterest,
+			OpenInterestValue: result[i].OpenInterestValue,
+			Timestamp:         result[i].Timestamp,
+		})
+	}
+	return oi, nil
+}
+
+func getInRangeMySQL(exchangeName string, wheres map[string]interface{}, startDate, endDate time.Time) (oi []Data, err error) {
+	q := generateMySQLQuery(wheres, startDate, endDate)
+	var result []*modelMySQL.OpenInterest
+	result, err = modelMySQL.OpenInterests(q...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return oi, err
+	}
+	for i := range result {
+		oi = append(oi, Data{
+			ID:                result[i].ID,
+			Exchange:          strings.ToLower(exchangeName),
+			ExchangeNameID:    result[i].ExchangeNameID,
+			Base:              strings.ToUpper(result[i].Base),
+			Quote:             strings.ToUpper(result[i].Quote),
+			AssetType:         strings.ToLower(result[i].Asset),
+			Interval:          result[i].Interval,
+			OpenInterest:      result[i].OpenInterest,
+			OpenInterestValue: result[i].OpenInterestValue,
+			Timestamp:         result[i].Timestamp,
+		})
+	}
+	return oi, nil
+}
+
+func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
+
+// generateMySQLQuery uses a typed timestamp range as mysql does not parse the
+// RFC3339 time zone designator
+func generateMySQLQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
+
+// intervalColumn returns the quoted interval column name as interval is a
+// reserved word in mysql
+func intervalColumn() string {
+	if repository.GetSQLDialect() == database.DBMySQL {
+		return "`interval`"
+	}
+	return "interval"
+}
@@ -0,0 +1,173 @@
+package openinterest
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestOpenInterest(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+		runner func(t *testing.T)
+		closer func(dbConn *database.Instance) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			openInterestSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func openInterestSQLTester(t *testing.T) {
+	start := time.Now().UTC().Truncate(time.Hour)
+	var openInterest []Data
+	for i := 0; i < 20; i++ {
+		openInterest = append(openInterest, Data{
+			Timestamp:         start.Add(time.Duration(i) * kline.FiveMin.Duration()),
+			Exchange:          testExchanges[0].Name,
+			Base:              currency.BTC.String(),
+			Quote:             currency.USDT.String(),
+			AssetType:         asset.Future.String(),
+			Interval:          int64(kline.FiveMin.Duration().Seconds()),
+			OpenInterest:      float64(i * (i + 3)),
+			OpenInterestValue: float64(i * (i + 2)),
+		})
+	}
+	err := Insert(openInterest...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// insert updated open interest with fresh IDs to test conflict resolution
+	for i := range openInterest {
+		openInterest[i].ID = ""
+		openInterest[i].OpenInterest = 1337
+	}
+	err = Insert(openInterest...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetInRange(
+		testExchanges[0].Name,
+		asset.Future.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		int64(kline.FiveMin.Duration().Seconds()),
+		start.Add(-time.Hour),
+		start.Add(time.Hour*2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 20 {
+		t.Fatalf("unique constraints failing, expected 20 received %v", len(resp))
+	}
+	if resp[0].OpenInterest != 1337 {
+		t.Errorf("expected updated open interest 1337 received %v", resp[0].OpenInterest)
+	}
+	if !resp[19].Timestamp.Equal(openInterest[19].Timestamp) {
+		t.Errorf("expected timestamp %v received %v", openInterest[19].Timestamp, resp[19].Timestamp)
+	}
+
+	resp, err = GetInRange(
+		testExchanges[0].Name,
+		asset.Future.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		int64(kline.OneHour.Duration().Seconds()),
+		start.Add(-time.Hour),
+		start.Add(time.Hour*2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 0 {
+		t.Errorf("expected no open interest for interval %v received %v", kline.OneHour, len(resp))
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
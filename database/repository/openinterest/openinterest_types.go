@@ -0,0 +1,23 @@
+package openinterest
+
+import (
+	"errors"
+	"time"
+)
+
+var errNoOpenInterestData = errors.New("no open interest data to insert")
+
+// Data defines open interest data in its simplest
+// db friendly form
+type Data struct {
+	ID                string
+	Exchange          string
+	ExchangeNameID    string
+	Base              string
+	Quote             string
+	AssetType         string
+	Interval          int64
+	OpenInterest      float64
+	OpenInterestValue float64
+	Timestamp         time.Time
+}
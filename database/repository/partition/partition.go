@@ -0,0 +1,41 @@
+package partition
+
+import (
+	"context"
+
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/repository"
+)
+
+// Enable converts the candle and trade tables to time partitioned tables.
+// TimescaleDB hypertables are used when the extension is installed, otherwise
+// native postgres monthly partitions are created, including monthsAhead
+// future partitions. Every stored row is copied while the tables are locked,
+// so it is run offline by the dbmigrate partition command. It is safe to call
+// repeatedly and returns the mode in use
+func Enable(monthsAhead int) (string, error) {
+	return run("SELECT gct_enable_time_partitioning($1)", monthsAhead)
+}
+
+// Extend creates upcoming monthly partitions for tables which have already
+// been converted by Enable and returns the mode in use, ModeNone is returned
+// when the tables have not been converted
+func Extend(monthsAhead int) (string, error) {
+	return run("SELECT gct_extend_time_partitioning($1)", monthsAhead)
+}
+
+func run(query string, monthsAhead int) (string, error) {
+	if database.DB.SQL == nil {
+		return "", database.ErrDatabaseSupportDisabled
+	}
+	if repository.GetSQLDialect() != database.DBPostgreSQL {
+		return "", errPartitioningUnsupported
+	}
+	if monthsAhead < 0 {
+		return "", errInvalidMonthsAhead
+	}
+
+	var mode string
+	err := database.DB.SQL.QueryRowContext(context.Background(), query, monthsAhead).Scan(&mode)
+	return mode, err
+}
@@ -0,0 +1,111 @@
+package partition
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestEnable(t *testing.T) {
+	_, err := Enable(DefaultMonthsAhead)
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+
+	testCases := []struct {
+		name     string
+		config   *database.Config
+		expected error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			expected: errPartitioningUnsupported,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// enabling twice ensures an already partitioned schema is
+			// only extended
+			for i := 0; i < 2; i++ {
+				mode, err := Enable(DefaultMonthsAhead)
+				if !errors.Is(err, test.expected) {
+					t.Fatalf("received %v, expected %v", err, test.expected)
+				}
+				if err == nil && mode != ModeNative && mode != ModeTimescaleDB {
+					t.Errorf("unexpected partitioning mode %v", mode)
+				}
+			}
+
+			mode, err := Extend(DefaultMonthsAhead)
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("received %v, expected %v", err, test.expected)
+			}
+			if err == nil && mode != ModeNative && mode != ModeTimescaleDB {
+				t.Errorf("unexpected partitioning mode %v", mode)
+			}
+
+			if test.expected == nil {
+				_, err = Enable(-1)
+				if !errors.Is(err, errInvalidMonthsAhead) {
+					t.Errorf("received %v, expected %v", err, errInvalidMonthsAhead)
+				}
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
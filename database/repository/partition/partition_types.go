@@ -0,0 +1,19 @@
+package partition
+
+import "errors"
+
+// Partitioning modes returned by Enable and Extend
+const (
+	ModeNone        = "none"
+	ModeNative      = "native"
+	ModeTimescaleDB = "timescaledb"
+)
+
+// DefaultMonthsAhead is the number of future monthly partitions kept ready
+// when using native postgres partitioning
+const DefaultMonthsAhead = 3
+
+var (
+	errPartitioningUnsupported = errors.New("time partitioning is only supported by postgres")
+	errInvalidMonthsAhead      = errors.New("months ahead cannot be negative")
+)
@@ -11,6 +11,8 @@ func GetSQLDialect() string {
 		return database.DBSQLite3
 	case "psql", "postgres", "postgresql":
 		return database.DBPostgreSQL
+	case "mysql":
+		return database.DBMySQL
 	}
 	return "invalid driver"
 }
@@ -23,6 +23,10 @@ func TestGetSQLDialect(t *testing.T) {
 			"sqlite3",
 			database.DBSQLite3,
 		},
+		{
+			"mysql",
+			database.DBMySQL,
+		},
 		{
 			"invalid",
 			database.DBInvalidDriver,
@@ -2,15 +2,19 @@ package script
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
 	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
 	"github.com/idoall/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
 	"github.com/volatiletech/null"
 )
 
@@ -81,6 +85,16 @@ func Event(id, name, path string, data null.Bytes, executionType, status string,
 			}
 			return
 		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = mysqlEvent(ctx, tx, id, name, path, data, executionType, status, time)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
+			err = tx.Rollback()
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
+			}
+			return
+		}
 	} else {
 		var tempEvent = modelPSQL.Script{
 			ScriptID:   id,
@@ -120,3 +134,75 @@ func Event(id, name, path string, data null.Bytes, executionType, status string,
 		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
 	}
 }
+
+// mysqlEvent stores a script and its execution, mysql has no uuid column
+// default so IDs are generated here
+func mysqlEvent(ctx context.Context, tx *sql.Tx, id, name, path string, data null.Bytes, executionType, status string, executedAt time.Time) error {
+	tempEvent, err := modelMySQL.Scripts(modelMySQL.ScriptWhere.ScriptID.EQ(id)).One(ctx, tx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		newUUID, errUUID := uuid.NewV4()
+		if errUUID != nil {
+			return errUUID
+		}
+		tempEvent = &modelMySQL.Script{
+			ID:             newUUID.String(),
+			ScriptID:       id,
+			ScriptName:     name,
+			ScriptPath:     path,
+			ScriptData:     data,
+			LastExecutedAt: null.TimeFrom(executedAt.UTC()),
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
+	case err == nil:
+		tempEvent.LastExecutedAt = null.TimeFrom(executedAt.UTC())
+		_, err = tempEvent.Update(ctx, tx, boil.Whitelist("last_executed_at"))
+	}
+	if err != nil {
+		return err
+	}
+
+	executionUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	tempScriptExecution := &modelMySQL.ScriptExecution{
+		ID:              executionUUID.String(),
+		ExecutionTime:   executedAt.UTC(),
+		ExecutionStatus: status,
+		ExecutionType:   executionType,
+	}
+	return tempEvent.AddScriptExecutions(ctx, tx, true, tempScriptExecution)
+}
+
+// DeleteExecutionsBefore removes every script execution record which ran
+// before the supplied time
+func DeleteExecutionsBefore(before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var totalDeleted int64
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		// sqlite3 execution times are stored in the time.Time string format
+		// which sorts chronologically for UTC times
+		totalDeleted, err = modelSQLite.ScriptExecutions(qm.Where("execution_time < ?", before.UTC().String())).DeleteAll(ctx, tx)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		totalDeleted, err = modelMySQL.ScriptExecutions(qm.Where("execution_time < ?", before.UTC())).DeleteAll(ctx, tx)
+	} else {
+		totalDeleted, err = modelPSQL.ScriptExecutions(qm.Where("execution_time < ?", before.UTC())).DeleteAll(ctx, tx)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "DeleteExecutionsBefore rollback failed: %v", errRB)
+		}
+		return 0, err
+	}
+	return totalDeleted, tx.Commit()
+}
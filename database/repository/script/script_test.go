@@ -21,6 +21,7 @@ var (
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -45,7 +46,7 @@ func TestScript(t *testing.T) {
 	testCases := []struct {
 		name   string
 		config *database.Config
-		runner func()
+		runner func(t *testing.T)
 		closer func(dbConn *database.Instance) error
 		output interface{}
 	}{
@@ -59,6 +60,16 @@ func TestScript(t *testing.T) {
 			testhelpers.CloseDatabase,
 			nil,
 		},
+		{
+			"SQLite-Delete",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			deleteScript,
+			testhelpers.CloseDatabase,
+			nil,
+		},
 		{
 			"Postgres-Write",
 			testhelpers.PostgresTestDatabase,
@@ -66,6 +77,27 @@ func TestScript(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"MySQL-Write",
+			testhelpers.MySQLTestDatabase,
+			writeScript,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Delete",
+			testhelpers.PostgresTestDatabase,
+			deleteScript,
+			nil,
+			nil,
+		},
+		{
+			"MySQL-Delete",
+			testhelpers.MySQLTestDatabase,
+			deleteScript,
+			nil,
+			nil,
+		},
 	}
 
 	for x := range testCases {
@@ -81,7 +113,7 @@ func TestScript(t *testing.T) {
 			}
 
 			if test.runner != nil {
-				test.runner()
+				test.runner(t)
 			}
 
 			if test.closer != nil {
@@ -94,7 +126,8 @@ func TestScript(t *testing.T) {
 	}
 }
 
-func writeScript() {
+func writeScript(t *testing.T) {
+	t.Helper()
 	var wg sync.WaitGroup
 	for x := 0; x < 20; x++ {
 		wg.Add(1)
@@ -108,3 +141,23 @@ func writeScript() {
 	}
 	wg.Wait()
 }
+
+func deleteScript(t *testing.T) {
+	t.Helper()
+	writeScript(t)
+
+	deleted, err := DeleteExecutionsBefore(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Error(err)
+	}
+	if deleted != 0 {
+		t.Errorf("expected no executions deleted, received %v", deleted)
+	}
+	deleted, err = DeleteExecutionsBefore(time.Now().Add(time.Minute))
+	if err != nil {
+		t.Error(err)
+	}
+	if deleted < 20 {
+		t.Errorf("expected at least 20 executions deleted, received %v", deleted)
+	}
+}
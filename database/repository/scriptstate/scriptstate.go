@@ -0,0 +1,216 @@
+package scriptstate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
+	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+func validate(scriptName, key string) error {
+	if scriptName == "" {
+		return errScriptNameUnset
+	}
+	if key == "" {
+		return fmt.Errorf("%s %w", scriptName, errKeyUnset)
+	}
+	return nil
+}
+
+// Set saves a script's value for a key, replacing any value already saved
+func Set(scriptName, key, value string) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	err := validate(scriptName, key)
+	if err != nil {
+		return err
+	}
+	ctx := boil.SkipTimestamps(context.Background())
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	switch repository.GetSQLDialect() {
+	case database.DBSQLite3, database.DBSQLite:
+		state := modelSQLite.ScriptState{
+			ID:         id.String(),
+			ScriptName: scriptName,
+			StateKey:   key,
+			StateValue: value,
+			UpdatedAt:  now.Format(time.RFC3339Nano),
+		}
+		// the unique constraint replaces existing values on conflict
+		return state.Insert(ctx, database.DB.SQL, boil.Infer())
+	case database.DBMySQL:
+		state := modelMySQL.ScriptState{
+			ID:         id.String(),
+			ScriptName: scriptName,
+			StateKey:   key,
+			StateValue: value,
+			UpdatedAt:  now,
+		}
+		return state.Upsert(ctx, database.DB.SQL, boil.Whitelist("state_value", "updated_at"), boil.Infer())
+	default:
+		state := modelPSQL.ScriptState{
+			ID:         id.String(),
+			ScriptName: scriptName,
+			StateKey:   key,
+			StateValue: value,
+			UpdatedAt:  now,
+		}
+		return state.Upsert(ctx,
+			database.DB.SQL,
+			true,
+			[]string{"script_name", "state_key"},
+			boil.Whitelist("state_value", "updated_at"),
+			boil.Infer())
+	}
+}
+
+// Get returns a script's saved value for a key
+func Get(scriptName, key string) (Data, error) {
+	if database.DB.SQL == nil {
+		return Data{}, database.ErrDatabaseSupportDisabled
+	}
+	err := validate(scriptName, key)
+	if err != nil {
+		return Data{}, err
+	}
+	resp, err := get(scriptName, qm.Where("state_key = ?", key))
+	if err != nil {
+		return Data{}, err
+	}
+	if len(resp) == 0 {
+		return Data{}, fmt.Errorf("%s %s %w", scriptName, key, ErrNoState)
+	}
+	return resp[0], nil
+}
+
+// GetAll returns all saved values for a script ordered by key
+func GetAll(scriptName string) ([]Data, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if scriptName == "" {
+		return nil, errScriptNameUnset
+	}
+	return get(scriptName)
+}
+
+func get(scriptName string, mods ...qm.QueryMod) ([]Data, error) {
+	ctx := context.Background()
+	mods = append(mods, qm.Where("script_name = ?", scriptName), qm.OrderBy("state_key"))
+	var resp []Data
+	switch repository.GetSQLDialect() {
+	case database.DBSQLite3, database.DBSQLite:
+		result, err := modelSQLite.ScriptStates(mods...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			updated, err := time.Parse(time.RFC3339Nano, result[i].UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, Data{
+				ID:         result[i].ID,
+				ScriptName: result[i].ScriptName,
+				Key:        result[i].StateKey,
+				Value:      result[i].StateValue,
+				UpdatedAt:  updated,
+			})
+		}
+	case database.DBMySQL:
+		result, err := modelMySQL.ScriptStates(mods...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			resp = append(resp, Data{
+				ID:         result[i].ID,
+				ScriptName: result[i].ScriptName,
+				Key:        result[i].StateKey,
+				Value:      result[i].StateValue,
+				UpdatedAt:  result[i].UpdatedAt.UTC(),
+			})
+		}
+	default:
+		result, err := modelPSQL.ScriptStates(mods...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			resp = append(resp, Data{
+				ID:         result[i].ID,
+				ScriptName: result[i].ScriptName,
+				Key:        result[i].StateKey,
+				Value:      result[i].StateValue,
+				UpdatedAt:  result[i].UpdatedAt.UTC(),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// Delete removes a script's saved value for a key, it returns ErrNoState when
+// no value was saved
+func Delete(scriptName, key string) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	err := validate(scriptName, key)
+	if err != nil {
+		return err
+	}
+	deleted, err := deleteState(scriptName, qm.Where("state_key = ?", key))
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%s %s %w", scriptName, key, ErrNoState)
+	}
+	return nil
+}
+
+// DeleteAll removes all saved values for a script and returns the amount
+// removed
+func DeleteAll(scriptName string) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	if scriptName == "" {
+		return 0, errScriptNameUnset
+	}
+	return deleteState(scriptName)
+}
+
+func deleteState(scriptName string, mods ...qm.QueryMod) (int64, error) {
+	ctx := context.Background()
+	mods = append(mods, qm.Where("script_name = ?", scriptName))
+	var deleted int64
+	var err error
+	switch repository.GetSQLDialect() {
+	case database.DBSQLite3, database.DBSQLite:
+		deleted, err = modelSQLite.ScriptStates(mods...).DeleteAll(ctx, database.DB.SQL)
+	case database.DBMySQL:
+		deleted, err = modelMySQL.ScriptStates(mods...).DeleteAll(ctx, database.DB.SQL)
+	default:
+		deleted, err = modelPSQL.ScriptStates(mods...).DeleteAll(ctx, database.DB.SQL)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	return deleted, nil
+}
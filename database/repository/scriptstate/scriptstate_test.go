@@ -0,0 +1,157 @@
+package scriptstate
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestScriptState(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scriptStateSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func scriptStateSQLTester(t *testing.T) {
+	const script = "state.gct"
+	_, err := DeleteAll(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Set(script, "position", `{"amount":1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Set(script, "position", `{"amount":2}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Set(script, "last_signal", `"buy"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Set("other.gct", "position", `{"amount":3}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := Get(script, "position")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Value != `{"amount":2}` {
+		t.Errorf("received %v, expected the updated value", resp.Value)
+	}
+	if resp.UpdatedAt.IsZero() {
+		t.Error("expected updated time to be set")
+	}
+
+	all, err := GetAll(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Key != "last_signal" || all[1].Key != "position" {
+		t.Errorf("received %+v, expected two values ordered by key", all)
+	}
+
+	err = Delete(script, "last_signal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Delete(script, "last_signal")
+	if !errors.Is(err, ErrNoState) {
+		t.Errorf("received %v, expected %v", err, ErrNoState)
+	}
+	_, err = Get(script, "last_signal")
+	if !errors.Is(err, ErrNoState) {
+		t.Errorf("received %v, expected %v", err, ErrNoState)
+	}
+
+	deleted, err := DeleteAll(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 1 {
+		t.Errorf("received %v deleted, expected 1", deleted)
+	}
+	_, err = Get("other.gct", "position")
+	if err != nil {
+		t.Errorf("expected other script state to remain, received %v", err)
+	}
+	_, err = DeleteAll("other.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	err := validate("", "key")
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	err = validate("script.gct", "")
+	if !errors.Is(err, errKeyUnset) {
+		t.Errorf("received %v, expected %v", err, errKeyUnset)
+	}
+}
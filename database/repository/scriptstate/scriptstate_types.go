@@ -0,0 +1,23 @@
+package scriptstate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errScriptNameUnset = errors.New("script state script name not set")
+	errKeyUnset        = errors.New("script state key not set")
+
+	// ErrNoState is returned when a script has no state saved for a key
+	ErrNoState = errors.New("script state not found")
+)
+
+// Data defines a script state value in its simplest db friendly form
+type Data struct {
+	ID         string
+	ScriptName string
+	Key        string
+	Value      string
+	UpdatedAt  time.Time
+}
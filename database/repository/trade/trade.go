@@ -10,6 +10,8 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/database"
+	dbmysql "github.com/idoall/gocryptotrader/database/drivers/mysql"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
 	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -33,12 +35,15 @@ func Insert(trades ...Data) error {
 		}
 	}
 
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
 
 	tx, err := database.DB.SQL.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("beginTx %w", err)
+		return fmt.Errorf("%w: beginTx %v", ErrTransaction, err)
 	}
 	defer func() {
 		if err != nil {
@@ -49,64 +54,100 @@ func Insert(trades ...Data) error {
 		}
 	}()
 
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		err = insertSQLite(ctx, tx, trades...)
-	} else {
-		err = insertPostgres(ctx, tx, trades...)
-	}
+	err = InsertTx(ctx, tx, trades...)
 	if err != nil {
 		return err
 	}
 
-	return tx.Commit()
+	errCommit := tx.Commit()
+	if errCommit != nil {
+		return fmt.Errorf("%w: commit %v", ErrTransaction, errCommit)
+	}
+	return nil
+}
+
+// InsertTx saves trade data within an existing transaction, the exchange
+// UUID of every trade must already be set
+func InsertTx(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	for i := range trades {
+		if trades[i].ExchangeNameID == "" {
+			return errors.New("exchange uuid not set, cannot insert")
+		}
+	}
+	ctx = boil.SkipTimestamps(ctx)
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return insertSQLite(ctx, tx, trades...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		return insertMySQL(ctx, tx, trades...)
+	}
+	return insertPostgres(ctx, tx, trades...)
+}
+
+// insertIgnoreQueries insert a trade, skipping it without error when it
+// conflicts with a stored trade on any unique key so that one already saved
+// trade ID cannot fail a whole batch
+var insertIgnoreQueries = map[string]string{
+	database.DBSQLite3: `INSERT OR IGNORE INTO trade (id, exchange_name_id, tid, base, quote, asset, price, amount, side, timestamp)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+	database.DBPostgreSQL: `INSERT INTO trade (id, exchange_name_id, tid, base, quote, asset, price, amount, side, timestamp)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT DO NOTHING`,
 }
 
 func insertSQLite(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	return insertIgnore(ctx, tx, insertIgnoreQueries[database.DBSQLite3], func(t time.Time) interface{} {
+		return t.UTC().Format(time.RFC3339)
+	}, trades...)
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	return insertIgnore(ctx, tx, insertIgnoreQueries[database.DBPostgreSQL], func(t time.Time) interface{} {
+		return t.UTC()
+	}, trades...)
+}
+
+func insertIgnore(ctx context.Context, tx *sql.Tx, query string, timestamp func(time.Time) interface{}, trades ...Data) error {
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
 	for i := range trades {
 		if trades[i].ID == "" {
-			freshUUID, err := uuid.NewV4()
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
 			if err != nil {
 				return err
 			}
 			trades[i].ID = freshUUID.String()
 		}
-		var tempEvent = modelSQLite.Trade{
-			ID:             trades[i].ID,
-			ExchangeNameID: trades[i].ExchangeNameID,
-			Base:           strings.ToUpper(trades[i].Base),
-			Quote:          strings.ToUpper(trades[i].Quote),
-			Asset:          strings.ToLower(trades[i].AssetType),
-			Price:          trades[i].Price,
-			Amount:         trades[i].Amount,
-			Timestamp:      trades[i].Timestamp.UTC().Format(time.RFC3339),
-		}
-		if trades[i].Side != "" {
-			tempEvent.Side.SetValid(strings.ToUpper(trades[i].Side))
-		}
-		if trades[i].TID != "" {
-			tempEvent.Tid.SetValid(trades[i].TID)
-		}
-		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		_, err = stmt.ExecContext(ctx,
+			trades[i].ID,
+			trades[i].ExchangeNameID,
+			sql.NullString{String: trades[i].TID, Valid: trades[i].TID != ""},
+			strings.ToUpper(trades[i].Base),
+			strings.ToUpper(trades[i].Quote),
+			strings.ToLower(trades[i].AssetType),
+			trades[i].Price,
+			trades[i].Amount,
+			sql.NullString{String: strings.ToUpper(trades[i].Side), Valid: trades[i].Side != ""},
+			timestamp(trades[i].Timestamp))
 		if err != nil {
 			return err
 		}
 	}
-
 	return nil
 }
 
-func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
-	var err error
+func insertMySQL(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 	for i := range trades {
 		if trades[i].ID == "" {
-			var freshUUID uuid.UUID
-			freshUUID, err = uuid.NewV4()
+			freshUUID, err := uuid.NewV4()
 			if err != nil {
 				return err
 			}
 			trades[i].ID = freshUUID.String()
 		}
-		var tempEvent = modelPSQL.Trade{
+		var tempEvent = modelMySQL.Trade{
 			ExchangeNameID: trades[i].ExchangeNameID,
 			Base:           strings.ToUpper(trades[i].Base),
 			Quote:          strings.ToUpper(trades[i].Quote),
@@ -123,8 +164,9 @@ func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 			tempEvent.Tid.SetValid(trades[i].TID)
 		}
 
-		err = tempEvent.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer())
-		if err != nil {
+		// matches the postgres and sqlite inserts which ignore conflicts
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil && !dbmysql.IsDuplicateEntry(err) {
 			return err
 		}
 	}
@@ -139,6 +181,11 @@ func GetByUUID(uuid string) (td Data, err error) {
 		if err != nil {
 			return td, fmt.Errorf("trade.Get getByUUIDSQLite %w", err)
 		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		td, err = getByUUIDMySQL(uuid)
+		if err != nil {
+			return td, fmt.Errorf("trade.Get getByUUIDMySQL %w", err)
+		}
 	} else {
 		td, err = getByUUIDPostgres(uuid)
 		if err != nil {
@@ -202,6 +249,30 @@ func getByUUIDPostgres(uuid string) (td Data, err error) {
 	return td, nil
 }
 
+func getByUUIDMySQL(uuid string) (td Data, err error) {
+	query := modelMySQL.Trades(qm.Where("id = ?", uuid))
+	var result *modelMySQL.Trade
+	result, err = query.One(context.Background(), database.DB.SQL)
+	if err != nil {
+		return td, err
+	}
+
+	td = Data{
+		ID:        result.ID,
+		Timestamp: result.Timestamp,
+		Exchange:  result.ExchangeNameID,
+		Base:      strings.ToUpper(result.Base),
+		Quote:     strings.ToUpper(result.Quote),
+		AssetType: strings.ToLower(result.Asset),
+		Price:     result.Price,
+		Amount:    result.Amount,
+	}
+	if result.Side.Valid {
+		td.Side = result.Side.String
+	}
+	return td, nil
+}
+
 // GetInRange returns all trades by an exchange in a date range
 func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
 	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
@@ -209,6 +280,11 @@ func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate
 		if err != nil {
 			return td, fmt.Errorf("trade.GetByExchangeInRange getInRangeSQLite %w", err)
 		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		td, err = getInRangeMySQL(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return td, fmt.Errorf("trade.GetByExchangeInRange getInRangeMySQL %w", err)
+		}
 	} else {
 		td, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
 		if err != nil {
@@ -273,7 +349,7 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 		"base":             strings.ToUpper(base),
 		"quote":            strings.ToUpper(quote),
 	}
-	q := generateQuery(wheres, startDate, endDate)
+	q := generatePostgresQuery(wheres, startDate, endDate)
 	query := modelPSQL.Trades(q...)
 	var result []*modelPSQL.Trade
 	result, err = query.All(context.Background(), database.DB.SQL)
@@ -299,6 +375,44 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 	return td, nil
 }
 
+func getInRangeMySQL(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+	var exchangeUUID uuid.UUID
+	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]interface{}{
+		"exchange_name_id": exchangeUUID,
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+	}
+	q := generatePostgresQuery(wheres, startDate, endDate)
+	query := modelMySQL.Trades(q...)
+	var result []*modelMySQL.Trade
+	result, err = query.All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return td, err
+	}
+	for i := range result {
+		t := Data{
+			ID:        result[i].ID,
+			Timestamp: result[i].Timestamp,
+			Exchange:  strings.ToLower(exchangeName),
+			Base:      strings.ToUpper(result[i].Base),
+			Quote:     strings.ToUpper(result[i].Quote),
+			AssetType: strings.ToLower(result[i].Asset),
+			Price:     result[i].Price,
+			Amount:    result[i].Amount,
+		}
+		if result[i].Side.Valid {
+			t.Side = result[i].Side.String
+		}
+		td = append(td, t)
+	}
+	return td, nil
+}
+
 // DeleteTrades will remove trades from the database using trade.Data
 func DeleteTrades(trades ...Data) error {
 	ctx := context.Background()
@@ -316,11 +430,7 @@ func DeleteTrades(trades ...Data) error {
 			}
 		}
 	}()
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		err = deleteTradesSQLite(context.Background(), tx, trades...)
-	} else {
-		err = deleteTradesPostgres(context.Background(), tx, trades...)
-	}
+	err = DeleteTradesTx(ctx, tx, trades...)
 	if err != nil {
 		return err
 	}
@@ -328,6 +438,16 @@ func DeleteTrades(trades ...Data) error {
 	return tx.Commit()
 }
 
+// DeleteTradesTx removes trades by their IDs within an existing transaction
+func DeleteTradesTx(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return deleteTradesSQLite(ctx, tx, trades...)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		return deleteTradesMySQL(ctx, tx, trades...)
+	}
+	return deleteTradesPostgres(ctx, tx, trades...)
+}
+
 func deleteTradesSQLite(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 	var tradeIDs []interface{}
 	for i := range trades {
@@ -348,6 +468,29 @@ func deleteTradesPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error
 	return err
 }
 
+func deleteTradesMySQL(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	var tradeIDs []interface{}
+	for i := range trades {
+		tradeIDs = append(tradeIDs, trades[i].ID)
+	}
+	query := modelMySQL.Trades(qm.WhereIn(`id in ?`, tradeIDs...))
+	_, err := query.DeleteAll(ctx, tx)
+	return err
+}
+
+// generatePostgresQuery uses a typed timestamp range so postgres can prune
+// time partitions and orders by the partition key
+func generatePostgresQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()),
+		qm.OrderBy("timestamp"),
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+	return query
+}
+
 func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
 	query := []qm.QueryMod{
 		qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
@@ -357,3 +500,105 @@ func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.Qu
 	}
 	return query
 }
+
+// DeleteTradesBefore removes every stored trade with a timestamp before the
+// supplied time
+func DeleteTradesBefore(before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("beginTx %w", err)
+	}
+	var totalDeleted int64
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		totalDeleted, err = modelSQLite.Trades(qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339))).DeleteAll(ctx, tx)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		totalDeleted, err = modelMySQL.Trades(qm.Where("timestamp < ?", before.UTC())).DeleteAll(ctx, tx)
+	} else {
+		totalDeleted, err = modelPSQL.Trades(qm.Where("timestamp < ?", before.UTC())).DeleteAll(ctx, tx)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "DeleteTradesBefore tx.Rollback %v", errRB)
+		}
+		return 0, err
+	}
+	return totalDeleted, tx.Commit()
+}
+
+// SeriesBefore returns every stored trade series which has trades before the
+// supplied time, along with the series' oldest trade time
+func SeriesBefore(before time.Time) ([]SeriesDetails, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	ctx := context.Background()
+	selectQuery := qm.Select("exchange_name_id", "asset", "base", "quote", "min(timestamp) as oldest")
+	groupQuery := qm.GroupBy("exchange_name_id, asset, base, quote")
+	var resp []SeriesDetails
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		var rows []struct {
+			ExchangeNameID string `boil:"exchange_name_id"`
+			Asset          string `boil:"asset"`
+			Base           string `boil:"base"`
+			Quote          string `boil:"quote"`
+			Oldest         string `boil:"oldest"`
+		}
+		err := modelSQLite.Trades(selectQuery,
+			qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)),
+			groupQuery,
+		).Bind(ctx, database.DB.SQL, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			oldest, err := time.Parse(time.RFC3339, rows[i].Oldest)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, SeriesDetails{
+				ExchangeNameID: rows[i].ExchangeNameID,
+				AssetType:      rows[i].Asset,
+				Base:           rows[i].Base,
+				Quote:          rows[i].Quote,
+				Oldest:         oldest,
+			})
+		}
+		return resp, nil
+	}
+
+	var rows []struct {
+		ExchangeNameID string    `boil:"exchange_name_id"`
+		Asset          string    `boil:"asset"`
+		Base           string    `boil:"base"`
+		Quote          string    `boil:"quote"`
+		Oldest         time.Time `boil:"oldest"`
+	}
+	mods := []qm.QueryMod{selectQuery,
+		qm.Where("timestamp < ?", before.UTC()),
+		groupQuery,
+	}
+	var err error
+	if repository.GetSQLDialect() == database.DBMySQL {
+		err = modelMySQL.Trades(mods...).Bind(ctx, database.DB.SQL, &rows)
+	} else {
+		err = modelPSQL.Trades(mods...).Bind(ctx, database.DB.SQL, &rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		resp = append(resp, SeriesDetails{
+			ExchangeNameID: rows[i].ExchangeNameID,
+			AssetType:      rows[i].Asset,
+			Base:           rows[i].Base,
+			Quote:          rows[i].Quote,
+			Oldest:         rows[i].Oldest.UTC(),
+		})
+	}
+	return resp, nil
+}
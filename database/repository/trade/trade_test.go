@@ -36,6 +36,7 @@ func TestMain(m *testing.M) {
 	}
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		log.Fatal(err)
@@ -56,6 +57,11 @@ func TestTrades(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -189,6 +195,75 @@ func tradeSQLTester(t *testing.T) {
 	if len(v) != 0 {
 		t.Errorf("should all be ded %v", v)
 	}
+
+	tradeRetentionTester(t)
+}
+
+func tradeRetentionTester(t *testing.T) {
+	t.Helper()
+	cutoff := time.Now().Add(-time.Hour).Truncate(time.Second)
+	oldest := cutoff.Add(-time.Hour * 48)
+	var trades []Data
+	for i := 0; i < 10; i++ {
+		uu, _ := uuid.NewV4()
+		ts := oldest.Add(time.Hour * time.Duration(i))
+		if i >= 5 {
+			ts = cutoff.Add(time.Minute * time.Duration(i))
+		}
+		trades = append(trades, Data{
+			ID:        uu.String(),
+			Timestamp: ts,
+			Exchange:  testExchanges[0].Name,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+			AssetType: asset.Spot.String(),
+			Price:     1,
+			Amount:    1,
+			Side:      order.Buy.String(),
+			TID:       fmt.Sprintf("retention%v", i),
+		})
+	}
+	err := Insert(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	series, err := SeriesBefore(cutoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series) != 1 {
+		t.Fatalf("expected 1 series, received %v", len(series))
+	}
+	if !series[0].Oldest.Equal(oldest) {
+		t.Errorf("received oldest %v, expected %v", series[0].Oldest, oldest)
+	}
+	if series[0].AssetType != asset.Spot.String() ||
+		series[0].Base != currency.BTC.String() ||
+		series[0].Quote != currency.USD.String() {
+		t.Errorf("unexpected series %+v", series[0])
+	}
+
+	deleted, err := DeleteTradesBefore(cutoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 5 {
+		t.Errorf("received %v deleted, expected 5", deleted)
+	}
+	remaining, err := GetInRange(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USD.String(),
+		oldest,
+		time.Now().Add(time.Hour))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(remaining) != 5 {
+		t.Errorf("received %v remaining trades, expected 5", len(remaining))
+	}
 }
 
 func seedDB() error {
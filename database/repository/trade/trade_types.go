@@ -1,6 +1,13 @@
 package trade
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrTransaction is returned when a transaction to save trades cannot be
+// started or committed, so the trades were not rejected and can be retried
+var ErrTransaction = errors.New("trade transaction failed")
 
 // Data defines trade data in its simplest
 // db friendly form
@@ -17,3 +24,13 @@ type Data struct {
 	Side           string
 	Timestamp      time.Time
 }
+
+// SeriesDetails holds the details of a stored trade series and the time of
+// its oldest trade
+type SeriesDetails struct {
+	ExchangeNameID string
+	AssetType      string
+	Base           string
+	Quote          string
+	Oldest         time.Time
+}
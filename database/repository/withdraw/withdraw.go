@@ -9,6 +9,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/database"
+	modelMySQL "github.com/idoall/gocryptotrader/database/models/mysql"
 	modelPSQL "github.com/idoall/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -48,6 +49,8 @@ func Event(res *withdraw.Response) {
 
 	if repository.GetSQLDialect() == database.DBSQLite3 {
 		err = addSQLiteEvent(ctx, tx, res)
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		err = addMySQLEvent(ctx, tx, res)
 	} else {
 		err = addPSQLEvent(ctx, tx, res)
 	}
@@ -137,6 +140,81 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 	return nil
 }
 
+func addMySQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	var tempEvent = modelMySQL.WithdrawalHistory{
+		ID:             newUUID.String(),
+		ExchangeNameID: res.Exchange.Name,
+		ExchangeID:     res.Exchange.ID,
+		Status:         res.Exchange.Status,
+		Currency:       res.RequestDetails.Currency.String(),
+		Amount:         res.RequestDetails.Amount,
+		WithdrawType:   int(res.RequestDetails.Type),
+	}
+
+	if res.RequestDetails.Description != "" {
+		tempEvent.Description.SetValid(res.RequestDetails.Description)
+	}
+
+	err = tempEvent.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
+		err = tx.Rollback()
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
+		}
+		return
+	}
+
+	if res.RequestDetails.Type == withdraw.Fiat {
+		fiatEvent := &modelMySQL.WithdrawalFiat{
+			BankName:          res.RequestDetails.Fiat.Bank.BankName,
+			BankAddress:       res.RequestDetails.Fiat.Bank.BankAddress,
+			BankAccountName:   res.RequestDetails.Fiat.Bank.AccountName,
+			BankAccountNumber: res.RequestDetails.Fiat.Bank.AccountNumber,
+			BSB:               res.RequestDetails.Fiat.Bank.BSBNumber,
+			SwiftCode:         res.RequestDetails.Fiat.Bank.SWIFTCode,
+			Iban:              res.RequestDetails.Fiat.Bank.IBAN,
+		}
+		err = tempEvent.SetWithdrawalFiatWithdrawalFiats(ctx, tx, true, fiatEvent)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
+			err = tx.Rollback()
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
+			}
+			return
+		}
+	}
+
+	if res.RequestDetails.Type == withdraw.Crypto {
+		cryptoEvent := &modelMySQL.WithdrawalCrypto{
+			Address: res.RequestDetails.Crypto.Address,
+			Fee:     res.RequestDetails.Crypto.FeeAmount,
+		}
+		if res.RequestDetails.Crypto.AddressTag != "" {
+			cryptoEvent.AddressTag.SetValid(res.RequestDetails.Crypto.AddressTag)
+		}
+		err = tempEvent.AddWithdrawalCryptoWithdrawalCryptos(ctx, tx, true, cryptoEvent)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
+			err = tx.Rollback()
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
+			}
+			return
+		}
+	}
+
+	res.ID = newUUID
+
+	return nil
+}
+
 func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
 	newUUID, errUUID := uuid.NewV4()
 	if errUUID != nil {
@@ -363,6 +441,61 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 			}
 			resp = append(resp, tempResp)
 		}
+	} else if repository.GetSQLDialect() == database.DBMySQL {
+		v, err := modelMySQL.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+
+		for x := range v {
+			var tempResp = &withdraw.Response{}
+			newUUID, _ := uuid.FromString(v[x].ID)
+			tempResp.ID = newUUID
+			tempResp.Exchange.ID = v[x].ExchangeID
+			tempResp.Exchange.Status = v[x].Status
+			tempResp.RequestDetails = withdraw.Request{
+				Currency:    currency.NewCode(v[x].Currency),
+				Description: v[x].Description.String,
+				Amount:      v[x].Amount,
+				Type:        withdraw.RequestType(v[x].WithdrawType),
+			}
+			tempResp.CreatedAt = v[x].CreatedAt
+			tempResp.UpdatedAt = v[x].UpdatedAt
+
+			exchangeName, err := v[x].ExchangeName().One(ctx, database.DB.SQL)
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Unable to get exchange name")
+				tempUUID, errUUID := uuid.FromString(v[x].ExchangeNameID)
+				if errUUID != nil {
+					log.Errorf(log.DatabaseMgr, "invalid exchange name UUID for record %v", v[x].ID)
+				} else {
+					tempResp.Exchange.UUID = tempUUID
+				}
+			} else {
+				tempResp.Exchange.Name = exchangeName.Name
+			}
+
+			if withdraw.RequestType(v[x].WithdrawType) == withdraw.Crypto {
+				x, err := v[x].WithdrawalCryptoWithdrawalCryptos().One(ctx, database.DB.SQL)
+				if err != nil {
+					return nil, err
+				}
+				tempResp.RequestDetails.Crypto.Address = x.Address
+				tempResp.RequestDetails.Crypto.AddressTag = x.AddressTag.String
+				tempResp.RequestDetails.Crypto.FeeAmount = x.Fee
+			} else if withdraw.RequestType(v[x].WithdrawType) == withdraw.Fiat {
+				x, err := v[x].WithdrawalFiatWithdrawalFiats().One(ctx, database.DB.SQL)
+				if err != nil {
+					return nil, err
+				}
+				tempResp.RequestDetails.Fiat.Bank.AccountName = x.BankAccountName
+				tempResp.RequestDetails.Fiat.Bank.AccountNumber = x.BankAccountNumber
+				tempResp.RequestDetails.Fiat.Bank.IBAN = x.Iban
+				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
+				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
+			}
+			resp = append(resp, tempResp)
+		}
 	} else {
 		v, err := modelPSQL.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
 		if err != nil {
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// retentionDuration is saved in config as a duration string such as "168h" or
+// "1m". Numbers are read as nanoseconds so configs saved before durations were
+// written as strings still load
+type retentionDuration time.Duration
+
+func (d retentionDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *retentionDuration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var ns int64
+		if errNum := json.Unmarshal(data, &ns); errNum != nil {
+			return fmt.Errorf("retention duration %s must be a duration string such as \"24h\"", data)
+		}
+		*d = retentionDuration(ns)
+		return nil
+	}
+	if s == "" {
+		*d = 0
+		return nil
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("retention duration: %w", err)
+	}
+	*d = retentionDuration(v)
+	return nil
+}
+
+// retentionConfig drops RetentionConfig's JSON methods so its remaining fields
+// are handled as usual
+type retentionConfig RetentionConfig
+
+type retentionMaxAge struct {
+	MaxAge retentionDuration `json:"maxAge"`
+}
+
+type retentionDownsample struct {
+	MaxAge             retentionDuration `json:"maxAge"`
+	DownsampleInterval retentionDuration `json:"downsampleInterval"`
+}
+
+// MarshalJSON saves the check interval as a duration string
+func (r RetentionConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		retentionConfig
+		CheckInterval retentionDuration `json:"checkInterval"`
+	}{retentionConfig(r), retentionDuration(r.CheckInterval)})
+}
+
+// UnmarshalJSON reads the check interval as a duration string
+func (r *RetentionConfig) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*retentionConfig
+		CheckInterval retentionDuration `json:"checkInterval"`
+	}{retentionConfig: (*retentionConfig)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.CheckInterval = time.Duration(aux.CheckInterval)
+	return nil
+}
+
+// MarshalJSON saves the max age and downsample interval as duration strings
+func (t TradeRetention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(retentionDownsample{
+		MaxAge:             retentionDuration(t.MaxAge),
+		DownsampleInterval: retentionDuration(t.DownsampleInterval),
+	})
+}
+
+// UnmarshalJSON reads the max age and downsample interval as duration strings
+func (t *TradeRetention) UnmarshalJSON(data []byte) error {
+	var aux retentionDownsample
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*t = TradeRetention{
+		MaxAge:             time.Duration(aux.MaxAge),
+		DownsampleInterval: time.Duration(aux.DownsampleInterval),
+	}
+	return nil
+}
+
+// MarshalJSON saves the interval, max age and downsample interval as duration
+// strings
+func (c CandleRetention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Interval retentionDuration `json:"interval"`
+		retentionDownsample
+	}{retentionDuration(c.Interval), retentionDownsample{
+		MaxAge:             retentionDuration(c.MaxAge),
+		DownsampleInterval: retentionDuration(c.DownsampleInterval),
+	}})
+}
+
+// UnmarshalJSON reads the interval, max age and downsample interval as
+// duration strings
+func (c *CandleRetention) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Interval retentionDuration `json:"interval"`
+		retentionDownsample
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*c = CandleRetention{
+		Interval:           time.Duration(aux.Interval),
+		MaxAge:             time.Duration(aux.MaxAge),
+		DownsampleInterval: time.Duration(aux.DownsampleInterval),
+	}
+	return nil
+}
+
+// MarshalJSON saves the max age as a duration string
+func (r RecordRetention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(retentionMaxAge{MaxAge: retentionDuration(r.MaxAge)})
+}
+
+// UnmarshalJSON reads the max age as a duration string
+func (r *RecordRetention) UnmarshalJSON(data []byte) error {
+	var aux retentionMaxAge
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.MaxAge = time.Duration(aux.MaxAge)
+	return nil
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetentionConfigJSON(t *testing.T) {
+	t.Parallel()
+	cfg := RetentionConfig{
+		Enabled:       true,
+		CheckInterval: time.Hour,
+		Trades:        TradeRetention{MaxAge: time.Hour * 24 * 7, DownsampleInterval: time.Minute},
+		Candles: []CandleRetention{
+			{Interval: time.Minute, MaxAge: time.Hour * 24 * 30, DownsampleInterval: time.Hour},
+		},
+		AuditEvents: RecordRetention{MaxAge: time.Hour * 24 * 90},
+	}
+	data, err := json.Marshal(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`"checkInterval":"1h0m0s"`,
+		`"trades":{"maxAge":"168h0m0s","downsampleInterval":"1m0s"}`,
+		`{"interval":"1m0s","maxAge":"720h0m0s","downsampleInterval":"1h0m0s"}`,
+		`"auditEvents":{"maxAge":"2160h0m0s"}`,
+		`"enabled":true`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("expected %s in %s", expected, data)
+		}
+	}
+	var loaded RetentionConfig
+	err = json.Unmarshal(data, &loaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Enabled || loaded.CheckInterval != cfg.CheckInterval || loaded.Trades != cfg.Trades ||
+		len(loaded.Candles) != 1 || loaded.Candles[0] != cfg.Candles[0] || loaded.AuditEvents != cfg.AuditEvents {
+		t.Errorf("received %+v, expected %+v", loaded, cfg)
+	}
+
+	err = json.Unmarshal([]byte(`{"checkInterval":3600000000000,"trades":{"maxAge":"24h"}}`), &loaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.CheckInterval != time.Hour || loaded.Trades.MaxAge != time.Hour*24 || loaded.Trades.DownsampleInterval != 0 {
+		t.Errorf("expected nanoseconds and duration strings to load, received %+v", loaded)
+	}
+	err = json.Unmarshal([]byte(`{"maxAge":"a week"}`), &loaded.AuditEvents)
+	if err == nil {
+		t.Error("expected an invalid duration to error")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/idoall/gocryptotrader/database"
 	"github.com/idoall/gocryptotrader/database/drivers"
+	mysqlConn "github.com/idoall/gocryptotrader/database/drivers/mysql"
 	psqlConn "github.com/idoall/gocryptotrader/database/drivers/postgres"
 	sqliteConn "github.com/idoall/gocryptotrader/database/drivers/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository"
@@ -21,6 +22,8 @@ var (
 	TempDir string
 	// PostgresTestDatabase postgresql database config details
 	PostgresTestDatabase *database.Config
+	// MySQLTestDatabase mysql database config details
+	MySQLTestDatabase *database.Config
 	// MigrationDir default folder for migration's
 	MigrationDir = filepath.Join("..", "..", "migrations")
 )
@@ -73,6 +76,23 @@ func GetConnectionDetails() *database.Config {
 	}
 }
 
+// GetMySQLConnectionDetails returns connection details for a MySQL test db
+// instance such as a local container
+func GetMySQLConnectionDetails() *database.Config {
+	return &database.Config{
+		Enabled:           true,
+		Driver:            database.DBMySQL,
+		ConnectionDetails: drivers.ConnectionDetails{
+			// Host:     "localhost",
+			// Port:     3306,
+			// Username: "",
+			// Password: "",
+			// Database: "",
+			// SSLMode:  "",
+		},
+	}
+}
+
 // ConnectToDatabase opens connection to database and returns pointer to instance of database.DB
 func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err error) {
 	database.DB.Config = conn
@@ -87,6 +107,11 @@ func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err er
 		if err != nil {
 			return nil, err
 		}
+	} else if conn.Driver == database.DBMySQL {
+		dbConn, err = mysqlConn.Connect()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = migrateDB(database.DB.SQL)
@@ -0,0 +1,408 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// timeLayouts are attempted in order when parsing a timestamp without a
+// time zone
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// encode writes records to w using the supplied columns
+func encode(w io.Writer, format string, cols []column, records []record, loc *time.Location) error {
+	switch format {
+	case FormatCSV:
+		return encodeCSV(w, cols, records, loc)
+	case FormatJSONL:
+		return encodeJSONL(w, cols, records, loc)
+	case FormatParquet:
+		return encodeParquet(w, cols, records)
+	}
+	return errInvalidFormat
+}
+
+// decode reads records from r, matching file columns against the available
+// columns
+func decode(r io.Reader, format string, available []column, loc *time.Location) ([]record, error) {
+	switch format {
+	case FormatCSV:
+		return decodeCSV(r, available, loc)
+	case FormatJSONL:
+		return decodeJSONL(r, available, loc)
+	case FormatParquet:
+		return decodeParquet(r, available)
+	}
+	return nil, errInvalidFormat
+}
+
+func encodeCSV(w io.Writer, cols []column, records []record, loc *time.Location) error {
+	c := csv.NewWriter(w)
+	header := make([]string, len(cols))
+	for i := range cols {
+		header[i] = cols[i].name
+	}
+	err := c.Write(header)
+	if err != nil {
+		return err
+	}
+	row := make([]string, len(cols))
+	for i := range records {
+		for j := range cols {
+			row[j] = formatValue(records[i][cols[j].name], loc)
+		}
+		err = c.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
+
+func encodeJSONL(w io.Writer, cols []column, records []record, loc *time.Location) error {
+	bw := bufio.NewWriter(w)
+	var line bytes.Buffer
+	for i := range records {
+		line.Reset()
+		line.WriteByte('{')
+		for j := range cols {
+			if j > 0 {
+				line.WriteByte(',')
+			}
+			var v interface{}
+			switch t := records[i][cols[j].name].(type) {
+			case time.Time:
+				v = t.In(loc).Format(time.RFC3339Nano)
+			default:
+				v = t
+			}
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			line.WriteString(strconv.Quote(cols[j].name))
+			line.WriteByte(':')
+			line.Write(data)
+		}
+		line.WriteString("}\n")
+		_, err := bw.Write(line.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func encodeParquet(w io.Writer, cols []column, records []record) error {
+	pw, err := writer.NewJSONWriterFromWriter(parquetSchema(cols), w, 1)
+	if err != nil {
+		return err
+	}
+	row := make(map[string]interface{}, len(cols))
+	for i := range records {
+		for j := range cols {
+			v := records[i][cols[j].name]
+			switch cols[j].kind {
+			case timeColumn:
+				var ms int64
+				if t, ok := v.(time.Time); ok && !t.IsZero() {
+					ms = t.UnixNano() / int64(time.Millisecond)
+				}
+				v = ms
+			case stringColumn:
+				if v == nil {
+					v = ""
+				}
+			}
+			row[cols[j].name] = v
+		}
+		var data []byte
+		data, err = json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		err = pw.Write(string(data))
+		if err != nil {
+			return err
+		}
+	}
+	return pw.WriteStop()
+}
+
+// parquetSchema builds a flat parquet schema definition for the columns
+func parquetSchema(cols []column) string {
+	fields := make([]string, len(cols))
+	for i := range cols {
+		var t string
+		switch cols[i].kind {
+		case floatColumn:
+			t = "DOUBLE"
+		case intColumn:
+			t = "INT64"
+		case timeColumn:
+			t = "TIMESTAMP_MILLIS"
+		default:
+			t = "UTF8"
+		}
+		fields[i] = fmt.Sprintf(`{"Tag":"name=%s, type=%s, repetitiontype=REQUIRED"}`, cols[i].name, t)
+	}
+	return `{"Tag":"name=parquet_go_root, repetitiontype=REQUIRED","Fields":[` + strings.Join(fields, ",") + `]}`
+}
+
+func decodeCSV(r io.Reader, available []column, loc *time.Location) ([]record, error) {
+	c := csv.NewReader(r)
+	c.TrimLeadingSpace = true
+	header, err := c.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errNoRecords
+		}
+		return nil, err
+	}
+	cols, err := matchColumns(available, header)
+	if err != nil {
+		return nil, err
+	}
+	var records []record
+	for {
+		row, err := c.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		rec := make(record, len(cols))
+		for i := range cols {
+			if i >= len(row) || row[i] == "" {
+				continue
+			}
+			rec[cols[i].name], err = parseValue(cols[i], row[i], loc)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", len(records)+1, err)
+			}
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func decodeJSONL(r io.Reader, available []column, loc *time.Location) ([]record, error) {
+	byName := make(map[string]column, len(available))
+	for i := range available {
+		byName[available[i].name] = available[i]
+	}
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	var records []record
+	for s.Scan() {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		d := json.NewDecoder(bytes.NewReader(line))
+		d.UseNumber()
+		var raw map[string]interface{}
+		err := d.Decode(&raw)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", len(records)+1, err)
+		}
+		rec := make(record, len(raw))
+		for k, v := range raw {
+			col, ok := byName[strings.ToLower(k)]
+			if !ok {
+				return nil, fmt.Errorf("row %d: %w %s", len(records)+1, errUnknownColumn, k)
+			}
+			if v == nil {
+				continue
+			}
+			var text string
+			switch t := v.(type) {
+			case string:
+				text = t
+			case json.Number:
+				text = t.String()
+			default:
+				return nil, fmt.Errorf("row %d: %w for %s: %v", len(records)+1, errInvalidValue, k, v)
+			}
+			if text == "" {
+				continue
+			}
+			rec[col.name], err = parseValue(col, text, loc)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", len(records)+1, err)
+			}
+		}
+		records = append(records, rec)
+	}
+	return records, s.Err()
+}
+
+func decodeParquet(r io.Reader, available []column) ([]record, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	bf, err := buffer.NewBufferFile(data)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := reader.NewParquetColumnReader(bf, 1)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.ReadStop()
+
+	rows := pr.GetNumRows()
+	paths := pr.SchemaHandler.ValueColumns
+	names := make([]string, len(paths))
+	for i := range paths {
+		ex := pr.SchemaHandler.InPathToExPath[paths[i]]
+		names[i] = ex[strings.LastIndex(ex, ".")+1:]
+	}
+	cols, err := matchColumns(available, names)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]record, rows)
+	for i := range records {
+		records[i] = make(record, len(cols))
+	}
+	for i := range cols {
+		var values []interface{}
+		values, _, _, err = pr.ReadColumnByPath(paths[i], rows)
+		if err != nil {
+			return nil, err
+		}
+		for j := range values {
+			if j >= len(records) || values[j] == nil {
+				continue
+			}
+			var v interface{}
+			switch t := values[j].(type) {
+			case int64:
+				switch cols[i].kind {
+				case timeColumn:
+					v = time.Unix(0, t*int64(time.Millisecond)).UTC()
+				case floatColumn:
+					v = float64(t)
+				default:
+					v = t
+				}
+			case int32:
+				v = int64(t)
+			case float64:
+				v = t
+			case float32:
+				v = float64(t)
+			case string:
+				if t == "" {
+					continue
+				}
+				v, err = parseValue(cols[i], t, time.UTC)
+				if err != nil {
+					return nil, fmt.Errorf("row %d: %w", j+1, err)
+				}
+			default:
+				return nil, fmt.Errorf("row %d: %w for %s: %v", j+1, errInvalidValue, cols[i].name, values[j])
+			}
+			records[j][cols[i].name] = v
+		}
+	}
+	return records, nil
+}
+
+// matchColumns returns the column definition for each supplied column name
+func matchColumns(available []column, names []string) ([]column, error) {
+	byName := make(map[string]column, len(available))
+	for i := range available {
+		byName[available[i].name] = available[i]
+	}
+	seen := make(map[string]bool, len(names))
+	cols := make([]column, len(names))
+	for i := range names {
+		name := strings.ToLower(strings.TrimSpace(names[i]))
+		col, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%w %s", errUnknownColumn, names[i])
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w %s", errDuplicateColumn, names[i])
+		}
+		seen[name] = true
+		cols[i] = col
+	}
+	return cols, nil
+}
+
+// parseValue converts a text value into the column's type. Timestamps are
+// accepted as RFC3339, a date time in the supplied location or unix seconds
+func parseValue(col column, s string, loc *time.Location) (interface{}, error) {
+	s = strings.TrimSpace(s)
+	switch col.kind {
+	case floatColumn:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %v", errInvalidValue, col.name, s)
+		}
+		return v, nil
+	case intColumn:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %v", errInvalidValue, col.name, s)
+		}
+		return v, nil
+	case timeColumn:
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return t.UTC(), nil
+		}
+		for i := range timeLayouts {
+			if t, err := time.ParseInLocation(timeLayouts[i], s, loc); err == nil {
+				return t.UTC(), nil
+			}
+		}
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Unix(v, 0).UTC(), nil
+		}
+		return nil, fmt.Errorf("%w for %s: %v", errInvalidValue, col.name, s)
+	}
+	return s, nil
+}
+
+// formatValue converts a typed value into text
+func formatValue(v interface{}, loc *time.Location) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case time.Time:
+		if t.IsZero() {
+			return ""
+		}
+		return t.In(loc).Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,770 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/repository/candle"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/repository/trade"
+	"github.com/idoall/gocryptotrader/database/repository/withdraw"
+	"github.com/idoall/gocryptotrader/log"
+	withdrawPortfolio "github.com/idoall/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	candleColumns = []column{
+		{"timestamp", timeColumn},
+		{"exchange", stringColumn},
+		{"asset", stringColumn},
+		{"base", stringColumn},
+		{"quote", stringColumn},
+		{"interval", intColumn},
+		{"open", floatColumn},
+		{"high", floatColumn},
+		{"low", floatColumn},
+		{"close", floatColumn},
+		{"volume", floatColumn},
+	}
+	tradeColumns = []column{
+		{"id", stringColumn},
+		{"tid", stringColumn},
+		{"timestamp", timeColumn},
+		{"exchange", stringColumn},
+		{"asset", stringColumn},
+		{"base", stringColumn},
+		{"quote", stringColumn},
+		{"price", floatColumn},
+		{"amount", floatColumn},
+		{"side", stringColumn},
+	}
+	withdrawalColumns = []column{
+		{"id", stringColumn},
+		{"created_at", timeColumn},
+		{"updated_at", timeColumn},
+		{"exchange", stringColumn},
+		{"exchange_id", stringColumn},
+		{"status", stringColumn},
+		{"currency", stringColumn},
+		{"amount", floatColumn},
+		{"type", stringColumn},
+		{"description", stringColumn},
+		{"address", stringColumn},
+		{"address_tag", stringColumn},
+		{"fee", floatColumn},
+		{"bank_account_name", stringColumn},
+		{"bank_account_number", stringColumn},
+		{"bank_bsb", stringColumn},
+		{"bank_swift_code", stringColumn},
+		{"bank_iban", stringColumn},
+	}
+)
+
+// FormatFromPath returns the file format matching a file's extension
+func FormatFromPath(path string) (string, error) {
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")) {
+	case "csv":
+		return FormatCSV, nil
+	case "jsonl", "json", "ndjson":
+		return FormatJSONL, nil
+	case "parquet":
+		return FormatParquet, nil
+	}
+	return "", fmt.Errorf("%w: cannot determine format of %s", errInvalidFormat, path)
+}
+
+// Columns returns the column names available for a data type
+func Columns(dataType string) ([]string, error) {
+	cols, err := columnsForDataType(dataType)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].name
+	}
+	return names, nil
+}
+
+func columnsForDataType(dataType string) ([]column, error) {
+	switch dataType {
+	case DataTypeCandles:
+		return candleColumns, nil
+	case DataTypeTrades:
+		return tradeColumns, nil
+	case DataTypeWithdrawals:
+		return withdrawalColumns, nil
+	}
+	return nil, errInvalidDataType
+}
+
+// ExportToFile exports stored data to a file, the format is determined by
+// the file extension if it is not set
+func ExportToFile(path string, req *ExportRequest) (int, error) {
+	if req == nil {
+		return 0, errExportRequestUnset
+	}
+	if req.Format == "" {
+		var err error
+		req.Format, err = FormatFromPath(path)
+		if err != nil {
+			return 0, err
+		}
+	}
+	// validate before creating the file so invalid requests leave nothing
+	// behind
+	_, err := validateExportRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0770)
+	if err != nil {
+		return 0, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	count, err := Export(f, req)
+	if errC := f.Close(); errC != nil && err == nil {
+		err = errC
+	}
+	if err != nil {
+		if errR := os.Remove(path); errR != nil {
+			log.Errorln(log.DatabaseMgr, errR)
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+// Export writes stored data matching the request to w and returns the amount
+// of records written
+func Export(w io.Writer, req *ExportRequest) (int, error) {
+	cols, err := validateExportRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	var records []record
+	switch req.DataType {
+	case DataTypeCandles:
+		records, err = loadCandles(req)
+	case DataTypeTrades:
+		records, err = loadTrades(req)
+	case DataTypeWithdrawals:
+		records, err = loadWithdrawals(req)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	loc := req.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return len(records), encode(w, req.Format, cols, records, loc)
+}
+
+// validateExportRequest checks the request and returns the columns to export
+func validateExportRequest(req *ExportRequest) ([]column, error) {
+	if req == nil {
+		return nil, errExportRequestUnset
+	}
+	req.Format = strings.ToLower(req.Format)
+	if req.Format != FormatCSV && req.Format != FormatJSONL && req.Format != FormatParquet {
+		return nil, errInvalidFormat
+	}
+	req.DataType = strings.ToLower(req.DataType)
+	available, err := columnsForDataType(req.DataType)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Start.Before(req.End) {
+		return nil, errInvalidDates
+	}
+	if req.DataType != DataTypeWithdrawals {
+		if req.Exchange == "" || req.Pair.IsEmpty() || !req.Asset.IsValid() {
+			return nil, fmt.Errorf("%w: exchange, pair and asset must be set", errMissingValue)
+		}
+		if req.DataType == DataTypeCandles && req.Interval <= 0 {
+			return nil, fmt.Errorf("%w: interval must be set", errMissingValue)
+		}
+	}
+	if len(req.Columns) == 0 {
+		return available, nil
+	}
+	return matchColumns(available, req.Columns)
+}
+
+func loadCandles(req *ExportRequest) ([]record, error) {
+	interval := int64(req.Interval.Duration().Seconds())
+	series, err := candle.Series(req.Exchange,
+		req.Pair.Base.String(),
+		req.Pair.Quote.String(),
+		interval,
+		req.Asset.String(),
+		req.Start,
+		req.End)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]record, len(series.Candles))
+	for i := range series.Candles {
+		records[i] = record{
+			"timestamp": series.Candles[i].Timestamp.UTC(),
+			"exchange":  req.Exchange,
+			"asset":     req.Asset.String(),
+			"base":      req.Pair.Base.String(),
+			"quote":     req.Pair.Quote.String(),
+			"interval":  interval,
+			"open":      series.Candles[i].Open,
+			"high":      series.Candles[i].High,
+			"low":       series.Candles[i].Low,
+			"close":     series.Candles[i].Close,
+			"volume":    series.Candles[i].Volume,
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i]["timestamp"].(time.Time).Before(records[j]["timestamp"].(time.Time))
+	})
+	return records, nil
+}
+
+func loadTrades(req *ExportRequest) ([]record, error) {
+	trades, err := trade.GetInRange(req.Exchange,
+		req.Asset.String(),
+		req.Pair.Base.String(),
+		req.Pair.Quote.String(),
+		req.Start,
+		req.End)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Before(trades[j].Timestamp)
+	})
+	records := make([]record, len(trades))
+	for i := range trades {
+		records[i] = record{
+			"id":        trades[i].ID,
+			"tid":       trades[i].TID,
+			"timestamp": trades[i].Timestamp.UTC(),
+			"exchange":  req.Exchange,
+			"asset":     req.Asset.String(),
+			"base":      req.Pair.Base.String(),
+			"quote":     req.Pair.Quote.String(),
+			"price":     trades[i].Price,
+			"amount":    trades[i].Amount,
+			"side":      trades[i].Side,
+		}
+	}
+	return records, nil
+}
+
+func loadWithdrawals(req *ExportRequest) ([]record, error) {
+	events, err := withdraw.GetEventsByDate(req.Exchange, req.Start, req.End, 0)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]record, len(events))
+	for i := range events {
+		requestType := "fiat"
+		if events[i].RequestDetails.Type == withdrawPortfolio.Crypto {
+			requestType = "crypto"
+		}
+		records[i] = record{
+			"id":                  events[i].ID.String(),
+			"created_at":          events[i].CreatedAt.UTC(),
+			"updated_at":          events[i].UpdatedAt.UTC(),
+			"exchange":            events[i].Exchange.Name,
+			"exchange_id":         events[i].Exchange.ID,
+			"status":              events[i].Exchange.Status,
+			"currency":            events[i].RequestDetails.Currency.String(),
+			"amount":              events[i].RequestDetails.Amount,
+			"type":                requestType,
+			"description":         events[i].RequestDetails.Description,
+			"address":             events[i].RequestDetails.Crypto.Address,
+			"address_tag":         events[i].RequestDetails.Crypto.AddressTag,
+			"fee":                 events[i].RequestDetails.Crypto.FeeAmount,
+			"bank_account_name":   events[i].RequestDetails.Fiat.Bank.AccountName,
+			"bank_account_number": events[i].RequestDetails.Fiat.Bank.AccountNumber,
+			"bank_bsb":            events[i].RequestDetails.Fiat.Bank.BSBNumber,
+			"bank_swift_code":     events[i].RequestDetails.Fiat.Bank.SWIFTCode,
+			"bank_iban":           events[i].RequestDetails.Fiat.Bank.IBAN,
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i]["created_at"].(time.Time).Before(records[j]["created_at"].(time.Time))
+	})
+	return records, nil
+}
+
+// ImportFromFile imports data from a file, the format is determined by the
+// file extension if it is not set
+func ImportFromFile(path string, req *ImportRequest) (*ImportResult, error) {
+	if req == nil {
+		return nil, errImportRequestUnset
+	}
+	if req.Format == "" {
+		var err error
+		req.Format, err = FormatFromPath(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errC := f.Close(); errC != nil {
+			log.Errorln(log.DatabaseMgr, errC)
+		}
+	}()
+	return Import(f, req)
+}
+
+// Import reads records from r, validates every record and then stores them,
+// handling records which are already stored via the duplicate handling mode.
+// Nothing is stored if any record is invalid or any write fails
+func Import(r io.Reader, req *ImportRequest) (*ImportResult, error) {
+	if req == nil {
+		return nil, errImportRequestUnset
+	}
+	req.Format = strings.ToLower(req.Format)
+	req.DataType = strings.ToLower(req.DataType)
+	req.Duplicates = strings.ToLower(req.Duplicates)
+	if req.Duplicates == "" {
+		req.Duplicates = DuplicateSkip
+	}
+	if req.Duplicates != DuplicateSkip && req.Duplicates != DuplicateOverwrite && req.Duplicates != DuplicateError {
+		return nil, errInvalidDuplicates
+	}
+	available, err := columnsForDataType(req.DataType)
+	if err != nil {
+		return nil, err
+	}
+	if req.DataType == DataTypeWithdrawals {
+		return nil, errImportNotSupported
+	}
+	loc := req.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	records, err := decode(r, req.Format, available, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errNoRecords
+	}
+
+	var series map[seriesKey][]*importRecord
+	switch req.DataType {
+	case DataTypeCandles:
+		series, err = parseCandles(records, req)
+	case DataTypeTrades:
+		series, err = parseTrades(records, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	result := &ImportResult{Records: len(records)}
+	plans := make([]*importPlan, 0, len(series))
+	for k, v := range series {
+		var plan *importPlan
+		if req.DataType == DataTypeCandles {
+			plan, err = planCandles(k, v, req.Duplicates)
+		} else {
+			plan, err = planTrades(k, v, req.Duplicates)
+		}
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	// every series is stored in a single transaction so a failed import
+	// leaves the database unchanged
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range plans {
+		err = plans[i].execute(ctx, tx)
+		if err != nil {
+			if errRB := tx.Rollback(); errRB != nil {
+				log.Errorln(log.DatabaseMgr, errRB)
+			}
+			return nil, err
+		}
+		result.Imported += plans[i].imported
+		result.Skipped += plans[i].skipped
+		result.Overwritten += plans[i].overwritten
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	// records duplicated within the file itself are skipped or overwritten
+	// by a later record before reaching the database
+	result.Skipped = result.Records - result.Imported
+	return result, nil
+}
+
+// seriesKey groups imported records which are stored together
+type seriesKey struct {
+	exchange string
+	asset    string
+	base     string
+	quote    string
+	interval int64
+}
+
+// importRecord holds a validated candle or trade and the row it came from
+type importRecord struct {
+	row    int
+	key    string
+	candle candle.Candle
+	trade  trade.Data
+}
+
+// importPlan holds the database operations for a series, which are run
+// within the import's transaction
+type importPlan struct {
+	execute     func(ctx context.Context, tx *sql.Tx) error
+	imported    int
+	skipped     int
+	overwritten int
+}
+
+// stringValue returns a record's string value, falling back to the default
+func stringValue(rec record, name, def string) string {
+	if v, ok := rec[name].(string); ok && v != "" {
+		return v
+	}
+	return def
+}
+
+// seriesFromRecord returns the series a record belongs to
+func seriesFromRecord(rec record, row int, req *ImportRequest) (seriesKey, error) {
+	var def seriesKey
+	if !req.Pair.IsEmpty() {
+		def.base = req.Pair.Base.String()
+		def.quote = req.Pair.Quote.String()
+	}
+	if req.Asset != "" {
+		def.asset = req.Asset.String()
+	}
+	k := seriesKey{
+		exchange: strings.ToLower(stringValue(rec, "exchange", req.Exchange)),
+		asset:    strings.ToLower(stringValue(rec, "asset", def.asset)),
+		base:     strings.ToUpper(stringValue(rec, "base", def.base)),
+		quote:    strings.ToUpper(stringValue(rec, "quote", def.quote)),
+	}
+	for _, v := range []struct{ name, value string }{
+		{"exchange", k.exchange},
+		{"asset", k.asset},
+		{"base", k.base},
+		{"quote", k.quote},
+	} {
+		if v.value == "" {
+			return k, fmt.Errorf("row %d: %w for %s", row, errMissingValue, v.name)
+		}
+	}
+	if _, ok := rec["timestamp"].(time.Time); !ok {
+		return k, fmt.Errorf("row %d: %w for timestamp", row, errMissingValue)
+	}
+	return k, nil
+}
+
+// dedupe removes records duplicated within the import, keeping the first
+// record when skipping and the last when overwriting
+func dedupe(recs []*importRecord, mode string) ([]*importRecord, error) {
+	idx := make(map[string]int, len(recs))
+	out := make([]*importRecord, 0, len(recs))
+	for i := range recs {
+		j, ok := idx[recs[i].key]
+		if !ok {
+			idx[recs[i].key] = len(out)
+			out = append(out, recs[i])
+			continue
+		}
+		switch mode {
+		case DuplicateError:
+			return nil, fmt.Errorf("row %d: %w of row %d", recs[i].row, errDuplicateRecord, out[j].row)
+		case DuplicateOverwrite:
+			out[j] = recs[i]
+		}
+	}
+	return out, nil
+}
+
+func parseCandles(records []record, req *ImportRequest) (map[seriesKey][]*importRecord, error) {
+	series := make(map[seriesKey][]*importRecord)
+	for i := range records {
+		row := i + 1
+		k, err := seriesFromRecord(records[i], row, req)
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := records[i]["interval"].(int64); ok {
+			k.interval = v
+		} else {
+			k.interval = int64(req.Interval.Duration().Seconds())
+		}
+		if k.interval <= 0 {
+			return nil, fmt.Errorf("row %d: %w for interval", row, errMissingValue)
+		}
+		c := candle.Candle{Timestamp: records[i]["timestamp"].(time.Time)}
+		for _, f := range []struct {
+			name  string
+			value *float64
+		}{
+			{"open", &c.Open},
+			{"high", &c.High},
+			{"low", &c.Low},
+			{"close", &c.Close},
+			{"volume", &c.Volume},
+		} {
+			v, ok := records[i][f.name].(float64)
+			if !ok {
+				return nil, fmt.Errorf("row %d: %w for %s", row, errMissingValue, f.name)
+			}
+			*f.value = v
+		}
+		err = validateCandle(&c, k.interval)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		series[k] = append(series[k], &importRecord{
+			row:    row,
+			key:    strconv.FormatInt(c.Timestamp.Unix(), 10),
+			candle: c,
+		})
+	}
+	for k := range series {
+		var err error
+		series[k], err = dedupe(series[k], req.Duplicates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return series, nil
+}
+
+// validateCandle ensures a candle's values are consistent and that it is
+// aligned to its interval
+func validateCandle(c *candle.Candle, interval int64) error {
+	if c.Timestamp.Unix()%interval != 0 {
+		return fmt.Errorf("%w: timestamp %v is not aligned to a %ds interval", errInvalidCandle, c.Timestamp, interval)
+	}
+	if c.Open < 0 || c.High < 0 || c.Low < 0 || c.Close < 0 || c.Volume < 0 {
+		return fmt.Errorf("%w: values cannot be negative", errInvalidCandle)
+	}
+	if c.High < c.Low || c.High < c.Open || c.High < c.Close || c.Low > c.Open || c.Low > c.Close {
+		return fmt.Errorf("%w: high and low must contain open and close", errInvalidCandle)
+	}
+	return nil
+}
+
+func planCandles(k seriesKey, recs []*importRecord, mode string) (*importPlan, error) {
+	sort.Slice(recs, func(i, j int) bool {
+		return recs[i].candle.Timestamp.Before(recs[j].candle.Timestamp)
+	})
+	exchangeID, err := exchange.UUIDByName(k.exchange)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := candle.Series(k.exchange,
+		k.base,
+		k.quote,
+		k.interval,
+		k.asset,
+		recs[0].candle.Timestamp,
+		recs[len(recs)-1].candle.Timestamp)
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return nil, err
+	}
+	existing := make(map[int64]bool, len(stored.Candles))
+	for i := range stored.Candles {
+		existing[stored.Candles[i].Timestamp.Unix()] = true
+	}
+
+	plan := &importPlan{}
+	insert := &candle.Item{
+		ExchangeID: exchangeID.String(),
+		Base:       k.base,
+		Quote:      k.quote,
+		Interval:   k.interval,
+		Asset:      k.asset,
+	}
+	var overwrite []candle.Candle
+	for i := range recs {
+		if existing[recs[i].candle.Timestamp.Unix()] {
+			switch mode {
+			case DuplicateError:
+				return nil, fmt.Errorf("row %d: %w, candle %v already stored", recs[i].row, errDuplicateRecord, recs[i].candle.Timestamp)
+			case DuplicateSkip:
+				plan.skipped++
+				continue
+			}
+			overwrite = append(overwrite, recs[i].candle)
+		}
+		insert.Candles = append(insert.Candles, recs[i].candle)
+	}
+	plan.imported = len(insert.Candles)
+	plan.overwritten = len(overwrite)
+	plan.execute = func(ctx context.Context, tx *sql.Tx) error {
+		for i := range overwrite {
+			// candles are removed one at a time as deletion removes every
+			// stored candle between the first and last supplied candle
+			_, err := candle.DeleteCandlesTx(ctx, tx, &candle.Item{
+				ExchangeID: insert.ExchangeID,
+				Base:       insert.Base,
+				Quote:      insert.Quote,
+				Interval:   insert.Interval,
+				Asset:      insert.Asset,
+				Candles:    []candle.Candle{overwrite[i]},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(insert.Candles) == 0 {
+			return nil
+		}
+		_, err := candle.InsertTx(ctx, tx, insert)
+		return err
+	}
+	return plan, nil
+}
+
+func parseTrades(records []record, req *ImportRequest) (map[seriesKey][]*importRecord, error) {
+	series := make(map[seriesKey][]*importRecord)
+	for i := range records {
+		row := i + 1
+		k, err := seriesFromRecord(records[i], row, req)
+		if err != nil {
+			return nil, err
+		}
+		t := trade.Data{
+			ID:        stringValue(records[i], "id", ""),
+			TID:       stringValue(records[i], "tid", ""),
+			Exchange:  k.exchange,
+			Base:      k.base,
+			Quote:     k.quote,
+			AssetType: k.asset,
+			Side:      strings.ToUpper(stringValue(records[i], "side", "")),
+			Timestamp: records[i]["timestamp"].(time.Time),
+		}
+		var ok bool
+		if t.Price, ok = records[i]["price"].(float64); !ok {
+			return nil, fmt.Errorf("row %d: %w for price", row, errMissingValue)
+		}
+		if t.Amount, ok = records[i]["amount"].(float64); !ok {
+			return nil, fmt.Errorf("row %d: %w for amount", row, errMissingValue)
+		}
+		if t.Price <= 0 || t.Amount <= 0 {
+			return nil, fmt.Errorf("row %d: %w: price and amount must be greater than zero", row, errInvalidTrade)
+		}
+		series[k] = append(series[k], &importRecord{
+			row:   row,
+			key:   tradeKey(&t),
+			trade: t,
+		})
+	}
+	for k := range series {
+		var err error
+		series[k], err = dedupe(series[k], req.Duplicates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return series, nil
+}
+
+// tradeKey identifies a trade by its exchange trade ID, or by its details
+// when the ID is unknown
+func tradeKey(t *trade.Data) string {
+	if t.TID != "" {
+		return t.TID
+	}
+	return strconv.FormatInt(t.Timestamp.UnixNano(), 10) + "|" +
+		strconv.FormatFloat(t.Price, 'f', -1, 64) + "|" +
+		strconv.FormatFloat(t.Amount, 'f', -1, 64) + "|" +
+		strings.ToUpper(t.Side)
+}
+
+func planTrades(k seriesKey, recs []*importRecord, mode string) (*importPlan, error) {
+	sort.Slice(recs, func(i, j int) bool {
+		return recs[i].trade.Timestamp.Before(recs[j].trade.Timestamp)
+	})
+	exchangeID, err := exchange.UUIDByName(k.exchange)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := trade.GetInRange(k.exchange,
+		k.asset,
+		k.base,
+		k.quote,
+		recs[0].trade.Timestamp,
+		recs[len(recs)-1].trade.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]trade.Data, len(stored))
+	for i := range stored {
+		existing[tradeKey(&stored[i])] = stored[i]
+	}
+
+	plan := &importPlan{}
+	var insert, overwrite []trade.Data
+	for i := range recs {
+		if s, ok := existing[recs[i].key]; ok {
+			switch mode {
+			case DuplicateError:
+				return nil, fmt.Errorf("row %d: %w, trade %v already stored", recs[i].row, errDuplicateRecord, recs[i].key)
+			case DuplicateSkip:
+				plan.skipped++
+				continue
+			}
+			overwrite = append(overwrite, s)
+		}
+		recs[i].trade.ExchangeNameID = exchangeID.String()
+		insert = append(insert, recs[i].trade)
+	}
+	plan.imported = len(insert)
+	plan.overwritten = len(overwrite)
+	plan.execute = func(ctx context.Context, tx *sql.Tx) error {
+		if len(overwrite) > 0 {
+			err := trade.DeleteTradesTx(ctx, tx, overwrite...)
+			if err != nil {
+				return err
+			}
+		}
+		if len(insert) == 0 {
+			return nil
+		}
+		return trade.InsertTx(ctx, tx, insert...)
+	}
+	return plan, nil
+}
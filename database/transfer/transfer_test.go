@@ -0,0 +1,475 @@
+package transfer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/drivers"
+	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/testhelpers"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+
+	var err error
+	testhelpers.MigrationDir = filepath.Join("..", "migrations")
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func testCandleRecords() []record {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var records []record
+	for i := 0; i < 3; i++ {
+		records = append(records, record{
+			"timestamp": start.Add(time.Hour * time.Duration(i)),
+			"exchange":  testExchanges[0].Name,
+			"asset":     asset.Spot.String(),
+			"base":      currency.BTC.String(),
+			"quote":     currency.USDT.String(),
+			"interval":  int64(3600),
+			"open":      100 + float64(i),
+			"high":      110 + float64(i),
+			"low":       90 + float64(i),
+			"close":     105.5 + float64(i),
+			"volume":    1.25,
+		})
+	}
+	return records
+}
+
+func TestEncodeDecode(t *testing.T) {
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	for _, format := range []string{FormatCSV, FormatJSONL, FormatParquet} {
+		records := testCandleRecords()
+		var buf bytes.Buffer
+		err := encode(&buf, format, candleColumns, records, loc)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if format == FormatCSV && !strings.Contains(buf.String(), "2020-01-01T10:00:00+10:00") {
+			t.Errorf("expected timestamps to be written in the requested location, received %s", buf.String())
+		}
+		decoded, err := decode(&buf, format, candleColumns, time.UTC)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if len(decoded) != len(records) {
+			t.Fatalf("%s: expected %v records received %v", format, len(records), len(decoded))
+		}
+		for i := range records {
+			for k, v := range records[i] {
+				if ts, ok := v.(time.Time); ok {
+					if !ts.Equal(decoded[i][k].(time.Time)) {
+						t.Errorf("%s: %s received %v expected %v", format, k, decoded[i][k], v)
+					}
+					continue
+				}
+				if decoded[i][k] != v {
+					t.Errorf("%s: %s received %v expected %v", format, k, decoded[i][k], v)
+				}
+			}
+		}
+	}
+
+	_, err := decode(strings.NewReader("timestamp,meow\n"), FormatCSV, candleColumns, time.UTC)
+	if !errors.Is(err, errUnknownColumn) {
+		t.Errorf("received %v, expected %v", err, errUnknownColumn)
+	}
+	_, err = decode(strings.NewReader("open,open\n"), FormatCSV, candleColumns, time.UTC)
+	if !errors.Is(err, errDuplicateColumn) {
+		t.Errorf("received %v, expected %v", err, errDuplicateColumn)
+	}
+	_, err = decode(strings.NewReader(`{"open":"bruh"}`), FormatJSONL, candleColumns, time.UTC)
+	if !errors.Is(err, errInvalidValue) {
+		t.Errorf("received %v, expected %v", err, errInvalidValue)
+	}
+	err = encode(&bytes.Buffer{}, "xlsx", candleColumns, nil, time.UTC)
+	if !errors.Is(err, errInvalidFormat) {
+		t.Errorf("received %v, expected %v", err, errInvalidFormat)
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	col := column{"timestamp", timeColumn}
+	expected := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	for _, s := range []string{
+		"2020-01-01T00:00:00Z",
+		"2020-01-01T05:00:00+05:00",
+		"2019-12-31 19:00:00",
+		"2019-12-31T19:00:00",
+		"1577836800",
+	} {
+		v, err := parseValue(col, s, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !v.(time.Time).Equal(expected) {
+			t.Errorf("%s: received %v expected %v", s, v, expected)
+		}
+	}
+	_, err := parseValue(col, "yesterday", loc)
+	if !errors.Is(err, errInvalidValue) {
+		t.Errorf("received %v, expected %v", err, errInvalidValue)
+	}
+	_, err = parseValue(column{"interval", intColumn}, "1.5", loc)
+	if !errors.Is(err, errInvalidValue) {
+		t.Errorf("received %v, expected %v", err, errInvalidValue)
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	for path, expected := range map[string]string{
+		"candles.CSV":     FormatCSV,
+		"trades.jsonl":    FormatJSONL,
+		"a/b/c.parquet":   FormatParquet,
+		"trades.ndjson":   FormatJSONL,
+		"candles.parquet": FormatParquet,
+	} {
+		f, err := FormatFromPath(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f != expected {
+			t.Errorf("%s: received %v expected %v", path, f, expected)
+		}
+	}
+	_, err := FormatFromPath("candles.xlsx")
+	if !errors.Is(err, errInvalidFormat) {
+		t.Errorf("received %v, expected %v", err, errInvalidFormat)
+	}
+}
+
+func TestValidateExportRequest(t *testing.T) {
+	_, err := validateExportRequest(nil)
+	if !errors.Is(err, errExportRequestUnset) {
+		t.Errorf("received %v, expected %v", err, errExportRequestUnset)
+	}
+	req := &ExportRequest{
+		DataType: "CANDLES",
+		Format:   "CSV",
+		Exchange: testExchanges[0].Name,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Interval: kline.OneHour,
+		Start:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		Columns:  []string{"close", "timestamp"},
+	}
+	cols, err := validateExportRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 2 || cols[0].name != "close" || cols[1].name != "timestamp" {
+		t.Errorf("expected requested column order to be kept, received %v", cols)
+	}
+
+	req.Columns = []string{"price"}
+	_, err = validateExportRequest(req)
+	if !errors.Is(err, errUnknownColumn) {
+		t.Errorf("received %v, expected %v", err, errUnknownColumn)
+	}
+
+	req.Columns = nil
+	req.Interval = 0
+	_, err = validateExportRequest(req)
+	if !errors.Is(err, errMissingValue) {
+		t.Errorf("received %v, expected %v", err, errMissingValue)
+	}
+
+	req.DataType = DataTypeWithdrawals
+	req.Exchange = ""
+	req.Start, req.End = req.End, req.Start
+	_, err = validateExportRequest(req)
+	if !errors.Is(err, errInvalidDates) {
+		t.Errorf("received %v, expected %v", err, errInvalidDates)
+	}
+
+	req.Format = "xlsx"
+	_, err = validateExportRequest(req)
+	if !errors.Is(err, errInvalidFormat) {
+		t.Errorf("received %v, expected %v", err, errInvalidFormat)
+	}
+}
+
+func TestParseCandles(t *testing.T) {
+	req := &ImportRequest{
+		Exchange:   testExchanges[0].Name,
+		Asset:      asset.Spot,
+		Pair:       currency.NewPair(currency.BTC, currency.USDT),
+		Interval:   kline.OneHour,
+		Duplicates: DuplicateSkip,
+	}
+	records := testCandleRecords()
+	for i := range records {
+		// fall back to the request details
+		delete(records[i], "exchange")
+		delete(records[i], "interval")
+	}
+	dup := make(record)
+	for k, v := range records[0] {
+		dup[k] = v
+	}
+	dup["close"] = 109.0
+	records = append(records, dup)
+
+	series, err := parseCandles(records, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := seriesKey{
+		exchange: testExchanges[0].Name,
+		asset:    asset.Spot.String(),
+		base:     currency.BTC.String(),
+		quote:    currency.USDT.String(),
+		interval: 3600,
+	}
+	if len(series) != 1 || len(series[k]) != 3 {
+		t.Fatalf("expected a single series of 3 candles, received %v", series)
+	}
+	if series[k][0].candle.Close != 105.5 {
+		t.Errorf("expected first duplicate to be kept when skipping, received %v", series[k][0].candle.Close)
+	}
+
+	req.Duplicates = DuplicateOverwrite
+	series, err = parseCandles(records, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if series[k][0].candle.Close != 109 {
+		t.Errorf("expected last duplicate to be kept when overwriting, received %v", series[k][0].candle.Close)
+	}
+
+	req.Duplicates = DuplicateError
+	_, err = parseCandles(records, req)
+	if !errors.Is(err, errDuplicateRecord) {
+		t.Errorf("received %v, expected %v", err, errDuplicateRecord)
+	}
+
+	records = testCandleRecords()
+	records[1]["high"] = 1.0
+	_, err = parseCandles(records, req)
+	if !errors.Is(err, errInvalidCandle) {
+		t.Errorf("received %v, expected %v", err, errInvalidCandle)
+	}
+
+	records = testCandleRecords()
+	records[1]["timestamp"] = records[1]["timestamp"].(time.Time).Add(time.Minute)
+	_, err = parseCandles(records, req)
+	if !errors.Is(err, errInvalidCandle) {
+		t.Errorf("received %v, expected %v", err, errInvalidCandle)
+	}
+
+	records = testCandleRecords()
+	delete(records[2], "volume")
+	_, err = parseCandles(records, req)
+	if !errors.Is(err, errMissingValue) {
+		t.Errorf("received %v, expected %v", err, errMissingValue)
+	}
+}
+
+func TestParseTrades(t *testing.T) {
+	req := &ImportRequest{
+		Exchange:   testExchanges[0].Name,
+		Asset:      asset.Spot,
+		Pair:       currency.NewPair(currency.BTC, currency.USDT),
+		Duplicates: DuplicateSkip,
+	}
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []record{
+		{"timestamp": ts, "price": 100.0, "amount": 1.0, "side": "buy", "tid": "1"},
+		{"timestamp": ts, "price": 100.0, "amount": 1.0, "side": "buy"},
+		{"timestamp": ts, "price": 101.0, "amount": 1.0, "side": "buy", "tid": "1"},
+		{"timestamp": ts, "price": 100.0, "amount": 1.0, "side": "BUY"},
+	}
+	series, err := parseTrades(records, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range series {
+		if len(v) != 2 {
+			t.Errorf("expected duplicate trades to be removed, received %v", len(v))
+		}
+		if v[0].trade.Side != "BUY" {
+			t.Errorf("expected side to be normalised, received %v", v[0].trade.Side)
+		}
+	}
+
+	records[0]["price"] = 0.0
+	_, err = parseTrades(records, req)
+	if !errors.Is(err, errInvalidTrade) {
+		t.Errorf("received %v, expected %v", err, errInvalidTrade)
+	}
+
+	req.Exchange = ""
+	_, err = parseTrades(records, req)
+	if !errors.Is(err, errMissingValue) {
+		t.Errorf("received %v, expected %v", err, errMissingValue)
+	}
+}
+
+func TestImportValidation(t *testing.T) {
+	_, err := Import(strings.NewReader(""), nil)
+	if !errors.Is(err, errImportRequestUnset) {
+		t.Errorf("received %v, expected %v", err, errImportRequestUnset)
+	}
+	_, err = Import(strings.NewReader(""), &ImportRequest{DataType: DataTypeCandles, Format: FormatCSV, Duplicates: "ignore"})
+	if !errors.Is(err, errInvalidDuplicates) {
+		t.Errorf("received %v, expected %v", err, errInvalidDuplicates)
+	}
+	_, err = Import(strings.NewReader(""), &ImportRequest{DataType: DataTypeWithdrawals, Format: FormatCSV})
+	if !errors.Is(err, errImportNotSupported) {
+		t.Errorf("received %v, expected %v", err, errImportNotSupported)
+	}
+	_, err = Import(strings.NewReader(""), &ImportRequest{DataType: DataTypeCandles, Format: FormatCSV})
+	if !errors.Is(err, errNoRecords) {
+		t.Errorf("received %v, expected %v", err, errNoRecords)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchange.ResetExchangeCache()
+			err = exchange.InsertMany(testExchanges)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			err = encode(&buf, FormatCSV, candleColumns, testCandleRecords(), time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data := buf.Bytes()
+			req := &ImportRequest{
+				DataType: DataTypeCandles,
+				Format:   FormatCSV,
+			}
+			result, err := Import(bytes.NewReader(data), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Imported != 3 {
+				t.Errorf("expected 3 imported candles, received %+v", result)
+			}
+
+			result, err = Import(bytes.NewReader(data), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Imported != 0 || result.Skipped != 3 {
+				t.Errorf("expected stored candles to be skipped, received %+v", result)
+			}
+
+			req.Duplicates = DuplicateError
+			_, err = Import(bytes.NewReader(data), req)
+			if !errors.Is(err, errDuplicateRecord) {
+				t.Errorf("received %v, expected %v", err, errDuplicateRecord)
+			}
+
+			req.Duplicates = DuplicateOverwrite
+			result, err = Import(bytes.NewReader(data), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Imported != 3 || result.Overwritten != 3 {
+				t.Errorf("expected stored candles to be overwritten, received %+v", result)
+			}
+
+			buf.Reset()
+			count, err := Export(&buf, &ExportRequest{
+				DataType: DataTypeCandles,
+				Format:   FormatJSONL,
+				Exchange: testExchanges[0].Name,
+				Asset:    asset.Spot,
+				Pair:     currency.NewPair(currency.BTC, currency.USDT),
+				Interval: kline.OneHour,
+				Start:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:      time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				Columns:  []string{"timestamp", "close"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 3 {
+				t.Errorf("expected 3 exported candles, received %v", count)
+			}
+			if !strings.HasPrefix(buf.String(), `{"timestamp":"2020-01-01T00:00:00Z","close":105.5}`) {
+				t.Errorf("unexpected export %s", buf.String())
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package transfer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+// Supported file formats
+const (
+	FormatCSV     = "csv"
+	FormatJSONL   = "jsonl"
+	FormatParquet = "parquet"
+)
+
+// Supported data types
+const (
+	DataTypeCandles     = "candles"
+	DataTypeTrades      = "trades"
+	DataTypeWithdrawals = "withdrawals"
+)
+
+// Duplicate handling modes for imports
+const (
+	// DuplicateSkip keeps the stored record and ignores the imported one
+	DuplicateSkip = "skip"
+	// DuplicateOverwrite replaces the stored record with the imported one
+	DuplicateOverwrite = "overwrite"
+	// DuplicateError aborts the import before anything is stored
+	DuplicateError = "error"
+)
+
+var (
+	errInvalidFormat      = errors.New("invalid format, must be csv, jsonl or parquet")
+	errInvalidDataType    = errors.New("invalid data type, must be candles, trades or withdrawals")
+	errInvalidDuplicates  = errors.New("invalid duplicate handling, must be skip, overwrite or error")
+	errInvalidDates       = errors.New("start date must be before end date")
+	errUnknownColumn      = errors.New("unknown column")
+	errDuplicateColumn    = errors.New("column specified more than once")
+	errMissingValue       = errors.New("missing value")
+	errInvalidValue       = errors.New("invalid value")
+	errInvalidCandle      = errors.New("invalid candle")
+	errInvalidTrade       = errors.New("invalid trade")
+	errDuplicateRecord    = errors.New("duplicate record")
+	errImportNotSupported = errors.New("withdrawal history cannot be imported")
+	errNoRecords          = errors.New("no records found")
+	errExportRequestUnset = errors.New("export request unset")
+	errImportRequestUnset = errors.New("import request unset")
+)
+
+// ExportRequest defines which stored data to export and how to format it
+type ExportRequest struct {
+	DataType string
+	Format   string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// Interval is only used when exporting candles
+	Interval kline.Interval
+	Start    time.Time
+	End      time.Time
+	// Columns restricts and orders the exported columns, all columns are
+	// exported when empty
+	Columns []string
+	// Location is the time zone timestamps are written in for csv and jsonl,
+	// defaults to UTC. Parquet timestamps are always stored as UTC
+	Location *time.Location
+}
+
+// ImportRequest defines how to read and store imported data. Exchange, Asset,
+// Pair and Interval are used for any row which does not have its own value
+type ImportRequest struct {
+	DataType string
+	Format   string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Interval kline.Interval
+	// Location is the time zone used for timestamps which do not specify
+	// one, defaults to UTC
+	Location *time.Location
+	// Duplicates is the duplicate handling mode, defaults to skip
+	Duplicates string
+}
+
+// ImportResult holds the outcome of an import
+type ImportResult struct {
+	Records     int
+	Imported    int
+	Skipped     int
+	Overwritten int
+}
+
+type columnKind uint8
+
+const (
+	stringColumn columnKind = iota
+	floatColumn
+	intColumn
+	timeColumn
+)
+
+type column struct {
+	name string
+	kind columnKind
+}
+
+// record holds a row of typed values keyed by column name
+type record map[string]interface{}
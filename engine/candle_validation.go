@@ -0,0 +1,206 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database/repository/candle"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+)
+
+// candle validation sources
+const (
+	CandleValidationSourceTrades   = "trades"
+	CandleValidationSourceExchange = "exchange"
+)
+
+var (
+	errInvalidValidationSource = errors.New("invalid candle validation source, must be trades or exchange")
+	errInvalidTolerance        = errors.New("candle validation tolerance cannot be negative")
+	errNoValidationCandles     = errors.New("no candles to validate against")
+)
+
+// CandleValidationRequest defines the range of stored candles to validate and
+// where to source the candles to validate them against
+type CandleValidationRequest struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	Start    time.Time
+	End      time.Time
+	// Source is either trades, which rebuilds candles from stored trades, or
+	// exchange, which re-fetches candles from the exchange
+	Source string
+	// TolerancePercentage is the allowed percentage difference between a
+	// stored and validation candle value before it is flagged
+	TolerancePercentage float64
+	// Repair overwrites discrepant and missing stored candles with the
+	// validation candles
+	Repair bool
+}
+
+// CandleDiscrepancy holds a stored candle which does not match its validation
+// candle
+type CandleDiscrepancy struct {
+	Time     time.Time
+	Missing  bool
+	Stored   kline.Candle
+	Expected kline.Candle
+	Reasons  []string
+}
+
+// CandleValidationResult holds the outcome of validating stored candles
+type CandleValidationResult struct {
+	CandlesChecked  int
+	CandlesRepaired int
+	Discrepancies   []CandleDiscrepancy
+}
+
+// ValidateSavedCandles compares stored candles against candles rebuilt from
+// stored trades or re-fetched from the exchange, flagging any stored candle
+// outside of the tolerance and optionally overwriting it
+func (bot *Engine) ValidateSavedCandles(req *CandleValidationRequest) (*CandleValidationResult, error) {
+	if req == nil || req.Exchange == "" || req.Pair.IsEmpty() || !req.Asset.IsValid() || req.Interval <= 0 {
+		return nil, errInvalidArguments
+	}
+	if !req.Start.Before(req.End) {
+		return nil, errors.New("start cannot be after end")
+	}
+	if req.TolerancePercentage < 0 {
+		return nil, errInvalidTolerance
+	}
+
+	var expected kline.Item
+	var err error
+	switch strings.ToLower(req.Source) {
+	case CandleValidationSourceTrades:
+		var trades []trade.Data
+		trades, err = trade.GetTradesInRange(req.Exchange,
+			req.Asset.String(),
+			req.Pair.Base.String(),
+			req.Pair.Quote.String(),
+			req.Start,
+			req.End)
+		if err != nil {
+			return nil, err
+		}
+		if len(trades) == 0 {
+			return nil, errNoValidationCandles
+		}
+		expected, err = trade.ConvertTradesToCandles(req.Interval, trades...)
+	case CandleValidationSourceExchange:
+		exch := bot.GetExchangeByName(req.Exchange)
+		if exch == nil {
+			return nil, errExchangeNotLoaded
+		}
+		expected, err = exch.GetHistoricCandlesExtended(req.Pair, req.Asset, req.Start, req.End, req.Interval)
+	default:
+		return nil, errInvalidValidationSource
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(expected.Candles) == 0 {
+		return nil, errNoValidationCandles
+	}
+
+	stored, err := kline.LoadFromDatabase(req.Exchange, req.Pair, req.Asset, req.Interval, req.Start, req.End)
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return nil, err
+	}
+
+	result := &CandleValidationResult{
+		Discrepancies: compareCandles(stored.Candles, expected.Candles, req.Interval, req.TolerancePercentage),
+	}
+	result.CandlesChecked = len(expected.Candles)
+	if !req.Repair {
+		return result, nil
+	}
+
+	for i := range result.Discrepancies {
+		// candles are overwritten one at a time as forcing a store deletes
+		// every stored candle between the first and last supplied candle
+		repair := kline.Item{
+			Exchange: req.Exchange,
+			Pair:     req.Pair,
+			Asset:    req.Asset,
+			Interval: req.Interval,
+			Candles:  []kline.Candle{result.Discrepancies[i].Expected},
+		}
+		_, err = kline.StoreInDatabase(&repair, true)
+		if err != nil {
+			return result, fmt.Errorf("unable to repair candle %v: %w", result.Discrepancies[i].Time, err)
+		}
+		result.CandlesRepaired++
+	}
+	return result, nil
+}
+
+// compareCandles returns every expected candle which is either missing from
+// or outside of the tolerance of the stored candles
+func compareCandles(stored, expected []kline.Candle, interval kline.Interval, tolerance float64) []CandleDiscrepancy {
+	storedByTime := make(map[int64]kline.Candle, len(stored))
+	for i := range stored {
+		storedByTime[interval.CandleStart(stored[i].Time).Unix()] = stored[i]
+	}
+
+	var resp []CandleDiscrepancy
+	for i := range expected {
+		t := interval.CandleStart(expected[i].Time)
+		expected[i].Time = t
+		s, ok := storedByTime[t.Unix()]
+		if !ok {
+			resp = append(resp, CandleDiscrepancy{
+				Time:     t,
+				Missing:  true,
+				Expected: expected[i],
+			})
+			continue
+		}
+		var reasons []string
+		fields := []struct {
+			name             string
+			stored, expected float64
+		}{
+			{"open", s.Open, expected[i].Open},
+			{"high", s.High, expected[i].High},
+			{"low", s.Low, expected[i].Low},
+			{"close", s.Close, expected[i].Close},
+			{"volume", s.Volume, expected[i].Volume},
+		}
+		for j := range fields {
+			if exceedsTolerance(fields[j].stored, fields[j].expected, tolerance) {
+				reasons = append(reasons, fmt.Sprintf("%s stored %v expected %v",
+					fields[j].name,
+					fields[j].stored,
+					fields[j].expected))
+			}
+		}
+		if len(reasons) > 0 {
+			resp = append(resp, CandleDiscrepancy{
+				Time:     t,
+				Stored:   s,
+				Expected: expected[i],
+				Reasons:  reasons,
+			})
+		}
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp
+}
+
+// exceedsTolerance returns whether the stored value differs from the expected
+// value by more than the tolerance percentage of the expected value
+func exceedsTolerance(stored, expected, tolerancePercentage float64) bool {
+	return math.Abs(stored-expected) > math.Abs(expected)*tolerancePercentage/100
+}
@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+func TestValidateSavedCandles(t *testing.T) {
+	var bot Engine
+	_, err := bot.ValidateSavedCandles(nil)
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received %v, expected %v", err, errInvalidArguments)
+	}
+
+	req := &CandleValidationRequest{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+		Start:    time.Now().Add(-time.Hour * 24),
+		End:      time.Now(),
+		Source:   "bruh",
+	}
+	_, err = bot.ValidateSavedCandles(req)
+	if !errors.Is(err, errInvalidValidationSource) {
+		t.Errorf("received %v, expected %v", err, errInvalidValidationSource)
+	}
+
+	req.TolerancePercentage = -1
+	_, err = bot.ValidateSavedCandles(req)
+	if !errors.Is(err, errInvalidTolerance) {
+		t.Errorf("received %v, expected %v", err, errInvalidTolerance)
+	}
+
+	req.TolerancePercentage = 0
+	req.Start, req.End = req.End, req.Start
+	_, err = bot.ValidateSavedCandles(req)
+	if err == nil {
+		t.Error("expected error when start is after end")
+	}
+}
+
+func TestCompareCandles(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	stored := []kline.Candle{
+		{Time: start, Open: 100, High: 110, Low: 90, Close: 105, Volume: 10},
+		{Time: start.Add(time.Hour), Open: 105, High: 120, Low: 100, Close: 115, Volume: 20},
+	}
+	expected := []kline.Candle{
+		{Time: start.Add(time.Minute), Open: 100, High: 110, Low: 90, Close: 105, Volume: 10.05},
+		{Time: start.Add(time.Hour), Open: 105, High: 130, Low: 100, Close: 115, Volume: 20},
+		{Time: start.Add(time.Hour * 2), Open: 115, High: 120, Low: 110, Close: 112, Volume: 5},
+	}
+
+	resp := compareCandles(stored, expected, kline.OneHour, 1)
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 discrepancies received %v", len(resp))
+	}
+	if !resp[0].Time.Equal(start.Add(time.Hour)) || resp[0].Missing {
+		t.Errorf("unexpected discrepancy %+v", resp[0])
+	}
+	if len(resp[0].Reasons) != 1 {
+		t.Errorf("expected 1 reason received %v", resp[0].Reasons)
+	}
+	if !resp[1].Missing || !resp[1].Time.Equal(start.Add(time.Hour*2)) {
+		t.Errorf("expected missing candle, received %+v", resp[1])
+	}
+
+	resp = compareCandles(stored, expected, kline.OneHour, 0)
+	if len(resp) != 3 {
+		t.Errorf("expected 3 discrepancies with no tolerance received %v", len(resp))
+	}
+}
+
+func TestExceedsTolerance(t *testing.T) {
+	if exceedsTolerance(101, 100, 1) {
+		t.Error("expected value within tolerance")
+	}
+	if !exceedsTolerance(101.5, 100, 1) {
+		t.Error("expected value outside of tolerance")
+	}
+	if !exceedsTolerance(1, 0, 50) {
+		t.Error("expected any difference from zero to exceed tolerance")
+	}
+	if exceedsTolerance(0, 0, 0) {
+		t.Error("expected equal values to be within tolerance")
+	}
+}
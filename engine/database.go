@@ -7,14 +7,20 @@ import (
 	"time"
 
 	"github.com/idoall/gocryptotrader/database"
+	dbmysql "github.com/idoall/gocryptotrader/database/drivers/mysql"
 	dbpsql "github.com/idoall/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/idoall/gocryptotrader/database/drivers/sqlite3"
+	"github.com/idoall/gocryptotrader/database/repository/partition"
 	"github.com/idoall/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 )
 
 var (
 	dbConn *database.Instance
+
+	// timePartitioningCheckInterval is the delay between ensuring future
+	// partitions exist when time partitioning is enabled
+	timePartitioningCheckInterval = time.Hour * 24
 )
 
 type databaseManager struct {
@@ -57,6 +63,13 @@ func (a *databaseManager) Start(bot *Engine) (err error) {
 				bot.Config.Database.Database,
 				bot.Config.Database.Driver)
 			dbConn, err = dbsqlite3.Connect()
+		} else if bot.Config.Database.Driver == database.DBMySQL {
+			log.Debugf(log.DatabaseMgr,
+				"Attempting to establish database connection to host %s/%s utilising %s driver\n",
+				bot.Config.Database.Host,
+				bot.Config.Database.Database,
+				bot.Config.Database.Driver)
+			dbConn, err = dbmysql.Connect()
 		}
 		if err != nil {
 			return fmt.Errorf("database failed to connect: %v Some features that utilise a database will be unavailable", err)
@@ -69,6 +82,10 @@ func (a *databaseManager) Start(bot *Engine) (err error) {
 			boil.DebugWriter = DBLogger
 		}
 
+		if bot.Config.Database.TimePartitioning {
+			a.ensureTimePartitioning()
+		}
+
 		go a.run(bot)
 		return nil
 	}
@@ -99,9 +116,11 @@ func (a *databaseManager) run(bot *Engine) {
 	bot.ServicesWG.Add(1)
 
 	t := time.NewTicker(time.Second * 2)
+	partitionTicker := time.NewTicker(timePartitioningCheckInterval)
 
 	defer func() {
 		t.Stop()
+		partitionTicker.Stop()
 		atomic.CompareAndSwapInt32(&a.stopped, 1, 0)
 		atomic.CompareAndSwapInt32(&a.started, 1, 0)
 
@@ -116,10 +135,30 @@ func (a *databaseManager) run(bot *Engine) {
 			return
 		case <-t.C:
 			a.checkConnection()
+		case <-partitionTicker.C:
+			if bot.Config.Database.TimePartitioning {
+				a.ensureTimePartitioning()
+			}
 		}
 	}
 }
 
+// ensureTimePartitioning creates upcoming partitions for candle and trade
+// tables which have been time partitioned by the dbmigrate partition command.
+// Failures are logged as the existing partitions remain usable
+func (a *databaseManager) ensureTimePartitioning() {
+	mode, err := partition.Extend(partition.DefaultMonthsAhead)
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Time partitioning unavailable, ensure migrations are up to date: %v\n", err)
+		return
+	}
+	if mode == partition.ModeNone {
+		log.Warnln(log.DatabaseMgr, "Time partitioning is enabled but the candle and trade tables are not partitioned, run dbmigrate -command partition to convert them")
+		return
+	}
+	log.Debugf(log.DatabaseMgr, "Candle and trade tables time partitioned using %s partitioning\n", mode)
+}
+
 func (a *databaseManager) checkConnection() {
 	dbConn.Mu.Lock()
 	defer dbConn.Mu.Unlock()
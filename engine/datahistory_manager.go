@@ -0,0 +1,692 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/repository/datahistoryjob"
+	"github.com/idoall/gocryptotrader/database/repository/datahistoryjobresult"
+	exchange "github.com/idoall/gocryptotrader/exchanges"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+func (d dataHistoryStatus) String() string {
+	switch d {
+	case dataHistoryStatusActive:
+		return "active"
+	case dataHistoryStatusFailed:
+		return "failed"
+	case dataHistoryStatusComplete:
+		return "complete"
+	case dataHistoryStatusRemoved:
+		return "removed"
+	}
+	return "unknown"
+}
+
+func (d dataHistoryDataType) String() string {
+	switch d {
+	case dataHistoryCandleDataType:
+		return "candles"
+	case dataHistoryTradeDataType:
+		return "trades"
+	case dataHistoryOpenInterestDataType:
+		return "openinterest"
+	case dataHistoryLongShortRatioDataType:
+		return "longshortratio"
+	}
+	return "unknown"
+}
+
+func (d *dataHistoryManager) Started() bool {
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+func (d *dataHistoryManager) Start() error {
+	if atomic.AddInt32(&d.started, 1) != 1 {
+		return errors.New("data history manager already started")
+	}
+	database.DB.Mu.RLock()
+	connected := database.DB.SQL != nil && database.DB.Connected
+	database.DB.Mu.RUnlock()
+	if !connected {
+		atomic.StoreInt32(&d.started, 0)
+		return errDatabaseNotConnected
+	}
+
+	log.Debugln(log.DataHistoryMgr, "Data history manager starting...")
+	jobs, err := datahistoryjob.GetJobsByStatus(int64(dataHistoryStatusActive))
+	if err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		atomic.StoreInt32(&d.started, 0)
+		return err
+	}
+	d.m.Lock()
+	d.jobs = nil
+	for i := range jobs {
+		var job *DataHistoryJob
+		job, err = convertDBToJob(&jobs[i])
+		if err != nil {
+			log.Errorf(log.DataHistoryMgr, "Data history manager unable to load job %v: %v", jobs[i].Nickname, err)
+			continue
+		}
+		d.jobs = append(d.jobs, job)
+	}
+	jobCount := len(d.jobs)
+	d.m.Unlock()
+
+	d.shutdown = make(chan struct{})
+	go d.run()
+	log.Debugf(log.DataHistoryMgr, "Data history manager started with %d active jobs.\n", jobCount)
+	return nil
+}
+
+func (d *dataHistoryManager) Stop() error {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return errDataHistoryManagerNotStarted
+	}
+
+	if atomic.AddInt32(&d.stopped, 1) != 1 {
+		return errors.New("data history manager is already stopped")
+	}
+
+	close(d.shutdown)
+	log.Debugln(log.DataHistoryMgr, "Data history manager shutting down...")
+	return nil
+}
+
+func (d *dataHistoryManager) run() {
+	t := time.NewTicker(DataHistoryManagerCheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&d.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&d.started, 1, 0)
+		log.Debugln(log.DataHistoryMgr, "Data history manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-d.shutdown:
+			return
+		case <-t.C:
+			d.runJobs()
+		}
+	}
+}
+
+// runJobs processes the next batch of intervals for every active job. Each
+// exchange request is subject to the exchange's own rate limiter. The jobs are
+// processed from a snapshot so the manager is not locked while data is fetched
+func (d *dataHistoryManager) runJobs() {
+	d.m.Lock()
+	jobs := make([]*DataHistoryJob, len(d.jobs))
+	copy(jobs, d.jobs)
+	d.m.Unlock()
+
+	for i := range jobs {
+		select {
+		case <-d.shutdown:
+			return
+		default:
+		}
+		err := d.runJob(jobs[i])
+		if err != nil {
+			log.Errorf(log.DataHistoryMgr, "Data history job %v: %v", jobs[i].Nickname, err)
+		}
+	}
+
+	d.m.Lock()
+	active := d.jobs[:0]
+	for i := range d.jobs {
+		if d.jobs[i].Status == dataHistoryStatusActive {
+			active = append(active, d.jobs[i])
+		}
+	}
+	d.jobs = active
+	d.m.Unlock()
+}
+
+// runJob fetches and saves up to the job's batch size of unprocessed
+// intervals, recording the result of each and updating the job status once
+// there is nothing left to process. The manager is only locked while the
+// job's results and status are saved
+func (d *dataHistoryManager) runJob(job *DataHistoryJob) error {
+	d.m.Lock()
+	if job.Status != dataHistoryStatusActive {
+		d.m.Unlock()
+		return nil
+	}
+	ranges := job.rangesToProcess()
+	d.m.Unlock()
+
+	exch := Bot.GetExchangeByName(job.Exchange)
+	if exch == nil {
+		return errExchangeNotLoaded
+	}
+
+	var results []DataHistoryJobResult
+processing:
+	for i := range ranges {
+		select {
+		case <-d.shutdown:
+			break processing
+		default:
+		}
+		resultID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		result := DataHistoryJobResult{
+			ID:                resultID,
+			JobID:             job.ID,
+			IntervalStartDate: ranges[i].Start,
+			IntervalEndDate:   ranges[i].End,
+			Status:            dataHistoryStatusComplete,
+			Date:              time.Now(),
+		}
+		switch job.DataType {
+		case dataHistoryCandleDataType:
+			result.Result, err = processCandleData(job, exch, ranges[i])
+		case dataHistoryTradeDataType:
+			result.Result, err = processTradeData(job, exch, ranges[i])
+		case dataHistoryOpenInterestDataType:
+			result.Result, err = processOpenInterestData(job, exch, ranges[i])
+		case dataHistoryLongShortRatioDataType:
+			result.Result, err = processLongShortRatioData(job, exch, ranges[i])
+		default:
+			err = errInvalidDataHistoryDataType
+		}
+		if err != nil {
+			result.Status = dataHistoryStatusFailed
+			result.Result = err.Error()
+		}
+		results = append(results, result)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	// the job may have been deleted or replaced while its data was fetched,
+	// in which case saving would write it back over the change
+	if job.Status != dataHistoryStatusActive {
+		return nil
+	}
+	dbResults := make([]*datahistoryjobresult.DataHistoryJobResult, len(results))
+	for i := range results {
+		dbResults[i] = convertResultToDB(&results[i])
+	}
+	err := datahistoryjobresult.Upsert(dbResults...)
+	if err != nil {
+		return err
+	}
+	for i := range results {
+		job.Results[results[i].IntervalStartDate] = append(job.Results[results[i].IntervalStartDate], results[i])
+	}
+	if len(job.rangesToProcess()) > 0 {
+		return nil
+	}
+	job.Status = job.completionStatus()
+	log.Infof(log.DataHistoryMgr, "Data history job %v finished with status %v\n", job.Nickname, job.Status)
+	return datahistoryjob.Upsert(convertJobToDB(job))
+}
+
+// processCandleData fetches and saves candles for an interval unless they
+// have already been saved
+func processCandleData(job *DataHistoryJob, exch exchange.IBotExchange, r kline.DateRange) (string, error) {
+	saved, err := kline.LoadFromDatabase(job.Exchange, job.Pair, job.Asset, job.Interval, r.Start, r.End)
+	if err == nil && uint32(len(saved.Candles)) >= kline.TotalCandlesPerInterval(r.Start, r.End, job.Interval) {
+		return "candles already saved", nil
+	}
+	candles, err := exch.GetHistoricCandlesExtended(job.Pair, job.Asset, r.Start, r.End, job.Interval)
+	if err != nil {
+		return "", err
+	}
+	if len(candles.Candles) == 0 {
+		return "no candles returned", nil
+	}
+	count, err := kline.StoreInDatabase(&candles, false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("saved %d candles", count), nil
+}
+
+// processTradeData fetches and saves trades for an interval unless trades
+// have already been saved within it
+func processTradeData(job *DataHistoryJob, exch exchange.IBotExchange, r kline.DateRange) (string, error) {
+	saved, err := trade.GetTradesInRange(job.Exchange,
+		job.Asset.String(),
+		job.Pair.Base.String(),
+		job.Pair.Quote.String(),
+		r.Start,
+		r.End)
+	if err == nil && len(saved) > 0 {
+		return "trades already saved", nil
+	}
+	trades, err := exch.GetHistoricTrades(job.Pair, job.Asset, r.Start, r.End)
+	if err != nil {
+		return "", err
+	}
+	if len(trades) == 0 {
+		return "no trades returned", nil
+	}
+	err = trade.SaveTradesToDatabase(trades...)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("saved %d trades", len(trades)), nil
+}
+
+// processOpenInterestData fetches and saves open interest for an interval
+// unless it has already been saved
+func processOpenInterestData(job *DataHistoryJob, exch exchange.IBotExchange, r kline.DateRange) (string, error) {
+	saved, err := futuresdata.LoadOpenInterest(job.Exchange, job.Pair, job.Asset, job.Interval, r.Start, r.End)
+	if err == nil && uint32(len(saved)) >= kline.TotalCandlesPerInterval(r.Start, r.End, job.Interval) {
+		return "open interest already saved", nil
+	}
+	openInterest, err := exch.GetOpenInterestHistory(job.Pair, job.Asset, r.Start, r.End, job.Interval)
+	if err != nil {
+		return "", err
+	}
+	if len(openInterest) == 0 {
+		return "no open interest returned", nil
+	}
+	count, err := futuresdata.StoreOpenInterest(openInterest...)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("saved %d open interest", count), nil
+}
+
+// processLongShortRatioData fetches and saves every long/short ratio type for
+// an interval, skipping ratio types which have already been saved
+func processLongShortRatioData(job *DataHistoryJob, exch exchange.IBotExchange, r kline.DateRange) (string, error) {
+	expected := kline.TotalCandlesPerInterval(r.Start, r.End, job.Interval)
+	var count int
+	for _, ratioType := range []futuresdata.RatioType{
+		futuresdata.GlobalAccountRatio,
+		futuresdata.TopAccountRatio,
+		futuresdata.TopPositionRatio,
+	} {
+		saved, err := futuresdata.LoadLongShortRatios(job.Exchange, job.Pair, job.Asset, ratioType, job.Interval, r.Start, r.End)
+		if err == nil && uint32(len(saved)) >= expected {
+			continue
+		}
+		ratios, err := exch.GetLongShortRatioHistory(job.Pair, job.Asset, ratioType, r.Start, r.End, job.Interval)
+		if err != nil {
+			return "", fmt.Errorf("%s %w", ratioType, err)
+		}
+		saveCount, err := futuresdata.StoreLongShortRatios(ratios...)
+		if err != nil {
+			return "", err
+		}
+		count += saveCount
+	}
+	if count == 0 {
+		return "no long/short ratios saved", nil
+	}
+	return fmt.Sprintf("saved %d long/short ratios", count), nil
+}
+
+// dateRanges splits the job into the intervals requested from the exchange.
+// Candles, open interest and long/short ratios are requested up to the request
+// size limit at a time, trades one job interval at a time
+func (j *DataHistoryJob) dateRanges() []kline.DateRange {
+	limit := uint32(j.RequestSizeLimit)
+	if j.DataType == dataHistoryTradeDataType {
+		limit = 1
+	}
+	ranges := kline.CalcDateRanges(j.StartDate, j.EndDate, j.Interval, limit)
+	resp := ranges[:0]
+	for i := range ranges {
+		if ranges[i].End.After(ranges[i].Start) {
+			resp = append(resp, ranges[i])
+		}
+	}
+	return resp
+}
+
+// rangesToProcess returns the next batch of intervals which have not yet
+// succeeded and have retry attempts remaining
+func (j *DataHistoryJob) rangesToProcess() []kline.DateRange {
+	var resp []kline.DateRange
+	ranges := j.dateRanges()
+	for i := range ranges {
+		results := j.Results[ranges[i].Start]
+		if hasSucceeded(results) || int64(len(results)) >= j.MaxRetryAttempts {
+			continue
+		}
+		resp = append(resp, ranges[i])
+		if int64(len(resp)) >= j.BatchSize {
+			break
+		}
+	}
+	return resp
+}
+
+// completionStatus returns complete if every interval has succeeded,
+// otherwise failed
+func (j *DataHistoryJob) completionStatus() dataHistoryStatus {
+	ranges := j.dateRanges()
+	for i := range ranges {
+		if !hasSucceeded(j.Results[ranges[i].Start]) {
+			return dataHistoryStatusFailed
+		}
+	}
+	return dataHistoryStatusComplete
+}
+
+func hasSucceeded(results []DataHistoryJobResult) bool {
+	for i := range results {
+		if results[i].Status == dataHistoryStatusComplete {
+			return true
+		}
+	}
+	return false
+}
+
+// validateJob checks a job against its exchange before it is saved, applying
+// defaults to any unset limits
+func validateJob(job *DataHistoryJob, exch exchange.IBotExchange) error {
+	if job.Nickname == "" {
+		return errNicknameUnset
+	}
+	if exch == nil {
+		return errExchangeNotLoaded
+	}
+	job.Exchange = exch.GetName()
+	if !job.Asset.IsValid() {
+		return fmt.Errorf("%s %w", job.Asset, errInvalidDataHistoryAsset)
+	}
+	pairs, err := exch.GetEnabledPairs(job.Asset)
+	if err != nil {
+		return err
+	}
+	if !pairs.Contains(job.Pair, false) {
+		return fmt.Errorf("%v %v %w", job.Exchange, job.Pair, errDataHistoryPairNotEnabled)
+	}
+	switch job.DataType {
+	case dataHistoryCandleDataType, dataHistoryTradeDataType,
+		dataHistoryOpenInterestDataType, dataHistoryLongShortRatioDataType:
+	default:
+		return errInvalidDataHistoryDataType
+	}
+	if job.Interval <= 0 {
+		return errInvalidDataHistoryInterval
+	}
+	if job.StartDate.IsZero() || job.EndDate.IsZero() || !job.StartDate.Before(job.EndDate) {
+		return errInvalidDataHistoryJobDates
+	}
+	if job.RequestSizeLimit == 0 {
+		job.RequestSizeLimit = defaultDataHistoryRequestSizeLimit
+	}
+	if job.BatchSize == 0 {
+		job.BatchSize = defaultDataHistoryBatchLimit
+	}
+	if job.MaxRetryAttempts == 0 {
+		job.MaxRetryAttempts = defaultDataHistoryRetryAttempts
+	}
+	if job.RequestSizeLimit < 0 || job.BatchSize < 0 || job.MaxRetryAttempts < 0 {
+		return errInvalidDataHistoryLimits
+	}
+	job.StartDate = job.StartDate.UTC().Truncate(job.Interval.Duration())
+	job.EndDate = job.EndDate.UTC().Truncate(job.Interval.Duration())
+	return nil
+}
+
+// UpsertJob validates and saves a job, replacing any existing job with the
+// same nickname. Active jobs are processed on the next run
+func (d *dataHistoryManager) UpsertJob(job *DataHistoryJob) error {
+	if !d.Started() {
+		return errDataHistoryManagerNotStarted
+	}
+	if job == nil {
+		return errNicknameUnset
+	}
+	err := validateJob(job, Bot.GetExchangeByName(job.Exchange))
+	if err != nil {
+		return err
+	}
+	job.Status = dataHistoryStatusActive
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	dbJob := convertJobToDB(job)
+	err = datahistoryjob.Upsert(dbJob)
+	if err != nil {
+		return err
+	}
+	job.ID, err = uuid.FromString(dbJob.ID)
+	if err != nil {
+		return err
+	}
+	job.CreatedDate = dbJob.CreatedDate
+	job.Results = make(map[time.Time][]DataHistoryJobResult)
+	results, err := datahistoryjobresult.GetByJobID(dbJob.ID)
+	if err != nil {
+		return err
+	}
+	for i := range results {
+		var result DataHistoryJobResult
+		result, err = convertDBToResult(&results[i])
+		if err != nil {
+			return err
+		}
+		job.Results[result.IntervalStartDate] = append(job.Results[result.IntervalStartDate], result)
+	}
+
+	d.trackJob(job)
+	return nil
+}
+
+// trackJob adds a job to the active jobs, replacing any job with the same
+// nickname. A replaced job is flagged as removed so a run still fetching its
+// data does not save over the replacement. The manager must be locked
+func (d *dataHistoryManager) trackJob(job *DataHistoryJob) {
+	for i := range d.jobs {
+		if strings.EqualFold(d.jobs[i].Nickname, job.Nickname) {
+			if d.jobs[i] != job {
+				d.jobs[i].Status = dataHistoryStatusRemoved
+			}
+			d.jobs[i] = job
+			return
+		}
+	}
+	d.jobs = append(d.jobs, job)
+}
+
+// GetByNickname returns a job by its nickname, optionally with all of its
+// results
+func (d *dataHistoryManager) GetByNickname(nickname string, fullDetails bool) (*DataHistoryJob, error) {
+	if !d.Started() {
+		return nil, errDataHistoryManagerNotStarted
+	}
+	if nickname == "" {
+		return nil, errNicknameUnset
+	}
+	var dbJob *datahistoryjob.DataHistoryJob
+	var err error
+	if fullDetails {
+		dbJob, err = datahistoryjob.GetJobAndAllResults(nickname)
+	} else {
+		dbJob, err = datahistoryjob.GetByNickName(nickname)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s %w: %v", nickname, errJobNotFound, err)
+	}
+	return convertDBToJob(dbJob)
+}
+
+// GetActiveJobs returns all jobs currently being processed
+func (d *dataHistoryManager) GetActiveJobs() ([]DataHistoryJob, error) {
+	if !d.Started() {
+		return nil, errDataHistoryManagerNotStarted
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	resp := make([]DataHistoryJob, len(d.jobs))
+	for i := range d.jobs {
+		resp[i] = *d.jobs[i]
+	}
+	return resp, nil
+}
+
+// GetAllJobStatusBetween returns all jobs created between a set of dates
+func (d *dataHistoryManager) GetAllJobStatusBetween(start, end time.Time) ([]*DataHistoryJob, error) {
+	if !d.Started() {
+		return nil, errDataHistoryManagerNotStarted
+	}
+	if !start.Before(end) {
+		return nil, errInvalidDataHistoryJobDates
+	}
+	dbJobs, err := datahistoryjob.GetJobsBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*DataHistoryJob, len(dbJobs))
+	for i := range dbJobs {
+		resp[i], err = convertDBToJob(&dbJobs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// DeleteJob stops processing a job and flags it as removed in the database
+func (d *dataHistoryManager) DeleteJob(nickname string) error {
+	if !d.Started() {
+		return errDataHistoryManagerNotStarted
+	}
+	if nickname == "" {
+		return errNicknameUnset
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	dbJob, err := datahistoryjob.GetByNickName(nickname)
+	if err != nil {
+		return fmt.Errorf("%s %w: %v", nickname, errJobNotFound, err)
+	}
+	dbJob.Status = int64(dataHistoryStatusRemoved)
+	err = datahistoryjob.Upsert(dbJob)
+	if err != nil {
+		return err
+	}
+	d.untrackJob(nickname)
+	return nil
+}
+
+// untrackJob stops processing a job, flagging it as removed so a run still
+// fetching its data does not save it. The manager must be locked
+func (d *dataHistoryManager) untrackJob(nickname string) {
+	for i := range d.jobs {
+		if strings.EqualFold(d.jobs[i].Nickname, nickname) {
+			d.jobs[i].Status = dataHistoryStatusRemoved
+			d.jobs = append(d.jobs[:i], d.jobs[i+1:]...)
+			return
+		}
+	}
+}
+
+func convertJobToDB(job *DataHistoryJob) *datahistoryjob.DataHistoryJob {
+	dbJob := &datahistoryjob.DataHistoryJob{
+		Nickname:         job.Nickname,
+		ExchangeName:     job.Exchange,
+		Asset:            job.Asset.String(),
+		Base:             job.Pair.Base.String(),
+		Quote:            job.Pair.Quote.String(),
+		StartDate:        job.StartDate,
+		EndDate:          job.EndDate,
+		Interval:         int64(job.Interval.Duration().Seconds()),
+		RequestSizeLimit: job.RequestSizeLimit,
+		DataType:         int64(job.DataType),
+		MaxRetryAttempts: job.MaxRetryAttempts,
+		BatchSize:        job.BatchSize,
+		Status:           int64(job.Status),
+		CreatedDate:      job.CreatedDate,
+	}
+	if job.ID != uuid.Nil {
+		dbJob.ID = job.ID.String()
+	}
+	return dbJob
+}
+
+func convertDBToJob(dbJob *datahistoryjob.DataHistoryJob) (*DataHistoryJob, error) {
+	id, err := uuid.FromString(dbJob.ID)
+	if err != nil {
+		return nil, err
+	}
+	cp, err := currency.NewPairFromStrings(dbJob.Base, dbJob.Quote)
+	if err != nil {
+		return nil, err
+	}
+	job := &DataHistoryJob{
+		ID:               id,
+		Nickname:         dbJob.Nickname,
+		Exchange:         dbJob.ExchangeName,
+		Asset:            asset.Item(dbJob.Asset),
+		Pair:             cp,
+		StartDate:        dbJob.StartDate.UTC(),
+		EndDate:          dbJob.EndDate.UTC(),
+		Interval:         kline.Interval(time.Duration(dbJob.Interval) * time.Second),
+		RequestSizeLimit: dbJob.RequestSizeLimit,
+		DataType:         dataHistoryDataType(dbJob.DataType),
+		MaxRetryAttempts: dbJob.MaxRetryAttempts,
+		BatchSize:        dbJob.BatchSize,
+		Status:           dataHistoryStatus(dbJob.Status),
+		CreatedDate:      dbJob.CreatedDate,
+		Results:          make(map[time.Time][]DataHistoryJobResult),
+	}
+	for i := range dbJob.Results {
+		var result DataHistoryJobResult
+		result, err = convertDBToResult(&dbJob.Results[i])
+		if err != nil {
+			return nil, err
+		}
+		job.Results[result.IntervalStartDate] = append(job.Results[result.IntervalStartDate], result)
+	}
+	return job, nil
+}
+
+func convertResultToDB(result *DataHistoryJobResult) *datahistoryjobresult.DataHistoryJobResult {
+	return &datahistoryjobresult.DataHistoryJobResult{
+		ID:                result.ID.String(),
+		JobID:             result.JobID.String(),
+		IntervalStartDate: result.IntervalStartDate,
+		IntervalEndDate:   result.IntervalEndDate,
+		Status:            int64(result.Status),
+		Result:            result.Result,
+		Date:              result.Date,
+	}
+}
+
+func convertDBToResult(dbResult *datahistoryjobresult.DataHistoryJobResult) (DataHistoryJobResult, error) {
+	id, err := uuid.FromString(dbResult.ID)
+	if err != nil {
+		return DataHistoryJobResult{}, err
+	}
+	jobID, err := uuid.FromString(dbResult.JobID)
+	if err != nil {
+		return DataHistoryJobResult{}, err
+	}
+	return DataHistoryJobResult{
+		ID:                id,
+		JobID:             jobID,
+		IntervalStartDate: dbResult.IntervalStartDate.UTC(),
+		IntervalEndDate:   dbResult.IntervalEndDate.UTC(),
+		Status:            dataHistoryStatus(dbResult.Status),
+		Result:            dbResult.Result,
+		Date:              dbResult.Date,
+	}, nil
+}
@@ -0,0 +1,343 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/bitstamp"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+func TestDataHistoryManagerStartStop(t *testing.T) {
+	var d dataHistoryManager
+	err := d.Stop()
+	if !errors.Is(err, errDataHistoryManagerNotStarted) {
+		t.Errorf("received %v, expected %v", err, errDataHistoryManagerNotStarted)
+	}
+	err = d.Start()
+	if !errors.Is(err, errDatabaseNotConnected) {
+		t.Errorf("received %v, expected %v", err, errDatabaseNotConnected)
+	}
+	if d.Started() {
+		t.Error("expected manager to not be started without a database")
+	}
+	err = d.UpsertJob(&DataHistoryJob{})
+	if !errors.Is(err, errDataHistoryManagerNotStarted) {
+		t.Errorf("received %v, expected %v", err, errDataHistoryManagerNotStarted)
+	}
+	_, err = d.GetActiveJobs()
+	if !errors.Is(err, errDataHistoryManagerNotStarted) {
+		t.Errorf("received %v, expected %v", err, errDataHistoryManagerNotStarted)
+	}
+}
+
+func TestValidateJob(t *testing.T) {
+	exch := new(bitstamp.Bitstamp)
+	exch.SetDefaults()
+	pairs := currency.Pairs{currency.NewPair(currency.BTC, currency.USD)}
+	exch.CurrencyPairs.StorePairs(asset.Spot, pairs, false)
+	exch.CurrencyPairs.StorePairs(asset.Spot, pairs, true)
+	err := exch.CurrencyPairs.SetAssetEnabled(asset.Spot, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newJob := func() *DataHistoryJob {
+		return &DataHistoryJob{
+			Nickname:  "TestValidateJob",
+			Exchange:  testExchange,
+			Asset:     asset.Spot,
+			Pair:      pairs[0],
+			StartDate: time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC),
+			EndDate:   time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			Interval:  kline.OneHour,
+		}
+	}
+
+	job := newJob()
+	err = validateJob(job, exch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.RequestSizeLimit != defaultDataHistoryRequestSizeLimit ||
+		job.BatchSize != defaultDataHistoryBatchLimit ||
+		job.MaxRetryAttempts != defaultDataHistoryRetryAttempts {
+		t.Errorf("expected defaults to be applied, received %+v", job)
+	}
+	if !job.StartDate.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected start date to be truncated to the interval, received %v", job.StartDate)
+	}
+
+	job = newJob()
+	job.Nickname = ""
+	if err = validateJob(job, exch); !errors.Is(err, errNicknameUnset) {
+		t.Errorf("received %v, expected %v", err, errNicknameUnset)
+	}
+
+	job = newJob()
+	if err = validateJob(job, nil); !errors.Is(err, errExchangeNotLoaded) {
+		t.Errorf("received %v, expected %v", err, errExchangeNotLoaded)
+	}
+
+	job = newJob()
+	job.Pair = currency.NewPair(currency.XRP, currency.NewCode("MEOW"))
+	if err = validateJob(job, exch); !errors.Is(err, errDataHistoryPairNotEnabled) {
+		t.Errorf("received %v, expected %v", err, errDataHistoryPairNotEnabled)
+	}
+
+	job = newJob()
+	job.DataType = 1337
+	if err = validateJob(job, exch); !errors.Is(err, errInvalidDataHistoryDataType) {
+		t.Errorf("received %v, expected %v", err, errInvalidDataHistoryDataType)
+	}
+
+	job = newJob()
+	job.DataType = dataHistoryLongShortRatioDataType
+	if err = validateJob(job, exch); err != nil {
+		t.Error(err)
+	}
+
+	job = newJob()
+	job.Interval = 0
+	if err = validateJob(job, exch); !errors.Is(err, errInvalidDataHistoryInterval) {
+		t.Errorf("received %v, expected %v", err, errInvalidDataHistoryInterval)
+	}
+
+	job = newJob()
+	job.EndDate = job.StartDate
+	if err = validateJob(job, exch); !errors.Is(err, errInvalidDataHistoryJobDates) {
+		t.Errorf("received %v, expected %v", err, errInvalidDataHistoryJobDates)
+	}
+
+	job = newJob()
+	job.BatchSize = -1
+	if err = validateJob(job, exch); !errors.Is(err, errInvalidDataHistoryLimits) {
+		t.Errorf("received %v, expected %v", err, errInvalidDataHistoryLimits)
+	}
+}
+
+func TestRangesToProcess(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := &DataHistoryJob{
+		ID:               uuid.Must(uuid.NewV4()),
+		StartDate:        start,
+		EndDate:          start.Add(time.Hour * 10),
+		Interval:         kline.OneHour,
+		RequestSizeLimit: 2,
+		MaxRetryAttempts: 2,
+		BatchSize:        3,
+		Results:          make(map[time.Time][]DataHistoryJobResult),
+	}
+	ranges := job.dateRanges()
+	if len(ranges) != 5 {
+		t.Fatalf("expected 5 ranges received %v", len(ranges))
+	}
+	for i := range ranges {
+		if !ranges[i].End.After(ranges[i].Start) {
+			t.Errorf("expected range end after start, received %+v", ranges[i])
+		}
+	}
+
+	batch := job.rangesToProcess()
+	if len(batch) != 3 {
+		t.Fatalf("expected batch of 3 received %v", len(batch))
+	}
+
+	// first range succeeds, second exhausts its retries
+	job.Results[ranges[0].Start] = []DataHistoryJobResult{{Status: dataHistoryStatusComplete}}
+	job.Results[ranges[1].Start] = []DataHistoryJobResult{
+		{Status: dataHistoryStatusFailed},
+		{Status: dataHistoryStatusFailed},
+	}
+	batch = job.rangesToProcess()
+	if len(batch) != 3 || !batch[0].Start.Equal(ranges[2].Start) {
+		t.Errorf("expected processed ranges to be skipped, received %+v", batch)
+	}
+
+	for i := 2; i < len(ranges); i++ {
+		job.Results[ranges[i].Start] = []DataHistoryJobResult{
+			{Status: dataHistoryStatusFailed},
+			{Status: dataHistoryStatusComplete},
+		}
+	}
+	if len(job.rangesToProcess()) != 0 {
+		t.Error("expected no ranges left to process")
+	}
+	if s := job.completionStatus(); s != dataHistoryStatusFailed {
+		t.Errorf("received %v, expected %v", s, dataHistoryStatusFailed)
+	}
+
+	job.Results[ranges[1].Start] = append(job.Results[ranges[1].Start],
+		DataHistoryJobResult{Status: dataHistoryStatusComplete})
+	if s := job.completionStatus(); s != dataHistoryStatusComplete {
+		t.Errorf("received %v, expected %v", s, dataHistoryStatusComplete)
+	}
+
+	job.DataType = dataHistoryTradeDataType
+	if len(job.dateRanges()) != 10 {
+		t.Errorf("expected trades to be fetched one interval at a time, received %v ranges", len(job.dateRanges()))
+	}
+}
+
+func TestDataHistoryJobConversion(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := &DataHistoryJob{
+		ID:               uuid.Must(uuid.NewV4()),
+		Nickname:         "conversion",
+		Exchange:         testExchange,
+		Asset:            asset.Spot,
+		Pair:             currency.NewPair(currency.BTC, currency.USD),
+		StartDate:        start,
+		EndDate:          start.Add(time.Hour),
+		Interval:         kline.OneHour,
+		RequestSizeLimit: 1,
+		DataType:         dataHistoryTradeDataType,
+		MaxRetryAttempts: 1,
+		BatchSize:        1,
+		Status:           dataHistoryStatusComplete,
+	}
+	dbJob := convertJobToDB(job)
+	if dbJob.Interval != 3600 {
+		t.Errorf("expected interval to be stored in seconds, received %v", dbJob.Interval)
+	}
+	result := &DataHistoryJobResult{
+		ID:                uuid.Must(uuid.NewV4()),
+		JobID:             job.ID,
+		IntervalStartDate: start,
+		IntervalEndDate:   start.Add(time.Hour),
+		Status:            dataHistoryStatusComplete,
+	}
+	dbJob.Results = append(dbJob.Results, *convertResultToDB(result))
+
+	converted, err := convertDBToJob(dbJob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.ID != job.ID ||
+		converted.Interval != job.Interval ||
+		converted.DataType != job.DataType ||
+		converted.Status != job.Status ||
+		!converted.Pair.Equal(job.Pair) {
+		t.Errorf("received %+v, expected %+v", converted, job)
+	}
+	if len(converted.Results[start]) != 1 {
+		t.Error("expected result to be keyed by interval start date")
+	}
+	if dataHistoryStatusRemoved.String() != "removed" ||
+		dataHistoryCandleDataType.String() != "candles" ||
+		dataHistoryOpenInterestDataType.String() != "openinterest" {
+		t.Error("unexpected string conversion")
+	}
+}
+
+func TestRunJobsShutdown(t *testing.T) {
+	d := dataHistoryManager{
+		shutdown: make(chan struct{}),
+		jobs: []*DataHistoryJob{
+			{Nickname: "complete", Status: dataHistoryStatusComplete},
+			{Nickname: "active", Status: dataHistoryStatusActive},
+		},
+	}
+	err := d.runJob(d.jobs[0])
+	if err != nil {
+		t.Error(err)
+	}
+	close(d.shutdown)
+	d.runJobs()
+	if len(d.jobs) != 2 {
+		t.Errorf("received %v jobs, expected jobs to be untouched after shutdown", len(d.jobs))
+	}
+}
+
+const blockingHistoryExchange = "BlockingHistoryExchange"
+
+// blockingHistoryExch holds candle requests until released so a job can be
+// changed while its data is being fetched
+type blockingHistoryExch struct {
+	FakePassingExchange
+	fetching chan struct{}
+	release  chan struct{}
+}
+
+func (b *blockingHistoryExch) GetName() string { return blockingHistoryExchange }
+
+func (b *blockingHistoryExch) GetHistoricCandlesExtended(_ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
+	b.fetching <- struct{}{}
+	<-b.release
+	return kline.Item{}, nil
+}
+
+func TestRunJobChangedDuringFetch(t *testing.T) {
+	SetupTestHelpers(t)
+	exch := &blockingHistoryExch{
+		fetching: make(chan struct{}),
+		release:  make(chan struct{}),
+	}
+	Bot.exchangeManager.add(exch)
+	defer func() {
+		err := Bot.exchangeManager.removeExchange(blockingHistoryExchange)
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newJob := func() *DataHistoryJob {
+		return &DataHistoryJob{
+			ID:               uuid.Must(uuid.NewV4()),
+			Nickname:         "changed",
+			Exchange:         blockingHistoryExchange,
+			Asset:            asset.Spot,
+			Pair:             currency.NewPair(currency.BTC, currency.USD),
+			StartDate:        start,
+			EndDate:          start.Add(time.Hour),
+			Interval:         kline.OneHour,
+			DataType:         dataHistoryCandleDataType,
+			RequestSizeLimit: 1,
+			MaxRetryAttempts: 1,
+			BatchSize:        1,
+			Status:           dataHistoryStatusActive,
+			Results:          make(map[time.Time][]DataHistoryJobResult),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		change func(d *dataHistoryManager, job *DataHistoryJob)
+	}{
+		{"deleted", func(d *dataHistoryManager, job *DataHistoryJob) {
+			d.untrackJob(job.Nickname)
+		}},
+		{"replaced", func(d *dataHistoryManager, job *DataHistoryJob) {
+			d.trackJob(newJob())
+		}},
+	}
+	for x := range testCases {
+		d := dataHistoryManager{shutdown: make(chan struct{})}
+		job := newJob()
+		d.trackJob(job)
+
+		errs := make(chan error)
+		go func() {
+			errs <- d.runJob(job)
+		}()
+		<-exch.fetching
+		d.m.Lock()
+		testCases[x].change(&d, job)
+		d.m.Unlock()
+		close(exch.release)
+
+		// saving results requires the database, so any attempt to save the
+		// changed job returns an error
+		if err := <-errs; err != nil {
+			t.Errorf("%s: received %v, expected the job to not be saved", testCases[x].name, err)
+		}
+		if job.Status != dataHistoryStatusRemoved || len(job.Results) != 0 {
+			t.Errorf("%s: expected job to be left removed without results, received %+v", testCases[x].name, job)
+		}
+		exch.release = make(chan struct{})
+	}
+}
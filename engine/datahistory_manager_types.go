@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+// vars related to the data history manager
+var (
+	// DataHistoryManagerCheckInterval is the delay between processing batches
+	// of active data history jobs
+	DataHistoryManagerCheckInterval = time.Minute
+
+	errDataHistoryManagerNotStarted = errors.New("data history manager not started")
+	errDatabaseNotConnected         = errors.New("database not connected")
+	errNicknameUnset                = errors.New("data history job nickname unset")
+	errJobNotFound                  = errors.New("data history job not found")
+	errInvalidDataHistoryJobDates   = errors.New("data history job start date must be before the end date")
+	errInvalidDataHistoryAsset      = errors.New("invalid data history job asset")
+	errDataHistoryPairNotEnabled    = errors.New("data history job pair not enabled")
+	errInvalidDataHistoryDataType   = errors.New("invalid data history job data type")
+	errInvalidDataHistoryInterval   = errors.New("invalid data history job interval")
+	errInvalidDataHistoryLimits     = errors.New("data history job request size, batch size and max retries must be greater than zero")
+)
+
+const (
+	defaultDataHistoryRequestSizeLimit = 500
+	defaultDataHistoryBatchLimit       = 3
+	defaultDataHistoryRetryAttempts    = 3
+)
+
+// dataHistoryStatus is the status of a data history job or one of its
+// processed intervals
+type dataHistoryStatus int64
+
+// dataHistoryDataType is the type of data a data history job will fetch
+type dataHistoryDataType int64
+
+// data history job and result statuses
+const (
+	dataHistoryStatusActive dataHistoryStatus = iota
+	dataHistoryStatusFailed
+	dataHistoryStatusComplete
+	dataHistoryStatusRemoved
+)
+
+// data history job data types
+const (
+	dataHistoryCandleDataType dataHistoryDataType = iota
+	dataHistoryTradeDataType
+	dataHistoryOpenInterestDataType
+	dataHistoryLongShortRatioDataType
+)
+
+// dataHistoryManager processes persistent jobs which fetch and save historic
+// candle, trade, open interest or long/short ratio data for a date range in batches, resuming any active jobs
+// after a restart
+type dataHistoryManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	jobs     []*DataHistoryJob
+	m        sync.Mutex
+}
+
+// DataHistoryJob defines a range of candle, trade, open interest or long/short
+// ratio data to fetch from an exchange and save to the database
+type DataHistoryJob struct {
+	ID               uuid.UUID
+	Nickname         string
+	Exchange         string
+	Asset            asset.Item
+	Pair             currency.Pair
+	StartDate        time.Time
+	EndDate          time.Time
+	Interval         kline.Interval
+	RequestSizeLimit int64
+	DataType         dataHistoryDataType
+	MaxRetryAttempts int64
+	// BatchSize is the maximum amount of intervals processed per run of the
+	// data history manager
+	BatchSize   int64
+	Status      dataHistoryStatus
+	CreatedDate time.Time
+	// Results holds the processing attempts for each interval, keyed by
+	// the interval start date
+	Results map[time.Time][]DataHistoryJobResult
+}
+
+// DataHistoryJobResult holds the result of fetching and saving a single
+// interval of a data history job
+type DataHistoryJobResult struct {
+	ID                uuid.UUID
+	JobID             uuid.UUID
+	IntervalStartDate time.Time
+	IntervalEndDate   time.Time
+	Status            dataHistoryStatus
+	Result            string
+	Date              time.Time
+}
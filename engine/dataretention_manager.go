@@ -0,0 +1,389 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/database/repository/audit"
+	"github.com/idoall/gocryptotrader/database/repository/candle"
+	exchangeDB "github.com/idoall/gocryptotrader/database/repository/exchange"
+	scriptevent "github.com/idoall/gocryptotrader/database/repository/script"
+	sqltrade "github.com/idoall/gocryptotrader/database/repository/trade"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// retentionWindow is the approximate span of stored data loaded at once when
+// downsampling
+const retentionWindow = kline.OneDay
+
+func (d *dataRetentionManager) Started() bool {
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+func (d *dataRetentionManager) Start() error {
+	if atomic.AddInt32(&d.started, 1) != 1 {
+		return errors.New("data retention manager already started")
+	}
+	database.DB.Mu.RLock()
+	connected := database.DB.SQL != nil && database.DB.Connected
+	var cfg *database.RetentionConfig
+	if database.DB.Config != nil {
+		cfg = database.DB.Config.Retention
+	}
+	database.DB.Mu.RUnlock()
+	if !connected {
+		atomic.StoreInt32(&d.started, 0)
+		return errDatabaseNotConnected
+	}
+	if cfg == nil || !cfg.Enabled {
+		atomic.StoreInt32(&d.started, 0)
+		return errRetentionDisabled
+	}
+	err := validateRetentionConfig(cfg)
+	if err != nil {
+		atomic.StoreInt32(&d.started, 0)
+		return err
+	}
+	d.config = *cfg
+	if d.config.CheckInterval <= 0 {
+		d.config.CheckInterval = DataRetentionManagerCheckInterval
+	}
+
+	log.Debugln(log.DatabaseMgr, "Data retention manager starting...")
+	d.shutdown = make(chan struct{})
+	go d.run()
+	log.Debugf(log.DatabaseMgr, "Data retention manager started, checking every %v.\n", d.config.CheckInterval)
+	return nil
+}
+
+func (d *dataRetentionManager) Stop() error {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return errDataRetentionManagerNotStarted
+	}
+
+	if atomic.AddInt32(&d.stopped, 1) != 1 {
+		return errors.New("data retention manager is already stopped")
+	}
+
+	close(d.shutdown)
+	log.Debugln(log.DatabaseMgr, "Data retention manager shutting down...")
+	return nil
+}
+
+func (d *dataRetentionManager) run() {
+	t := time.NewTicker(d.config.CheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&d.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&d.started, 1, 0)
+		log.Debugln(log.DatabaseMgr, "Data retention manager shutdown.")
+	}()
+
+	// retention is applied on start so expired data is not kept until the
+	// first check interval elapses
+	d.applyRetention()
+	for {
+		select {
+		case <-d.shutdown:
+			return
+		case <-t.C:
+			d.applyRetention()
+		}
+	}
+}
+
+func (d *dataRetentionManager) applyRetention() {
+	result, err := applyRetention(&d.config, time.Now())
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Data retention manager: %v", err)
+	}
+	log.Debugf(log.DatabaseMgr,
+		"Data retention manager removed %d trades, %d candles, %d audit events and %d script executions, saving %d candles from trades and %d downsampled candles.\n",
+		result.TradesDeleted,
+		result.CandlesDeleted,
+		result.AuditEventsDeleted,
+		result.ScriptExecutionsDeleted,
+		result.TradeCandlesSaved,
+		result.DownsampledCandlesSaved)
+}
+
+// validateRetentionConfig ensures every retention rule can be applied
+func validateRetentionConfig(cfg *database.RetentionConfig) error {
+	if cfg.Trades.MaxAge < 0 || cfg.AuditEvents.MaxAge < 0 || cfg.ScriptExecutions.MaxAge < 0 {
+		return errInvalidRetentionMaxAge
+	}
+	if cfg.Trades.DownsampleInterval != 0 {
+		if cfg.Trades.MaxAge == 0 {
+			return errDownsampleWithoutMaxAge
+		}
+		err := validateRetentionInterval(cfg.Trades.DownsampleInterval)
+		if err != nil {
+			return fmt.Errorf("trades: %w", err)
+		}
+	}
+	for i := range cfg.Candles {
+		err := validateRetentionInterval(cfg.Candles[i].Interval)
+		if err != nil {
+			return fmt.Errorf("candles: %w", err)
+		}
+		if cfg.Candles[i].MaxAge < 0 {
+			return fmt.Errorf("candles %v: %w", kline.Interval(cfg.Candles[i].Interval), errInvalidRetentionMaxAge)
+		}
+		if cfg.Candles[i].DownsampleInterval == 0 {
+			continue
+		}
+		if cfg.Candles[i].MaxAge == 0 {
+			return fmt.Errorf("candles %v: %w", kline.Interval(cfg.Candles[i].Interval), errDownsampleWithoutMaxAge)
+		}
+		if !kline.CanConvert(kline.Interval(cfg.Candles[i].Interval), kline.Interval(cfg.Candles[i].DownsampleInterval)) {
+			return fmt.Errorf("candles %v: %w", kline.Interval(cfg.Candles[i].Interval), errInvalidDownsampleInterval)
+		}
+	}
+	return nil
+}
+
+func validateRetentionInterval(i time.Duration) error {
+	if i < time.Second || i%time.Second != 0 {
+		return errInvalidRetentionInterval
+	}
+	return nil
+}
+
+// applyRetention removes all data older than its configured max age. Data is
+// only removed after any configured downsampling succeeds so nothing is lost
+// when a downsample fails. A max age of zero keeps data indefinitely
+func applyRetention(cfg *database.RetentionConfig, now time.Time) (RetentionResult, error) {
+	var result RetentionResult
+	var errs []string
+	if cfg.Trades.MaxAge > 0 {
+		err := applyTradeRetention(&cfg.Trades, now, &result)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("trades: %v", err))
+		}
+	}
+	for i := range cfg.Candles {
+		if cfg.Candles[i].MaxAge <= 0 {
+			continue
+		}
+		err := applyCandleRetention(&cfg.Candles[i], now, &result)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%v candles: %v", kline.Interval(cfg.Candles[i].Interval), err))
+		}
+	}
+	var err error
+	if cfg.AuditEvents.MaxAge > 0 {
+		result.AuditEventsDeleted, err = audit.DeleteEventsBefore(now.Add(-cfg.AuditEvents.MaxAge))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("audit events: %v", err))
+		}
+	}
+	if cfg.ScriptExecutions.MaxAge > 0 {
+		result.ScriptExecutionsDeleted, err = scriptevent.DeleteExecutionsBefore(now.Add(-cfg.ScriptExecutions.MaxAge))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("script executions: %v", err))
+		}
+	}
+	if len(errs) > 0 {
+		return result, errors.New(strings.Join(errs, ", "))
+	}
+	return result, nil
+}
+
+func applyTradeRetention(r *database.TradeRetention, now time.Time, result *RetentionResult) error {
+	cutoff := now.Add(-r.MaxAge)
+	if r.DownsampleInterval > 0 {
+		// whole candles only, so trades in the cutoff's candle are kept until
+		// the candle can be completed
+		interval := kline.Interval(r.DownsampleInterval)
+		cutoff = interval.CandleStart(cutoff)
+		saved, err := downsampleTrades(interval, cutoff)
+		result.TradeCandlesSaved += saved
+		if err != nil {
+			return err
+		}
+	}
+	deleted, err := sqltrade.DeleteTradesBefore(cutoff)
+	result.TradesDeleted += deleted
+	return err
+}
+
+func applyCandleRetention(r *database.CandleRetention, now time.Time, result *RetentionResult) error {
+	cutoff := now.Add(-r.MaxAge)
+	if r.DownsampleInterval > 0 {
+		interval := kline.Interval(r.DownsampleInterval)
+		cutoff = interval.CandleStart(cutoff)
+		saved, err := downsampleCandles(kline.Interval(r.Interval), interval, cutoff)
+		result.DownsampledCandlesSaved += saved
+		if err != nil {
+			return err
+		}
+	}
+	deleted, err := candle.DeleteCandlesBefore(int64(r.Interval.Seconds()), cutoff)
+	result.CandlesDeleted += deleted
+	return err
+}
+
+// downsampleTrades converts every stored trade before the cutoff into candles
+// of the supplied interval, saving any candles which are not already stored
+func downsampleTrades(interval kline.Interval, cutoff time.Time) (uint64, error) {
+	series, err := sqltrade.SeriesBefore(cutoff)
+	if err != nil {
+		return 0, err
+	}
+	var saved uint64
+	for i := range series {
+		exchangeName, err := retentionExchangeName(series[i].ExchangeNameID)
+		if err != nil {
+			return saved, err
+		}
+		for start := retentionWindowStart(interval, series[i].Oldest); start.Before(cutoff); start = retentionWindowEnd(interval, start) {
+			end := retentionWindowEnd(interval, start)
+			if end.After(cutoff) {
+				end = cutoff
+			}
+			trades, err := trade.GetTradesInRange(exchangeName,
+				series[i].AssetType,
+				series[i].Base,
+				series[i].Quote,
+				start,
+				end.Add(-time.Nanosecond))
+			if err != nil {
+				return saved, err
+			}
+			if len(trades) == 0 {
+				continue
+			}
+			candles, err := trade.ConvertTradesToCandles(interval, trades...)
+			if err != nil {
+				return saved, err
+			}
+			count, err := storeMissingCandles(&candles, start, end)
+			saved += count
+			if err != nil {
+				return saved, err
+			}
+		}
+	}
+	return saved, nil
+}
+
+// downsampleCandles merges every stored candle of an interval before the
+// cutoff into candles of a larger interval, saving any candles which are not
+// already stored
+func downsampleCandles(interval, newInterval kline.Interval, cutoff time.Time) (uint64, error) {
+	series, err := candle.SeriesBefore(int64(interval.Duration().Seconds()), cutoff)
+	if err != nil {
+		return 0, err
+	}
+	var saved uint64
+	for i := range series {
+		exchangeName, err := retentionExchangeName(series[i].ExchangeID)
+		if err != nil {
+			return saved, err
+		}
+		p, err := currency.NewPairFromStrings(series[i].Base, series[i].Quote)
+		if err != nil {
+			return saved, err
+		}
+		a := asset.Item(series[i].Asset)
+		for start := retentionWindowStart(newInterval, series[i].Oldest); start.Before(cutoff); start = retentionWindowEnd(newInterval, start) {
+			end := retentionWindowEnd(newInterval, start)
+			if end.After(cutoff) {
+				end = cutoff
+			}
+			stored, err := kline.LoadFromDatabase(exchangeName, p, a, interval, start, end.Add(-time.Second))
+			if err != nil {
+				if errors.Is(err, candle.ErrNoCandleDataFound) {
+					continue
+				}
+				return saved, err
+			}
+			resampled, err := stored.ConvertToNewInterval(newInterval)
+			if err != nil {
+				return saved, err
+			}
+			count, err := storeMissingCandles(resampled, start, end)
+			saved += count
+			if err != nil {
+				return saved, err
+			}
+		}
+	}
+	return saved, nil
+}
+
+// storeMissingCandles saves the candles which are not already stored between
+// start and end
+func storeMissingCandles(k *kline.Item, start, end time.Time) (uint64, error) {
+	stored, err := kline.LoadFromDatabase(k.Exchange, k.Pair, k.Asset, k.Interval, start, end.Add(-time.Second))
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return 0, err
+	}
+	existing := make(map[int64]struct{}, len(stored.Candles))
+	for i := range stored.Candles {
+		existing[stored.Candles[i].Time.Unix()] = struct{}{}
+	}
+	missing := *k
+	missing.Candles = nil
+	for i := range k.Candles {
+		if _, ok := existing[k.Candles[i].Time.Unix()]; ok {
+			continue
+		}
+		missing.Candles = append(missing.Candles, k.Candles[i])
+	}
+	if len(missing.Candles) == 0 {
+		return 0, nil
+	}
+	return kline.StoreInDatabase(&missing, false)
+}
+
+// retentionWindowSize returns the largest multiple of the interval which fits
+// within a retention window so windows always hold whole candles
+func retentionWindowSize(interval kline.Interval) time.Duration {
+	if interval >= retentionWindow {
+		return interval.Duration()
+	}
+	return (retentionWindow.Duration() / interval.Duration()) * interval.Duration()
+}
+
+// retentionWindowStart returns the start of the retention window containing t,
+// which is always the open time of a candle of the interval
+func retentionWindowStart(interval kline.Interval, t time.Time) time.Time {
+	if interval >= retentionWindow {
+		return interval.CandleStart(t)
+	}
+	return t.UTC().Truncate(retentionWindowSize(interval))
+}
+
+// retentionWindowEnd returns the end of the retention window opening at start.
+// Calendar month and year windows vary in length so each holds one candle
+func retentionWindowEnd(interval kline.Interval, start time.Time) time.Time {
+	switch interval {
+	case kline.OneMonth:
+		return start.AddDate(0, 1, 0)
+	case kline.OneYear:
+		return start.AddDate(1, 0, 0)
+	}
+	return start.Add(retentionWindowSize(interval))
+}
+
+func retentionExchangeName(exchangeID string) (string, error) {
+	id, err := uuid.FromString(exchangeID)
+	if err != nil {
+		return "", err
+	}
+	exch, err := exchangeDB.OneByUUID(id)
+	if err != nil {
+		return "", err
+	}
+	return exch.Name, nil
+}
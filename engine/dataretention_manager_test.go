@@ -0,0 +1,357 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	sqltrade "github.com/idoall/gocryptotrader/database/repository/trade"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+func TestDataRetentionManagerStartStop(t *testing.T) {
+	var d dataRetentionManager
+	err := d.Stop()
+	if !errors.Is(err, errDataRetentionManagerNotStarted) {
+		t.Errorf("received %v, expected %v", err, errDataRetentionManagerNotStarted)
+	}
+	err = d.Start()
+	if !errors.Is(err, errDatabaseNotConnected) {
+		t.Errorf("received %v, expected %v", err, errDatabaseNotConnected)
+	}
+	if d.Started() {
+		t.Error("expected manager to not be started without a database")
+	}
+}
+
+func TestValidateRetentionConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      database.RetentionConfig
+		expected error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			cfg: database.RetentionConfig{
+				Trades: database.TradeRetention{MaxAge: time.Hour * 24 * 7, DownsampleInterval: time.Minute},
+				Candles: []database.CandleRetention{
+					{Interval: time.Minute, MaxAge: time.Hour * 24 * 30, DownsampleInterval: time.Hour},
+					{Interval: time.Hour * 24},
+				},
+				AuditEvents:      database.RecordRetention{MaxAge: time.Hour * 24 * 90},
+				ScriptExecutions: database.RecordRetention{MaxAge: time.Hour * 24 * 90},
+			},
+		},
+		{
+			name:     "negative max age",
+			cfg:      database.RetentionConfig{AuditEvents: database.RecordRetention{MaxAge: -time.Hour}},
+			expected: errInvalidRetentionMaxAge,
+		},
+		{
+			name:     "trade downsample without max age",
+			cfg:      database.RetentionConfig{Trades: database.TradeRetention{DownsampleInterval: time.Minute}},
+			expected: errDownsampleWithoutMaxAge,
+		},
+		{
+			name:     "trade downsample fractional seconds",
+			cfg:      database.RetentionConfig{Trades: database.TradeRetention{MaxAge: time.Hour, DownsampleInterval: time.Millisecond * 1500}},
+			expected: errInvalidRetentionInterval,
+		},
+		{
+			name:     "candle interval unset",
+			cfg:      database.RetentionConfig{Candles: []database.CandleRetention{{MaxAge: time.Hour}}},
+			expected: errInvalidRetentionInterval,
+		},
+		{
+			name: "candle downsample smaller than interval",
+			cfg: database.RetentionConfig{Candles: []database.CandleRetention{
+				{Interval: time.Hour, MaxAge: time.Hour, DownsampleInterval: time.Minute},
+			}},
+			expected: errInvalidDownsampleInterval,
+		},
+		{
+			name: "candle downsample not a multiple",
+			cfg: database.RetentionConfig{Candles: []database.CandleRetention{
+				{Interval: time.Hour * 4, MaxAge: time.Hour, DownsampleInterval: time.Hour * 6},
+			}},
+			expected: errInvalidDownsampleInterval,
+		},
+		{
+			name: "candle downsample to calendar month",
+			cfg: database.RetentionConfig{Candles: []database.CandleRetention{
+				{Interval: time.Hour * 24, MaxAge: time.Hour, DownsampleInterval: kline.OneMonth.Duration()},
+			}},
+		},
+		{
+			name: "candle downsample weeks to calendar month",
+			cfg: database.RetentionConfig{Candles: []database.CandleRetention{
+				{Interval: kline.OneWeek.Duration(), MaxAge: time.Hour, DownsampleInterval: kline.OneMonth.Duration()},
+			}},
+			expected: errInvalidDownsampleInterval,
+		},
+		{
+			name: "candle downsample without max age",
+			cfg: database.RetentionConfig{Candles: []database.CandleRetention{
+				{Interval: time.Minute, DownsampleInterval: time.Hour},
+			}},
+			expected: errDownsampleWithoutMaxAge,
+		},
+	}
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			err := validateRetentionConfig(&test.cfg)
+			if !errors.Is(err, test.expected) {
+				t.Errorf("received %v, expected %v", err, test.expected)
+			}
+		})
+	}
+}
+
+func TestRetentionWindowSize(t *testing.T) {
+	if w := retentionWindowSize(kline.OneMin); w != time.Hour*24 {
+		t.Errorf("received %v, expected %v", w, time.Hour*24)
+	}
+	if w := retentionWindowSize(kline.Interval(time.Hour * 7)); w != time.Hour*21 {
+		t.Errorf("received %v, expected %v", w, time.Hour*21)
+	}
+	if w := retentionWindowSize(kline.OneWeek); w != time.Hour*24*7 {
+		t.Errorf("received %v, expected %v", w, time.Hour*24*7)
+	}
+}
+
+func TestRetentionWindows(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		interval   kline.Interval
+		t          time.Time
+		start, end time.Time
+	}{
+		{kline.OneMin, time.Date(2021, 1, 20, 13, 0, 0, 0, time.UTC),
+			time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{kline.ThreeDay, time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+			time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{kline.OneMonth, time.Date(2021, 1, 20, 13, 0, 0, 0, time.UTC),
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{kline.OneMonth, time.Date(2021, 2, 20, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{kline.OneYear, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for x := range testCases {
+		start := retentionWindowStart(testCases[x].interval, testCases[x].t)
+		if !start.Equal(testCases[x].start) {
+			t.Errorf("%v received start %v, expected %v", testCases[x].interval, start, testCases[x].start)
+		}
+		if end := retentionWindowEnd(testCases[x].interval, start); !end.Equal(testCases[x].end) {
+			t.Errorf("%v received end %v, expected %v", testCases[x].interval, end, testCases[x].end)
+		}
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	now := time.Now().UTC()
+	base := now.Add(-time.Hour * 72).Truncate(time.Hour * 24)
+	p := currency.NewPair(currency.BTC, currency.USD)
+
+	var trades []sqltrade.Data
+	for i := 0; i < 12; i++ {
+		ts := base.Add(time.Second * 30 * time.Duration(i))
+		if i >= 10 {
+			// recent trades which must be kept
+			ts = now.Add(-time.Minute * time.Duration(i))
+		}
+		id, err := uuid.NewV4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		trades = append(trades, sqltrade.Data{
+			ID:        id.String(),
+			Timestamp: ts,
+			Exchange:  testExchange,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+			AssetType: asset.Spot.String(),
+			Price:     float64(i + 1),
+			Amount:    1,
+			Side:      order.Buy.String(),
+			TID:       fmt.Sprintf("%v", i),
+		})
+	}
+	err := sqltrade.Insert(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hourly := kline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+	}
+	for i := 0; i < 48; i++ {
+		hourly.Candles = append(hourly.Candles, kline.Candle{
+			Time:   base.Add(time.Hour * time.Duration(i)),
+			Open:   float64(i),
+			High:   float64(i + 2),
+			Low:    float64(i),
+			Close:  float64(i + 1),
+			Volume: 1,
+		})
+	}
+	_, err = kline.StoreInDatabase(&hourly, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &database.RetentionConfig{
+		Enabled: true,
+		Trades: database.TradeRetention{
+			MaxAge:             time.Hour * 24,
+			DownsampleInterval: time.Minute,
+		},
+		Candles: []database.CandleRetention{
+			{
+				Interval:           time.Hour,
+				MaxAge:             time.Hour * 24,
+				DownsampleInterval: time.Hour * 24,
+			},
+		},
+		AuditEvents:      database.RecordRetention{MaxAge: time.Hour * 24},
+		ScriptExecutions: database.RecordRetention{MaxAge: time.Hour * 24},
+	}
+	result, err := applyRetention(cfg, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TradeCandlesSaved != 5 {
+		t.Errorf("received %v trade candles saved, expected 5", result.TradeCandlesSaved)
+	}
+	if result.TradesDeleted != 10 {
+		t.Errorf("received %v trades deleted, expected 10", result.TradesDeleted)
+	}
+	if result.DownsampledCandlesSaved != 2 {
+		t.Errorf("received %v downsampled candles saved, expected 2", result.DownsampledCandlesSaved)
+	}
+	if result.CandlesDeleted != 48 {
+		t.Errorf("received %v candles deleted, expected 48", result.CandlesDeleted)
+	}
+
+	daily, err := kline.LoadFromDatabase(testExchange, p, asset.Spot, kline.OneDay, base, base.Add(time.Hour*47))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(daily.Candles) != 2 {
+		t.Fatalf("received %v daily candles, expected 2", len(daily.Candles))
+	}
+	if daily.Candles[0].Open != 0 || daily.Candles[0].Close != 24 ||
+		daily.Candles[0].High != 25 || daily.Candles[0].Volume != 24 {
+		t.Errorf("unexpected downsampled candle %+v", daily.Candles[0])
+	}
+
+	remaining, err := sqltrade.GetInRange(testExchange, asset.Spot.String(), p.Base.String(), p.Quote.String(), base, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 2 {
+		t.Errorf("received %v remaining trades, expected 2", len(remaining))
+	}
+
+	result, err = applyRetention(cfg, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (RetentionResult{}) {
+		t.Errorf("expected nothing to be removed twice, received %+v", result)
+	}
+}
+
+func TestApplyRetentionCalendarMonth(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	// a 31 day window starting from the oldest candle spans the end of
+	// january, so each calendar month must be built from its own window
+	now := time.Date(2021, 3, 10, 0, 0, 0, 0, time.UTC)
+	first := time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	daily := kline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: kline.OneDay,
+	}
+	for d := first; d.Before(now); d = d.AddDate(0, 0, 1) {
+		daily.Candles = append(daily.Candles, kline.Candle{
+			Time:   d,
+			Open:   1,
+			High:   2,
+			Low:    1,
+			Close:  1,
+			Volume: 1,
+		})
+	}
+	_, err := kline.StoreInDatabase(&daily, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &database.RetentionConfig{
+		Enabled: true,
+		Candles: []database.CandleRetention{
+			{
+				Interval:           kline.OneDay.Duration(),
+				MaxAge:             time.Hour * 24,
+				DownsampleInterval: kline.OneMonth.Duration(),
+			},
+		},
+	}
+	result, err := applyRetention(cfg, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DownsampledCandlesSaved != 2 {
+		t.Errorf("received %v downsampled candles saved, expected 2", result.DownsampledCandlesSaved)
+	}
+	// march candles are kept until the month can be completed
+	if result.CandlesDeleted != 45 {
+		t.Errorf("received %v candles deleted, expected 45", result.CandlesDeleted)
+	}
+
+	months, err := kline.LoadFromDatabase(testExchange, p, asset.Spot, kline.OneMonth, first, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(months.Candles) != 2 {
+		t.Fatalf("received %v monthly candles, expected 2", len(months.Candles))
+	}
+	expected := []kline.Candle{
+		{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), Open: 1, High: 2, Low: 1, Close: 1, Volume: 17},
+		{Time: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), Open: 1, High: 2, Low: 1, Close: 1, Volume: 28},
+	}
+	for i := range expected {
+		if !months.Candles[i].Time.Equal(expected[i].Time) || months.Candles[i].Volume != expected[i].Volume {
+			t.Errorf("received %+v, expected %+v", months.Candles[i], expected[i])
+		}
+	}
+
+	result, err = applyRetention(cfg, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (RetentionResult{}) {
+		t.Errorf("expected nothing to be removed twice, received %+v", result)
+	}
+}
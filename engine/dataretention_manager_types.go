@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/idoall/gocryptotrader/database"
+)
+
+// vars related to the data retention manager
+var (
+	// DataRetentionManagerCheckInterval is the delay between retention runs
+	// when the retention config does not set one
+	DataRetentionManagerCheckInterval = time.Hour
+
+	errDataRetentionManagerNotStarted = errors.New("data retention manager not started")
+	errRetentionDisabled              = errors.New("database retention is not enabled")
+	errInvalidRetentionMaxAge         = errors.New("retention max age cannot be negative")
+	errInvalidRetentionInterval       = errors.New("retention interval must be a whole number of seconds greater than zero")
+	errInvalidDownsampleInterval      = errors.New("downsample interval must be a whole multiple of the stored interval")
+	errDownsampleWithoutMaxAge        = errors.New("downsample interval requires a max age")
+)
+
+// dataRetentionManager periodically removes stored data which has outlived
+// its configured retention, downsampling trades and candles into larger
+// candles first when configured to
+type dataRetentionManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	config   database.RetentionConfig
+}
+
+// RetentionResult holds the number of rows affected by a retention run
+type RetentionResult struct {
+	TradesDeleted           int64
+	TradeCandlesSaved       uint64
+	CandlesDeleted          int64
+	DownsampledCandlesSaved uint64
+	AuditEventsDeleted      int64
+	ScriptExecutionsDeleted int64
+}
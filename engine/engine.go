@@ -15,7 +15,9 @@ import (
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/currency/coinmarketcap"
 	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/request"
+	"github.com/idoall/gocryptotrader/exchanges/ticker"
 	"github.com/idoall/gocryptotrader/exchanges/trade"
 	gctscript "github.com/idoall/gocryptotrader/gctscript/vm"
 	gctlog "github.com/idoall/gocryptotrader/log"
@@ -35,6 +37,9 @@ type Engine struct {
 	DatabaseManager             databaseManager
 	GctScriptManager            *gctscript.GctScriptManager
 	OrderManager                orderManager
+	OrderbookStaleManager       orderbookStaleManager
+	DataHistoryManager          dataHistoryManager
+	DataRetentionManager        dataRetentionManager
 	PortfolioManager            portfolioManager
 	CommsManager                commsManager
 	exchangeManager             exchangeManager
@@ -216,6 +221,12 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 	b.Settings.DisableExchangeAutoPairUpdates = s.DisableExchangeAutoPairUpdates
 	b.Settings.ExchangePurgeCredentials = s.ExchangePurgeCredentials
 	b.Settings.EnableWebsocketRoutine = s.EnableWebsocketRoutine
+	b.Settings.EnableOrderbookStaleMonitor = s.EnableOrderbookStaleMonitor
+	b.Settings.EnableDataHistoryManager = s.EnableDataHistoryManager
+	b.Settings.DataHistoryManagerDelay = s.DataHistoryManagerDelay
+	if b.Settings.DataHistoryManagerDelay > 0 {
+		DataHistoryManagerCheckInterval = b.Settings.DataHistoryManagerDelay
+	}
 
 	// Checks if the flag values are different from the defaults
 	b.Settings.MaxHTTPRequestJobsLimit = s.MaxHTTPRequestJobsLimit
@@ -290,6 +301,9 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
+	gctlog.Debugf(gctlog.Global, "\t Enable orderbook stale monitor: %v\n", s.EnableOrderbookStaleMonitor)
+	gctlog.Debugf(gctlog.Global, "\t Enable data history manager: %v\n", s.EnableDataHistoryManager)
+	gctlog.Debugf(gctlog.Global, "\t Data history manager delay: %v\n", s.DataHistoryManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable NTP client: %v", s.EnableNTPClient)
 	gctlog.Debugf(gctlog.Global, "\t Enable Database manager: %v", s.EnableDatabaseManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable dispatcher: %v", s.EnableDispatcher)
@@ -344,6 +358,13 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.DatabaseManager.Started() {
+		// trades are recorded on disk until saved so none are lost on crash
+		if err := trade.SetupWriteAheadLog(filepath.Join(bot.Settings.DataDir, "trade")); err != nil {
+			gctlog.Errorf(gctlog.Global, "Trade write-ahead log unable to start: %v", err)
+		}
+	}
+
 	if bot.Settings.EnableDispatcher {
 		if err := dispatch.Start(bot.Settings.DispatchMaxWorkerAmount, bot.Settings.DispatchJobsLimit); err != nil {
 			gctlog.Errorf(gctlog.DispatchMgr, "Dispatcher unable to start: %v", err)
@@ -388,6 +409,9 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	ticker.SetHistoryBufferSize(bot.Config.MarketHistory.TickerBufferSize)
+	orderbook.SetHistoryBufferSize(bot.Config.MarketHistory.OrderbookBufferSize)
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	bot.SetupExchanges()
 	if bot.exchangeManager.Len() == 0 {
@@ -469,6 +493,30 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableOrderbookStaleMonitor {
+		if err = bot.OrderbookStaleManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook staleness manager unable to start: %v", err)
+		}
+	}
+
+	if bot.Settings.EnableDataHistoryManager {
+		if bot.DatabaseManager.Started() {
+			if err = bot.DataHistoryManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Data history manager unable to start: %v", err)
+			}
+		} else {
+			gctlog.Warnln(gctlog.Global, "Data history manager requires the database manager to be enabled")
+		}
+	}
+
+	if bot.DatabaseManager.Started() && bot.Config != nil &&
+		bot.Config.Database.Retention != nil &&
+		bot.Config.Database.Retention.Enabled {
+		if err = bot.DataRetentionManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data retention manager unable to start: %v", err)
+		}
+	}
+
 	if bot.Settings.EnableEventManager {
 		go EventManger()
 	}
@@ -505,6 +553,24 @@ func (bot *Engine) Stop() {
 		}
 	}
 
+	if bot.OrderbookStaleManager.Started() {
+		if err := bot.OrderbookStaleManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook staleness manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.DataHistoryManager.Started() {
+		if err := bot.DataHistoryManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data history manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.DataRetentionManager.Started() {
+		if err := bot.DataRetentionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data retention manager unable to stop. Error: %v", err)
+		}
+	}
+
 	if bot.NTPManager.Started() {
 		if err := bot.NTPManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "NTP manager unable to stop. Error: %v", err)
@@ -529,6 +595,10 @@ func (bot *Engine) Stop() {
 		}
 	}
 
+	if err := trade.CloseWriteAheadLog(); err != nil {
+		gctlog.Errorf(gctlog.Global, "Trade write-ahead log unable to close. Error: %v", err)
+	}
+
 	if bot.DatabaseManager.Started() {
 		if err := bot.DatabaseManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to stop. Error: %v", err)
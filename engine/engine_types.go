@@ -32,7 +32,10 @@ type Settings struct {
 	EnableGCTScriptManager      bool
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
+	EnableOrderbookStaleMonitor bool
+	EnableDataHistoryManager    bool
 	EventManagerDelay           time.Duration
+	DataHistoryManagerDelay     time.Duration
 	Verbose                     bool
 
 	// Exchange syncer settings
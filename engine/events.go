@@ -199,6 +199,12 @@ func (e *Event) processOrderbook() bool {
 		return false
 	}
 
+	err = checkOrderbookStaleness(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		log.Warnf(log.EventMgr, "Events: Skipping orderbook condition check. Err: %s\n", err)
+		return false
+	}
+
 	success := false
 	if e.Condition.CheckBids || e.Condition.CheckBidsAndAsks {
 		for x := range ob.Bids {
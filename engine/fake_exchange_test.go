@@ -9,6 +9,7 @@ import (
 	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
@@ -131,6 +132,22 @@ func (h *FakePassingExchange) SubmitOrder(_ *order.Submit) (order.SubmitResponse
 		OrderID:       "FakePassingExchangeOrder",
 	}, nil
 }
+func (h *FakePassingExchange) SubmitFuturesOrder(s *futures.Submit) (order.SubmitResponse, error) {
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       s.ClientID,
+	}, nil
+}
+func (h *FakePassingExchange) GetFuturesPositions(p currency.Pair, a asset.Item) ([]futures.Position, error) {
+	return []futures.Position{
+		{
+			Exchange:  fakePassExchange,
+			Pair:      p,
+			AssetType: a,
+			Amount:    -2,
+		},
+	}, nil
+}
 func (h *FakePassingExchange) ModifyOrder(_ *order.Modify) (string, error) { return "", nil }
 func (h *FakePassingExchange) CancelOrder(_ *order.Cancel) error           { return nil }
 func (h *FakePassingExchange) CancelBatchOrders(_ []order.Cancel) (order.CancelBatchResponse, error) {
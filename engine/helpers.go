@@ -20,10 +20,12 @@ import (
 	"github.com/idoall/gocryptotrader/common"
 	"github.com/idoall/gocryptotrader/common/file"
 	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database/repository/candle"
 	"github.com/idoall/gocryptotrader/dispatch"
 	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/stats"
 	"github.com/idoall/gocryptotrader/exchanges/ticker"
@@ -47,6 +49,8 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 	systems["portfolio"] = bot.PortfolioManager.Started()
 	systems["ntp_timekeeper"] = bot.NTPManager.Started()
 	systems["database"] = bot.DatabaseManager.Started()
+	systems["data_history_manager"] = bot.DataHistoryManager.Started()
+	systems["data_retention_manager"] = bot.DataRetentionManager.Started()
 	systems["exchange_syncer"] = bot.Settings.EnableExchangeSyncManager
 	systems["grpc"] = bot.Settings.EnableGRPC
 	systems["grpc_proxy"] = bot.Settings.EnableGRPCProxy
@@ -118,6 +122,16 @@ func (bot *Engine) SetSubsystem(subsys string, enable bool) error {
 			return bot.DatabaseManager.Start(bot)
 		}
 		return bot.DatabaseManager.Stop()
+	case "data_history_manager":
+		if enable {
+			return bot.DataHistoryManager.Start()
+		}
+		return bot.DataHistoryManager.Stop()
+	case "data_retention_manager":
+		if enable {
+			return bot.DataRetentionManager.Start()
+		}
+		return bot.DataRetentionManager.Stop()
 	case "exchange_syncer":
 		if enable {
 			bot.ExchangeCurrencyPairManager.Start()
@@ -760,6 +774,70 @@ func (bot *Engine) GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts
 	return response
 }
 
+// GetResampledHistoricCandles retrieves candles from an exchange at the
+// requested interval. If the exchange does not support the interval, candles
+// are retrieved at the longest enabled interval it is a multiple of and
+// converted, with the start date aligned to the requested interval
+func (bot *Engine) GetResampledHistoricCandles(exchName string, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval, extended bool) (kline.Item, error) {
+	exch := bot.GetExchangeByName(exchName)
+	if exch == nil {
+		return kline.Item{}, errExchangeNotLoaded
+	}
+	sourceInterval, err := exch.GetBase().Features.Enabled.Kline.SourceInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	if sourceInterval != interval {
+		start = interval.CandleStart(start)
+	}
+
+	var item kline.Item
+	if extended {
+		item, err = exch.GetHistoricCandlesExtended(p, a, start, end, sourceInterval)
+	} else {
+		item, err = exch.GetHistoricCandles(p, a, start, end, sourceInterval)
+	}
+	if err != nil || sourceInterval == interval {
+		return item, err
+	}
+
+	item.Interval = sourceInterval
+	resampled, err := item.ConvertToNewInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return *resampled, nil
+}
+
+// LoadResampledCandlesFromDatabase loads stored candles at the requested
+// interval. If none are stored and the exchange does not support the interval,
+// candles stored at the interval GetResampledHistoricCandles retrieves them at
+// are loaded and converted instead
+func (bot *Engine) LoadResampledCandlesFromDatabase(exchName string, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	item, err := kline.LoadFromDatabase(exchName, p, a, interval, start, end)
+	if !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return item, err
+	}
+	exch := bot.GetExchangeByName(exchName)
+	if exch == nil {
+		return item, err
+	}
+	sourceInterval, sourceErr := exch.GetBase().Features.Enabled.Kline.SourceInterval(interval)
+	if sourceErr != nil || sourceInterval == interval {
+		return item, err
+	}
+
+	item, err = kline.LoadFromDatabase(exchName, p, a, sourceInterval, interval.CandleStart(start), end)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	resampled, err := item.ConvertToNewInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return *resampled, nil
+}
+
 func verifyCert(pemData []byte) error {
 	var pemBlock *pem.Block
 	pemBlock, _ = pem.Decode(pemData)
@@ -0,0 +1,184 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/idoall/gocryptotrader/communications/base"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/orderbook"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// vars related to the orderbook staleness manager
+var (
+	OrderbookStaleCheckInterval = time.Second * 5
+	errStaleManagerNotStarted   = errors.New("orderbook staleness manager not started")
+)
+
+const (
+	orderbookStaleEvent     = "orderbook_stale"
+	orderbookRecoveredEvent = "orderbook_recovered"
+)
+
+// orderbookStaleManager periodically checks every stored orderbook against
+// its exchange stale threshold and raises alerts when a book stops receiving
+// updates
+type orderbookStaleManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	stale    map[string]orderbook.StaleBook
+	m        sync.Mutex
+}
+
+func (o *orderbookStaleManager) Started() bool {
+	return atomic.LoadInt32(&o.started) == 1
+}
+
+func (o *orderbookStaleManager) Start() error {
+	if atomic.AddInt32(&o.started, 1) != 1 {
+		return errors.New("orderbook staleness manager already started")
+	}
+
+	log.Debugln(log.OrderBook, "Orderbook staleness manager starting...")
+	o.shutdown = make(chan struct{})
+	o.m.Lock()
+	o.stale = make(map[string]orderbook.StaleBook)
+	o.m.Unlock()
+	go o.run()
+	log.Debugln(log.OrderBook, "Orderbook staleness manager started.")
+	return nil
+}
+
+func (o *orderbookStaleManager) Stop() error {
+	if atomic.LoadInt32(&o.started) == 0 {
+		return errStaleManagerNotStarted
+	}
+
+	if atomic.AddInt32(&o.stopped, 1) != 1 {
+		return errors.New("orderbook staleness manager is already stopped")
+	}
+
+	close(o.shutdown)
+	log.Debugln(log.OrderBook, "Orderbook staleness manager shutting down...")
+	return nil
+}
+
+func (o *orderbookStaleManager) run() {
+	t := time.NewTicker(OrderbookStaleCheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&o.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		log.Debugln(log.OrderBook, "Orderbook staleness manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-t.C:
+			o.checkOrderbooks()
+		}
+	}
+}
+
+// GetStaleOrderbooks returns the orderbooks currently flagged as stale
+func (o *orderbookStaleManager) GetStaleOrderbooks() ([]orderbook.StaleBook, error) {
+	if !o.Started() {
+		return nil, errStaleManagerNotStarted
+	}
+	o.m.Lock()
+	defer o.m.Unlock()
+	books := make([]orderbook.StaleBook, 0, len(o.stale))
+	for _, v := range o.stale {
+		books = append(books, v)
+	}
+	return books, nil
+}
+
+// checkOrderbooks compares the current set of stale orderbooks against the
+// previously flagged set, alerting on any orderbook entering or leaving it
+func (o *orderbookStaleManager) checkOrderbooks() {
+	current := make(map[string]orderbook.StaleBook)
+	exchanges := Bot.GetExchanges()
+	for i := range exchanges {
+		name := exchanges[i].GetName()
+		threshold, err := getOrderbookStaleThreshold(name)
+		if err != nil {
+			log.Errorf(log.OrderBook, "Orderbook staleness manager: %v", err)
+			continue
+		}
+		books := orderbook.GetStaleOrderbooks(name, threshold)
+		for x := range books {
+			current[staleBookKey(books[x].Exchange, books[x].Pair, books[x].Asset)] = books[x]
+		}
+	}
+
+	o.m.Lock()
+	defer o.m.Unlock()
+	for k, v := range current {
+		if _, ok := o.stale[k]; ok {
+			continue
+		}
+		o.alert(orderbookStaleEvent, &v,
+			fmt.Sprintf("%s %s %s orderbook is stale, last update received at %s",
+				v.Exchange,
+				v.Pair,
+				strings.ToUpper(v.Asset.String()),
+				v.LastReceived.Format(time.RFC3339)))
+	}
+	for k, v := range o.stale {
+		if _, ok := current[k]; ok {
+			continue
+		}
+		o.alert(orderbookRecoveredEvent, &v,
+			fmt.Sprintf("%s %s %s orderbook has recovered",
+				v.Exchange,
+				v.Pair,
+				strings.ToUpper(v.Asset.String())))
+	}
+	o.stale = current
+}
+
+func (o *orderbookStaleManager) alert(event string, b *orderbook.StaleBook, msg string) {
+	log.Warnln(log.OrderBook, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    event,
+		Message: msg,
+	})
+	if Bot.Settings.EnableWebsocketRPC {
+		relayWebsocketEvent(b, event, b.Asset.String(), b.Exchange)
+	}
+}
+
+func staleBookKey(exch string, p currency.Pair, a asset.Item) string {
+	return strings.ToLower(exch) + p.String() + a.String()
+}
+
+// getOrderbookStaleThreshold returns the configured orderbook stale threshold
+// for an exchange
+func getOrderbookStaleThreshold(exch string) (time.Duration, error) {
+	exchCfg, err := Bot.Config.GetExchangeConfig(exch)
+	if err != nil {
+		return 0, err
+	}
+	return exchCfg.OrderbookStaleThreshold, nil
+}
+
+// checkOrderbookStaleness returns an error if the stored orderbook for the
+// supplied exchange, pair and asset has exceeded its stale threshold and
+// therefore cannot be used for staleness sensitive operations
+func checkOrderbookStaleness(exch string, p currency.Pair, a asset.Item) error {
+	threshold, err := getOrderbookStaleThreshold(exch)
+	if err != nil {
+		return err
+	}
+	return orderbook.CheckStale(exch, p, a, threshold)
+}
@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/orderbook"
+)
+
+func TestOrderbookStaleManager(t *testing.T) {
+	bot := SetupTestHelpers(t)
+	var o orderbookStaleManager
+	_, err := o.GetStaleOrderbooks()
+	if !errors.Is(err, errStaleManagerNotStarted) {
+		t.Errorf("received %v, expected %v", err, errStaleManagerNotStarted)
+	}
+	if err = o.Stop(); err == nil {
+		t.Error("expected error when stopping an unstarted manager")
+	}
+
+	exchCfg, err := bot.Config.GetExchangeConfig(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	originalThreshold := exchCfg.OrderbookStaleThreshold
+	defer func() { exchCfg.OrderbookStaleThreshold = originalThreshold }()
+	exchCfg.OrderbookStaleThreshold = time.Millisecond
+
+	p := currency.NewPair(currency.BTC, currency.DOGE)
+	ob := orderbook.Base{
+		Pair:         p,
+		AssetType:    asset.Spot,
+		ExchangeName: testExchange,
+		Bids:         []orderbook.Item{{Price: 1, Amount: 1}},
+	}
+	err = ob.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = o.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond * 10)
+	err = checkOrderbookStaleness(testExchange, p, asset.Spot)
+	if !errors.Is(err, orderbook.ErrOrderbookStale) {
+		t.Errorf("received %v, expected %v", err, orderbook.ErrOrderbookStale)
+	}
+
+	o.checkOrderbooks()
+	books, err := o.GetStaleOrderbooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for i := range books {
+		if books[i].Pair.Equal(p) && books[i].Asset == asset.Spot {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected orderbook to be flagged as stale")
+	}
+
+	exchCfg.OrderbookStaleThreshold = time.Hour
+	o.checkOrderbooks()
+	books, err = o.GetStaleOrderbooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range books {
+		if books[i].Pair.Equal(p) {
+			t.Error("expected orderbook to have recovered")
+		}
+	}
+	err = checkOrderbookStaleness(testExchange, p, asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = o.Stop()
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/idoall/gocryptotrader/common"
 	"github.com/idoall/gocryptotrader/communications/base"
 	"github.com/idoall/gocryptotrader/currency"
+	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/log"
 )
@@ -126,6 +128,46 @@ func (o *orderStore) Add(det *order.Detail) error {
 	return nil
 }
 
+// upsert adds untracked orders and updates tracked orders from det. A fill is
+// published when an order is added as filled or partially filled, or when a
+// tracked order's status or executed amount changes while filled
+func (o *orderStore) upsert(det *order.Detail) (added bool, err error) {
+	if det == nil {
+		return false, errors.New("order store: Order is nil")
+	}
+	od, err := o.GetByExchangeAndID(det.Exchange, det.ID)
+	if err != nil {
+		if !errors.Is(err, ErrOrderNotFound) && !errors.Is(err, ErrExchangeNotFound) {
+			return false, err
+		}
+		err = o.Add(det)
+		if err != nil {
+			return false, err
+		}
+		publishFill(det)
+		return true, nil
+	}
+	status, executed := od.Status, od.ExecutedAmount
+	od.UpdateOrderFromDetail(det)
+	if od.Status != status || od.ExecutedAmount != executed {
+		publishFill(od)
+	}
+	return false, nil
+}
+
+// publishFill sends a copy of filled and partially filled orders to their
+// exchange fill stream. Failures are logged as the order is already tracked
+func publishFill(d *order.Detail) {
+	if d.Status != order.Filled && d.Status != order.PartiallyFilled {
+		return
+	}
+	fill := *d
+	err := order.PublishFill(&fill)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to publish %s order %s fill: %v\n", d.Exchange, d.ID, err)
+	}
+}
+
 // Started returns the status of the orderManager
 func (o *orderManager) Started() bool {
 	return atomic.LoadInt32(&o.started) == 1
@@ -305,8 +347,8 @@ func (o *orderManager) GetOrderInfo(exchangeName, orderID string, cp currency.Pa
 		return order.Detail{}, err
 	}
 
-	err = o.orderStore.Add(&result)
-	if err != nil && err != ErrOrdersAlreadyExists {
+	_, err = o.orderStore.upsert(&result)
+	if err != nil {
 		return order.Detail{}, err
 	}
 
@@ -316,6 +358,80 @@ func (o *orderManager) GetOrderInfo(exchangeName, orderID string, cp currency.Pa
 // Submit will take in an order struct, send it to the exchange and
 // populate it in the orderManager if successful
 func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, error) {
+	exch, err := o.validate(newOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := exch.SubmitOrder(newOrder)
+	if err != nil {
+		return nil, err
+	}
+	return o.track(newOrder, result)
+}
+
+// SubmitFutures will take in a derivatives order, send it to the exchange and
+// populate it in the orderManager if successful. Closing a position submits
+// a reduce only market order for the open position's amount
+func (o *orderManager) SubmitFutures(newOrder *futures.Submit) (*orderSubmitResponse, error) {
+	if newOrder == nil {
+		return nil, errors.New("order cannot be nil")
+	}
+
+	if newOrder.ClosePosition {
+		var err error
+		newOrder, err = closePositionOrder(newOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	exch, err := o.validate(&newOrder.Submit)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := exch.SubmitFuturesOrder(newOrder)
+	if err != nil {
+		return nil, err
+	}
+	return o.track(&newOrder.Submit, result)
+}
+
+// closePositionOrder returns the order which closes the open position of the
+// close order's pair
+func closePositionOrder(closeOrder *futures.Submit) (*futures.Submit, error) {
+	err := closeOrder.Validate()
+	if err != nil {
+		return nil, err
+	}
+	exch := Bot.GetExchangeByName(closeOrder.Exchange)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	positions, err := exch.GetFuturesPositions(closeOrder.Pair, closeOrder.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	var position futures.Position
+	for i := range positions {
+		if positions[i].Amount != 0 {
+			position = positions[i]
+			break
+		}
+	}
+	position.Exchange, position.Pair, position.AssetType = closeOrder.Exchange, closeOrder.Pair, closeOrder.AssetType
+	closing, err := position.CloseOrder()
+	if err != nil {
+		return nil, err
+	}
+	closing.ClientID = closeOrder.ClientID
+	return closing, nil
+}
+
+// validate checks the order against the order manager's limits and conforms
+// it to the exchange's execution limits
+func (o *orderManager) validate(newOrder *order.Submit) (exchange.IBotExchange, error) {
 	if newOrder == nil {
 		return nil, errors.New("order cannot be nil")
 	}
@@ -351,17 +467,21 @@ func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, err
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
-	result, err := exch.SubmitOrder(newOrder)
+
+	err := exch.ConformOrderToLimits(newOrder)
 	if err != nil {
 		return nil, err
 	}
+	return exch, nil
+}
 
+// track adds a submitted order to the orderStore
+func (o *orderManager) track(newOrder *order.Submit, result order.SubmitResponse) (*orderSubmitResponse, error) {
 	if !result.IsOrderPlaced {
 		return nil, errors.New("order unable to be placed")
 	}
 
-	var id uuid.UUID
-	id, err = uuid.NewV4()
+	id, err := uuid.NewV4()
 	if err != nil {
 		log.Warnf(log.OrderMgr,
 			"Order manager: Unable to generate UUID. Err: %s",
@@ -386,7 +506,7 @@ func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, err
 	if result.FullyMatched {
 		status = order.Filled
 	}
-	err = o.orderStore.Add(&order.Detail{
+	detail := &order.Detail{
 		ImmediateOrCancel: newOrder.ImmediateOrCancel,
 		HiddenOrder:       newOrder.HiddenOrder,
 		FillOrKill:        newOrder.FillOrKill,
@@ -413,10 +533,12 @@ func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, err
 		Date:              time.Now(),
 		LastUpdated:       time.Now(),
 		Pair:              newOrder.Pair,
-	})
+	}
+	err = o.orderStore.Add(detail)
 	if err != nil {
 		return nil, fmt.Errorf("unable to add %v order %v to orderStore: %s", newOrder.Exchange, result.OrderID, err)
 	}
+	publishFill(detail)
 
 	return &orderSubmitResponse{
 		SubmitResponse: order.SubmitResponse{
@@ -474,8 +596,16 @@ func (o *orderManager) processOrders() {
 
 			for z := range result {
 				ord := &result[z]
-				result := o.orderStore.Add(ord)
-				if result != ErrOrdersAlreadyExists {
+				added, err := o.orderStore.upsert(ord)
+				if err != nil {
+					log.Errorf(log.OrderMgr,
+						"Order manager: Unable to track %s order %s: %s",
+						ord.Exchange,
+						ord.ID,
+						err)
+					continue
+				}
+				if added {
 					msg := fmt.Sprintf("Order manager: Exchange %s added order ID=%v pair=%v price=%v amount=%v side=%v type=%v.",
 						ord.Exchange, ord.ID, ord.Pair, ord.Price, ord.Amount, ord.Side, ord.Type)
 					log.Debugf(log.OrderMgr, "%v", msg)
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 )
 
@@ -413,7 +415,137 @@ func TestSubmit(t *testing.T) {
 	}
 }
 
+func TestSubmitFutures(t *testing.T) {
+	OrdersSetup(t)
+	_, err := Bot.OrderManager.SubmitFutures(nil)
+	if err == nil {
+		t.Error("Expected error from nil order")
+	}
+
+	pair, err := currency.NewPairFromString("BTCUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := &futures.Submit{
+		Submit: order.Submit{
+			Exchange:  fakePassExchange,
+			Pair:      pair,
+			AssetType: asset.PerpetualContract,
+			Side:      order.Buy,
+			Type:      order.Market,
+			ClientID:  "TestSubmitFutures",
+		},
+	}
+	_, err = Bot.OrderManager.SubmitFutures(o)
+	if err == nil {
+		t.Error("Expected error from validation")
+	}
+
+	o.Amount = 1
+	Bot.OrderManager.cfg.EnforceLimitConfig = true
+	Bot.OrderManager.cfg.LimitAmount = 0.5
+	_, err = Bot.OrderManager.SubmitFutures(o)
+	if err == nil {
+		t.Error("Expected fail due to order limit exceeds allowed limit")
+	}
+	Bot.OrderManager.cfg.EnforceLimitConfig = false
+	Bot.OrderManager.cfg.LimitAmount = 0
+
+	_, err = Bot.OrderManager.SubmitFutures(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Bot.OrderManager.orderStore.GetByExchangeAndID(fakePassExchange, "TestSubmitFutures")
+	if err != nil {
+		t.Error(err)
+	}
+
+	closing := &futures.Submit{
+		Submit: order.Submit{
+			Exchange:  fakePassExchange,
+			Pair:      pair,
+			AssetType: asset.PerpetualContract,
+			ClientID:  "TestSubmitFuturesClose",
+		},
+		ClosePosition: true,
+	}
+	_, err = Bot.OrderManager.SubmitFutures(closing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed, err := Bot.OrderManager.orderStore.GetByExchangeAndID(fakePassExchange, "TestSubmitFuturesClose")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if closed.Side != order.Buy || closed.Amount != 2 || closed.Type != order.Market {
+		t.Errorf("expected a market buy of the short position received %v %v %v", closed.Type, closed.Side, closed.Amount)
+	}
+}
+
 func TestProcessOrders(t *testing.T) {
 	OrdersSetup(t)
 	Bot.OrderManager.processOrders()
 }
+
+func TestOrderStoreUpsert(t *testing.T) {
+	OrdersSetup(t)
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pipe, err := order.SubscribeToExchangeFills(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		err = pipe.Release()
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+	_, err = Bot.OrderManager.orderStore.upsert(nil)
+	if err == nil {
+		t.Error("Expected error from nil order")
+	}
+	added, err := Bot.OrderManager.orderStore.upsert(&order.Detail{
+		Exchange: testExchange,
+		ID:       "TestOrderStoreUpsert",
+		Amount:   2,
+		Status:   order.New,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !added {
+		t.Error("expected untracked order to be added")
+	}
+
+	// the dispatcher drops data on a handshake timeout so update the order
+	// until a fill is received
+	for i := 1; i <= 10; i++ {
+		added, err = Bot.OrderManager.orderStore.upsert(&order.Detail{
+			Exchange:       testExchange,
+			ID:             "TestOrderStoreUpsert",
+			ExecutedAmount: float64(i) / 10,
+			Status:         order.PartiallyFilled,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if added {
+			t.Error("expected tracked order to be updated")
+		}
+		select {
+		case data := <-pipe.C:
+			fill := (*data.(*interface{})).(order.Detail)
+			if fill.ID != "TestOrderStoreUpsert" || fill.Status != order.PartiallyFilled || fill.Amount != 2 {
+				t.Errorf("unexpected fill %+v", fill)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	t.Fatal("expected the fill to be published")
+}
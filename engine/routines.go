@@ -8,6 +8,7 @@ import (
 
 	"github.com/idoall/gocryptotrader/common"
 	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/stats"
@@ -314,6 +315,18 @@ func WebsocketDataHandler(exchName string, data interface{}) error {
 				d.AssetType,
 				d)
 		}
+	case []liquidation.Data:
+		if Bot.Settings.Verbose {
+			for i := range d {
+				log.Infof(log.WebsocketMgr, "%s websocket %s %s liquidation %s %.8f @ %.8f",
+					exchName,
+					FormatCurrency(d[i].Pair),
+					d[i].AssetType,
+					d[i].Side,
+					d[i].Amount,
+					d[i].Price)
+			}
+		}
 	case *orderbook.Base:
 		if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
 			Bot.ExchangeCurrencyPairManager.update(exchName,
@@ -324,17 +337,9 @@ func WebsocketDataHandler(exchName string, data interface{}) error {
 		}
 		printOrderbookSummary(d, "websocket", nil)
 	case *order.Detail:
-		if !Bot.OrderManager.orderStore.exists(d) {
-			err := Bot.OrderManager.orderStore.Add(d)
-			if err != nil {
-				return err
-			}
-		} else {
-			od, err := Bot.OrderManager.orderStore.GetByExchangeAndID(d.Exchange, d.ID)
-			if err != nil {
-				return err
-			}
-			od.UpdateOrderFromDetail(d)
+		_, err := Bot.OrderManager.orderStore.upsert(d)
+		if err != nil {
+			return err
 		}
 	case *order.Cancel:
 		return Bot.OrderManager.Cancel(d)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/sharedtestvalues"
@@ -45,6 +46,10 @@ func TestHandleData(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	err = WebsocketDataHandler(exchName, []liquidation.Data{{}})
+	if err != nil {
+		t.Error(err)
+	}
 	origOrder := &order.Detail{
 		Exchange: fakePassExchange,
 		ID:       orderID,
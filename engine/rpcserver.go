@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -29,9 +30,14 @@ import (
 	"github.com/idoall/gocryptotrader/database/models/sqlite3"
 	"github.com/idoall/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/repository/scriptstate"
+	"github.com/idoall/gocryptotrader/database/transfer"
+	"github.com/idoall/gocryptotrader/dispatch"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/ticker"
@@ -39,6 +45,8 @@ import (
 	"github.com/idoall/gocryptotrader/gctrpc"
 	"github.com/idoall/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/idoall/gocryptotrader/gctscript/vm"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/backtest"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/sandbox"
 	"github.com/idoall/gocryptotrader/log"
 	"github.com/idoall/gocryptotrader/portfolio"
 	"github.com/idoall/gocryptotrader/portfolio/banking"
@@ -62,6 +70,8 @@ var (
 	errExchangeNotLoaded    = errors.New("exchange is not loaded/doesn't exist")
 	errExchangeBaseNotFound = errors.New("cannot get exchange base")
 	errInvalidArguments     = errors.New(invalidArguments)
+	errScriptNameUnset      = errors.New("script name unset")
+	errInvalidTransferPath  = errors.New("file path must be relative to the exports directory")
 )
 
 // RPCServer struct
@@ -351,15 +361,20 @@ func (s *RPCServer) GetTicker(_ context.Context, r *gctrpc.GetTickerRequest) (*g
 	}
 
 	resp := &gctrpc.TickerResponse{
-		Pair:        r.Pair,
-		LastUpdated: t.LastUpdated.Unix(),
-		Last:        t.Last,
-		High:        t.High,
-		Low:         t.Low,
-		Bid:         t.Bid,
-		Ask:         t.Ask,
-		Volume:      t.Volume,
-		PriceAth:    t.PriceATH,
+		Pair:            r.Pair,
+		LastUpdated:     t.LastUpdated.Unix(),
+		Last:            t.Last,
+		High:            t.High,
+		Low:             t.Low,
+		Bid:             t.Bid,
+		Ask:             t.Ask,
+		Volume:          t.Volume,
+		PriceAth:        t.PriceATH,
+		MarkPrice:       t.MarkPrice,
+		IndexPrice:      t.IndexPrice,
+		FundingRate:     t.FundingRate,
+		NextFundingTime: unixOrZero(t.NextFundingTime),
+		OpenInterest:    t.OpenInterest,
 	}
 
 	return resp, nil
@@ -382,14 +397,19 @@ func (s *RPCServer) GetTickers(_ context.Context, r *gctrpc.GetTickersRequest) (
 					Base:      t.Pair.Base.String(),
 					Quote:     t.Pair.Quote.String(),
 				},
-				LastUpdated: t.LastUpdated.Unix(),
-				Last:        t.Last,
-				High:        t.High,
-				Low:         t.Low,
-				Bid:         t.Bid,
-				Ask:         t.Ask,
-				Volume:      t.Volume,
-				PriceAth:    t.PriceATH,
+				LastUpdated:     t.LastUpdated.Unix(),
+				Last:            t.Last,
+				High:            t.High,
+				Low:             t.Low,
+				Bid:             t.Bid,
+				Ask:             t.Ask,
+				Volume:          t.Volume,
+				PriceAth:        t.PriceATH,
+				MarkPrice:       t.MarkPrice,
+				IndexPrice:      t.IndexPrice,
+				FundingRate:     t.FundingRate,
+				NextFundingTime: unixOrZero(t.NextFundingTime),
+				OpenInterest:    t.OpenInterest,
 			})
 		}
 		tickers = append(tickers, &ticker)
@@ -495,6 +515,110 @@ func (s *RPCServer) GetOrderbooks(_ context.Context, r *gctrpc.GetOrderbooksRequ
 	return &gctrpc.GetOrderbooksResponse{Orderbooks: orderbooks}, nil
 }
 
+// GetTickerHistory returns the stored ticker history for a specific exchange,
+// currency pair and asset type
+func (s *RPCServer) GetTickerHistory(_ context.Context, r *gctrpc.GetTickerHistoryRequest) (*gctrpc.GetTickerHistoryResponse, error) {
+	if r.Pair == nil {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	var since time.Time
+	if r.Since != "" {
+		since, err = time.Parse(common.SimpleTimeFormat, r.Since)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	history, err := ticker.GetHistory(r.Exchange,
+		currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		a,
+		int(r.Limit),
+		since)
+	if err != nil {
+		return nil, err
+	}
+
+	tickers := make([]*gctrpc.TickerResponse, len(history))
+	for i := range history {
+		tickers[i] = &gctrpc.TickerResponse{
+			Pair:            r.Pair,
+			LastUpdated:     history[i].LastUpdated.Unix(),
+			Last:            history[i].Last,
+			High:            history[i].High,
+			Low:             history[i].Low,
+			Bid:             history[i].Bid,
+			Ask:             history[i].Ask,
+			Volume:          history[i].Volume,
+			PriceAth:        history[i].PriceATH,
+			MarkPrice:       history[i].MarkPrice,
+			IndexPrice:      history[i].IndexPrice,
+			FundingRate:     history[i].FundingRate,
+			NextFundingTime: unixOrZero(history[i].NextFundingTime),
+			OpenInterest:    history[i].OpenInterest,
+		}
+	}
+	return &gctrpc.GetTickerHistoryResponse{Tickers: tickers}, nil
+}
+
+// GetOrderbookHistory returns the stored top of book history for a specific
+// exchange, currency pair and asset type
+func (s *RPCServer) GetOrderbookHistory(_ context.Context, r *gctrpc.GetOrderbookHistoryRequest) (*gctrpc.GetOrderbookHistoryResponse, error) {
+	if r.Pair == nil {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	var since time.Time
+	if r.Since != "" {
+		since, err = time.Parse(common.SimpleTimeFormat, r.Since)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	history, err := orderbook.GetHistory(r.Exchange,
+		currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		a,
+		int(r.Limit),
+		since)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOrderbookHistoryResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: r.AssetType,
+		History:   make([]*gctrpc.TopOfBook, len(history)),
+	}
+	for i := range history {
+		resp.History[i] = &gctrpc.TopOfBook{
+			BidPrice:    history[i].BidPrice,
+			BidAmount:   history[i].BidAmount,
+			AskPrice:    history[i].AskPrice,
+			AskAmount:   history[i].AskAmount,
+			LastUpdated: history[i].LastUpdated.Unix(),
+		}
+	}
+	return resp, nil
+}
+
 // GetAccountInfo returns an account balance for a specific exchange
 func (s *RPCServer) GetAccountInfo(_ context.Context, r *gctrpc.GetAccountInfoRequest) (*gctrpc.GetAccountInfoResponse, error) {
 	exch := s.GetExchangeByName(r.Exchange)
@@ -923,6 +1047,11 @@ func (s *RPCServer) SimulateOrder(_ context.Context, r *gctrpc.SimulateOrderRequ
 		return nil, err
 	}
 
+	err = checkOrderbookStaleness(r.Exchange, p, asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+
 	var buy = true
 	if !strings.EqualFold(r.Side, order.Buy.String()) &&
 		!strings.EqualFold(r.Side, order.Bid.String()) {
@@ -964,6 +1093,11 @@ func (s *RPCServer) WhaleBomb(_ context.Context, r *gctrpc.WhaleBombRequest) (*g
 		return nil, err
 	}
 
+	err = checkOrderbookStaleness(r.Exchange, p, asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+
 	var buy = true
 	if !strings.EqualFold(r.Side, order.Buy.String()) &&
 		!strings.EqualFold(r.Side, order.Bid.String()) {
@@ -1634,14 +1768,19 @@ func (s *RPCServer) GetTickerStream(r *gctrpc.GetTickerStreamRequest, stream gct
 				Base:      t.Pair.Base.String(),
 				Quote:     t.Pair.Quote.String(),
 				Delimiter: t.Pair.Delimiter},
-			LastUpdated: t.LastUpdated.Unix(),
-			Last:        t.Last,
-			High:        t.High,
-			Low:         t.Low,
-			Bid:         t.Bid,
-			Ask:         t.Ask,
-			Volume:      t.Volume,
-			PriceAth:    t.PriceATH,
+			LastUpdated:     t.LastUpdated.Unix(),
+			Last:            t.Last,
+			High:            t.High,
+			Low:             t.Low,
+			Bid:             t.Bid,
+			Ask:             t.Ask,
+			Volume:          t.Volume,
+			PriceAth:        t.PriceATH,
+			MarkPrice:       t.MarkPrice,
+			IndexPrice:      t.IndexPrice,
+			FundingRate:     t.FundingRate,
+			NextFundingTime: unixOrZero(t.NextFundingTime),
+			OpenInterest:    t.OpenInterest,
 		})
 		if err != nil {
 			return err
@@ -1674,14 +1813,101 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 				Base:      t.Pair.Base.String(),
 				Quote:     t.Pair.Quote.String(),
 				Delimiter: t.Pair.Delimiter},
-			LastUpdated: t.LastUpdated.Unix(),
-			Last:        t.Last,
-			High:        t.High,
-			Low:         t.Low,
-			Bid:         t.Bid,
-			Ask:         t.Ask,
-			Volume:      t.Volume,
-			PriceAth:    t.PriceATH,
+			LastUpdated:     t.LastUpdated.Unix(),
+			Last:            t.Last,
+			High:            t.High,
+			Low:             t.Low,
+			Bid:             t.Bid,
+			Ask:             t.Ask,
+			Volume:          t.Volume,
+			PriceAth:        t.PriceATH,
+			MarkPrice:       t.MarkPrice,
+			IndexPrice:      t.IndexPrice,
+			FundingRate:     t.FundingRate,
+			NextFundingTime: unixOrZero(t.NextFundingTime),
+			OpenInterest:    t.OpenInterest,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetOrderExecutionLimits returns the trading rules for an exchange currency
+// pair
+func (s *RPCServer) GetOrderExecutionLimits(_ context.Context, r *gctrpc.GetOrderExecutionLimitsRequest) (*gctrpc.GetOrderExecutionLimitsResponse, error) {
+	if r.Pair == nil {
+		return nil, errInvalidArguments
+	}
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := exch.GetOrderExecutionLimits(a, currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.GetOrderExecutionLimitsResponse{
+		Exchange:                r.Exchange,
+		Pair:                    r.Pair,
+		AssetType:               a.String(),
+		MinPrice:                level.MinPrice,
+		MaxPrice:                level.MaxPrice,
+		PriceStepIncrementSize:  level.PriceStepIncrementSize,
+		MinAmount:               level.MinAmount,
+		MaxAmount:               level.MaxAmount,
+		AmountStepIncrementSize: level.AmountStepIncrementSize,
+		MinNotional:             level.MinNotional,
+	}, nil
+}
+
+// GetLiquidationStream streams forced liquidations for an exchange, or for all
+// exchanges if no exchange name is supplied
+func (s *RPCServer) GetLiquidationStream(r *gctrpc.GetLiquidationStreamRequest, stream gctrpc.GoCryptoTrader_GetLiquidationStreamServer) error {
+	var pipe dispatch.Pipe
+	var err error
+	if r.Exchange == "" {
+		pipe, err = liquidation.SubscribeToAllLiquidations()
+	} else {
+		if s.GetExchangeByName(r.Exchange) == nil {
+			return errExchangeNotLoaded
+		}
+		pipe, err = liquidation.SubscribeToExchangeLiquidations(r.Exchange)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		l := (*data.(*interface{})).(liquidation.Data)
+
+		err := stream.Send(&gctrpc.LiquidationResponse{
+			Exchange: l.Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      l.Pair.Base.String(),
+				Quote:     l.Pair.Quote.String(),
+				Delimiter: l.Pair.Delimiter},
+			Asset:     l.AssetType.String(),
+			Side:      l.Side.String(),
+			Price:     l.Price,
+			Amount:    l.Amount,
+			Timestamp: l.Timestamp.Unix(),
 		})
 		if err != nil {
 			return err
@@ -1776,12 +2002,12 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, r *gctrpc.GetHistoricC
 		Quote:     currency.NewCode(r.Pair.Quote),
 	}
 	if r.UseDb {
-		klineItem, err = kline.LoadFromDatabase(r.Exchange,
+		klineItem, err = s.LoadResampledCandlesFromDatabase(r.Exchange,
 			pair,
 			a,
-			interval,
 			UTCStartTime,
-			UTCEndTime)
+			UTCEndTime,
+			interval)
 		if err != nil {
 			return nil, err
 		}
@@ -1790,19 +2016,13 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, r *gctrpc.GetHistoricC
 		if exchangeEngine == nil {
 			return nil, errors.New("Exchange " + r.Exchange + " not found")
 		}
-		if r.ExRequest {
-			klineItem, err = exchangeEngine.GetHistoricCandlesExtended(pair,
-				a,
-				UTCStartTime,
-				UTCEndTime,
-				interval)
-		} else {
-			klineItem, err = exchangeEngine.GetHistoricCandles(pair,
-				a,
-				UTCStartTime,
-				UTCEndTime,
-				interval)
-		}
+		klineItem, err = s.GetResampledHistoricCandles(r.Exchange,
+			pair,
+			a,
+			UTCStartTime,
+			UTCEndTime,
+			interval,
+			r.ExRequest)
 	}
 
 	if err != nil {
@@ -1914,12 +2134,7 @@ func (s *RPCServer) GCTScriptStatus(_ context.Context, r *gctrpc.GCTScriptStatus
 	}
 
 	gctscript.AllVMSync.Range(func(k, v interface{}) bool {
-		vm := v.(*gctscript.VM)
-		resp.Scripts = append(resp.Scripts, &gctrpc.GCTScript{
-			UUID:    vm.ID.String(),
-			Name:    vm.ShortName(),
-			NextRun: vm.NextRun.String(),
-		})
+		resp.Scripts = append(resp.Scripts, scriptStatus(v.(*gctscript.VM)))
 
 		return true
 	})
@@ -1927,6 +2142,23 @@ func (s *RPCServer) GCTScriptStatus(_ context.Context, r *gctrpc.GCTScriptStatus
 	return resp, nil
 }
 
+// scriptStatus returns a running script's schedule and pipeline
+func scriptStatus(vm *gctscript.VM) *gctrpc.GCTScript {
+	state := vm.ScheduleState()
+	script := &gctrpc.GCTScript{
+		UUID:       vm.ID.String(),
+		Name:       vm.ShortName(),
+		NextRun:    state.NextRun.String(),
+		Schedule:   vm.Schedule,
+		LastStatus: state.LastStatus,
+		Pipeline:   vm.Pipeline,
+	}
+	if !state.LastRun.IsZero() {
+		script.LastRun = state.LastRun.String()
+	}
+	return script
+}
+
 // GCTScriptQuery queries a running script and returns script running information
 func (s *RPCServer) GCTScriptQuery(_ context.Context, r *gctrpc.GCTScriptQueryRequest) (*gctrpc.GCTScriptQueryResponse, error) {
 	if !s.GctScriptManager.Started() {
@@ -1941,13 +2173,9 @@ func (s *RPCServer) GCTScriptQuery(_ context.Context, r *gctrpc.GCTScriptQueryRe
 	if v, f := gctscript.AllVMSync.Load(UUID); f {
 		resp := &gctrpc.GCTScriptQueryResponse{
 			Status: MsgStatusOK,
-			Script: &gctrpc.GCTScript{
-				Name:    v.(*gctscript.VM).ShortName(),
-				UUID:    v.(*gctscript.VM).ID.String(),
-				Path:    v.(*gctscript.VM).Path,
-				NextRun: v.(*gctscript.VM).NextRun.String(),
-			},
+			Script: scriptStatus(v.(*gctscript.VM)),
 		}
+		resp.Script.Path = v.(*gctscript.VM).Path
 		data, err := v.(*gctscript.VM).Read()
 		if err != nil {
 			return nil, err
@@ -2018,6 +2246,11 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 		return &gctrpc.GenericResponse{Status: gctscript.ErrScriptingDisabled.Error()}, nil
 	}
 
+	limits, err := scriptLimits(r.Limits)
+	if err != nil {
+		return nil, err
+	}
+
 	fPath := filepath.Join(gctscript.ScriptPath, r.ScriptName)
 	var fPathExits = fPath
 	if filepath.Ext(fPath) == ".zip" {
@@ -2070,7 +2303,10 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 		}
 		var failedFiles []string
 		for x := range files {
-			err = s.GctScriptManager.Validate(files[x])
+			err = sandbox.SaveLimits(files[x], limits)
+			if err == nil {
+				err = s.GctScriptManager.Validate(files[x])
+			}
 			if err != nil {
 				failedFiles = append(failedFiles, files[x])
 			}
@@ -2087,12 +2323,19 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 			return &gctrpc.GenericResponse{Status: gctscript.ErrScriptFailedValidation, Data: strings.Join(failedFiles, ", ")}, nil
 		}
 	} else {
-		err = s.GctScriptManager.Validate(fPath)
+		err = sandbox.SaveLimits(fPath, limits)
+		if err == nil {
+			err = s.GctScriptManager.Validate(fPath)
+		}
 		if err != nil {
 			errRemove := os.Remove(fPath)
 			if errRemove != nil {
 				log.Errorf(log.GCTScriptMgr, "Failed to remove file %v, manual deletion required: %v", filepath.Base(fPath), errRemove)
 			}
+			errRemove = sandbox.SaveLimits(fPath, nil)
+			if errRemove != nil {
+				log.Errorf(log.GCTScriptMgr, "Failed to remove limits of %v, manual deletion required: %v", filepath.Base(fPath), errRemove)
+			}
 			return &gctrpc.GenericResponse{Status: gctscript.ErrScriptFailedValidation, Data: err.Error()}, nil
 		}
 	}
@@ -2103,6 +2346,24 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 	}, nil
 }
 
+// scriptLimits converts the limits a script is uploaded with, nil is returned
+// when the script is unlimited
+func scriptLimits(l *gctrpc.GCTScriptLimits) (*sandbox.Limits, error) {
+	if l == nil {
+		return nil, nil
+	}
+	limits := &sandbox.Limits{
+		MaxAllocs:          l.MaxAllocs,
+		MaxRuntime:         time.Duration(l.MaxRuntime) * time.Second,
+		MaxOrdersPerMinute: l.MaxOrdersPerMinute,
+		MaxNotional:        l.MaxNotional,
+		Exchanges:          l.Exchanges,
+		Pairs:              l.Pairs,
+		AllowWithdrawals:   l.AllowWithdrawals,
+	}
+	return limits, limits.Validate()
+}
+
 // GCTScriptReadScript read a script and return contents
 func (s *RPCServer) GCTScriptReadScript(_ context.Context, r *gctrpc.GCTScriptReadScriptRequest) (*gctrpc.GCTScriptQueryResponse, error) {
 	if !s.GctScriptManager.Started() {
@@ -2192,6 +2453,158 @@ func (s *RPCServer) GCTScriptAutoLoadToggle(_ context.Context, r *gctrpc.GCTScri
 	return &gctrpc.GenericResponse{Status: "success", Data: "script " + r.Script + " added to autoload list"}, nil
 }
 
+// GCTScriptBacktest runs a script once per saved candle over a period, with
+// orders filled against the candles, and returns the script's performance
+func (s *RPCServer) GCTScriptBacktest(ctx context.Context, r *gctrpc.GCTScriptBacktestRequest) (*gctrpc.GCTScriptBacktestResponse, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	if r.Script == nil || r.Script.Name == "" {
+		return nil, errScriptNameUnset
+	}
+	pair, a, start, end, err := parseFuturesDataRequest(r.Exchange, r.Pair, r.AssetType, r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	w, err := backtest.New(&backtest.Config{
+		Exchange:   r.Exchange,
+		Pair:       pair,
+		Asset:      a,
+		Interval:   kline.Interval(r.Interval),
+		Start:      start,
+		End:        end,
+		BaseFunds:  r.BaseFunds,
+		QuoteFunds: r.QuoteFunds,
+		Fee:        r.Fee,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.Script.Path == "" {
+		r.Script.Path = gctscript.ScriptPath
+	}
+	report, err := s.GctScriptManager.Backtest(ctx, filepath.Join(r.Script.Path, r.Script.Name), w)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GCTScriptBacktestResponse{
+		Script:             r.Script.Name,
+		Exchange:           report.Exchange,
+		Pair:               r.Pair,
+		AssetType:          report.Asset.String(),
+		Interval:           report.Interval.Short(),
+		Start:              report.Start.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		End:                report.End.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		Candles:            int64(report.Candles),
+		StartingBase:       report.StartingBase,
+		StartingQuote:      report.StartingQuote,
+		FinalBase:          report.FinalBase,
+		FinalQuote:         report.FinalQuote,
+		StartingValue:      report.StartingValue,
+		FinalValue:         report.FinalValue,
+		ProfitLoss:         report.ProfitLoss,
+		ReturnPercent:      report.ReturnPercent,
+		BuyAndHoldPercent:  report.BuyAndHoldPercent,
+		MaxDrawdownPercent: report.MaxDrawdownPercent,
+		Fees:               report.Fees,
+		FilledOrders:       int64(report.FilledOrders),
+	}
+	for i := range report.Orders {
+		o := &report.Orders[i]
+		var trades []*gctrpc.TradeHistory
+		for j := range o.Trades {
+			trades = append(trades, &gctrpc.TradeHistory{
+				CreationTime: o.Trades[j].Timestamp.Unix(),
+				Id:           o.Trades[j].TID,
+				Price:        o.Trades[j].Price,
+				Amount:       o.Trades[j].Amount,
+				Exchange:     o.Exchange,
+				AssetType:    o.AssetType.String(),
+				OrderSide:    o.Side.String(),
+				Fee:          o.Trades[j].Fee,
+			})
+		}
+		resp.Orders = append(resp.Orders, &gctrpc.OrderDetails{
+			Exchange:      o.Exchange,
+			Id:            o.ID,
+			BaseCurrency:  o.Pair.Base.String(),
+			QuoteCurrency: o.Pair.Quote.String(),
+			AssetType:     o.AssetType.String(),
+			OrderSide:     o.Side.String(),
+			OrderType:     o.Type.String(),
+			CreationTime:  o.Date.Unix(),
+			UpdateTime:    o.LastUpdated.Unix(),
+			Status:        o.Status.String(),
+			Price:         o.Price,
+			Amount:        o.Amount,
+			OpenVolume:    o.RemainingAmount,
+			Fee:           o.Fee,
+			Cost:          o.Cost,
+			Trades:        trades,
+		})
+	}
+	return resp, nil
+}
+
+// scriptStateName returns the name script state is saved under, which is the
+// script's file name
+func scriptStateName(script string) (string, error) {
+	script = filepath.Base(strings.TrimSpace(script))
+	if script == "" || script == "." || script == string(filepath.Separator) {
+		return "", errScriptNameUnset
+	}
+	if filepath.Ext(script) != common.GctExt {
+		script += common.GctExt
+	}
+	return script, nil
+}
+
+// GCTScriptGetState returns the state saved by a script
+func (s *RPCServer) GCTScriptGetState(_ context.Context, r *gctrpc.GCTScriptStateRequest) (*gctrpc.GCTScriptStateResponse, error) {
+	name, err := scriptStateName(r.Script)
+	if err != nil {
+		return nil, err
+	}
+	values, err := scriptstate.GetAll(name)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GCTScriptStateResponse{
+		Script: name,
+		Values: make([]*gctrpc.GCTScriptStateValue, len(values)),
+	}
+	for i := range values {
+		resp.Values[i] = &gctrpc.GCTScriptStateValue{
+			Key:       values[i].Key,
+			Value:     values[i].Value,
+			UpdatedAt: values[i].UpdatedAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// GCTScriptClearState removes a script's saved value for a key, or all of its
+// saved values when no key is set
+func (s *RPCServer) GCTScriptClearState(_ context.Context, r *gctrpc.GCTScriptClearStateRequest) (*gctrpc.GenericResponse, error) {
+	name, err := scriptStateName(r.Script)
+	if err != nil {
+		return nil, err
+	}
+	if r.Key != "" {
+		err = scriptstate.Delete(name, r.Key)
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GenericResponse{Status: "success", Data: "script " + name + " state " + r.Key + " cleared"}, nil
+	}
+	cleared, err := scriptstate.DeleteAll(name)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success", Data: fmt.Sprintf("script %s state cleared, %d values removed", name, cleared)}, nil
+}
+
 // SetExchangeAsset enables or disables an exchanges asset type
 func (s *RPCServer) SetExchangeAsset(_ context.Context, r *gctrpc.SetExchangeAssetRequest) (*gctrpc.GenericResponse, error) {
 	exch := s.GetExchangeByName(r.Exchange)
@@ -2935,3 +3348,511 @@ func (s *RPCServer) GetRecentTrades(_ context.Context, r *gctrpc.GetSavedTradesR
 
 	return resp, nil
 }
+
+// unixOrZero returns the unix timestamp of t or zero if t is unset
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// ValidateSavedCandles compares stored candles against candles rebuilt from
+// stored trades or re-fetched from the exchange, optionally repairing them
+func (s *RPCServer) ValidateSavedCandles(_ context.Context, r *gctrpc.ValidateSavedCandlesRequest) (*gctrpc.ValidateSavedCandlesResponse, error) {
+	if r.Pair == nil {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Engine.ValidateSavedCandles(&CandleValidationRequest{
+		Exchange: r.Exchange,
+		Pair: currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		Asset:               a,
+		Interval:            kline.Interval(r.Interval),
+		Start:               start,
+		End:                 end,
+		Source:              r.Source,
+		TolerancePercentage: r.TolerancePercentage,
+		Repair:              r.Repair,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.ValidateSavedCandlesResponse{
+		Exchange:        r.Exchange,
+		Pair:            r.Pair,
+		AssetType:       a.String(),
+		Interval:        r.Interval,
+		Source:          r.Source,
+		CandlesChecked:  int64(result.CandlesChecked),
+		CandlesRepaired: int64(result.CandlesRepaired),
+	}
+	for i := range result.Discrepancies {
+		d := &gctrpc.CandleDiscrepancy{
+			Time:     result.Discrepancies[i].Time.Format(common.SimpleTimeFormatWithTimezone),
+			Missing:  result.Discrepancies[i].Missing,
+			Expected: candleToRPC(&result.Discrepancies[i].Expected),
+			Reasons:  result.Discrepancies[i].Reasons,
+		}
+		if !d.Missing {
+			d.Stored = candleToRPC(&result.Discrepancies[i].Stored)
+		}
+		resp.Discrepancies = append(resp.Discrepancies, d)
+	}
+	return resp, nil
+}
+
+func candleToRPC(c *kline.Candle) *gctrpc.Candle {
+	return &gctrpc.Candle{
+		Time:   c.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		Low:    c.Low,
+		High:   c.High,
+		Open:   c.Open,
+		Close:  c.Close,
+		Volume: c.Volume,
+	}
+}
+
+// UpsertDataHistoryJob adds or updates a data history job for the data history
+// manager to process
+func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertDataHistoryJobRequest) (*gctrpc.UpsertDataHistoryJobResponse, error) {
+	if r == nil || r.Pair == nil {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	job := DataHistoryJob{
+		Nickname: r.Nickname,
+		Exchange: r.Exchange,
+		Asset:    a,
+		Pair: currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		StartDate:        start,
+		EndDate:          end,
+		Interval:         kline.Interval(r.Interval),
+		RequestSizeLimit: r.RequestSizeLimit,
+		DataType:         dataHistoryDataType(r.DataType),
+		MaxRetryAttempts: r.MaxRetryAttempts,
+		BatchSize:        r.BatchSize,
+	}
+	err = s.DataHistoryManager.UpsertJob(&job)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.UpsertDataHistoryJobResponse{
+		Message: "successfully upserted job: " + job.Nickname,
+		JobId:   job.ID.String(),
+	}, nil
+}
+
+// GetDataHistoryJobDetails returns a data history job, optionally with the
+// results of every processed interval
+func (s *RPCServer) GetDataHistoryJobDetails(_ context.Context, r *gctrpc.GetDataHistoryJobDetailsRequest) (*gctrpc.DataHistoryJob, error) {
+	if r == nil || r.Nickname == "" {
+		return nil, errNicknameUnset
+	}
+	job, err := s.DataHistoryManager.GetByNickname(r.Nickname, r.FullDetails)
+	if err != nil {
+		return nil, err
+	}
+	return convertDataHistoryJobToRPC(job), nil
+}
+
+// GetActiveDataHistoryJobs returns all data history jobs currently being
+// processed
+func (s *RPCServer) GetActiveDataHistoryJobs(_ context.Context, _ *gctrpc.GetActiveDataHistoryJobsRequest) (*gctrpc.DataHistoryJobs, error) {
+	jobs, err := s.DataHistoryManager.GetActiveJobs()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.DataHistoryJobs{}
+	for i := range jobs {
+		resp.Results = append(resp.Results, convertDataHistoryJobToRPC(&jobs[i]))
+	}
+	return resp, nil
+}
+
+// GetDataHistoryJobsBetween returns all data history jobs created between a
+// set of dates
+func (s *RPCServer) GetDataHistoryJobsBetween(_ context.Context, r *gctrpc.GetDataHistoryJobsBetweenRequest) (*gctrpc.DataHistoryJobs, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.DataHistoryManager.GetAllJobStatusBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.DataHistoryJobs{}
+	for i := range jobs {
+		resp.Results = append(resp.Results, convertDataHistoryJobToRPC(jobs[i]))
+	}
+	return resp, nil
+}
+
+// DeleteDataHistoryJob stops processing a data history job and flags it as
+// removed
+func (s *RPCServer) DeleteDataHistoryJob(_ context.Context, r *gctrpc.GetDataHistoryJobDetailsRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil || r.Nickname == "" {
+		return nil, errNicknameUnset
+	}
+	err := s.DataHistoryManager.DeleteJob(r.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   "deleted job: " + r.Nickname,
+	}, nil
+}
+
+func convertDataHistoryJobToRPC(job *DataHistoryJob) *gctrpc.DataHistoryJob {
+	resp := &gctrpc.DataHistoryJob{
+		Id:       job.ID.String(),
+		Nickname: job.Nickname,
+		Exchange: job.Exchange,
+		Asset:    job.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: job.Pair.Delimiter,
+			Base:      job.Pair.Base.String(),
+			Quote:     job.Pair.Quote.String(),
+		},
+		StartDate:        job.StartDate.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:          job.EndDate.Format(common.SimpleTimeFormatWithTimezone),
+		Interval:         int64(job.Interval.Duration()),
+		RequestSizeLimit: job.RequestSizeLimit,
+		DataType:         job.DataType.String(),
+		MaxRetryAttempts: job.MaxRetryAttempts,
+		BatchSize:        job.BatchSize,
+		Status:           job.Status.String(),
+		CreatedDate:      job.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	var results []DataHistoryJobResult
+	for _, v := range job.Results {
+		results = append(results, v...)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].IntervalStartDate.Equal(results[j].IntervalStartDate) {
+			return results[i].Date.Before(results[j].Date)
+		}
+		return results[i].IntervalStartDate.Before(results[j].IntervalStartDate)
+	})
+	for i := range results {
+		resp.JobResults = append(resp.JobResults, &gctrpc.DataHistoryJobResult{
+			StartDate: results[i].IntervalStartDate.Format(common.SimpleTimeFormatWithTimezone),
+			EndDate:   results[i].IntervalEndDate.Format(common.SimpleTimeFormatWithTimezone),
+			Status:    results[i].Status.String(),
+			Message:   results[i].Result,
+			RunDate:   results[i].Date.Format(common.SimpleTimeFormatWithTimezone),
+		})
+	}
+	return resp
+}
+
+// ExportData exports stored candles, trades or withdrawal history to a file
+func (s *RPCServer) ExportData(_ context.Context, r *gctrpc.ExportDataRequest) (*gctrpc.ExportDataResponse, error) {
+	loc, err := rpcTimezone(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.ParseInLocation(common.SimpleTimeFormat, r.Start, loc)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.ParseInLocation(common.SimpleTimeFormat, r.End, loc)
+	if err != nil {
+		return nil, err
+	}
+	req := &transfer.ExportRequest{
+		DataType: r.DataType,
+		Format:   r.Format,
+		Exchange: r.Exchange,
+		Interval: kline.Interval(r.Interval),
+		Start:    start,
+		End:      end,
+		Columns:  r.Columns,
+		Location: loc,
+	}
+	if r.AssetType != "" {
+		req.Asset, err = asset.New(r.AssetType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Pair != nil {
+		req.Pair = currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		}
+	}
+
+	name := r.FilePath
+	if name == "" {
+		if req.Format == "" {
+			req.Format = transfer.FormatCSV
+		}
+		name = strings.ToLower(r.DataType)
+		if r.Exchange != "" {
+			name += "_" + strings.ToLower(r.Exchange)
+		}
+		name = fmt.Sprintf("%s_%d.%s", name, time.Now().Unix(), strings.ToLower(req.Format))
+	}
+	path, err := s.transferPath(name)
+	if err != nil {
+		return nil, err
+	}
+	count, err := transfer.ExportToFile(path, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ExportDataResponse{
+		FilePath: path,
+		Records:  int64(count),
+	}, nil
+}
+
+// ImportData imports candles or trades from a file
+func (s *RPCServer) ImportData(_ context.Context, r *gctrpc.ImportDataRequest) (*gctrpc.ImportDataResponse, error) {
+	if r.FilePath == "" {
+		return nil, errInvalidArguments
+	}
+	path, err := s.transferPath(r.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := rpcTimezone(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	req := &transfer.ImportRequest{
+		DataType:   r.DataType,
+		Format:     r.Format,
+		Exchange:   r.Exchange,
+		Interval:   kline.Interval(r.Interval),
+		Location:   loc,
+		Duplicates: r.Duplicates,
+	}
+	if r.AssetType != "" {
+		req.Asset, err = asset.New(r.AssetType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Pair != nil {
+		req.Pair = currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		}
+	}
+	result, err := transfer.ImportFromFile(path, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ImportDataResponse{
+		Records:     int64(result.Records),
+		Imported:    int64(result.Imported),
+		Overwritten: int64(result.Overwritten),
+		Skipped:     int64(result.Skipped),
+	}, nil
+}
+
+// transferPath returns the location of an export or import file within the
+// data directory's exports folder. Absolute paths and paths leaving the
+// exports folder are rejected
+func (s *RPCServer) transferPath(name string) (string, error) {
+	if filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", fmt.Errorf("%w: %s", errInvalidTransferPath, name)
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(name), "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("%w: %s", errInvalidTransferPath, name)
+		}
+	}
+	return filepath.Join(s.Settings.DataDir, "exports", filepath.Clean(name)), nil
+}
+
+// rpcTimezone loads an IANA time zone, defaulting to UTC
+func rpcTimezone(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(tz)
+}
+
+// GetOpenInterestHistory returns the open interest of a derivatives contract
+// for an interval, either fetched from the exchange or loaded from the
+// database
+func (s *RPCServer) GetOpenInterestHistory(_ context.Context, r *gctrpc.GetOpenInterestHistoryRequest) (*gctrpc.GetOpenInterestHistoryResponse, error) {
+	pair, a, start, end, err := parseFuturesDataRequest(r.Exchange, r.Pair, r.AssetType, r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	interval := kline.Interval(r.Interval)
+
+	var history []futuresdata.OpenInterest
+	if r.UseDb {
+		history, err = futuresdata.LoadOpenInterest(r.Exchange, pair, a, interval, start, end)
+	} else {
+		exch := s.GetExchangeByName(r.Exchange)
+		if exch == nil {
+			return nil, errExchangeNotLoaded
+		}
+		history, err = exch.GetOpenInterestHistory(pair, a, start, end, interval)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOpenInterestHistoryResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Interval:  interval.Short(),
+	}
+	for i := range history {
+		resp.OpenInterest = append(resp.OpenInterest, &gctrpc.OpenInterest{
+			Time:              history[i].Timestamp.UTC().Format(common.SimpleTimeFormat),
+			OpenInterest:      history[i].OpenInterest,
+			OpenInterestValue: history[i].OpenInterestValue,
+		})
+	}
+	return resp, nil
+}
+
+// GetLongShortRatioHistory returns the long/short ratio of a derivatives
+// contract for an interval, either fetched from the exchange or loaded from
+// the database
+func (s *RPCServer) GetLongShortRatioHistory(_ context.Context, r *gctrpc.GetLongShortRatioHistoryRequest) (*gctrpc.GetLongShortRatioHistoryResponse, error) {
+	pair, a, start, end, err := parseFuturesDataRequest(r.Exchange, r.Pair, r.AssetType, r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	ratioType, err := futuresdata.StringToRatioType(r.RatioType)
+	if err != nil {
+		return nil, err
+	}
+	interval := kline.Interval(r.Interval)
+
+	var history []futuresdata.LongShortRatio
+	if r.UseDb {
+		history, err = futuresdata.LoadLongShortRatios(r.Exchange, pair, a, ratioType, interval, start, end)
+	} else {
+		exch := s.GetExchangeByName(r.Exchange)
+		if exch == nil {
+			return nil, errExchangeNotLoaded
+		}
+		history, err = exch.GetLongShortRatioHistory(pair, a, ratioType, start, end, interval)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetLongShortRatioHistoryResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		RatioType: ratioType.String(),
+		Interval:  interval.Short(),
+	}
+	for i := range history {
+		resp.Ratios = append(resp.Ratios, &gctrpc.LongShortRatio{
+			Time:           history[i].Timestamp.UTC().Format(common.SimpleTimeFormat),
+			LongShortRatio: history[i].LongShortRatio,
+			LongRatio:      history[i].LongRatio,
+			ShortRatio:     history[i].ShortRatio,
+		})
+	}
+	return resp, nil
+}
+
+// GetTradeProcessorStats returns trade processor throughput and backpressure
+// metrics
+func (s *RPCServer) GetTradeProcessorStats(_ context.Context, _ *gctrpc.GetTradeProcessorStatsRequest) (*gctrpc.GetTradeProcessorStatsResponse, error) {
+	stats := trade.GetStats()
+	resp := &gctrpc.GetTradeProcessorStatsResponse{
+		Received:           stats.Received,
+		Invalid:            stats.Invalid,
+		Duplicates:         stats.Duplicates,
+		Persisted:          stats.Persisted,
+		FailedSaves:        stats.FailedSaves,
+		DeadLettered:       stats.DeadLettered,
+		BackpressureEvents: stats.BackpressureEvents,
+		Buffered:           int64(stats.Buffered),
+		PendingSegments:    int64(stats.PendingSegments),
+		LastSaveDuration:   stats.LastSaveDuration.String(),
+	}
+	if !stats.LastSave.IsZero() {
+		resp.LastSave = stats.LastSave.UTC().Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp, nil
+}
+
+// parseFuturesDataRequest validates the shared fields of the futures data
+// requests. The asset type is not checked against the supported assets as
+// derivatives assets such as future are exchange specific
+func parseFuturesDataRequest(exchName string, p *gctrpc.CurrencyPair, assetType, startTime, endTime string) (currency.Pair, asset.Item, time.Time, time.Time, error) {
+	if exchName == "" {
+		return currency.Pair{}, "", time.Time{}, time.Time{}, errors.New(errExchangeNameUnset)
+	}
+	if p == nil || p.String() == "" {
+		return currency.Pair{}, "", time.Time{}, time.Time{}, errors.New(errCurrencyPairUnset)
+	}
+	if assetType == "" {
+		return currency.Pair{}, "", time.Time{}, time.Time{}, errors.New(errAssetTypeUnset)
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return currency.Pair{}, "", time.Time{}, time.Time{}, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return currency.Pair{}, "", time.Time{}, time.Time{}, err
+	}
+	if !start.Before(end) {
+		return currency.Pair{}, "", time.Time{}, time.Time{}, errors.New(errStartEndTimesUnset)
+	}
+	pair := currency.Pair{
+		Delimiter: p.Delimiter,
+		Base:      currency.NewCode(p.Base),
+		Quote:     currency.NewCode(p.Quote),
+	}
+	return pair, asset.Item(strings.ToLower(assetType)), start, end, nil
+}
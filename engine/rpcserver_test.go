@@ -3,10 +3,12 @@ package engine
 import (
 	"context"
 	"errors"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,12 +20,16 @@ import (
 	"github.com/idoall/gocryptotrader/database/drivers"
 	"github.com/idoall/gocryptotrader/database/repository"
 	"github.com/idoall/gocryptotrader/database/repository/exchange"
+	"github.com/idoall/gocryptotrader/database/repository/scriptstate"
 	sqltrade "github.com/idoall/gocryptotrader/database/repository/trade"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/trade"
 	"github.com/idoall/gocryptotrader/gctrpc"
+	gctscript "github.com/idoall/gocryptotrader/gctscript/vm"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/sandbox"
 	"github.com/thrasher-corp/goose"
 )
 
@@ -785,3 +791,343 @@ func TestGetHistoricTrades(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetFuturesDataHistory(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.GetOpenInterestHistory(context.Background(), &gctrpc.GetOpenInterestHistoryRequest{})
+	if err == nil || err.Error() != errExchangeNameUnset {
+		t.Errorf("received %v, expected %v", err, errExchangeNameUnset)
+	}
+
+	pair := &gctrpc.CurrencyPair{
+		Delimiter: currency.DashDelimiter,
+		Base:      currency.BTC.String(),
+		Quote:     currency.USD.String(),
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat)
+	end := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat)
+	_, err = s.GetOpenInterestHistory(context.Background(), &gctrpc.GetOpenInterestHistoryRequest{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot.String(),
+		Start:     end,
+		End:       start,
+		Interval:  int64(kline.OneHour),
+	})
+	if err == nil || err.Error() != errStartEndTimesUnset {
+		t.Errorf("received %v, expected %v", err, errStartEndTimesUnset)
+	}
+
+	_, err = s.GetOpenInterestHistory(context.Background(), &gctrpc.GetOpenInterestHistoryRequest{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot.String(),
+		Start:     start,
+		End:       end,
+		Interval:  int64(kline.OneHour),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+
+	_, err = s.GetLongShortRatioHistory(context.Background(), &gctrpc.GetLongShortRatioHistoryRequest{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot.String(),
+		RatioType: "whales",
+		Start:     start,
+		End:       end,
+		Interval:  int64(kline.OneHour),
+	})
+	if !errors.Is(err, futuresdata.ErrInvalidRatioType) {
+		t.Errorf("received %v, expected %v", err, futuresdata.ErrInvalidRatioType)
+	}
+
+	_, err = s.GetLongShortRatioHistory(context.Background(), &gctrpc.GetLongShortRatioHistoryRequest{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot.String(),
+		Start:     start,
+		End:       end,
+		Interval:  int64(kline.OneHour),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestGCTScriptState(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.GCTScriptGetState(context.Background(), &gctrpc.GCTScriptStateRequest{})
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+
+	err = scriptstate.Set("state.gct", "position", `{"amount":1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = scriptstate.Set("state.gct", "last_signal", `"buy"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.GCTScriptGetState(context.Background(), &gctrpc.GCTScriptStateRequest{Script: "state"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Script != "state.gct" || len(resp.Values) != 2 {
+		t.Fatalf("received %v, expected two values for state.gct", resp)
+	}
+
+	_, err = s.GCTScriptClearState(context.Background(), &gctrpc.GCTScriptClearStateRequest{Script: "state.gct", Key: "position"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.GCTScriptClearState(context.Background(), &gctrpc.GCTScriptClearStateRequest{Script: "state.gct", Key: "position"})
+	if !errors.Is(err, scriptstate.ErrNoState) {
+		t.Errorf("received %v, expected %v", err, scriptstate.ErrNoState)
+	}
+	_, err = s.GCTScriptClearState(context.Background(), &gctrpc.GCTScriptClearStateRequest{Script: "state.gct"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = s.GCTScriptGetState(context.Background(), &gctrpc.GCTScriptStateRequest{Script: "state.gct"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Values) != 0 {
+		t.Errorf("received %v, expected state to be cleared", resp.Values)
+	}
+}
+
+func TestGCTScriptBacktest(t *testing.T) {
+	manager, err := gctscript.NewManager(&gctscript.Config{Enabled: true, MaxVirtualMachines: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := RPCServer{Engine: &Engine{GctScriptManager: manager}}
+	_, err = s.GCTScriptBacktest(context.Background(), &gctrpc.GCTScriptBacktestRequest{})
+	if !errors.Is(err, gctscript.ErrScriptingDisabled) {
+		t.Errorf("received %v, expected %v", err, gctscript.ErrScriptingDisabled)
+	}
+
+	var wg sync.WaitGroup
+	err = manager.Start(&wg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = manager.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	_, err = s.GCTScriptBacktest(context.Background(), &gctrpc.GCTScriptBacktestRequest{})
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat)
+	_, err = s.GCTScriptBacktest(context.Background(), &gctrpc.GCTScriptBacktestRequest{
+		Script:    &gctrpc.GCTScript{Name: "backtest.gct"},
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: currency.DashDelimiter, Base: currency.BTC.String(), Quote: currency.USD.String()},
+		AssetType: asset.Spot.String(),
+		Interval:  int64(kline.OneHour),
+		Start:     start,
+		End:       start,
+	})
+	if err == nil || err.Error() != errStartEndTimesUnset {
+		t.Errorf("received %v, expected %v", err, errStartEndTimesUnset)
+	}
+}
+
+func TestGCTScriptUploadLimits(t *testing.T) {
+	manager, err := gctscript.NewManager(&gctscript.Config{Enabled: true, MaxVirtualMachines: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	err = manager.Start(&wg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = manager.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	scriptPath := gctscript.ScriptPath
+	gctscript.ScriptPath, err = ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(gctscript.ScriptPath); err != nil {
+			t.Error(err)
+		}
+		gctscript.ScriptPath = scriptPath
+	}()
+
+	s := RPCServer{Engine: &Engine{GctScriptManager: manager}}
+	script := []byte(`exch := import("exchange")
+t := exch.ticker("Bitstamp", "BTC-USD", "-", "SPOT")`)
+	_, err = s.GCTScriptUpload(context.Background(), &gctrpc.GCTScriptUploadRequest{
+		ScriptName: "limits.gct",
+		Data:       script,
+		Limits:     &gctrpc.GCTScriptLimits{MaxNotional: -1},
+	})
+	if err == nil {
+		t.Fatal("expected negative limits to return an error")
+	}
+
+	resp, err := s.GCTScriptUpload(context.Background(), &gctrpc.GCTScriptUploadRequest{
+		ScriptName: "limits.gct",
+		Data:       script,
+		Limits:     &gctrpc.GCTScriptLimits{MaxRuntime: 10, Exchanges: []string{"Bitstamp"}, Pairs: []string{"BTC-USD"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != MsgStatusOK {
+		t.Fatalf("received %v, expected script to be uploaded", resp)
+	}
+	limits, err := sandbox.LoadLimits(filepath.Join(gctscript.ScriptPath, "limits.gct"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limits == nil || limits.MaxRuntime != 10*time.Second {
+		t.Errorf("received %+v, expected saved limits", limits)
+	}
+
+	resp, err = s.GCTScriptUpload(context.Background(), &gctrpc.GCTScriptUploadRequest{
+		ScriptName: "limits.gct",
+		Data:       script,
+		Overwrite:  true,
+		Limits:     &gctrpc.GCTScriptLimits{Exchanges: []string{"Binance"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != gctscript.ErrScriptFailedValidation {
+		t.Errorf("received %v, expected a disallowed exchange to fail validation", resp)
+	}
+	limits, err = sandbox.LoadLimits(filepath.Join(gctscript.ScriptPath, "limits.gct"))
+	if err != nil || limits != nil {
+		t.Errorf("received %v %v, expected limits to be removed", limits, err)
+	}
+}
+
+func TestTransferPath(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Settings: Settings{DataDir: "datadir"}}}
+	for _, name := range []string{
+		"/etc/passwd",
+		"../candles.csv",
+		"nested/../../candles.csv",
+		"..",
+	} {
+		_, err := s.transferPath(name)
+		if !errors.Is(err, errInvalidTransferPath) {
+			t.Errorf("%s received %v, expected %v", name, err, errInvalidTransferPath)
+		}
+	}
+	path, err := s.transferPath("nested/./candles.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := filepath.Join("datadir", "exports", "nested", "candles.csv"); path != expected {
+		t.Errorf("received %v, expected %v", path, expected)
+	}
+}
+
+func TestExportImportData(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	var err error
+	engerino.Settings.DataDir, err = ioutil.TempDir("", "datadir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(engerino.Settings.DataDir)
+	s := RPCServer{Engine: engerino}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = kline.StoreInDatabase(&kline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+		Candles: []kline.Candle{
+			{Time: start, Open: 1, High: 2, Low: 1, Close: 2, Volume: 10},
+			{Time: start.Add(time.Hour), Open: 2, High: 3, Low: 1, Close: 1, Volume: 5},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair := &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+
+	_, err = s.ExportData(context.Background(), &gctrpc.ExportDataRequest{
+		DataType: "candles",
+		Start:    start.Format(common.SimpleTimeFormat),
+		End:      start.Add(time.Hour * 2).Format(common.SimpleTimeFormat),
+		FilePath: "../candles.csv",
+	})
+	if !errors.Is(err, errInvalidTransferPath) {
+		t.Errorf("received %v, expected %v", err, errInvalidTransferPath)
+	}
+	exported, err := s.ExportData(context.Background(), &gctrpc.ExportDataRequest{
+		DataType:  "candles",
+		Exchange:  testExchange,
+		AssetType: asset.Spot.String(),
+		Pair:      pair,
+		Interval:  int64(kline.OneHour),
+		Start:     start.Format(common.SimpleTimeFormat),
+		End:       start.Add(time.Hour * 2).Format(common.SimpleTimeFormat),
+		FilePath:  "candles.csv",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exported.Records != 2 || exported.FilePath != filepath.Join(engerino.Settings.DataDir, "exports", "candles.csv") {
+		t.Errorf("unexpected export %+v", exported)
+	}
+
+	_, err = s.ImportData(context.Background(), &gctrpc.ImportDataRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received %v, expected %v", err, errInvalidArguments)
+	}
+	_, err = s.ImportData(context.Background(), &gctrpc.ImportDataRequest{
+		DataType: "candles",
+		FilePath: exported.FilePath,
+	})
+	if !errors.Is(err, errInvalidTransferPath) {
+		t.Errorf("received %v, expected %v", err, errInvalidTransferPath)
+	}
+	imported, err := s.ImportData(context.Background(), &gctrpc.ImportDataRequest{
+		DataType: "candles",
+		FilePath: "candles.csv",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.Records != 2 || imported.Imported != 0 || imported.Skipped != 2 {
+		t.Errorf("expected stored candles to be skipped, received %+v", imported)
+	}
+}
+
+func TestGetTradeProcessorStats(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	resp, err := s.GetTradeProcessorStats(context.Background(), &gctrpc.GetTradeProcessorStatsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Received < 0 || resp.Buffered < 0 || resp.LastSaveDuration == "" {
+		t.Errorf("unexpected stats %+v", resp)
+	}
+}
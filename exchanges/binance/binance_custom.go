@@ -12,6 +12,7 @@ import (
 	"github.com/idoall/gocryptotrader/common/convert"
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 )
@@ -485,6 +486,10 @@ func (b *Binance) NewOrderContract(assetType asset.Item, o *NewOrderContractRequ
 		params.Set("newClientOrderID", o.NewClientOrderID)
 	}
 
+	if o.ReduceOnly != "" {
+		params.Set("reduceOnly", o.ReduceOnly)
+	}
+
 	if o.StopPrice != 0 {
 		params.Set("stopPrice", strconv.FormatFloat(o.StopPrice, 'f', -1, 64))
 	}
@@ -724,6 +729,55 @@ func (b *Binance) GetPremiumIndex(assetType asset.Item, symbol currency.Pair) (*
 	return p, nil
 }
 
+// GetContractTicker 24hr价格变动情况
+func (b *Binance) GetContractTicker(assetType asset.Item, symbol currency.Pair) (*PriceChangeStats, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol.String())
+
+	switch assetType {
+	case asset.Future: // U本位合约
+		var resp PriceChangeStats
+		path := fmt.Sprintf("%s/%s/v%s/%s?%s", futureApiURL, binanceFutureRESTBasePath, binanceAPIVersion, binanceContractTicker, params.Encode())
+		if err := b.SendHTTPRequest(path, limitDefault, &resp); err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	case asset.PerpetualContract: // 币本位合约, 返回数组
+		var resp []PriceChangeStats
+		path := fmt.Sprintf("%s/%s/v%s/%s?%s", perpetualApiURL, binancePerpetualRESTBasePath, binanceAPIVersion, binanceContractTicker, params.Encode())
+		if err := b.SendHTTPRequest(path, limitDefault, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp) == 0 {
+			return nil, fmt.Errorf("no ticker data returned for %s", symbol)
+		}
+		return &resp[0], nil
+	default:
+		return nil, fmt.Errorf("Error assetType")
+	}
+}
+
+// GetOpenInterest 获取未平仓合约数
+func (b *Binance) GetOpenInterest(assetType asset.Item, symbol currency.Pair) (*OpenInterestResponse, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol.String())
+
+	var path string
+	if assetType == asset.Future { // U本位合约
+		path = fmt.Sprintf("%s/%s/v%s/%s?%s", futureApiURL, binanceFutureRESTBasePath, binanceAPIVersion, binanceContractOpenInterest, params.Encode())
+	} else if assetType == asset.PerpetualContract { // 币本位合约
+		path = fmt.Sprintf("%s/%s/v%s/%s?%s", perpetualApiURL, binancePerpetualRESTBasePath, binanceAPIVersion, binanceContractOpenInterest, params.Encode())
+	} else {
+		return nil, fmt.Errorf("Error assetType")
+	}
+
+	var resp OpenInterestResponse
+	if err := b.SendHTTPRequest(path, limitDefault, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetFundingRate 查询资金费率历史
 func (b *Binance) GetFundingRate(assetType asset.Item, req FundingRateRequest) ([]FundingRateResponeItem, error) {
 
@@ -775,6 +829,110 @@ func (b *Binance) GetFundingRate(assetType asset.Item, req FundingRateRequest) (
 	return result, nil
 }
 
+// GetOpenInterestHist 合约持仓量历史，仅支持最近30天的数据
+func (b *Binance) GetOpenInterestHist(assetType asset.Item, req FuturesDataRequest) ([]OpenInterestHistResponse, error) {
+	params, err := futuresDataParams(assetType, req)
+	if err != nil {
+		return nil, err
+	}
+	if assetType == asset.PerpetualContract {
+		params.Set("contractType", "PERPETUAL")
+	}
+
+	path, err := futuresDataPath(assetType, binanceOpenInterestHist, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []OpenInterestHistResponse
+	if err := b.SendHTTPRequest(path, limitDefault, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetLongShortRatio 多空比历史，仅支持最近30天的数据
+// futuresdata.GlobalAccountRatio 多空持仓人数比
+// futuresdata.TopAccountRatio 大户账户数多空比
+// futuresdata.TopPositionRatio 大户持仓量多空比
+func (b *Binance) GetLongShortRatio(assetType asset.Item, ratioType futuresdata.RatioType, req FuturesDataRequest) ([]LongShortRatioResponse, error) {
+	var endpoint string
+	switch ratioType {
+	case futuresdata.GlobalAccountRatio:
+		endpoint = binanceGlobalLongShortAccountRatio
+	case futuresdata.TopAccountRatio:
+		endpoint = binanceTopLongShortAccountRatio
+	case futuresdata.TopPositionRatio:
+		endpoint = binanceTopLongShortPositionRatio
+	default:
+		return nil, fmt.Errorf("%w '%s'", futuresdata.ErrInvalidRatioType, ratioType)
+	}
+
+	params, err := futuresDataParams(assetType, req)
+	if err != nil {
+		return nil, err
+	}
+	// 币本位大户账户数多空比使用 symbol 而不是 pair
+	if assetType == asset.PerpetualContract && ratioType == futuresdata.TopAccountRatio {
+		params.Del("pair")
+		params.Set("symbol", req.Symbol.String())
+	}
+
+	path, err := futuresDataPath(assetType, endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []LongShortRatioResponse
+	if err := b.SendHTTPRequest(path, limitDefault, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// futuresDataParams 合约数据统计的公共参数，币本位合约使用标的交易对 pair
+func futuresDataParams(assetType asset.Item, req FuturesDataRequest) (url.Values, error) {
+	if req.Period == "" {
+		return nil, errors.New("period must be set")
+	}
+	params := url.Values{}
+	switch assetType {
+	case asset.Future:
+		params.Set("symbol", req.Symbol.String())
+	case asset.PerpetualContract:
+		pair := req.Symbol.String()
+		if i := strings.Index(pair, "_"); i > 0 {
+			pair = pair[:i]
+		}
+		params.Set("pair", pair)
+	default:
+		return nil, fmt.Errorf("Error assetType")
+	}
+	params.Set("period", req.Period)
+	if req.Limit != 0 {
+		params.Set("limit", strconv.FormatInt(req.Limit, 10))
+	}
+	if req.StartTime != 0 {
+		params.Set("startTime", strconv.FormatInt(req.StartTime, 10))
+	}
+	if req.EndTime != 0 {
+		params.Set("endTime", strconv.FormatInt(req.EndTime, 10))
+	}
+	return params, nil
+}
+
+// futuresDataPath 合约数据统计的请求地址，该接口不带版本号
+func futuresDataPath(assetType asset.Item, endpoint string, params url.Values) (string, error) {
+	switch assetType {
+	case asset.Future: // U本位合约
+		return fmt.Sprintf("%s/%s/%s?%s", futureApiURL, binanceFuturesDataPath, endpoint, params.Encode()), nil
+	case asset.PerpetualContract: // 币本位合约
+		return fmt.Sprintf("%s/%s/%s?%s", perpetualApiURL, binanceFuturesDataPath, endpoint, params.Encode()), nil
+	default:
+		return "", fmt.Errorf("Error assetType")
+	}
+}
+
 // PositionRisk 用户持仓风险V2 (USER_DATA)
 func (b *Binance) PositionRisk(assetType asset.Item, symbol string) ([]PositionRiskResponse, error) {
 
@@ -782,10 +940,14 @@ func (b *Binance) PositionRisk(assetType asset.Item, symbol string) ([]PositionR
 	var path string
 	if assetType == asset.Future { // U本位合约
 		path = fmt.Sprintf("%s/%s/v%s/%s", futureApiURL, binanceFutureRESTBasePath, binanceAPIVersion2, binancePositionRisk)
-		params.Set("symbol", strings.ToUpper(symbol))
+		if symbol != "" {
+			params.Set("symbol", strings.ToUpper(symbol))
+		}
 	} else if assetType == asset.PerpetualContract { // 币本位合约
 		path = fmt.Sprintf("%s/%s/v%s/%s", perpetualApiURL, binancePerpetualRESTBasePath, binanceAPIVersion, binancePositionRisk)
-		params.Set("pair", strings.ToUpper(symbol))
+		if symbol != "" {
+			params.Set("pair", strings.ToUpper(symbol))
+		}
 	} else {
 		return nil, fmt.Errorf("Error assetType")
 	}
@@ -862,6 +1024,9 @@ func (b *Binance) MarginType(assetType asset.Item, symbol currency.Pair, marginT
 	}
 	var resp response
 	err = b.SendAuthHTTPRequest(http.MethodPost, path, params, limitOrder, &resp)
+	if err == nil {
+		return true, nil
+	}
 	if strings.Index(err.Error(), "{\"code\":-4046,\"msg\":\"No need to change margin type.\"}") != -1 {
 		return true, nil
 	} else if !strings.EqualFold(err.Error(), "success") {
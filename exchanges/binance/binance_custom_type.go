@@ -1,6 +1,8 @@
 package binance
 
 import (
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/idoall/gocryptotrader/currency"
@@ -43,6 +45,24 @@ const (
 	binanceContractFundingRate = "fundingRate"
 	// binanceContractPreminuIndex 最新标记价格和资金费率
 	binanceContractPreminuIndex = "premiumIndex"
+	// binanceContractTicker 24hr价格变动情况
+	binanceContractTicker = "ticker/24hr"
+	// binanceContractOpenInterest 获取未平仓合约数
+	binanceContractOpenInterest = "openInterest"
+	// binanceFuturesDataPath 合约数据统计
+	binanceFuturesDataPath = "futures/data"
+	// binanceOpenInterestHist 合约持仓量历史
+	binanceOpenInterestHist = "openInterestHist"
+	// binanceGlobalLongShortAccountRatio 多空持仓人数比
+	binanceGlobalLongShortAccountRatio = "globalLongShortAccountRatio"
+	// binanceTopLongShortAccountRatio 大户账户数多空比
+	binanceTopLongShortAccountRatio = "topLongShortAccountRatio"
+	// binanceTopLongShortPositionRatio 大户持仓量多空比
+	binanceTopLongShortPositionRatio = "topLongShortPositionRatio"
+	// binanceFuturesDataLimit 合约数据统计每次请求的最大条数
+	binanceFuturesDataLimit = 500
+	// binanceFundingRateLimit 资金费率历史每次请求的最大条数
+	binanceFundingRateLimit = 1000
 	// 用户持仓风险
 	binancePositionRisk = "positionRisk"
 	// 变换逐全仓模式 (USER_DATA)
@@ -146,6 +166,25 @@ type MarkPriceStream struct {
 	NextFundingTime      int64   `json:"T"`        // 下次资金时间
 }
 
+// ForceOrderStream 强平订单推送
+type ForceOrderStream struct {
+	EventType string `json:"e"` // 事件类型
+	EventTime int64  `json:"E"` // 事件时间
+	Order     struct {
+		Symbol               string  `json:"s"`         // 交易对
+		Side                 string  `json:"S"`         // 订单方向
+		OrderType            string  `json:"o"`         // 订单类型
+		TimeInForce          string  `json:"f"`         // 有效方式
+		OrigQty              float64 `json:"q,string"`  // 订单数量
+		Price                float64 `json:"p,string"`  // 订单价格
+		AvgPrice             float64 `json:"ap,string"` // 平均价格
+		Status               string  `json:"X"`         // 订单状态
+		LastFilledQty        float64 `json:"l,string"`  // 订单最近成交量
+		AccumulatedFilledQty float64 `json:"z,string"`  // 订单累计成交量
+		TradeTime            int64   `json:"T"`         // 交易时间
+	} `json:"o"` // 强平订单
+}
+
 // AccountUpdateStream holds the ticker stream data
 type AccountUpdateStream struct {
 	EventType          string `json:"e"` // 事件类型
@@ -286,6 +325,17 @@ type PositionRiskResponse struct {
 	PositionSide     PositionSide `json:"positionSide"`             //  持仓方向
 }
 
+var (
+	// errFuturesDataAssetNotSupported 合约数据统计只支持U本位和币本位合约
+	errFuturesDataAssetNotSupported = errors.New("asset type not supported for futures data")
+	// errFuturesDataIntervalNotSupported 合约数据统计只支持 5m,15m,30m,1h,2h,4h,6h,12h,1d
+	errFuturesDataIntervalNotSupported = errors.New("interval not supported for futures data")
+	// errFuturesAssetNotSupported 合约交易只支持U本位和币本位合约
+	errFuturesAssetNotSupported = errors.New("asset type not supported for futures trading")
+	// errLeverageNotWhole 杠杆倍数必须为整数
+	errLeverageNotWhole = errors.New("leverage must be a whole number")
+)
+
 // MarginType 保证金模式
 type MarginType string
 
@@ -531,6 +581,44 @@ type PreminuIndexResponse struct {
 	Time            time.Time `json:"time"`            // 更新时间
 }
 
+// OpenInterestResponse 未平仓合约数
+type OpenInterestResponse struct {
+	Symbol       string  `json:"symbol"`              // 交易对
+	OpenInterest float64 `json:"openInterest,string"` // 未平仓合约数量
+	Time         int64   `json:"time"`                // 撮合引擎时间
+}
+
+// FuturesDataRequest 合约数据统计请求参数
+type FuturesDataRequest struct {
+	Symbol    currency.Pair // 交易对
+	Period    string        // 时间间隔 "5m","15m","30m","1h","2h","4h","6h","12h","1d"
+	StartTime int64
+	EndTime   int64
+	Limit     int64 // 默认 30，最大 500
+}
+
+// OpenInterestHistResponse 合约持仓量历史
+type OpenInterestHistResponse struct {
+	Symbol               string      `json:"symbol"`                      // 交易对
+	Pair                 string      `json:"pair"`                        // 标的交易对(币本位)
+	ContractType         string      `json:"contractType"`                // 合约类型(币本位)
+	SumOpenInterest      float64     `json:"sumOpenInterest,string"`      // 持仓总数量
+	SumOpenInterestValue float64     `json:"sumOpenInterestValue,string"` // 持仓总价值
+	Timestamp            json.Number `json:"timestamp"`                   // 毫秒时间戳
+}
+
+// LongShortRatioResponse 多空比
+type LongShortRatioResponse struct {
+	Symbol         string      `json:"symbol"`                // 交易对
+	Pair           string      `json:"pair"`                  // 标的交易对(币本位)
+	LongShortRatio float64     `json:"longShortRatio,string"` // 多空比
+	LongAccount    float64     `json:"longAccount,string"`    // 多仓账户数比例
+	ShortAccount   float64     `json:"shortAccount,string"`   // 空仓账户数比例
+	LongPosition   float64     `json:"longPosition,string"`   // 多仓持仓量比例
+	ShortPosition  float64     `json:"shortPosition,string"`  // 空仓持仓量比例
+	Timestamp      json.Number `json:"timestamp"`             // 毫秒时间戳，部分接口以字符串返回
+}
+
 // AccountSnapshotRequest 查询每日资产快照 (USER_DATA)
 type AccountSnapshotRequest struct {
 	Type      asset.Item `json:"type"`
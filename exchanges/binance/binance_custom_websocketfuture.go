@@ -15,6 +15,8 @@ import (
 	"github.com/idoall/gocryptotrader/common"
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
+	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/request"
 	"github.com/idoall/gocryptotrader/exchanges/stream"
 	"github.com/idoall/gocryptotrader/log"
@@ -282,6 +284,13 @@ func (b *Binance) wsHandleDataFuture(respRaw []byte) error {
 				AssetType:            asset.Future,
 				Exchange:             b.Name,
 			}
+		case "forceOrder":
+			liquidations, err := b.processForceOrder(respRaw, asset.Future, pairs, format)
+			if err != nil {
+				return err
+			}
+			b.WebsocketFuture.DataHandler <- liquidations
+			return b.AddLiquidationsToBuffer(liquidations...)
 		case "kline":
 			var kline KlineStream
 			err := json.Unmarshal(respRaw, &kline)
@@ -317,6 +326,43 @@ func (b *Binance) wsHandleDataFuture(respRaw []byte) error {
 	return nil
 }
 
+// processForceOrder 处理强平订单推送，并发布到强平数据流
+func (b *Binance) processForceOrder(respRaw []byte, assetType asset.Item, pairs currency.Pairs, format currency.PairFormat) ([]liquidation.Data, error) {
+	var forceOrder ForceOrderStream
+	err := json.Unmarshal(respRaw, &forceOrder)
+	if err != nil {
+		return nil, fmt.Errorf("%v - Could not convert to a ForceOrderStream structure %s",
+			b.Name,
+			err)
+	}
+	pair, err := currency.NewPairFromFormattedPairs(forceOrder.Order.Symbol, pairs, format)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(forceOrder.Order.Side)
+	if err != nil {
+		return nil, err
+	}
+	price := forceOrder.Order.AvgPrice
+	if price == 0 {
+		price = forceOrder.Order.Price
+	}
+	amount := forceOrder.Order.AccumulatedFilledQty
+	if amount == 0 {
+		amount = forceOrder.Order.OrigQty
+	}
+	liquidations := []liquidation.Data{{
+		Exchange:  b.Name,
+		Pair:      pair,
+		AssetType: assetType,
+		Side:      side,
+		Price:     price,
+		Amount:    amount,
+		Timestamp: time.Unix(0, forceOrder.Order.TradeTime*int64(time.Millisecond)),
+	}}
+	return liquidations, liquidation.Process(liquidations...)
+}
+
 // GenerateSubscriptionsFuture generates the default subscription set
 func (b *Binance) GenerateSubscriptionsFuture() ([]stream.ChannelSubscription, error) {
 	var channels = []string{"@markPrice", "@kline_1m", "@forceOrder"}
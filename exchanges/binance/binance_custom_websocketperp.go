@@ -282,6 +282,13 @@ func (b *Binance) wsHandleDataPerp(respRaw []byte) error {
 				AssetType:            asset.PerpetualContract,
 				Exchange:             b.Name,
 			}
+		case "forceOrder":
+			liquidations, err := b.processForceOrder(respRaw, asset.PerpetualContract, pairs, format)
+			if err != nil {
+				return err
+			}
+			b.WebsocketPerp.DataHandler <- liquidations
+			return b.AddLiquidationsToBuffer(liquidations...)
 		case "kline":
 			var kline KlineStream
 			err := json.Unmarshal(respRaw, &kline)
@@ -1,6 +1,11 @@
 package binance
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -9,8 +14,10 @@ import (
 	"github.com/idoall/gocryptotrader/currency"
 	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/request"
 	"github.com/idoall/gocryptotrader/portfolio/withdraw"
 )
 
@@ -38,12 +45,36 @@ func setFeeBuilder() *exchange.FeeBuilder {
 
 func TestGetExchangeInfo(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeInfo()
+	_, err := b.GetExchangeInfo(asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
 }
 
+func TestGetExecutionLimits(t *testing.T) {
+	t.Parallel()
+	var info ExchangeInfo
+	err := json.Unmarshal([]byte(`{"symbols":[
+		{"symbol":"BTCUSDT","status":"TRADING","baseAsset":"BTC","quoteAsset":"USDT","isSpotTradingAllowed":true,"filters":[
+			{"filterType":"PRICE_FILTER","minPrice":"0.01","maxPrice":"1000000","tickSize":"0.01"},
+			{"filterType":"LOT_SIZE","minQty":"0.00001","maxQty":"9000","stepSize":"0.00001"},
+			{"filterType":"NOTIONAL","minNotional":"5"}]},
+		{"symbol":"ETHUSDT","status":"BREAK","baseAsset":"ETH","quoteAsset":"USDT","isSpotTradingAllowed":true}
+	]}`), &info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limits := getExecutionLimits(&info, asset.Spot)
+	if len(limits) != 1 {
+		t.Fatalf("received %v spot limits, expected 1", len(limits))
+	}
+	if limits[0].MinNotional != 5 || limits[0].PriceStepIncrementSize != 0.01 ||
+		limits[0].AmountStepIncrementSize != 0.00001 {
+		t.Errorf("unexpected spot limits %+v", limits[0])
+	}
+}
+
 func TestFetchTradablePairs(t *testing.T) {
 	t.Parallel()
 
@@ -1164,3 +1195,306 @@ func TestGetRecentTrades(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// hostRedirect sends every request to the test server, preserving the
+// original host header so the requested API can still be asserted
+type hostRedirect struct {
+	host string
+}
+
+func (h hostRedirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = "http"
+	r.URL.Host = h.host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// newFuturesDataTestExchange returns a Binance instance with futures pair
+// formats stored whose requests are served by the supplied handler, as the
+// futures data endpoints are not routed through the mock server. The returned
+// function shuts down the test server
+func newFuturesDataTestExchange(t *testing.T, h http.HandlerFunc) (*Binance, func()) {
+	t.Helper()
+	server := httptest.NewServer(h)
+
+	var fb Binance
+	fb.SetDefaults()
+	fb.Verbose = false
+	err := fb.StoreAssetPairFormat(asset.Future, currency.PairStore{
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+		ConfigFormat:  &currency.PairFormat{Uppercase: true, Delimiter: currency.DashDelimiter},
+	})
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	err = fb.StoreAssetPairFormat(asset.PerpetualContract, currency.PairStore{
+		RequestFormat: &currency.PairFormat{Uppercase: true, Delimiter: currency.UnderscoreDelimiter},
+		ConfigFormat:  &currency.PairFormat{Uppercase: true, Delimiter: currency.UnderscoreDelimiter},
+	})
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	fb.Requester = request.New(fb.Name, &http.Client{
+		Transport: hostRedirect{host: server.Listener.Addr().String()},
+	})
+	return &fb, server.Close
+}
+
+func checkFuturesDataRequest(t *testing.T, r *http.Request, host, path string, query url.Values) {
+	t.Helper()
+	if r.Host != host {
+		t.Errorf("received host %s, expected %s", r.Host, host)
+	}
+	if r.URL.Path != path {
+		t.Errorf("received path %s, expected %s", r.URL.Path, path)
+	}
+	for k := range query {
+		if got := r.URL.Query().Get(k); got != query.Get(k) {
+			t.Errorf("received %s=%s, expected %s", k, got, query.Get(k))
+		}
+	}
+}
+
+func TestGetOpenInterestHist(t *testing.T) {
+	t.Parallel()
+	fb, closeServer := newFuturesDataTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "fapi.binance.com":
+			checkFuturesDataRequest(t, r, r.Host, "/futures/data/openInterestHist", url.Values{
+				"symbol":    {"BTCUSDT"},
+				"period":    {"5m"},
+				"limit":     {"30"},
+				"startTime": {"1609459200000"},
+				"endTime":   {"1609462800000"},
+			})
+			_, _ = w.Write([]byte(`[{"symbol":"BTCUSDT","sumOpenInterest":"20403.63700000","sumOpenInterestValue":"150570784.07809979","timestamp":"1609459500000"}]`))
+		case "dapi.binance.com":
+			checkFuturesDataRequest(t, r, r.Host, "/futures/data/openInterestHist", url.Values{
+				"pair":         {"BTCUSD"},
+				"contractType": {"PERPETUAL"},
+				"period":       {"1h"},
+			})
+			if r.URL.Query().Get("symbol") != "" {
+				t.Error("coin margined request should not set symbol")
+			}
+			_, _ = w.Write([]byte(`[{"pair":"BTCUSD","contractType":"PERPETUAL","sumOpenInterest":"20403","sumOpenInterestValue":"176196512.23400000","timestamp":1609459200000}]`))
+		default:
+			t.Errorf("unexpected host %s", r.Host)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	resp, err := fb.GetOpenInterestHist(asset.Future, FuturesDataRequest{
+		Symbol:    currency.NewPair(currency.BTC, currency.USDT),
+		Period:    "5m",
+		Limit:     30,
+		StartTime: 1609459200000,
+		EndTime:   1609462800000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("received %d results, expected 1", len(resp))
+	}
+	if resp[0].Symbol != "BTCUSDT" ||
+		resp[0].SumOpenInterest != 20403.637 ||
+		resp[0].SumOpenInterestValue != 150570784.07809979 ||
+		resp[0].Timestamp.String() != "1609459500000" {
+		t.Errorf("unexpected result %+v", resp[0])
+	}
+
+	resp, err = fb.GetOpenInterestHist(asset.PerpetualContract, FuturesDataRequest{
+		Symbol: currency.NewPairWithDelimiter("BTCUSD", "PERP", currency.UnderscoreDelimiter),
+		Period: "1h",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || resp[0].Pair != "BTCUSD" || resp[0].ContractType != "PERPETUAL" ||
+		resp[0].SumOpenInterest != 20403 || resp[0].Timestamp.String() != "1609459200000" {
+		t.Errorf("unexpected result %+v", resp)
+	}
+
+	_, err = fb.GetOpenInterestHist(asset.Future, FuturesDataRequest{
+		Symbol: currency.NewPair(currency.BTC, currency.USDT),
+	})
+	if err == nil {
+		t.Error("expected error for unset period")
+	}
+	_, err = fb.GetOpenInterestHist(asset.Spot, FuturesDataRequest{
+		Symbol: currency.NewPair(currency.BTC, currency.USDT),
+		Period: "5m",
+	})
+	if err == nil {
+		t.Error("expected error for spot asset")
+	}
+}
+
+func TestGetLongShortRatio(t *testing.T) {
+	t.Parallel()
+	fb, closeServer := newFuturesDataTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/futures/data/globalLongShortAccountRatio":
+			checkFuturesDataRequest(t, r, "fapi.binance.com", r.URL.Path, url.Values{"symbol": {"BTCUSDT"}, "period": {"5m"}})
+			_, _ = w.Write([]byte(`[{"symbol":"BTCUSDT","longShortRatio":"0.1960","longAccount":"0.1639","shortAccount":"0.8361","timestamp":"1583139600000"}]`))
+		case "/futures/data/topLongShortAccountRatio":
+			// coin margined top account ratios are requested by symbol
+			checkFuturesDataRequest(t, r, "dapi.binance.com", r.URL.Path, url.Values{"symbol": {"BTCUSD_PERP"}, "period": {"5m"}})
+			if q.Get("pair") != "" {
+				t.Error("coin margined top account ratio should not set pair")
+			}
+			_, _ = w.Write([]byte(`[{"symbol":"BTCUSD_PERP","longShortRatio":"1.5","longAccount":"0.6","shortAccount":"0.4","timestamp":1583139600000}]`))
+		case "/futures/data/topLongShortPositionRatio":
+			checkFuturesDataRequest(t, r, "fapi.binance.com", r.URL.Path, url.Values{"symbol": {"BTCUSDT"}, "period": {"5m"}})
+			_, _ = w.Write([]byte(`[{"symbol":"BTCUSDT","longShortRatio":"1.4342","longPosition":"0.5892","shortPosition":"0.4108","timestamp":"1583139600000"}]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	usdt := FuturesDataRequest{Symbol: currency.NewPair(currency.BTC, currency.USDT), Period: "5m"}
+	resp, err := fb.GetLongShortRatio(asset.Future, futuresdata.GlobalAccountRatio, usdt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || resp[0].LongShortRatio != 0.196 ||
+		resp[0].LongAccount != 0.1639 || resp[0].ShortAccount != 0.8361 ||
+		resp[0].Timestamp.String() != "1583139600000" {
+		t.Errorf("unexpected result %+v", resp)
+	}
+
+	resp, err = fb.GetLongShortRatio(asset.PerpetualContract, futuresdata.TopAccountRatio, FuturesDataRequest{
+		Symbol: currency.NewPairWithDelimiter("BTCUSD", "PERP", currency.UnderscoreDelimiter),
+		Period: "5m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || resp[0].LongShortRatio != 1.5 || resp[0].LongAccount != 0.6 ||
+		resp[0].Timestamp.String() != "1583139600000" {
+		t.Errorf("unexpected result %+v", resp)
+	}
+
+	resp, err = fb.GetLongShortRatio(asset.Future, futuresdata.TopPositionRatio, usdt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || resp[0].LongPosition != 0.5892 || resp[0].ShortPosition != 0.4108 {
+		t.Errorf("unexpected result %+v", resp)
+	}
+
+	_, err = fb.GetLongShortRatio(asset.Future, "bad", usdt)
+	if !errors.Is(err, futuresdata.ErrInvalidRatioType) {
+		t.Errorf("received %v, expected %v", err, futuresdata.ErrInvalidRatioType)
+	}
+}
+
+func TestGetOpenInterestHistory(t *testing.T) {
+	t.Parallel()
+	fb, closeServer := newFuturesDataTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		checkFuturesDataRequest(t, r, "fapi.binance.com", "/futures/data/openInterestHist", url.Values{
+			"symbol": {"BTCUSDT"},
+			"period": {"1h"},
+			"limit":  {"500"},
+		})
+		_, _ = w.Write([]byte(`[
+			{"symbol":"BTCUSDT","sumOpenInterest":"2","sumOpenInterestValue":"20","timestamp":1609462800000},
+			{"symbol":"BTCUSDT","sumOpenInterest":"1","sumOpenInterestValue":"10","timestamp":1609459200000}
+		]`))
+	})
+	defer closeServer()
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Unix(1609459200, 0)
+	end := start.Add(time.Hour * 2)
+	resp, err := fb.GetOpenInterestHistory(p, asset.Future, start, end, kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received %d results, expected 2", len(resp))
+	}
+	if !resp[0].Timestamp.Equal(start) || resp[0].OpenInterest != 1 || resp[0].OpenInterestValue != 10 {
+		t.Errorf("unexpected first result %+v", resp[0])
+	}
+	if !resp[1].Timestamp.Equal(start.Add(time.Hour)) || resp[1].OpenInterest != 2 {
+		t.Errorf("unexpected second result %+v", resp[1])
+	}
+	if resp[0].Exchange != fb.Name || !resp[0].Pair.Equal(p) ||
+		resp[0].AssetType != asset.Future || resp[0].Interval != kline.OneHour {
+		t.Errorf("unexpected result metadata %+v", resp[0])
+	}
+
+	_, err = fb.GetOpenInterestHistory(p, asset.Spot, start, end, kline.OneHour)
+	if !errors.Is(err, errFuturesDataAssetNotSupported) {
+		t.Errorf("received %v, expected %v", err, errFuturesDataAssetNotSupported)
+	}
+	_, err = fb.GetOpenInterestHistory(p, asset.Future, start, end, kline.OneMin)
+	if !errors.Is(err, errFuturesDataIntervalNotSupported) {
+		t.Errorf("received %v, expected %v", err, errFuturesDataIntervalNotSupported)
+	}
+}
+
+func TestGetLongShortRatioHistory(t *testing.T) {
+	t.Parallel()
+	fb, closeServer := newFuturesDataTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "fapi.binance.com":
+			_, _ = w.Write([]byte(`[{"symbol":"BTCUSDT","longShortRatio":"1.5","longAccount":"0.55","shortAccount":"0.45","longPosition":"0.6","shortPosition":"0.4","timestamp":"1609459200000"}]`))
+		case "dapi.binance.com":
+			// coin margined position ratios are returned as accounts
+			_, _ = w.Write([]byte(`[{"pair":"BTCUSD","longShortRatio":"3","longAccount":"0.75","shortAccount":"0.25","timestamp":1609459200000}]`))
+		default:
+			t.Errorf("unexpected host %s", r.Host)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	start := time.Unix(1609459200, 0)
+	end := start.Add(time.Hour)
+	usdt := currency.NewPair(currency.BTC, currency.USDT)
+	testCases := []struct {
+		name      string
+		pair      currency.Pair
+		asset     asset.Item
+		ratioType futuresdata.RatioType
+		long      float64
+		short     float64
+		ratio     float64
+	}{
+		{"GlobalAccount", usdt, asset.Future, futuresdata.GlobalAccountRatio, 0.55, 0.45, 1.5},
+		{"TopPosition", usdt, asset.Future, futuresdata.TopPositionRatio, 0.6, 0.4, 1.5},
+		{"CoinMarginedTopPosition", currency.NewPairWithDelimiter("BTCUSD", "PERP", currency.UnderscoreDelimiter),
+			asset.PerpetualContract, futuresdata.TopPositionRatio, 0.75, 0.25, 3},
+	}
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := fb.GetLongShortRatioHistory(test.pair, test.asset, test.ratioType, start, end, kline.OneHour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 1 {
+				t.Fatalf("received %d results, expected 1", len(resp))
+			}
+			if resp[0].LongRatio != test.long || resp[0].ShortRatio != test.short ||
+				resp[0].LongShortRatio != test.ratio || resp[0].RatioType != test.ratioType ||
+				!resp[0].Timestamp.Equal(start) {
+				t.Errorf("unexpected result %+v", resp[0])
+			}
+		})
+	}
+
+	_, err := fb.GetLongShortRatioHistory(usdt, asset.Future, "bad", start, end, kline.OneHour)
+	if !errors.Is(err, futuresdata.ErrInvalidRatioType) {
+		t.Errorf("received %v, expected %v", err, futuresdata.ErrInvalidRatioType)
+	}
+}
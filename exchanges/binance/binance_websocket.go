@@ -272,7 +272,7 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 
 				switch streamType[1] {
 				case "trade":
-					if !b.IsSaveTradeDataEnabled() {
+					if !b.IsTradeFeedEnabled() {
 						return nil
 					}
 					var t TradeStream
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
@@ -350,7 +353,12 @@ func (b *Binance) FetchTradablePairs(a asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return b.getTradablePairs(&info, a)
+}
 
+// getTradablePairs returns the tradable pairs for an asset type from the
+// exchange information
+func (b *Binance) getTradablePairs(info *ExchangeInfo, a asset.Item) ([]string, error) {
 	format, err := b.GetPairFormat(a, false)
 	if err != nil {
 		return nil, err
@@ -373,12 +381,52 @@ func (b *Binance) FetchTradablePairs(a asset.Item) ([]string, error) {
 	return pairs, nil
 }
 
+// getExecutionLimits returns the trading rules for an asset type from the
+// exchange information symbol filters
+func getExecutionLimits(info *ExchangeInfo, a asset.Item) []order.MinMaxLevel {
+	var limits []order.MinMaxLevel
+	for x := range info.Symbols {
+		if info.Symbols[x].Status != "TRADING" ||
+			(a == asset.Spot && !info.Symbols[x].IsSpotTradingAllowed) ||
+			(a == asset.Margin && !info.Symbols[x].IsMarginTradingAllowed) {
+			continue
+		}
+		level := order.MinMaxLevel{
+			Pair: currency.NewPair(currency.NewCode(info.Symbols[x].BaseAsset),
+				currency.NewCode(info.Symbols[x].QuoteAsset)),
+			Asset: a,
+		}
+		for y := range info.Symbols[x].Filters {
+			f := &info.Symbols[x].Filters[y]
+			switch f.FilterType {
+			case "PRICE_FILTER":
+				level.MinPrice = f.MinPrice
+				level.MaxPrice = f.MaxPrice
+				level.PriceStepIncrementSize = f.TickSize
+			case "LOT_SIZE":
+				level.MinAmount = f.MinQty
+				level.MaxAmount = f.MaxQty
+				level.AmountStepIncrementSize = f.StepSize
+			case "MIN_NOTIONAL", "NOTIONAL":
+				level.MinNotional = f.MinNotional
+			}
+		}
+		limits = append(limits, level)
+	}
+	return limits
+}
+
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, trading rules for each pair are also loaded
 func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
 	assetTypes := b.GetAssetTypes()
 	for i := range assetTypes {
-		p, err := b.FetchTradablePairs(assetTypes[i])
+		info, err := b.GetExchangeInfo(assetTypes[i])
+		if err != nil {
+			return err
+		}
+
+		p, err := b.getTradablePairs(&info, assetTypes[i])
 		if err != nil {
 			return err
 		}
@@ -392,12 +440,25 @@ func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
 		if err != nil {
 			return err
 		}
+
+		limits := getExecutionLimits(&info, assetTypes[i])
+		if len(limits) == 0 {
+			continue
+		}
+		err = b.LoadLimits(limits)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
 func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	if assetType == asset.Future || assetType == asset.PerpetualContract {
+		return b.updateContractTicker(p, assetType)
+	}
+
 	tick, err := b.GetTickers()
 	if err != nil {
 		return nil, err
@@ -441,6 +502,55 @@ func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 	return ticker.GetTicker(b.Name, p, assetType)
 }
 
+// updateContractTicker updates and returns the ticker for a futures or
+// perpetual contract including its mark price, index price, funding rate and
+// open interest
+func (b *Binance) updateContractTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	fPair, err := b.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	tick, err := b.GetContractTicker(assetType, fPair)
+	if err != nil {
+		return nil, err
+	}
+
+	premium, err := b.GetPremiumIndex(assetType, fPair)
+	if err != nil {
+		return nil, err
+	}
+
+	openInterest, err := b.GetOpenInterest(assetType, fPair)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ticker.ProcessTicker(&ticker.Price{
+		Last:            tick.LastPrice,
+		High:            tick.HighPrice,
+		Low:             tick.LowPrice,
+		Bid:             tick.BidPrice,
+		Ask:             tick.AskPrice,
+		Volume:          tick.Volume,
+		QuoteVolume:     tick.QuoteVolume,
+		Open:            tick.OpenPrice,
+		Close:           tick.PrevClosePrice,
+		MarkPrice:       premium.MarkPrice,
+		IndexPrice:      premium.IndexPrice,
+		FundingRate:     premium.LastFundingRate,
+		NextFundingTime: premium.NextFundingTime,
+		OpenInterest:    openInterest.OpenInterest,
+		Pair:            p,
+		ExchangeName:    b.Name,
+		AssetType:       assetType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ticker.GetTicker(b.Name, p, assetType)
+}
+
 // FetchTicker returns the ticker for a currency pair
 func (b *Binance) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	fPair, err := b.FormatExchangeCurrency(p, assetType)
@@ -588,11 +698,9 @@ func (b *Binance) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trad
 			Timestamp:    time.Unix(0, tradeData[i].Time*int64(time.Millisecond)),
 		})
 	}
-	if b.IsSaveTradeDataEnabled() {
-		err := trade.AddTradesToBuffer(b.Name, resp...)
-		if err != nil {
-			return nil, err
-		}
+	err = b.AddTradesToBuffer(resp...)
+	if err != nil {
+		return nil, err
 	}
 
 	sort.Sort(trade.ByDate(resp))
@@ -1100,3 +1208,372 @@ func (b *Binance) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, s
 	ret.SortCandlesByTimestamp(false)
 	return ret, nil
 }
+
+// GetOpenInterestHistory returns the open interest of a futures contract
+// between a time period for a set time interval. Binance only retains the
+// last 30 days of open interest history
+func (b *Binance) GetOpenInterestHistory(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) ([]futuresdata.OpenInterest, error) {
+	req, err := b.futuresDataRequest(p, a, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []futuresdata.OpenInterest
+	dates := kline.CalcDateRanges(start, end, interval, binanceFuturesDataLimit)
+	for x := range dates {
+		req.StartTime = dates[x].Start.UTC().Unix() * 1000
+		req.EndTime = dates[x].End.UTC().Unix() * 1000
+		history, err := b.GetOpenInterestHist(a, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range history {
+			ts, err := history[i].Timestamp.Int64()
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, futuresdata.OpenInterest{
+				Exchange:          b.Name,
+				Pair:              p,
+				AssetType:         a,
+				Interval:          interval,
+				OpenInterest:      history[i].SumOpenInterest,
+				OpenInterestValue: history[i].SumOpenInterestValue,
+				Timestamp:         time.Unix(0, ts*int64(time.Millisecond)).UTC(),
+			})
+		}
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Timestamp.Before(resp[j].Timestamp)
+	})
+	return resp, nil
+}
+
+// GetLongShortRatioHistory returns the long/short ratio of a futures contract
+// between a time period for a set time interval. Binance only retains the
+// last 30 days of long/short ratio history
+func (b *Binance) GetLongShortRatioHistory(p currency.Pair, a asset.Item, ratioType futuresdata.RatioType, start, end time.Time, interval kline.Interval) ([]futuresdata.LongShortRatio, error) {
+	req, err := b.futuresDataRequest(p, a, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []futuresdata.LongShortRatio
+	dates := kline.CalcDateRanges(start, end, interval, binanceFuturesDataLimit)
+	for x := range dates {
+		req.StartTime = dates[x].Start.UTC().Unix() * 1000
+		req.EndTime = dates[x].End.UTC().Unix() * 1000
+		history, err := b.GetLongShortRatio(a, ratioType, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range history {
+			ts, err := history[i].Timestamp.Int64()
+			if err != nil {
+				return nil, err
+			}
+			longRatio, shortRatio := history[i].LongAccount, history[i].ShortAccount
+			if ratioType == futuresdata.TopPositionRatio {
+				longRatio, shortRatio = history[i].LongPosition, history[i].ShortPosition
+				// coin margined position ratios are returned as accounts
+				if longRatio == 0 && shortRatio == 0 {
+					longRatio, shortRatio = history[i].LongAccount, history[i].ShortAccount
+				}
+			}
+			resp = append(resp, futuresdata.LongShortRatio{
+				Exchange:       b.Name,
+				Pair:           p,
+				AssetType:      a,
+				Interval:       interval,
+				RatioType:      ratioType,
+				LongShortRatio: history[i].LongShortRatio,
+				LongRatio:      longRatio,
+				ShortRatio:     shortRatio,
+				Timestamp:      time.Unix(0, ts*int64(time.Millisecond)).UTC(),
+			})
+		}
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Timestamp.Before(resp[j].Timestamp)
+	})
+	return resp, nil
+}
+
+// futuresDataRequest validates and builds the shared parameters of the
+// futures data endpoints
+func (b *Binance) futuresDataRequest(p currency.Pair, a asset.Item, interval kline.Interval) (FuturesDataRequest, error) {
+	if a != asset.Future && a != asset.PerpetualContract {
+		return FuturesDataRequest{}, fmt.Errorf("%s %w", a, errFuturesDataAssetNotSupported)
+	}
+	switch interval {
+	case kline.FiveMin, kline.FifteenMin, kline.ThirtyMin, kline.OneHour,
+		kline.TwoHour, kline.FourHour, kline.SixHour, kline.TwelveHour, kline.OneDay:
+	default:
+		return FuturesDataRequest{}, fmt.Errorf("%s %w", interval, errFuturesDataIntervalNotSupported)
+	}
+	formattedPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return FuturesDataRequest{}, err
+	}
+	return FuturesDataRequest{
+		Symbol: formattedPair,
+		Period: b.FormatExchangeKlineInterval(interval),
+		Limit:  binanceFuturesDataLimit,
+	}, nil
+}
+
+func checkFuturesAsset(a asset.Item) error {
+	if a != asset.Future && a != asset.PerpetualContract {
+		return fmt.Errorf("%s %w", a, errFuturesAssetNotSupported)
+	}
+	return nil
+}
+
+// futuresPair matches a contract symbol to the asset's available pairs
+func (b *Binance) futuresPair(symbol string, a asset.Item) (currency.Pair, error) {
+	pairs, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	format, err := b.GetPairFormat(a, true)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	return currency.NewPairFromFormattedPairs(symbol, pairs, format)
+}
+
+// GetFuturesPositions returns the positions of a futures asset, a pair
+// returns its position even when none is open so its leverage and margin type
+// can be read, an empty pair returns all open positions
+func (b *Binance) GetFuturesPositions(p currency.Pair, a asset.Item) ([]futures.Position, error) {
+	err := checkFuturesAsset(a)
+	if err != nil {
+		return nil, err
+	}
+	var symbol string
+	if !p.IsEmpty() {
+		fPair, err := b.FormatExchangeCurrency(p, a)
+		if err != nil {
+			return nil, err
+		}
+		symbol = fPair.String()
+	}
+	risk, err := b.PositionRisk(a, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []futures.Position
+	for i := range risk {
+		if p.IsEmpty() && risk[i].PositionAmt == 0 {
+			continue
+		}
+		pair := p
+		if pair.IsEmpty() {
+			pair, err = b.futuresPair(risk[i].Symbol, a)
+			if err != nil {
+				return nil, err
+			}
+		}
+		marginType, err := futures.StringToMarginType(string(risk[i].MarginType))
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.Position{
+			Exchange:         b.Name,
+			Pair:             pair,
+			AssetType:        a,
+			Amount:           risk[i].PositionAmt,
+			EntryPrice:       risk[i].EntryPrice,
+			MarkPrice:        risk[i].MarkPrice,
+			LiquidationPrice: risk[i].LiquidationPrice,
+			UnrealisedPNL:    risk[i].UnRealizedProfit,
+			Leverage:         float64(risk[i].Leverage),
+			MarginType:       marginType,
+			IsolatedMargin:   risk[i].IsolatedMargin,
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the leverage of a futures contract, Binance only
+// accepts whole numbers
+func (b *Binance) SetLeverage(p currency.Pair, a asset.Item, leverage float64) error {
+	err := checkFuturesAsset(a)
+	if err != nil {
+		return err
+	}
+	if leverage <= 0 {
+		return futures.ErrInvalidLeverage
+	}
+	if leverage != float64(int(leverage)) {
+		return fmt.Errorf("%v %w", leverage, errLeverageNotWhole)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	_, err = b.Leverage(a, fPair.String(), int(leverage))
+	return err
+}
+
+// SetMarginType sets whether a futures contract uses isolated or
+// crossed margin
+func (b *Binance) SetMarginType(p currency.Pair, a asset.Item, marginType futures.MarginType) error {
+	err := checkFuturesAsset(a)
+	if err != nil {
+		return err
+	}
+	var m MarginType
+	switch marginType {
+	case futures.Isolated:
+		m = MarginType_ISOLATED
+	case futures.Crossed:
+		m = MarginType_CROSSED
+	default:
+		return fmt.Errorf("%w '%s'", futures.ErrInvalidMarginType, marginType)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	_, err = b.MarginType(a, fPair, m)
+	return err
+}
+
+// GetFundingRateHistory returns the funding rates of a futures contract
+// between a time period
+func (b *Binance) GetFundingRateHistory(p currency.Pair, a asset.Item, start, end time.Time) ([]futures.FundingRate, error) {
+	err := checkFuturesAsset(a)
+	if err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []futures.FundingRate
+	req := FundingRateRequest{
+		Symbol:    fPair,
+		StartTime: start.UTC().Unix() * 1000,
+		EndTime:   end.UTC().Unix() * 1000,
+		Limit:     binanceFundingRateLimit,
+	}
+	for {
+		rates, err := b.GetFundingRate(a, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rates {
+			resp = append(resp, futures.FundingRate{
+				Exchange:  b.Name,
+				Pair:      p,
+				AssetType: a,
+				Rate:      rates[i].FundingRate,
+				Time:      rates[i].FundingTime.UTC(),
+			})
+		}
+		if len(rates) < binanceFundingRateLimit {
+			break
+		}
+		req.StartTime = rates[len(rates)-1].FundingTime.UnixNano()/int64(time.Millisecond) + 1
+	}
+	return resp, nil
+}
+
+// GetFuturesPremiumIndex returns the current mark and index price of a
+// futures contract with its latest funding rate
+func (b *Binance) GetFuturesPremiumIndex(p currency.Pair, a asset.Item) (*futures.PremiumIndex, error) {
+	err := checkFuturesAsset(a)
+	if err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	premium, err := b.GetPremiumIndex(a, fPair)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.PremiumIndex{
+		Exchange:        b.Name,
+		Pair:            p,
+		AssetType:       a,
+		MarkPrice:       premium.MarkPrice,
+		IndexPrice:      premium.IndexPrice,
+		FundingRate:     premium.LastFundingRate,
+		InterestRate:    premium.InterestRate,
+		NextFundingTime: premium.NextFundingTime.UTC(),
+		Time:            premium.Time.UTC(),
+	}, nil
+}
+
+// SubmitFuturesOrder submits a futures order in one-way position mode, closing
+// a position submits a reduce only market order for the open position
+func (b *Binance) SubmitFuturesOrder(s *futures.Submit) (order.SubmitResponse, error) {
+	err := s.Validate()
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	err = checkFuturesAsset(s.AssetType)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	if s.ClosePosition {
+		positions, err := b.GetFuturesPositions(s.Pair, s.AssetType)
+		if err != nil {
+			return order.SubmitResponse{}, err
+		}
+		var position futures.Position
+		for i := range positions {
+			if positions[i].Amount != 0 {
+				position = positions[i]
+				break
+			}
+		}
+		position.Exchange, position.Pair, position.AssetType = b.Name, s.Pair, s.AssetType
+		closing, err := position.CloseOrder()
+		if err != nil {
+			return order.SubmitResponse{}, err
+		}
+		closing.ClientID = s.ClientID
+		s = closing
+	}
+
+	fPair, err := b.FormatExchangeCurrency(s.Pair, s.AssetType)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	req := &NewOrderContractRequest{
+		Symbol:           fPair.String(),
+		Side:             order.Buy,
+		PositionSide:     PositionSideBOTH,
+		Type:             BinanceRequestParamsOrderMarket,
+		Quantity:         s.Amount,
+		NewClientOrderID: s.ClientID,
+	}
+	if s.Side == order.Sell || s.Side == order.Ask {
+		req.Side = order.Sell
+	}
+	if s.Type == order.Limit {
+		req.Type = BinanceRequestParamsOrderLimit
+		req.Price = s.Price
+		req.TimeInForce = BinanceRequestParamsTimeGTC
+	}
+	if s.ReduceOnly {
+		req.ReduceOnly = "true"
+	}
+	resp, err := b.NewOrderContract(s.AssetType, req)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		FullyMatched:  resp.ExecutedQty == resp.OrigQty,
+		OrderID:       strconv.FormatInt(resp.OrderID, 10),
+	}, nil
+}
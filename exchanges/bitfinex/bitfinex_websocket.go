@@ -299,7 +299,7 @@ func (b *Bitfinex) wsHandleData(respRaw []byte) error {
 			}
 			return nil
 		case wsTrades:
-			if !b.IsSaveTradeDataEnabled() {
+			if !b.IsTradeFeedEnabled() {
 				return nil
 			}
 			if chanAsset == asset.MarginFunding {
@@ -753,6 +753,27 @@ func TestWsInsertExectuionUpdate(t *testing.T) {
 	}
 }
 
+func TestWsLiquidation(t *testing.T) {
+	pressXToJSON := []byte(`{"table":"liquidation",
+   "action":"insert",
+   "data":[{
+    "orderID":"00000000-0000-0000-0000-000000000000","symbol":"XBTUSD",
+    "side":"Sell","price":10000.5,"leavesQty":1500
+   }]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+
+	pressXToJSON = []byte(`{"table":"liquidation",
+   "action":"delete",
+   "data":[{"orderID":"00000000-0000-0000-0000-000000000000","symbol":"XBTUSD"}]}`)
+	err = b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestWSConnectionHandling(t *testing.T) {
 	pressXToJSON := []byte(`{"info":"Welcome to the BitMEX Realtime API.","version":"1.1.0",
      "timestamp":"2015-01-18T10:14:06.802Z","docs":"https://www.bitmex.com/app/wsAPI","heartbeatEnabled":false}`)
@@ -14,6 +14,7 @@ import (
 	"github.com/idoall/gocryptotrader/currency"
 	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/stream"
@@ -216,7 +217,7 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 			}
 
 		case bitmexWSTrade:
-			if !b.IsSaveTradeDataEnabled() {
+			if !b.IsTradeFeedEnabled() {
 				return nil
 			}
 			var tradeHolder TradeData
@@ -263,6 +264,57 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 				})
 			}
 			return b.AddTradesToBuffer(trades...)
+		case bitmexWSLiquidation:
+			var liquidationHolder LiquidationData
+			err = json.Unmarshal(respRaw, &liquidationHolder)
+			if err != nil {
+				return err
+			}
+			// Only newly inserted liquidation orders are published, updates
+			// and deletions reflect the order being worked in the book
+			if liquidationHolder.Action != bitmexActionInsertData {
+				return nil
+			}
+			var liquidations []liquidation.Data
+			for i := range liquidationHolder.Data {
+				var p currency.Pair
+				p, err = currency.NewPairFromString(liquidationHolder.Data[i].Symbol)
+				if err != nil {
+					return err
+				}
+				var a asset.Item
+				a, err = b.GetPairAssetType(p)
+				if err != nil {
+					return err
+				}
+				var oSide order.Side
+				oSide, err = order.StringToOrderSide(liquidationHolder.Data[i].Side)
+				if err != nil {
+					b.Websocket.DataHandler <- order.ClassificationError{
+						Exchange: b.Name,
+						OrderID:  liquidationHolder.Data[i].OrderID,
+						Err:      err,
+					}
+				}
+				liquidations = append(liquidations, liquidation.Data{
+					Exchange:  b.Name,
+					Pair:      p,
+					AssetType: a,
+					Side:      oSide,
+					Price:     liquidationHolder.Data[i].Price,
+					Amount:    float64(liquidationHolder.Data[i].LeavesQty),
+					Timestamp: time.Now(),
+				})
+			}
+			if len(liquidations) == 0 {
+				return nil
+			}
+			err = liquidation.Process(liquidations...)
+			if err != nil {
+				return err
+			}
+			b.Websocket.DataHandler <- liquidations
+			return b.AddLiquidationsToBuffer(liquidations...)
 		case bitmexWSAnnouncement:
 			var announcement AnnouncementData
 			err = json.Unmarshal(respRaw, &announcement)
@@ -576,7 +628,7 @@ func (b *Bitmex) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription, e
 		return nil, fmt.Errorf("%s generate default subscriptions: pair and asset type len mismatch", b.Name)
 	}
 
-	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade}
+	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade, bitmexWSLiquidation}
 	subscriptions := []stream.ChannelSubscription{
 		{
 			Channel: bitmexWSAnnouncement,
@@ -62,6 +62,12 @@ type OrderBookData struct {
 	Action string        `json:"action"`
 }
 
+// LiquidationData contains liquidation resp data with action to be taken
+type LiquidationData struct {
+	Data   []Liquidation `json:"data"`
+	Action string        `json:"action"`
+}
+
 // TradeData contains trade resp data with action to be taken
 type TradeData struct {
 	Data   []Trade `json:"data"`
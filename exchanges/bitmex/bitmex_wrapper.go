@@ -284,17 +284,22 @@ func (b *Bitmex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 		}
 
 		err = ticker.ProcessTicker(&ticker.Price{
-			Last:         tick[j].LastPrice,
-			High:         tick[j].HighPrice,
-			Low:          tick[j].LowPrice,
-			Bid:          tick[j].BidPrice,
-			Ask:          tick[j].AskPrice,
-			Volume:       tick[j].Volume24h,
-			Close:        tick[j].PrevClosePrice,
-			Pair:         tick[j].Symbol,
-			LastUpdated:  tick[j].Timestamp,
-			ExchangeName: b.Name,
-			AssetType:    assetType})
+			Last:            tick[j].LastPrice,
+			High:            tick[j].HighPrice,
+			Low:             tick[j].LowPrice,
+			Bid:             tick[j].BidPrice,
+			Ask:             tick[j].AskPrice,
+			Volume:          tick[j].Volume24h,
+			Close:           tick[j].PrevClosePrice,
+			MarkPrice:       tick[j].MarkPrice,
+			IndexPrice:      tick[j].IndicativeSettlePrice,
+			FundingRate:     tick[j].FundingRate,
+			NextFundingTime: tick[j].FundingTimestamp,
+			OpenInterest:    float64(tick[j].OpenInterest),
+			Pair:            tick[j].Symbol,
+			LastUpdated:     tick[j].Timestamp,
+			ExchangeName:    b.Name,
+			AssetType:       assetType})
 		if err != nil {
 			return nil, err
 		}
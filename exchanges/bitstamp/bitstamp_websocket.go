@@ -103,7 +103,7 @@ func (b *Bitstamp) wsHandleData(respRaw []byte) error {
 			return err
 		}
 	case "trade":
-		if !b.IsSaveTradeDataEnabled() {
+		if !b.IsTradeFeedEnabled() {
 			return nil
 		}
 		wsTradeTemp := websocketTradeResponse{}
@@ -126,7 +126,7 @@ func (b *Bitstamp) wsHandleData(respRaw []byte) error {
 		if err != nil {
 			return err
 		}
-		return trade.AddTradesToBuffer(b.Name, trade.Data{
+		return b.AddTradesToBuffer(trade.Data{
 			Timestamp:    time.Unix(wsTradeTemp.Data.Timestamp, 0),
 			CurrencyPair: p,
 			AssetType:    a,
@@ -143,7 +143,7 @@ func (b *BTCMarkets) wsHandleData(respRaw []byte) error {
 			return err
 		}
 	case tradeEndPoint:
-		if !b.IsSaveTradeDataEnabled() {
+		if !b.IsTradeFeedEnabled() {
 			return nil
 		}
 		var t WsTrade
@@ -162,7 +162,7 @@ func (b *BTCMarkets) wsHandleData(respRaw []byte) error {
 			side = order.Sell
 		}
 
-		return trade.AddTradesToBuffer(b.Name, trade.Data{
+		return b.AddTradesToBuffer(trade.Data{
 			Timestamp:    t.Timestamp,
 			CurrencyPair: p,
 			AssetType:    asset.Spot,
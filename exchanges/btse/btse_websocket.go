@@ -187,7 +187,7 @@ func (b *BTSE) wsHandleData(respRaw []byte) error {
 			}
 		}
 	case strings.Contains(result["topic"].(string), "tradeHistory"):
-		if !b.IsSaveTradeDataEnabled() {
+		if !b.IsTradeFeedEnabled() {
 			return nil
 		}
 		var tradeHistory wsTradeHistory
@@ -226,7 +226,7 @@ func (b *BTSE) wsHandleData(respRaw []byte) error {
 				TID:          strconv.FormatInt(tradeHistory.Data[x].ID, 10),
 			})
 		}
-		return trade.AddTradesToBuffer(b.Name, trades...)
+		return b.AddTradesToBuffer(trades...)
 	case strings.Contains(result["topic"].(string), "orderBookApi"):
 		var t wsOrderBook
 		err = json.Unmarshal(respRaw, &t)
@@ -237,10 +237,10 @@ func (c *CoinbasePro) wsHandleData(respRaw []byte) error {
 				},
 			}
 		} else {
-			if !c.IsSaveTradeDataEnabled() {
+			if !c.IsTradeFeedEnabled() {
 				return nil
 			}
-			return trade.AddTradesToBuffer(c.Name, trade.Data{
+			return c.AddTradesToBuffer(trade.Data{
 				Timestamp:    wsOrder.Time,
 				Exchange:     c.Name,
 				CurrencyPair: p,
@@ -188,7 +188,7 @@ func (c *Coinbene) wsHandleData(respRaw []byte) error {
 			}
 		}
 	case strings.Contains(result[topic].(string), "tradeList"):
-		if !c.IsSaveTradeDataEnabled() {
+		if !c.IsTradeFeedEnabled() {
 			return nil
 		}
 		var tradeList WsTradeList
@@ -229,7 +229,7 @@ func (c *Coinbene) wsHandleData(respRaw []byte) error {
 				Side:         tSide,
 			})
 		}
-		return trade.AddTradesToBuffer(c.Name, trades...)
+		return c.AddTradesToBuffer(trades...)
 	case strings.Contains(result[topic].(string), "orderBook"):
 		var orderBook WsOrderbookData
 		err = json.Unmarshal(respRaw, &orderBook)
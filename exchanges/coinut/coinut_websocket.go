@@ -289,7 +289,7 @@ func (c *COINUT) wsHandleData(respRaw []byte) error {
 			return err
 		}
 	case "inst_trade":
-		if !c.IsSaveTradeDataEnabled() {
+		if !c.IsTradeFeedEnabled() {
 			return nil
 		}
 		var tradeSnap WsTradeSnapshot
@@ -330,9 +330,9 @@ func (c *COINUT) wsHandleData(respRaw []byte) error {
 				TID:          strconv.FormatInt(tradeSnap.Trades[i].TransID, 10),
 			})
 		}
-		return trade.AddTradesToBuffer(c.Name, trades...)
+		return c.AddTradesToBuffer(trades...)
 	case "inst_trade_update":
-		if !c.IsSaveTradeDataEnabled() {
+		if !c.IsTradeFeedEnabled() {
 			return nil
 		}
 		var tradeUpdate WsTradeUpdate
@@ -361,7 +361,7 @@ func (c *COINUT) wsHandleData(respRaw []byte) error {
 			}
 		}
 
-		return trade.AddTradesToBuffer(c.Name, trade.Data{
+		return c.AddTradesToBuffer(trade.Data{
 			Timestamp:    time.Unix(0, tradeUpdate.Timestamp*1000),
 			CurrencyPair: p,
 			AssetType:    asset.Spot,
@@ -15,7 +15,11 @@ import (
 	"github.com/idoall/gocryptotrader/config"
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
+	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/protocol"
 	"github.com/idoall/gocryptotrader/exchanges/request"
 	"github.com/idoall/gocryptotrader/exchanges/stream"
@@ -159,6 +163,10 @@ func (e *Base) SetFeatureDefaults() {
 			e.SetSaveTradeDataStatus(e.Config.Features.Enabled.SaveTradeData)
 		}
 
+		if e.IsSaveLiquidationDataEnabled() != e.Config.Features.Enabled.SaveLiquidationData {
+			e.SetSaveLiquidationDataStatus(e.Config.Features.Enabled.SaveLiquidationData)
+		}
+
 		e.Features.Enabled.AutoPairUpdates = e.Config.Features.Enabled.AutoPairUpdates
 	}
 }
@@ -1106,15 +1114,23 @@ func (e *Base) ValidateKline(pair currency.Pair, a asset.Item, interval kline.In
 }
 
 // AddTradesToBuffer is a helper function that will only
-// add trades to the buffer if it is allowed
+// add trades to the buffer if it is allowed, otherwise the trades are
+// only passed to live bars and trade stream subscribers
 func (e *Base) AddTradesToBuffer(trades ...trade.Data) error {
 	if !e.IsSaveTradeDataEnabled() {
-		return nil
+		return trade.PublishTrades(e.Name, trades...)
 	}
 
 	return trade.AddTradesToBuffer(e.Name, trades...)
 }
 
+// IsTradeFeedEnabled returns whether received trades should be processed,
+// either to be saved or because live bars or trade stream subscribers are
+// consuming them
+func (e *Base) IsTradeFeedEnabled() bool {
+	return e.IsSaveTradeDataEnabled() || trade.IsTradeFeedActive(e.Name)
+}
+
 // IsSaveTradeDataEnabled checks the state of
 // SaveTradeData in a concurrent-friendly manner
 func (e *Base) IsSaveTradeDataEnabled() bool {
@@ -1135,3 +1151,127 @@ func (e *Base) SetSaveTradeDataStatus(enabled bool) {
 		log.Debugf(log.Trade, "Set %v 'SaveTradeData' to %v", e.Name, enabled)
 	}
 }
+
+// AddLiquidationsToBuffer is a helper function that will only
+// add liquidations to the buffer if it is allowed
+func (e *Base) AddLiquidationsToBuffer(liquidations ...liquidation.Data) error {
+	if !e.IsSaveLiquidationDataEnabled() {
+		return nil
+	}
+
+	return liquidation.AddLiquidationsToBuffer(e.Name, liquidations...)
+}
+
+// IsSaveLiquidationDataEnabled checks the state of
+// SaveLiquidationData in a concurrent-friendly manner
+func (e *Base) IsSaveLiquidationDataEnabled() bool {
+	e.settingsMutex.RLock()
+	isEnabled := e.Features.Enabled.SaveLiquidationData
+	e.settingsMutex.RUnlock()
+	return isEnabled
+}
+
+// SetSaveLiquidationDataStatus locks and sets the status of
+// the config and the exchange's setting for SaveLiquidationData
+func (e *Base) SetSaveLiquidationDataStatus(enabled bool) {
+	e.settingsMutex.Lock()
+	defer e.settingsMutex.Unlock()
+	e.Features.Enabled.SaveLiquidationData = enabled
+	e.Config.Features.Enabled.SaveLiquidationData = enabled
+	if e.Verbose {
+		log.Debugf(log.ExchangeSys, "Set %v 'SaveLiquidationData' to %v", e.Name, enabled)
+	}
+}
+
+// LoadLimits loads the trading rules for the exchange, these are used to
+// round and validate orders before submission
+func (e *Base) LoadLimits(levels []order.MinMaxLevel) error {
+	return e.executionLimits.LoadLimits(levels)
+}
+
+// GetOrderExecutionLimits returns the trading rules for an asset and currency
+// pair
+func (e *Base) GetOrderExecutionLimits(a asset.Item, cp currency.Pair) (order.MinMaxLevel, error) {
+	return e.executionLimits.GetOrderExecutionLimits(a, cp)
+}
+
+// CheckOrderExecutionLimits checks an order price and amount against the
+// loaded trading rules
+func (e *Base) CheckOrderExecutionLimits(a asset.Item, cp currency.Pair, price, amount float64, orderType order.Type) error {
+	return e.executionLimits.CheckOrderExecutionLimits(a, cp, price, amount, orderType)
+}
+
+// ConformOrderToLimits rounds an order submission price and amount to the
+// trading rules of the exchange and validates the result. If no rules are
+// loaded for the pair the order is left untouched.
+func (e *Base) ConformOrderToLimits(s *order.Submit) error {
+	if s == nil {
+		return order.ErrSubmissionIsNil
+	}
+	level, err := e.executionLimits.GetOrderExecutionLimits(s.AssetType, s.Pair)
+	if err != nil {
+		if errors.Is(err, order.ErrExchangeLimitNotLoaded) {
+			return nil
+		}
+		return err
+	}
+	err = s.ConformToLimits(&level)
+	if err != nil {
+		return fmt.Errorf("%s %s %s %w", e.Name, s.AssetType, s.Pair, err)
+	}
+	return nil
+}
+
+// GetOpenInterestHistory returns the open interest of a derivatives contract
+// at the end of each interval between a time period. Exchanges which offer
+// open interest history override this
+func (e *Base) GetOpenInterestHistory(_ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) ([]futuresdata.OpenInterest, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLongShortRatioHistory returns the long/short ratio of a derivatives
+// contract at the end of each interval between a time period. Exchanges which
+// offer long/short ratio history override this
+func (e *Base) GetLongShortRatioHistory(_ currency.Pair, _ asset.Item, _ futuresdata.RatioType, _, _ time.Time, _ kline.Interval) ([]futuresdata.LongShortRatio, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFuturesPositions returns the open positions of a derivatives asset, an
+// empty pair returns positions for every pair. Exchanges which offer
+// derivatives trading override this
+func (e *Base) GetFuturesPositions(_ currency.Pair, _ asset.Item) ([]futures.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage used by positions of a derivatives
+// contract. Exchanges which offer derivatives trading override this
+func (e *Base) SetLeverage(_ currency.Pair, _ asset.Item, _ float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginType sets whether positions of a derivatives contract use
+// isolated or crossed margin. Exchanges which offer derivatives trading
+// override this
+func (e *Base) SetMarginType(_ currency.Pair, _ asset.Item, _ futures.MarginType) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRateHistory returns the funding rates of a perpetual contract
+// between a time period. Exchanges which offer funding rate history override
+// this
+func (e *Base) GetFundingRateHistory(_ currency.Pair, _ asset.Item, _, _ time.Time) ([]futures.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFuturesPremiumIndex returns the current mark and index price of a
+// derivatives contract with its latest funding rate. Exchanges which offer
+// derivatives trading override this
+func (e *Base) GetFuturesPremiumIndex(_ currency.Pair, _ asset.Item) (*futures.PremiumIndex, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitFuturesOrder submits a derivatives order which may only reduce or
+// close a position. Exchanges which offer derivatives trading override this
+func (e *Base) SubmitFuturesOrder(_ *futures.Submit) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
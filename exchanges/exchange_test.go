@@ -14,10 +14,14 @@ import (
 	"github.com/idoall/gocryptotrader/config"
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/protocol"
 	"github.com/idoall/gocryptotrader/exchanges/request"
 	"github.com/idoall/gocryptotrader/exchanges/stream"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
 	"github.com/idoall/gocryptotrader/log"
 	"github.com/idoall/gocryptotrader/portfolio/banking"
 )
@@ -2107,3 +2111,185 @@ func TestAddTradesToBuffer(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestIsTradeFeedEnabled(t *testing.T) {
+	b := Base{
+		Name: "tradeFeedTest",
+		Config: &config.ExchangeConfig{
+			Features: &config.FeaturesConfig{
+				Enabled: config.FeaturesEnabledConfig{},
+			},
+		},
+	}
+	if b.IsTradeFeedEnabled() {
+		t.Error("expected trade feed to be disabled without consumers")
+	}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	cfg := trade.BarConfig{Type: trade.TickBar, Threshold: 1}
+	_, err := trade.GetLiveBars(b.Name, cp, asset.Spot, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !b.IsTradeFeedEnabled() {
+		t.Error("expected live bars to enable the trade feed")
+	}
+	err = trade.StopLiveBars(b.Name, cp, asset.Spot, cfg)
+	if err != nil {
+		t.Error(err)
+	}
+	b.SetSaveTradeDataStatus(true)
+	if !b.IsTradeFeedEnabled() {
+		t.Error("expected saving trade data to enable the trade feed")
+	}
+}
+
+func TestSetSaveLiquidationDataStatus(t *testing.T) {
+	b := Base{
+		Config: &config.ExchangeConfig{
+			Features: &config.FeaturesConfig{
+				Enabled: config.FeaturesEnabledConfig{},
+			},
+		},
+	}
+
+	if b.IsSaveLiquidationDataEnabled() {
+		t.Errorf("expected false")
+	}
+	b.SetSaveLiquidationDataStatus(true)
+	if !b.IsSaveLiquidationDataEnabled() {
+		t.Errorf("expected true")
+	}
+	if !b.Config.Features.Enabled.SaveLiquidationData {
+		t.Errorf("expected config to be updated")
+	}
+	b.SetSaveLiquidationDataStatus(false)
+	if b.IsSaveLiquidationDataEnabled() {
+		t.Errorf("expected false")
+	}
+}
+
+func TestAddLiquidationsToBuffer(t *testing.T) {
+	b := Base{
+		Config: &config.ExchangeConfig{
+			Features: &config.FeaturesConfig{
+				Enabled: config.FeaturesEnabledConfig{},
+			},
+		},
+	}
+	err := b.AddLiquidationsToBuffer()
+	if err != nil {
+		t.Error(err)
+	}
+
+	b.SetSaveLiquidationDataStatus(true)
+	err = b.AddLiquidationsToBuffer()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConformOrderToLimits(t *testing.T) {
+	var b Base
+	err := b.ConformOrderToLimits(nil)
+	if !errors.Is(err, order.ErrSubmissionIsNil) {
+		t.Errorf("expected %v received %v", order.ErrSubmissionIsNil, err)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	s := &order.Submit{
+		Pair:      cp,
+		AssetType: asset.Spot,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Price:     10000.126,
+		Amount:    1.23456,
+	}
+	err = b.ConformOrderToLimits(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Price != 10000.126 || s.Amount != 1.23456 {
+		t.Error("order should not be altered when limits are not loaded")
+	}
+
+	err = b.LoadLimits([]order.MinMaxLevel{{
+		Pair:                    cp,
+		Asset:                   asset.Spot,
+		PriceStepIncrementSize:  0.01,
+		AmountStepIncrementSize: 0.01,
+		MaxAmount:               100,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = b.GetOrderExecutionLimits(asset.Spot, cp)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = b.ConformOrderToLimits(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Price != 10000.13 || s.Amount != 1.23 {
+		t.Errorf("expected price 10000.13 amount 1.23 received %v %v",
+			s.Price,
+			s.Amount)
+	}
+
+	s.Amount = 1000
+	err = b.ConformOrderToLimits(s)
+	if !errors.Is(err, order.ErrAmountExceedsMax) {
+		t.Errorf("expected %v received %v", order.ErrAmountExceedsMax, err)
+	}
+
+	err = b.CheckOrderExecutionLimits(asset.Spot, cp, 1, 1000, order.Market)
+	if !errors.Is(err, order.ErrAmountExceedsMax) {
+		t.Errorf("expected %v received %v", order.ErrAmountExceedsMax, err)
+	}
+}
+
+func TestFuturesDataHistoryNotSupported(t *testing.T) {
+	t.Parallel()
+	var b Base
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.GetOpenInterestHistory(cp, asset.Future, time.Now().Add(-time.Hour), time.Now(), kline.FiveMin)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = b.GetLongShortRatioHistory(cp, asset.Future, futuresdata.GlobalAccountRatio, time.Now().Add(-time.Hour), time.Now(), kline.FiveMin)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestFuturesTradingNotSupported(t *testing.T) {
+	t.Parallel()
+	var b Base
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.GetFuturesPositions(cp, asset.Future)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+	err = b.SetLeverage(cp, asset.Future, 10)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+	err = b.SetMarginType(cp, asset.Future, futures.Isolated)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = b.GetFundingRateHistory(cp, asset.Future, time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = b.GetFuturesPremiumIndex(cp, asset.Future)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = b.SubmitFuturesOrder(&futures.Submit{})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("expected %v received %v", common.ErrFunctionNotSupported, err)
+	}
+}
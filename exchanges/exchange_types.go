@@ -7,6 +7,7 @@ import (
 	"github.com/idoall/gocryptotrader/config"
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/protocol"
 	"github.com/idoall/gocryptotrader/exchanges/request"
 	"github.com/idoall/gocryptotrader/exchanges/stream"
@@ -136,9 +137,10 @@ type Features struct {
 
 // FeaturesEnabled stores the exchange enabled features
 type FeaturesEnabled struct {
-	AutoPairUpdates bool
-	Kline           kline.ExchangeCapabilitiesEnabled
-	SaveTradeData   bool
+	AutoPairUpdates     bool
+	Kline               kline.ExchangeCapabilitiesEnabled
+	SaveTradeData       bool
+	SaveLiquidationData bool
 }
 
 // FeaturesSupported stores the exchanges supported features
@@ -203,6 +205,7 @@ type Base struct {
 	WebsocketOrderbookBufferLimit int64
 	Websocket                     *stream.Websocket
 	*request.Requester
-	Config        *config.ExchangeConfig
-	settingsMutex sync.RWMutex
+	Config          *config.ExchangeConfig
+	settingsMutex   sync.RWMutex
+	executionLimits order.ExecutionLimits
 }
@@ -1012,6 +1012,29 @@ func TestParsingWSTradesData(t *testing.T) {
 	}
 }
 
+func TestParsingWSLiquidationTradesData(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+		"channel": "trades",
+		"market": "BTC-PERP",
+		"type": "update",
+		"data": [
+			{
+				"id": 44200174,
+				"price": 9760.5,
+				"size": 0.25,
+				"side": "sell",
+				"liquidation": true,
+				"time": "2020-05-15T01:10:04.369194+00:00"
+			}
+		]
+	}`)
+	err := f.wsHandleData(data)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestParsingWSTickerData(t *testing.T) {
 	t.Parallel()
 	data := []byte(`{
@@ -1278,3 +1301,40 @@ func TestParsingWSOBData2(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetExecutionLimits(t *testing.T) {
+	t.Parallel()
+	markets := []MarketData{
+		{
+			Name:           "BTC/USD",
+			MarketType:     spotString,
+			PriceIncrement: 1,
+			SizeIncrement:  0.0001,
+		},
+		{
+			Name:           "BTC-PERP",
+			MarketType:     futuresString,
+			PriceIncrement: 0.5,
+			SizeIncrement:  0.001,
+		},
+	}
+	limits, err := getExecutionLimits(markets, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limits) != 1 {
+		t.Fatalf("expected 1 limit received %v", len(limits))
+	}
+	if limits[0].PriceStepIncrementSize != 1 ||
+		limits[0].AmountStepIncrementSize != 0.0001 ||
+		limits[0].MinAmount != 0.0001 {
+		t.Errorf("unexpected limit %+v", limits[0])
+	}
+	limits, err = getExecutionLimits(markets, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limits) != 1 || limits[0].PriceStepIncrementSize != 0.5 {
+		t.Errorf("unexpected limits %+v", limits)
+	}
+}
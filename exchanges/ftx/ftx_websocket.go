@@ -16,6 +16,7 @@ import (
 	"github.com/idoall/gocryptotrader/currency"
 	exchange "github.com/idoall/gocryptotrader/exchanges"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/liquidation"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/stream"
@@ -293,14 +294,18 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 				return err
 			}
 		case wsTrades:
-			if !f.IsSaveTradeDataEnabled() {
-				return nil
-			}
 			var resultData WsTradeDataStore
 			err = json.Unmarshal(respRaw, &resultData)
 			if err != nil {
 				return err
 			}
+			err = f.wsProcessLiquidations(resultData.TradeData, p, a)
+			if err != nil {
+				return err
+			}
+			if !f.IsTradeFeedEnabled() {
+				return nil
+			}
 			var trades []trade.Data
 			for z := range resultData.TradeData {
 				var oSide order.Side
@@ -322,7 +327,7 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 					TID:          strconv.FormatInt(resultData.TradeData[z].ID, 10),
 				})
 			}
-			return trade.AddTradesToBuffer(f.Name, trades...)
+			return f.AddTradesToBuffer(trades...)
 		case wsOrders:
 			var resultData WsOrderDataStore
 			err = json.Unmarshal(respRaw, &resultData)
@@ -429,6 +434,42 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 	return nil
 }
 
+// wsProcessLiquidations extracts forced liquidations from a batch of trades
+// and pushes them to the liquidation feed
+func (f *FTX) wsProcessLiquidations(data []WsTradeData, p currency.Pair, a asset.Item) error {
+	var liquidations []liquidation.Data
+	for i := range data {
+		if !data[i].Liquidation {
+			continue
+		}
+		oSide, err := order.StringToOrderSide(data[i].Side)
+		if err != nil {
+			f.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: f.Name,
+				Err:      err,
+			}
+		}
+		liquidations = append(liquidations, liquidation.Data{
+			Exchange:  f.Name,
+			Pair:      p,
+			AssetType: a,
+			Side:      oSide,
+			Price:     data[i].Price,
+			Amount:    data[i].Size,
+			Timestamp: data[i].Time,
+		})
+	}
+	if len(liquidations) == 0 {
+		return nil
+	}
+	err := liquidation.Process(liquidations...)
+	if err != nil {
+		return err
+	}
+	f.Websocket.DataHandler <- liquidations
+	return f.AddLiquidationsToBuffer(liquidations...)
+}
+
 // WsProcessUpdateOB processes an update on the orderbook
 func (f *FTX) WsProcessUpdateOB(data *WsOrderbookData, p currency.Pair, a asset.Item) error {
 	update := buffer.Update{
@@ -228,38 +228,81 @@ func (f *FTX) FetchTradablePairs(a asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return getTradablePairs(markets, a), nil
+}
+
+// getTradablePairs returns the market names for an asset type
+func getTradablePairs(markets []MarketData, a asset.Item) []string {
 	var pairs []string
+	for x := range markets {
+		if marketMatchesAsset(&markets[x], a) {
+			pairs = append(pairs, markets[x].Name)
+		}
+	}
+	return pairs
+}
+
+// marketMatchesAsset returns whether the market is of the asset type
+func marketMatchesAsset(m *MarketData, a asset.Item) bool {
 	switch a {
 	case asset.Spot:
-		for x := range markets {
-			if markets[x].MarketType == spotString {
-				pairs = append(pairs, markets[x].Name)
-			}
-		}
+		return m.MarketType == spotString
 	case asset.Futures:
-		for x := range markets {
-			if markets[x].MarketType == futuresString {
-				pairs = append(pairs, markets[x].Name)
-			}
+		return m.MarketType == futuresString
+	}
+	return false
+}
+
+// getExecutionLimits returns the trading rules for each market of an asset
+// type
+func getExecutionLimits(markets []MarketData, a asset.Item) ([]order.MinMaxLevel, error) {
+	var limits []order.MinMaxLevel
+	for x := range markets {
+		if !marketMatchesAsset(&markets[x], a) {
+			continue
 		}
+		cp, err := currency.NewPairFromString(markets[x].Name)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, order.MinMaxLevel{
+			Pair:                    cp,
+			Asset:                   a,
+			PriceStepIncrementSize:  markets[x].PriceIncrement,
+			MinAmount:               markets[x].SizeIncrement,
+			AmountStepIncrementSize: markets[x].SizeIncrement,
+		})
 	}
-	return pairs, nil
+	return limits, nil
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, trading rules for each pair are also loaded
 func (f *FTX) UpdateTradablePairs(forceUpdate bool) error {
+	markets, err := f.GetMarkets()
+	if err != nil {
+		return err
+	}
 	assets := f.GetAssetTypes()
 	for x := range assets {
-		pairs, err := f.FetchTradablePairs(assets[x])
+		var p currency.Pairs
+		p, err = currency.NewPairsFromStrings(getTradablePairs(markets, assets[x]))
 		if err != nil {
 			return err
 		}
-		p, err := currency.NewPairsFromStrings(pairs)
+		err = f.UpdatePairs(p, assets[x], false, forceUpdate)
 		if err != nil {
 			return err
 		}
-		err = f.UpdatePairs(p, assets[x], false, forceUpdate)
+		var limits []order.MinMaxLevel
+		limits, err = getExecutionLimits(markets, assets[x])
+		if err != nil {
+			return err
+		}
+		if len(limits) == 0 {
+			continue
+		}
+		err = f.LoadLimits(limits)
 		if err != nil {
 			return err
 		}
@@ -282,6 +325,19 @@ func (f *FTX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price
 	if err != nil {
 		return nil, err
 	}
+
+	futures := make(map[string]*FuturesData)
+	if assetType == asset.Futures {
+		var futuresData []FuturesData
+		futuresData, err = f.GetFutures()
+		if err != nil {
+			return nil, err
+		}
+		for x := range futuresData {
+			futures[futuresData[x].Name] = &futuresData[x]
+		}
+	}
+
 	for a := range allPairs {
 		formattedPair, err := f.FormatExchangeCurrency(allPairs[a], assetType)
 		if err != nil {
@@ -300,6 +356,20 @@ func (f *FTX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price
 			resp.Last = markets[x].Last
 			resp.Bid = markets[x].Bid
 			resp.Ask = markets[x].Ask
+			if future, ok := futures[markets[x].Name]; ok {
+				resp.MarkPrice = future.Mark
+				resp.IndexPrice = future.Index
+				if allPairs[a].Equal(p) {
+					var stats FutureStatsData
+					stats, err = f.GetFutureStats(markets[x].Name)
+					if err != nil {
+						return nil, err
+					}
+					resp.FundingRate = stats.NextFundingRate
+					resp.NextFundingTime = stats.NextFundingTime
+					resp.OpenInterest = stats.OpenInterest
+				}
+			}
 			resp.LastUpdated = time.Now()
 			resp.AssetType = assetType
 			resp.ExchangeName = f.Name
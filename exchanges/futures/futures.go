@@ -0,0 +1,78 @@
+package futures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+// String implements the stringer interface
+func (m MarginType) String() string {
+	return string(m)
+}
+
+// IsValid returns whether the margin type is supported
+func (m MarginType) IsValid() bool {
+	return m == Isolated || m == Crossed
+}
+
+// StringToMarginType converts a string to a margin type, cross is accepted
+// for crossed margin
+func StringToMarginType(s string) (MarginType, error) {
+	m := MarginType(strings.ToLower(s))
+	if m == "cross" {
+		return Crossed, nil
+	}
+	if !m.IsValid() {
+		return "", fmt.Errorf("%w '%s'", ErrInvalidMarginType, s)
+	}
+	return m, nil
+}
+
+// Validate checks the order can be submitted. Orders closing a position do
+// not set an amount as it is taken from the position
+func (s *Submit) Validate() error {
+	if s == nil {
+		return order.ErrSubmissionIsNil
+	}
+	if !s.ClosePosition {
+		return s.Submit.Validate()
+	}
+	if s.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if s.AssetType == "" {
+		return order.ErrAssetNotSet
+	}
+	if s.Amount != 0 {
+		return errCloseWithAmount
+	}
+	if s.Type != "" && s.Type != order.Market {
+		return errCloseWithLimit
+	}
+	return nil
+}
+
+// CloseOrder returns the reduce only market order which closes the position
+func (p *Position) CloseOrder() (*Submit, error) {
+	if p.Amount == 0 {
+		return nil, fmt.Errorf("%s %s %s %w", p.Exchange, p.Pair, p.AssetType, ErrNoPosition)
+	}
+	s := &Submit{
+		Submit: order.Submit{
+			Exchange:  p.Exchange,
+			Pair:      p.Pair,
+			AssetType: p.AssetType,
+			Type:      order.Market,
+			Side:      order.Sell,
+			Amount:    p.Amount,
+		},
+		ReduceOnly: true,
+	}
+	if p.Amount < 0 {
+		s.Side = order.Buy
+		s.Amount = -p.Amount
+	}
+	return s, nil
+}
@@ -0,0 +1,115 @@
+package futures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+var testPair = currency.NewPair(currency.BTC, currency.USDT)
+
+func TestStringToMarginType(t *testing.T) {
+	t.Parallel()
+	m, err := StringToMarginType("ISOLATED")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != Isolated {
+		t.Errorf("received %v, expected %v", m, Isolated)
+	}
+	m, err = StringToMarginType("cross")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != Crossed {
+		t.Errorf("received %v, expected %v", m, Crossed)
+	}
+	_, err = StringToMarginType("portfolio")
+	if !errors.Is(err, ErrInvalidMarginType) {
+		t.Errorf("received %v, expected %v", err, ErrInvalidMarginType)
+	}
+}
+
+func TestSubmitValidate(t *testing.T) {
+	t.Parallel()
+	var s *Submit
+	err := s.Validate()
+	if !errors.Is(err, order.ErrSubmissionIsNil) {
+		t.Errorf("received %v, expected %v", err, order.ErrSubmissionIsNil)
+	}
+
+	s = &Submit{
+		Submit: order.Submit{
+			Pair:      testPair,
+			AssetType: asset.Future,
+			Side:      order.Sell,
+			Type:      order.Limit,
+			Price:     100,
+		},
+		ReduceOnly: true,
+	}
+	err = s.Validate()
+	if !errors.Is(err, order.ErrAmountIsInvalid) {
+		t.Errorf("received %v, expected %v", err, order.ErrAmountIsInvalid)
+	}
+	s.Amount = 1
+	err = s.Validate()
+	if err != nil {
+		t.Error(err)
+	}
+
+	s.ClosePosition = true
+	err = s.Validate()
+	if !errors.Is(err, errCloseWithAmount) {
+		t.Errorf("received %v, expected %v", err, errCloseWithAmount)
+	}
+	s.Amount = 0
+	err = s.Validate()
+	if !errors.Is(err, errCloseWithLimit) {
+		t.Errorf("received %v, expected %v", err, errCloseWithLimit)
+	}
+	s.Type = ""
+	err = s.Validate()
+	if err != nil {
+		t.Error(err)
+	}
+	s.AssetType = ""
+	err = s.Validate()
+	if !errors.Is(err, order.ErrAssetNotSet) {
+		t.Errorf("received %v, expected %v", err, order.ErrAssetNotSet)
+	}
+}
+
+func TestCloseOrder(t *testing.T) {
+	t.Parallel()
+	p := Position{Exchange: "test", Pair: testPair, AssetType: asset.Future}
+	_, err := p.CloseOrder()
+	if !errors.Is(err, ErrNoPosition) {
+		t.Errorf("received %v, expected %v", err, ErrNoPosition)
+	}
+
+	p.Amount = 2
+	s, err := p.CloseOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Sell || s.Amount != 2 || !s.ReduceOnly || s.Type != order.Market {
+		t.Errorf("received %+v, expected a reduce only market sell of 2", s)
+	}
+
+	p.Amount = -3
+	s, err = p.CloseOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Buy || s.Amount != 3 {
+		t.Errorf("received %+v, expected a buy of 3", s)
+	}
+	err = s.Validate()
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,86 @@
+package futures
+
+import (
+	"errors"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+// Vars for the futures package
+var (
+	// ErrInvalidMarginType is returned when a margin type is not recognised
+	ErrInvalidMarginType = errors.New("invalid margin type")
+	// ErrInvalidLeverage is returned when leverage is not above zero
+	ErrInvalidLeverage = errors.New("leverage must be greater than zero")
+	// ErrNoPosition is returned when closing a position which is not open
+	ErrNoPosition = errors.New("no open position")
+
+	errCloseWithAmount = errors.New("close position orders cannot set an amount")
+	errCloseWithLimit  = errors.New("close position orders must be market orders")
+)
+
+// MarginType defines whether a position's margin is shared with the rest of
+// the account or isolated to the position
+type MarginType string
+
+// Margin types
+const (
+	// Isolated margin is only used by the position it is assigned to
+	Isolated MarginType = "isolated"
+	// Crossed margin is shared across every position in the account
+	Crossed MarginType = "crossed"
+)
+
+// Position defines an open derivatives position
+type Position struct {
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	// Amount is the size of the position, positive when long and negative
+	// when short
+	Amount           float64
+	EntryPrice       float64
+	MarkPrice        float64
+	LiquidationPrice float64
+	UnrealisedPNL    float64
+	Leverage         float64
+	MarginType       MarginType
+	// IsolatedMargin is the margin assigned to an isolated position
+	IsolatedMargin float64
+}
+
+// FundingRate defines the funding rate paid between long and short
+// positions at a funding time
+type FundingRate struct {
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Rate      float64
+	Time      time.Time
+}
+
+// PremiumIndex defines the current mark and index prices of a contract with
+// its latest funding rate
+type PremiumIndex struct {
+	Exchange        string
+	Pair            currency.Pair
+	AssetType       asset.Item
+	MarkPrice       float64
+	IndexPrice      float64
+	FundingRate     float64
+	InterestRate    float64
+	NextFundingTime time.Time
+	Time            time.Time
+}
+
+// Submit contains a derivatives order which can be restricted to reducing a
+// position. When ClosePosition is set the amount and side are taken from the
+// open position and a reduce only market order closes it
+type Submit struct {
+	order.Submit
+	ReduceOnly    bool
+	ClosePosition bool
+}
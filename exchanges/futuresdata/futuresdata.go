@@ -0,0 +1,219 @@
+package futuresdata
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database/repository/longshortratio"
+	"github.com/idoall/gocryptotrader/database/repository/openinterest"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+// String implements the stringer interface
+func (r RatioType) String() string {
+	return string(r)
+}
+
+// IsValid returns whether the ratio type is supported
+func (r RatioType) IsValid() bool {
+	switch r {
+	case GlobalAccountRatio, TopAccountRatio, TopPositionRatio:
+		return true
+	}
+	return false
+}
+
+// StringToRatioType converts a string to a ratio type, an empty string
+// returns the global account ratio
+func StringToRatioType(s string) (RatioType, error) {
+	if s == "" {
+		return GlobalAccountRatio, nil
+	}
+	r := RatioType(strings.ToLower(s))
+	if !r.IsValid() {
+		return "", fmt.Errorf("%w '%s'", ErrInvalidRatioType, s)
+	}
+	return r, nil
+}
+
+func (o *OpenInterest) validate() error {
+	return validate(o.Exchange, o.Pair, o.AssetType, o.Interval, o.Timestamp)
+}
+
+func (l *LongShortRatio) validate() error {
+	err := validate(l.Exchange, l.Pair, l.AssetType, l.Interval, l.Timestamp)
+	if err != nil {
+		return err
+	}
+	if !l.RatioType.IsValid() {
+		return fmt.Errorf("%s %s %s %w '%s'", l.Exchange, l.Pair, l.AssetType, ErrInvalidRatioType, l.RatioType)
+	}
+	return nil
+}
+
+func validate(exchangeName string, p currency.Pair, a asset.Item, interval kline.Interval, ts time.Time) error {
+	if exchangeName == "" {
+		return errExchangeNameUnset
+	}
+	if p.IsEmpty() {
+		return fmt.Errorf("%s %w", exchangeName, errPairNotSet)
+	}
+	if a == "" {
+		return fmt.Errorf("%s %s %w", exchangeName, p, errAssetTypeNotSet)
+	}
+	if interval <= 0 {
+		return fmt.Errorf("%s %s %s %w", exchangeName, p, a, errInvalidInterval)
+	}
+	if ts.IsZero() {
+		return fmt.Errorf("%s %s %s %w", exchangeName, p, a, errInvalidTimestamp)
+	}
+	return nil
+}
+
+func validateRange(exchangeName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) error {
+	err := validate(exchangeName, p, a, interval, start)
+	if err != nil {
+		return err
+	}
+	if !start.Before(end) {
+		return errInvalidDateRange
+	}
+	return nil
+}
+
+// StoreOpenInterest saves open interest to the database, replacing the values
+// of any open interest already saved for the same interval and time. It
+// returns the amount of entries saved
+func StoreOpenInterest(data ...OpenInterest) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	sqlData := make([]openinterest.Data, len(data))
+	for i := range data {
+		err := data[i].validate()
+		if err != nil {
+			return 0, err
+		}
+		sqlData[i] = openinterest.Data{
+			Exchange:          data[i].Exchange,
+			Base:              data[i].Pair.Base.String(),
+			Quote:             data[i].Pair.Quote.String(),
+			AssetType:         data[i].AssetType.String(),
+			Interval:          int64(data[i].Interval.Duration().Seconds()),
+			OpenInterest:      data[i].OpenInterest,
+			OpenInterestValue: data[i].OpenInterestValue,
+			Timestamp:         data[i].Timestamp,
+		}
+	}
+	err := openinterest.Insert(sqlData...)
+	if err != nil {
+		return 0, err
+	}
+	return len(sqlData), nil
+}
+
+// LoadOpenInterest returns saved open interest for an exchange, pair, asset
+// and interval between a set of dates
+func LoadOpenInterest(exchangeName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) ([]OpenInterest, error) {
+	err := validateRange(exchangeName, p, a, interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+	results, err := openinterest.GetInRange(exchangeName,
+		a.String(),
+		p.Base.String(),
+		p.Quote.String(),
+		int64(interval.Duration().Seconds()),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]OpenInterest, len(results))
+	for i := range results {
+		resp[i] = OpenInterest{
+			Exchange:          exchangeName,
+			Pair:              p,
+			AssetType:         a,
+			Interval:          interval,
+			OpenInterest:      results[i].OpenInterest,
+			OpenInterestValue: results[i].OpenInterestValue,
+			Timestamp:         results[i].Timestamp.UTC(),
+		}
+	}
+	return resp, nil
+}
+
+// StoreLongShortRatios saves long/short ratios to the database, replacing the
+// values of any ratios already saved for the same interval, ratio type and
+// time. It returns the amount of entries saved
+func StoreLongShortRatios(data ...LongShortRatio) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	sqlData := make([]longshortratio.Data, len(data))
+	for i := range data {
+		err := data[i].validate()
+		if err != nil {
+			return 0, err
+		}
+		sqlData[i] = longshortratio.Data{
+			Exchange:       data[i].Exchange,
+			Base:           data[i].Pair.Base.String(),
+			Quote:          data[i].Pair.Quote.String(),
+			AssetType:      data[i].AssetType.String(),
+			Interval:       int64(data[i].Interval.Duration().Seconds()),
+			RatioType:      data[i].RatioType.String(),
+			LongShortRatio: data[i].LongShortRatio,
+			LongRatio:      data[i].LongRatio,
+			ShortRatio:     data[i].ShortRatio,
+			Timestamp:      data[i].Timestamp,
+		}
+	}
+	err := longshortratio.Insert(sqlData...)
+	if err != nil {
+		return 0, err
+	}
+	return len(sqlData), nil
+}
+
+// LoadLongShortRatios returns saved long/short ratios of a ratio type for an
+// exchange, pair, asset and interval between a set of dates
+func LoadLongShortRatios(exchangeName string, p currency.Pair, a asset.Item, ratioType RatioType, interval kline.Interval, start, end time.Time) ([]LongShortRatio, error) {
+	err := validateRange(exchangeName, p, a, interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if !ratioType.IsValid() {
+		return nil, fmt.Errorf("%w '%s'", ErrInvalidRatioType, ratioType)
+	}
+	results, err := longshortratio.GetInRange(exchangeName,
+		a.String(),
+		p.Base.String(),
+		p.Quote.String(),
+		ratioType.String(),
+		int64(interval.Duration().Seconds()),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]LongShortRatio, len(results))
+	for i := range results {
+		resp[i] = LongShortRatio{
+			Exchange:       exchangeName,
+			Pair:           p,
+			AssetType:      a,
+			Interval:       interval,
+			RatioType:      ratioType,
+			LongShortRatio: results[i].LongShortRatio,
+			LongRatio:      results[i].LongRatio,
+			ShortRatio:     results[i].ShortRatio,
+			Timestamp:      results[i].Timestamp.UTC(),
+		}
+	}
+	return resp, nil
+}
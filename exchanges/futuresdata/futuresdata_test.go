@@ -0,0 +1,111 @@
+package futuresdata
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+func TestStringToRatioType(t *testing.T) {
+	t.Parallel()
+	r, err := StringToRatioType("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != GlobalAccountRatio {
+		t.Errorf("received %v, expected %v", r, GlobalAccountRatio)
+	}
+	r, err = StringToRatioType("TOP_POSITION")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != TopPositionRatio {
+		t.Errorf("received %v, expected %v", r, TopPositionRatio)
+	}
+	_, err = StringToRatioType("whales")
+	if !errors.Is(err, ErrInvalidRatioType) {
+		t.Errorf("received %v, expected %v", err, ErrInvalidRatioType)
+	}
+}
+
+func TestStoreOpenInterest(t *testing.T) {
+	t.Parallel()
+	count, err := StoreOpenInterest()
+	if err != nil || count != 0 {
+		t.Errorf("expected no error and no entries saved, received %v %v", err, count)
+	}
+
+	oi := OpenInterest{}
+	_, err = StoreOpenInterest(oi)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received %v, expected %v", err, errExchangeNameUnset)
+	}
+	oi.Exchange = "test"
+	_, err = StoreOpenInterest(oi)
+	if !errors.Is(err, errPairNotSet) {
+		t.Errorf("received %v, expected %v", err, errPairNotSet)
+	}
+	oi.Pair = currency.NewPair(currency.BTC, currency.USDT)
+	_, err = StoreOpenInterest(oi)
+	if !errors.Is(err, errAssetTypeNotSet) {
+		t.Errorf("received %v, expected %v", err, errAssetTypeNotSet)
+	}
+	oi.AssetType = asset.Future
+	_, err = StoreOpenInterest(oi)
+	if !errors.Is(err, errInvalidInterval) {
+		t.Errorf("received %v, expected %v", err, errInvalidInterval)
+	}
+	oi.Interval = kline.FiveMin
+	_, err = StoreOpenInterest(oi)
+	if !errors.Is(err, errInvalidTimestamp) {
+		t.Errorf("received %v, expected %v", err, errInvalidTimestamp)
+	}
+	oi.Timestamp = time.Now()
+	_, err = StoreOpenInterest(oi)
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+}
+
+func TestStoreLongShortRatios(t *testing.T) {
+	t.Parallel()
+	lsr := LongShortRatio{
+		Exchange:  "test",
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Future,
+		Interval:  kline.FiveMin,
+		Timestamp: time.Now(),
+	}
+	_, err := StoreLongShortRatios(lsr)
+	if !errors.Is(err, ErrInvalidRatioType) {
+		t.Errorf("received %v, expected %v", err, ErrInvalidRatioType)
+	}
+	lsr.RatioType = TopAccountRatio
+	_, err = StoreLongShortRatios(lsr)
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Now().Add(-time.Hour)
+	_, err := LoadOpenInterest("test", p, asset.Future, kline.FiveMin, start, start)
+	if !errors.Is(err, errInvalidDateRange) {
+		t.Errorf("received %v, expected %v", err, errInvalidDateRange)
+	}
+	_, err = LoadLongShortRatios("test", p, asset.Future, "bad", kline.FiveMin, start, time.Now())
+	if !errors.Is(err, ErrInvalidRatioType) {
+		t.Errorf("received %v, expected %v", err, ErrInvalidRatioType)
+	}
+	_, err = LoadLongShortRatios("test", p, asset.Future, GlobalAccountRatio, kline.FiveMin, start, time.Now())
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+}
@@ -0,0 +1,69 @@
+package futuresdata
+
+import (
+	"errors"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+// Vars for the futuresdata package
+var (
+	errExchangeNameUnset = errors.New("futures data exchange name not set")
+	errPairNotSet        = errors.New("futures data currency pair not set")
+	errAssetTypeNotSet   = errors.New("futures data asset type not set")
+	errInvalidInterval   = errors.New("futures data interval not set")
+	errInvalidTimestamp  = errors.New("futures data timestamp not set")
+	errInvalidDateRange  = errors.New("futures data start date must be before the end date")
+
+	// ErrInvalidRatioType is returned when a long/short ratio type is not
+	// recognised
+	ErrInvalidRatioType = errors.New("invalid long/short ratio type")
+)
+
+// RatioType defines which accounts or positions a long/short ratio measures
+type RatioType string
+
+// Long/short ratio types
+const (
+	// GlobalAccountRatio is the ratio of all accounts holding a net long
+	// position to all accounts holding a net short position
+	GlobalAccountRatio RatioType = "global_account"
+	// TopAccountRatio is the ratio of long to short accounts amongst the
+	// exchange's top traders by margin balance
+	TopAccountRatio RatioType = "top_account"
+	// TopPositionRatio is the ratio of long to short position size amongst
+	// the exchange's top traders by margin balance
+	TopPositionRatio RatioType = "top_position"
+)
+
+// OpenInterest defines the total open contracts for a pair at the end of an
+// interval
+type OpenInterest struct {
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Interval  kline.Interval
+	// OpenInterest is the amount of open contracts
+	OpenInterest float64
+	// OpenInterestValue is the notional value of the open contracts
+	OpenInterestValue float64
+	Timestamp         time.Time
+}
+
+// LongShortRatio defines the long/short ratio for a pair at the end of an
+// interval. LongRatio and ShortRatio are the proportions of long and short
+// accounts or positions and sum to one
+type LongShortRatio struct {
+	Exchange       string
+	Pair           currency.Pair
+	AssetType      asset.Item
+	Interval       kline.Interval
+	RatioType      RatioType
+	LongShortRatio float64
+	LongRatio      float64
+	ShortRatio     float64
+	Timestamp      time.Time
+}
@@ -437,8 +437,8 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 				g.Websocket.DataHandler <- fmt.Errorf("%s - Unhandled websocket update: %+v", g.Name, result)
 			}
 		}
-		if len(trades) > 0 && g.IsSaveTradeDataEnabled() {
-			err := trade.AddTradesToBuffer(g.Name, trades...)
+		if len(trades) > 0 && g.IsTradeFeedEnabled() {
+			err := g.AddTradesToBuffer(trades...)
 			if err != nil {
 				g.Websocket.DataHandler <- err
 			}
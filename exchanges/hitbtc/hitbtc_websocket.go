@@ -196,7 +196,7 @@ func (h *HitBTC) wsHandleData(respRaw []byte) error {
 			return err
 		}
 	case "snapshotTrades", "updateTrades":
-		if !h.IsSaveTradeDataEnabled() {
+		if !h.IsTradeFeedEnabled() {
 			return nil
 		}
 		var tradeSnapshot WsTrade
@@ -231,7 +231,7 @@ func (h *HitBTC) wsHandleData(respRaw []byte) error {
 				TID:          strconv.FormatInt(tradeSnapshot.Params.Data[i].ID, 10),
 			})
 		}
-		return trade.AddTradesToBuffer(h.Name, trades...)
+		return h.AddTradesToBuffer(trades...)
 	case "activeOrders":
 		var o wsActiveOrdersResponse
 		err := json.Unmarshal(respRaw, &o)
@@ -316,6 +316,152 @@ func (h *HUOBI) GetAccountInfoContract(assetType asset.Item, contractCode string
 // 	return result.Data, err
 // }
 
+// contractPublicPath 返回合约公共接口地址
+func contractPublicPath(assetType asset.Item, endpoint string) (string, error) {
+	switch assetType {
+	case asset.Future: // U本位合约
+		return fmt.Sprintf("https://%s/%s/v%s/%s", futureApiURL, futureRESTBasePath, huobiAPIVersion, endpoint), nil
+	case asset.PerpetualContract: // 币本位合约
+		return fmt.Sprintf("https://%s/%s/v%s/%s", perpetualApiURL, perpetualRESTBasePath, huobiAPIVersion, endpoint), nil
+	}
+	return "", fmt.Errorf("%s asset type not supported", assetType)
+}
+
+// GetContractMarketDetailMerged 获取合约聚合行情
+func (h *HUOBI) GetContractMarketDetailMerged(assetType asset.Item, contractCode string) (ContractMarketDetailMerged, error) {
+	var urlPath string
+	switch assetType {
+	case asset.Future:
+		urlPath = fmt.Sprintf("https://%s/%s", futureApiURL, huobiFutureMarketMerged)
+	case asset.PerpetualContract:
+		urlPath = fmt.Sprintf("https://%s/%s", perpetualApiURL, huobiPerpetualMarketMerged)
+	default:
+		return ContractMarketDetailMerged{}, fmt.Errorf("%s asset type not supported", assetType)
+	}
+
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	var result struct {
+		Response
+		Tick ContractMarketDetailMerged `json:"tick"`
+	}
+	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return result.Tick, errors.New(result.ErrorMessage)
+	}
+	return result.Tick, err
+}
+
+// GetContractFundingRate 获取合约当前的资金费率
+func (h *HUOBI) GetContractFundingRate(assetType asset.Item, contractCode string) (ContractFundingRate, error) {
+	urlPath, err := contractPublicPath(assetType, huobiContractFundingRate)
+	if err != nil {
+		return ContractFundingRate{}, err
+	}
+
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	var result struct {
+		Response
+		Data ContractFundingRate `json:"data"`
+	}
+	err = h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return result.Data, errors.New(result.ErrorMessage)
+	}
+	return result.Data, err
+}
+
+// GetContractOpenInterest 获取合约当前持仓量
+func (h *HUOBI) GetContractOpenInterest(assetType asset.Item, contractCode string) (ContractOpenInterest, error) {
+	urlPath, err := contractPublicPath(assetType, huobiContractOpenInterest)
+	if err != nil {
+		return ContractOpenInterest{}, err
+	}
+
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	var result struct {
+		Response
+		Data []ContractOpenInterest `json:"data"`
+	}
+	err = h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return ContractOpenInterest{}, errors.New(result.ErrorMessage)
+	}
+	if err != nil {
+		return ContractOpenInterest{}, err
+	}
+	if len(result.Data) == 0 {
+		return ContractOpenInterest{}, fmt.Errorf("no open interest returned for %s", contractCode)
+	}
+	return result.Data[0], nil
+}
+
+// GetContractIndex 获取合约指数信息
+func (h *HUOBI) GetContractIndex(assetType asset.Item, contractCode string) (ContractIndex, error) {
+	urlPath, err := contractPublicPath(assetType, huobiContractIndex)
+	if err != nil {
+		return ContractIndex{}, err
+	}
+
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	var result struct {
+		Response
+		Data []ContractIndex `json:"data"`
+	}
+	err = h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return ContractIndex{}, errors.New(result.ErrorMessage)
+	}
+	if err != nil {
+		return ContractIndex{}, err
+	}
+	if len(result.Data) == 0 {
+		return ContractIndex{}, fmt.Errorf("no index price returned for %s", contractCode)
+	}
+	return result.Data[0], nil
+}
+
+// GetContractMarkPrice 获取合约最新标记价格(取最近一根1分钟标记价格K线收盘价)
+func (h *HUOBI) GetContractMarkPrice(assetType asset.Item, contractCode string) (float64, error) {
+	var urlPath string
+	switch assetType {
+	case asset.Future:
+		urlPath = fmt.Sprintf("https://%s/%s", futureApiURL, huobiFutureMarkPriceKline)
+	case asset.PerpetualContract:
+		urlPath = fmt.Sprintf("https://%s/%s", perpetualApiURL, huobiPerpetualMarkPriceKline)
+	default:
+		return 0, fmt.Errorf("%s asset type not supported", assetType)
+	}
+
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+	vals.Set("period", "1min")
+	vals.Set("size", "1")
+
+	var result struct {
+		Response
+		Data []ContractMarkPriceKline `json:"data"`
+	}
+	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return 0, errors.New(result.ErrorMessage)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Data) == 0 {
+		return 0, fmt.Errorf("no mark price returned for %s", contractCode)
+	}
+	return result.Data[len(result.Data)-1].Close, nil
+}
+
 func (h *HUOBI) SendAuthenticatedHTTPRequestContract(method string, assetType asset.Item, endpoint string, values url.Values, data, result interface{}, isVersion2API bool) error {
 	if !h.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
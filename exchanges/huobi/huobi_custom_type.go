@@ -12,8 +12,65 @@ const (
 	perpetualRESTBasePath = "swap-api"
 
 	huobiAccountInfoContract = "swap_account_info"
+
+	huobiContractFundingRate     = "swap_funding_rate"
+	huobiContractOpenInterest    = "swap_open_interest"
+	huobiContractIndex           = "swap_index"
+	huobiFutureMarketMerged      = "linear-swap-ex/market/detail/merged"
+	huobiPerpetualMarketMerged   = "swap-ex/market/detail/merged"
+	huobiFutureMarkPriceKline    = "index/market/history/linear_swap_mark_price_kline"
+	huobiPerpetualMarkPriceKline = "index/market/history/swap_mark_price_kline"
 )
 
+//----------合约行情
+
+// ContractMarketDetailMerged 合约聚合行情
+type ContractMarketDetailMerged struct {
+	Amount float64   `json:"amount,string"` // 成交量(币)
+	Ask    []float64 `json:"ask"`           // [卖1价,卖1量(张)]
+	Bid    []float64 `json:"bid"`           // [买1价,买1量(张)]
+	Close  float64   `json:"close,string"`  // 收盘价,当K线为最晚的一根时，是最新成交价
+	Count  float64   `json:"count"`         // 成交笔数
+	High   float64   `json:"high,string"`   // 最高价
+	Low    float64   `json:"low,string"`    // 最低价
+	Open   float64   `json:"open,string"`   // 开盘价
+	Volume float64   `json:"vol,string"`    // 成交量(张)
+	Time   int64     `json:"ts"`            // 时间戳
+}
+
+// ContractFundingRate 合约资金费率
+type ContractFundingRate struct {
+	ContractCode    string  `json:"contract_code"`            // 合约代码
+	FundingRate     float64 `json:"funding_rate,string"`      // 当期资金费率
+	EstimatedRate   float64 `json:"estimated_rate,string"`    // 下一期预测资金费率
+	FundingTime     int64   `json:"funding_time,string"`      // 当期资金费率时间
+	NextFundingTime int64   `json:"next_funding_time,string"` // 下一期资金费率时间
+}
+
+// ContractOpenInterest 合约持仓量
+type ContractOpenInterest struct {
+	ContractCode string  `json:"contract_code"` // 合约代码
+	Volume       float64 `json:"volume"`        // 持仓量(张)
+	Amount       float64 `json:"amount"`        // 持仓量(币)
+	Value        float64 `json:"value"`         // 总持仓额
+}
+
+// ContractIndex 合约指数价格
+type ContractIndex struct {
+	ContractCode string  `json:"contract_code"` // 合约代码
+	IndexPrice   float64 `json:"index_price"`   // 指数价格
+	IndexTime    int64   `json:"index_ts"`      // 指数时间
+}
+
+// ContractMarkPriceKline 标记价格K线
+type ContractMarkPriceKline struct {
+	ID    int64   `json:"id"`           // K线id
+	Open  float64 `json:"open,string"`  // 开盘价
+	Close float64 `json:"close,string"` // 收盘价
+	High  float64 `json:"high,string"`  // 最高价
+	Low   float64 `json:"low,string"`   // 最低价
+}
+
 //----------合约用用户帐号信息相关
 
 // SymbolBaseType 基础信息
@@ -328,6 +328,10 @@ func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
 
 // UpdateTicker updates and returns the ticker for a currency pair
 func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	if assetType == asset.Future || assetType == asset.PerpetualContract {
+		return h.updateContractTicker(p, assetType)
+	}
+
 	tickers, err := h.GetTickers()
 	if err != nil {
 		return nil, err
@@ -365,6 +369,66 @@ func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 	return ticker.GetTicker(h.Name, p, assetType)
 }
 
+// updateContractTicker updates and returns the ticker for a swap contract
+// including its mark price, index price, funding rate and open interest
+func (h *HUOBI) updateContractTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	contractCode := p.Format(currency.DashDelimiter, true).String()
+
+	tick, err := h.GetContractMarketDetailMerged(assetType, contractCode)
+	if err != nil {
+		return nil, err
+	}
+
+	markPrice, err := h.GetContractMarkPrice(assetType, contractCode)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := h.GetContractIndex(assetType, contractCode)
+	if err != nil {
+		return nil, err
+	}
+
+	funding, err := h.GetContractFundingRate(assetType, contractCode)
+	if err != nil {
+		return nil, err
+	}
+
+	openInterest, err := h.GetContractOpenInterest(assetType, contractCode)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerPrice := &ticker.Price{
+		Last:            tick.Close,
+		High:            tick.High,
+		Low:             tick.Low,
+		Volume:          tick.Volume,
+		Open:            tick.Open,
+		Close:           tick.Close,
+		MarkPrice:       markPrice,
+		IndexPrice:      index.IndexPrice,
+		FundingRate:     funding.FundingRate,
+		NextFundingTime: time.Unix(0, funding.NextFundingTime*int64(time.Millisecond)),
+		OpenInterest:    openInterest.Amount,
+		Pair:            p,
+		ExchangeName:    h.Name,
+		AssetType:       assetType,
+	}
+	if len(tick.Bid) > 0 {
+		tickerPrice.Bid = tick.Bid[0]
+	}
+	if len(tick.Ask) > 0 {
+		tickerPrice.Ask = tick.Ask[0]
+	}
+
+	err = ticker.ProcessTicker(tickerPrice)
+	if err != nil {
+		return nil, err
+	}
+	return ticker.GetTicker(h.Name, p, assetType)
+}
+
 // FetchTicker returns the ticker for a currency pair
 func (h *HUOBI) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.Name, p, assetType)
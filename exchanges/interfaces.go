@@ -8,6 +8,8 @@ import (
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/futuresdata"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
@@ -72,8 +74,19 @@ type IBotExchange interface {
 	SupportsAsset(assetType asset.Item) bool
 	GetHistoricCandles(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
 	GetHistoricCandlesExtended(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
+	GetOpenInterestHistory(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) ([]futuresdata.OpenInterest, error)
+	GetLongShortRatioHistory(p currency.Pair, a asset.Item, ratioType futuresdata.RatioType, timeStart, timeEnd time.Time, interval kline.Interval) ([]futuresdata.LongShortRatio, error)
+	GetFuturesPositions(p currency.Pair, a asset.Item) ([]futures.Position, error)
+	SetLeverage(p currency.Pair, a asset.Item, leverage float64) error
+	SetMarginType(p currency.Pair, a asset.Item, marginType futures.MarginType) error
+	GetFundingRateHistory(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time) ([]futures.FundingRate, error)
+	GetFuturesPremiumIndex(p currency.Pair, a asset.Item) (*futures.PremiumIndex, error)
+	SubmitFuturesOrder(s *futures.Submit) (order.SubmitResponse, error)
 	DisableRateLimiter() error
 	EnableRateLimiter() error
+	GetOrderExecutionLimits(a asset.Item, cp currency.Pair) (order.MinMaxLevel, error)
+	CheckOrderExecutionLimits(a asset.Item, cp currency.Pair, price, amount float64, orderType order.Type) error
+	ConformOrderToLimits(s *order.Submit) error
 
 	// Websocket specific wrapper functionality
 	// GetWebsocket returns a pointer to the websocket
@@ -0,0 +1,95 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/thrasher-corp/gct-ta/indicators"
+)
+
+// SMA returns the simple moving average, values are zero until the first
+// full period
+func SMA(in []float64, period int) []float64 {
+	return indicators.SMA(in, period)
+}
+
+// EMA returns the exponential moving average seeded with the simple moving
+// average of the first period
+func EMA(in []float64, period int) []float64 {
+	return indicators.EMA(in, period)
+}
+
+// WMA returns the linearly weighted moving average
+func WMA(in []float64, period int) ([]float64, error) {
+	if err := checkPeriods(period); err != nil {
+		return nil, err
+	}
+	return wmaFrom(in, period, 0), nil
+}
+
+// DEMA returns the double exponential moving average, values are zero until
+// both averages have a full period
+func DEMA(in []float64, period int) ([]float64, error) {
+	if err := checkPeriods(period); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(in))
+	ema1 := emaFrom(in, period, 0)
+	ema2 := emaFrom(ema1, period, period-1)
+	for x := 2*period - 2; x < len(in); x++ {
+		out[x] = 2*ema1[x] - ema2[x]
+	}
+	return out, nil
+}
+
+// TEMA returns the triple exponential moving average, values are zero until
+// all three averages have a full period
+func TEMA(in []float64, period int) ([]float64, error) {
+	if err := checkPeriods(period); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(in))
+	ema1 := emaFrom(in, period, 0)
+	ema2 := emaFrom(ema1, period, period-1)
+	ema3 := emaFrom(ema2, period, 2*period-2)
+	for x := 3*period - 3; x < len(in); x++ {
+		out[x] = 3*ema1[x] - 3*ema2[x] + ema3[x]
+	}
+	return out, nil
+}
+
+// HMA returns the Hull moving average, the weighted moving average of twice
+// the half period average less the full period average
+func HMA(in []float64, period int) ([]float64, error) {
+	if err := checkPeriods(period); err != nil {
+		return nil, err
+	}
+	half := period / 2
+	if half < 1 {
+		half = 1
+	}
+	sqrtPeriod := int(math.Round(math.Sqrt(float64(period))))
+	if sqrtPeriod < 1 {
+		sqrtPeriod = 1
+	}
+	halfWMA := wmaFrom(in, half, 0)
+	fullWMA := wmaFrom(in, period, 0)
+	diff := make([]float64, len(in))
+	for x := period - 1; x < len(in); x++ {
+		diff[x] = 2*halfWMA[x] - fullWMA[x]
+	}
+	return wmaFrom(diff, sqrtPeriod, period-1), nil
+}
+
+// MACD returns the moving average convergence divergence line, its signal
+// line and their histogram, values are zero until the signal line has a
+// full period
+func MACD(in []float64, fastPeriod, slowPeriod, signalPeriod int) (macd, signal, histogram []float64) {
+	return indicators.MACD(in, fastPeriod, slowPeriod, signalPeriod)
+}
+
+// BollingerBands returns the upper, middle and lower bands, the middle band
+// is the moving average and the outer bands the multiple of the standard
+// deviation above and below it
+func BollingerBands(in []float64, period int, deviationUp, deviationDown float64, maType MaType) (upper, middle, lower []float64) {
+	return indicators.BBANDS(in, period, deviationUp, deviationDown, maType)
+}
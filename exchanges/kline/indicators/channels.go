@@ -0,0 +1,272 @@
+package indicators
+
+import (
+	"math"
+	"strings"
+
+	"github.com/thrasher-corp/gct-ta/indicators"
+)
+
+// ATR returns the average true range using Wilder's smoothing, the first
+// value is at the period
+func ATR(high, low, closing []float64, period int) []float64 {
+	return indicators.ATR(high, low, closing, period)
+}
+
+// OBV returns the on balance volume, the running total of volume added on
+// up closes and subtracted on down closes
+func OBV(closing, volume []float64) []float64 {
+	if len(closing) == 0 {
+		return nil
+	}
+	return indicators.OBV(closing, volume)
+}
+
+// Donchian returns the highest high, the midpoint and the lowest low within
+// the period ending at each candle
+func Donchian(high, low []float64, period int) (upper, middle, lower []float64, err error) {
+	if err = checkPeriods(period); err != nil {
+		return nil, nil, nil, err
+	}
+	if err = checkLengths(high, low); err != nil {
+		return nil, nil, nil, err
+	}
+	upper, lower = highestLowest(high, low, period)
+	return upper, midpoints(high, low, period), lower, nil
+}
+
+// Ichimoku returns the conversion and base lines and the leading spans. The
+// spans are not displaced, they are plotted the base period ahead of the
+// candle they are calculated at, the lagging span is the close plotted the
+// base period behind
+func Ichimoku(high, low []float64, periodConversion, periodBase, periodSpanB int) (conversion, base, spanA, spanB []float64, err error) {
+	if err = checkPeriods(periodConversion, periodBase, periodSpanB); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if err = checkLengths(high, low); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	conversion = midpoints(high, low, periodConversion)
+	base = midpoints(high, low, periodBase)
+	spanB = midpoints(high, low, periodSpanB)
+	spanA = make([]float64, len(high))
+	start := periodConversion
+	if periodBase > start {
+		start = periodBase
+	}
+	for x := start - 1; x < len(high); x++ {
+		spanA[x] = (conversion[x] + base[x]) / 2
+	}
+	return conversion, base, spanA, spanB, nil
+}
+
+// Keltner returns the exponential moving average of the close with bands the
+// multiple of the average true range above and below it, values are zero
+// until both averages have a full period
+func Keltner(high, low, closing []float64, periodEMA, periodATR int, multiplier float64) (upper, middle, lower []float64, err error) {
+	if err = checkPeriods(periodEMA, periodATR); err != nil {
+		return nil, nil, nil, err
+	}
+	if err = checkLengths(high, low, closing); err != nil {
+		return nil, nil, nil, err
+	}
+	upper = make([]float64, len(closing))
+	middle = make([]float64, len(closing))
+	lower = make([]float64, len(closing))
+	ema := emaFrom(closing, periodEMA, 0)
+	atr := wilderATR(high, low, closing, periodATR)
+	start := periodEMA - 1
+	if periodATR > start {
+		start = periodATR
+	}
+	for x := start; x < len(closing); x++ {
+		middle[x] = ema[x]
+		upper[x] = ema[x] + multiplier*atr[x]
+		lower[x] = ema[x] - multiplier*atr[x]
+	}
+	return upper, middle, lower, nil
+}
+
+// VWAP returns the volume weighted typical price over the period ending at
+// each candle, a period of zero weights every candle up to it
+func VWAP(high, low, closing, volume []float64, period int) ([]float64, error) {
+	if period < 0 {
+		return nil, errInvalidPeriod
+	}
+	if err := checkLengths(high, low, closing, volume); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(closing))
+	var priceVolume, totalVolume float64
+	for x := range closing {
+		priceVolume += (high[x] + low[x] + closing[x]) / 3 * volume[x]
+		totalVolume += volume[x]
+		if period > 0 && x >= period {
+			priceVolume -= (high[x-period] + low[x-period] + closing[x-period]) / 3 * volume[x-period]
+			totalVolume -= volume[x-period]
+		}
+		if (period == 0 || x >= period-1) && totalVolume != 0 {
+			out[x] = priceVolume / totalVolume
+		}
+	}
+	return out, nil
+}
+
+// PSAR returns the parabolic stop and reverse, the acceleration factor starts
+// at the step and increases by it with each new extreme up to the maximum.
+// The first candle is used to set the initial trend so is zero
+func PSAR(high, low []float64, step, maximum float64) ([]float64, error) {
+	if err := CheckPSARParameters(step, maximum); err != nil {
+		return nil, err
+	}
+	if err := checkLengths(high, low); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(high))
+	if len(high) < 2 {
+		return out, nil
+	}
+	long := high[1]+low[1] >= high[0]+low[0]
+	sar, extreme := high[0], low[0]
+	if long {
+		sar, extreme = low[0], high[0]
+	}
+	acceleration := step
+	for x := 1; x < len(high); x++ {
+		sar += acceleration * (extreme - sar)
+		if long {
+			// the stop cannot be above the prior two lows
+			sar = math.Min(sar, low[x-1])
+			if x > 1 {
+				sar = math.Min(sar, low[x-2])
+			}
+			switch {
+			case low[x] < sar:
+				long = false
+				sar, extreme, acceleration = extreme, low[x], step
+			case high[x] > extreme:
+				extreme = high[x]
+				acceleration = math.Min(acceleration+step, maximum)
+			}
+		} else {
+			sar = math.Max(sar, high[x-1])
+			if x > 1 {
+				sar = math.Max(sar, high[x-2])
+			}
+			switch {
+			case high[x] > sar:
+				long = true
+				sar, extreme, acceleration = extreme, high[x], step
+			case low[x] < extreme:
+				extreme = low[x]
+				acceleration = math.Min(acceleration+step, maximum)
+			}
+		}
+		out[x] = sar
+	}
+	return out, nil
+}
+
+// CheckPSARParameters returns an error if the acceleration step or maximum
+// cannot be used to calculate the parabolic stop and reverse
+func CheckPSARParameters(step, maximum float64) error {
+	if step <= 0 || maximum <= 0 {
+		return errInvalidParameter
+	}
+	if step > maximum {
+		return errInvalidAcceleration
+	}
+	return nil
+}
+
+// SuperTrend returns the supertrend line and its direction, 1 when the line
+// is below the close as support and -1 when above it as resistance
+func SuperTrend(high, low, closing []float64, period int, multiplier float64) (line, direction []float64, err error) {
+	if err = checkPeriods(period); err != nil {
+		return nil, nil, err
+	}
+	if err = checkLengths(high, low, closing); err != nil {
+		return nil, nil, err
+	}
+	line = make([]float64, len(closing))
+	direction = make([]float64, len(closing))
+	atr := wilderATR(high, low, closing, period)
+	var upper, lower float64
+	for x := period; x < len(closing); x++ {
+		mid := (high[x] + low[x]) / 2
+		basicUpper := mid + multiplier*atr[x]
+		basicLower := mid - multiplier*atr[x]
+		if x == period {
+			upper, lower = basicUpper, basicLower
+			direction[x] = 1
+			if closing[x] < lower {
+				direction[x] = -1
+			}
+		} else {
+			// bands only tighten until the close crosses them
+			if basicUpper < upper || closing[x-1] > upper {
+				upper = basicUpper
+			}
+			if basicLower > lower || closing[x-1] < lower {
+				lower = basicLower
+			}
+			direction[x] = direction[x-1]
+			switch {
+			case direction[x] > 0 && closing[x] < lower:
+				direction[x] = -1
+			case direction[x] < 0 && closing[x] > upper:
+				direction[x] = 1
+			}
+		}
+		line[x] = upper
+		if direction[x] > 0 {
+			line[x] = lower
+		}
+	}
+	return line, direction, nil
+}
+
+// ParsePivotMethod returns the pivot point calculation method from its name
+func ParsePivotMethod(in string) (string, error) {
+	method := strings.ToLower(in)
+	if method != PivotClassic && method != PivotFibonacci && method != PivotCamarilla {
+		return "", errInvalidPivotMethod
+	}
+	return method, nil
+}
+
+// Pivots returns the pivot point with three resistance and support levels
+// for each candle calculated from the candle before it
+func Pivots(high, low, closing []float64, method string) (pivot, r1, r2, r3, s1, s2, s3 []float64, err error) {
+	if err = checkLengths(high, low, closing); err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, err
+	}
+	pivot = make([]float64, len(closing))
+	r1 = make([]float64, len(closing))
+	r2 = make([]float64, len(closing))
+	r3 = make([]float64, len(closing))
+	s1 = make([]float64, len(closing))
+	s2 = make([]float64, len(closing))
+	s3 = make([]float64, len(closing))
+	for x := 1; x < len(closing); x++ {
+		h, l, c := high[x-1], low[x-1], closing[x-1]
+		p := (h + l + c) / 3
+		spread := h - l
+		pivot[x] = p
+		switch method {
+		case PivotFibonacci:
+			r1[x], s1[x] = p+0.382*spread, p-0.382*spread
+			r2[x], s2[x] = p+0.618*spread, p-0.618*spread
+			r3[x], s3[x] = p+spread, p-spread
+		case PivotCamarilla:
+			r1[x], s1[x] = c+spread*1.1/12, c-spread*1.1/12
+			r2[x], s2[x] = c+spread*1.1/6, c-spread*1.1/6
+			r3[x], s3[x] = c+spread*1.1/4, c-spread*1.1/4
+		default:
+			r1[x], s1[x] = 2*p-l, 2*p-h
+			r2[x], s2[x] = p+spread, p-spread
+			r3[x], s3[x] = h+2*(p-l), l-2*(h-p)
+		}
+	}
+	return pivot, r1, r2, r3, s1, s2, s3, nil
+}
@@ -0,0 +1,297 @@
+package indicators
+
+import (
+	"math"
+	"strings"
+
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+// ParseSource returns the candle source from its name
+func ParseSource(in string) (Source, error) {
+	switch strings.ToLower(in) {
+	case "open":
+		return Open, nil
+	case "high":
+		return High, nil
+	case "low":
+		return Low, nil
+	case "close":
+		return Close, nil
+	case "vol", "volume":
+		return Volume, nil
+	case "typical":
+		return Typical, nil
+	default:
+		return 0, errInvalidSource
+	}
+}
+
+// String returns the name of the candle source
+func (s Source) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case High:
+		return "high"
+	case Low:
+		return "low"
+	case Close:
+		return "close"
+	case Volume:
+		return "volume"
+	case Typical:
+		return "typical"
+	default:
+		return ""
+	}
+}
+
+// Value returns the candle value for the source
+func (s Source) Value(c *kline.Candle) float64 {
+	switch s {
+	case Open:
+		return c.Open
+	case High:
+		return c.High
+	case Low:
+		return c.Low
+	case Volume:
+		return c.Volume
+	case Typical:
+		return (c.High + c.Low + c.Close) / 3
+	default:
+		return c.Close
+	}
+}
+
+// Series returns the source value of every candle in the item
+func (s Source) Series(item *kline.Item) []float64 {
+	out := make([]float64, len(item.Candles))
+	for x := range item.Candles {
+		out[x] = s.Value(&item.Candles[x])
+	}
+	return out
+}
+
+// OHLCV splits the candles of an item into a series for each of their values
+func OHLCV(item *kline.Item) (open, high, low, closing, volume []float64) {
+	open = make([]float64, len(item.Candles))
+	high = make([]float64, len(item.Candles))
+	low = make([]float64, len(item.Candles))
+	closing = make([]float64, len(item.Candles))
+	volume = make([]float64, len(item.Candles))
+	for x := range item.Candles {
+		open[x] = item.Candles[x].Open
+		high[x] = item.Candles[x].High
+		low[x] = item.Candles[x].Low
+		closing[x] = item.Candles[x].Close
+		volume[x] = item.Candles[x].Volume
+	}
+	return open, high, low, closing, volume
+}
+
+// Apply resets the streamer and updates it with every candle of the item,
+// the returned series is zero until the streamer is ready so it lines up with
+// the batch calculation of the same indicator
+func Apply(s Streamer, item *kline.Item) ([]float64, error) {
+	if s == nil {
+		return nil, errNilStreamer
+	}
+	if item == nil || len(item.Candles) == 0 {
+		return nil, errNoCandles
+	}
+	s.Reset()
+	out := make([]float64, len(item.Candles))
+	for x := range item.Candles {
+		value, ready := s.Update(item.Candles[x])
+		if ready {
+			out[x] = value
+		}
+	}
+	return out, nil
+}
+
+// checkPeriods returns an error if any period is not greater than zero
+func checkPeriods(periods ...int) error {
+	for x := range periods {
+		if periods[x] <= 0 {
+			return errInvalidPeriod
+		}
+	}
+	return nil
+}
+
+// checkLengths returns an error if the series are not the same length
+func checkLengths(series ...[]float64) error {
+	for x := 1; x < len(series); x++ {
+		if len(series[x]) != len(series[0]) {
+			return errSeriesLength
+		}
+	}
+	return nil
+}
+
+// smaFrom returns the simple moving average of the values from the offset
+// onwards, values before the first full period are zero
+func smaFrom(in []float64, period, offset int) []float64 {
+	out := make([]float64, len(in))
+	if offset < 0 || offset+period > len(in) {
+		return out
+	}
+	var sum float64
+	for x := offset; x < len(in); x++ {
+		sum += in[x]
+		if x >= offset+period {
+			sum -= in[x-period]
+		}
+		if x >= offset+period-1 {
+			out[x] = sum / float64(period)
+		}
+	}
+	return out
+}
+
+// emaFrom returns the exponential moving average of the values from the
+// offset onwards, it is seeded with the simple moving average of the first
+// period
+func emaFrom(in []float64, period, offset int) []float64 {
+	out := make([]float64, len(in))
+	if offset < 0 || offset+period > len(in) {
+		return out
+	}
+	start := offset + period - 1
+	out[start] = smaFrom(in[:start+1], period, offset)[start]
+	multiplier := 2 / (float64(period) + 1)
+	for x := start + 1; x < len(in); x++ {
+		out[x] = (in[x]-out[x-1])*multiplier + out[x-1]
+	}
+	return out
+}
+
+// wmaFrom returns the linearly weighted moving average of the values from
+// the offset onwards
+func wmaFrom(in []float64, period, offset int) []float64 {
+	out := make([]float64, len(in))
+	if offset < 0 || offset+period > len(in) {
+		return out
+	}
+	divisor := float64(period*(period+1)) / 2
+	for x := offset + period - 1; x < len(in); x++ {
+		var sum float64
+		for y := 0; y < period; y++ {
+			sum += float64(period-y) * in[x-y]
+		}
+		out[x] = sum / divisor
+	}
+	return out
+}
+
+// wilderRSI returns the relative strength index using Wilder's smoothing,
+// the first value is at the period
+func wilderRSI(in []float64, period int) []float64 {
+	out := make([]float64, len(in))
+	if period >= len(in) {
+		return out
+	}
+	var gain, loss float64
+	for x := 1; x <= period; x++ {
+		change := in[x] - in[x-1]
+		if change > 0 {
+			gain += change
+		} else {
+			loss -= change
+		}
+	}
+	gain /= float64(period)
+	loss /= float64(period)
+	out[period] = rsiValue(gain, loss)
+	for x := period + 1; x < len(in); x++ {
+		change := in[x] - in[x-1]
+		var g, l float64
+		if change > 0 {
+			g = change
+		} else {
+			l = -change
+		}
+		gain = (gain*float64(period-1) + g) / float64(period)
+		loss = (loss*float64(period-1) + l) / float64(period)
+		out[x] = rsiValue(gain, loss)
+	}
+	return out
+}
+
+func rsiValue(gain, loss float64) float64 {
+	if loss == 0 {
+		if gain == 0 {
+			return 50
+		}
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+// trueRanges returns the true range of each candle, the first candle has no
+// previous close so its range is its high less its low
+func trueRanges(high, low, closing []float64) []float64 {
+	out := make([]float64, len(closing))
+	for x := range closing {
+		out[x] = high[x] - low[x]
+		if x == 0 {
+			continue
+		}
+		out[x] = trueRange(high[x], low[x], closing[x-1])
+	}
+	return out
+}
+
+// trueRange returns the greatest of the candle's range and the distance of
+// its high and low from the previous close
+func trueRange(high, low, prevClose float64) float64 {
+	return math.Max(high-low, math.Max(math.Abs(high-prevClose), math.Abs(low-prevClose)))
+}
+
+// wilderATR returns the average true range using Wilder's smoothing, the
+// first value is at the period
+func wilderATR(high, low, closing []float64, period int) []float64 {
+	out := make([]float64, len(closing))
+	if period >= len(closing) {
+		return out
+	}
+	tr := trueRanges(high, low, closing)
+	var sum float64
+	for x := 1; x <= period; x++ {
+		sum += tr[x]
+	}
+	out[period] = sum / float64(period)
+	for x := period + 1; x < len(closing); x++ {
+		out[x] = (out[x-1]*float64(period-1) + tr[x]) / float64(period)
+	}
+	return out
+}
+
+// highestLowest returns the highest and lowest value within the period
+// ending at each value
+func highestLowest(high, low []float64, period int) (highest, lowest []float64) {
+	highest = make([]float64, len(high))
+	lowest = make([]float64, len(low))
+	for x := period - 1; x < len(high); x++ {
+		highest[x], lowest[x] = high[x], low[x]
+		for y := x - period + 1; y < x; y++ {
+			highest[x] = math.Max(highest[x], high[y])
+			lowest[x] = math.Min(lowest[x], low[y])
+		}
+	}
+	return highest, lowest
+}
+
+// midpoints returns the middle of the highest high and lowest low within the
+// period ending at each candle
+func midpoints(high, low []float64, period int) []float64 {
+	out := make([]float64, len(high))
+	highest, lowest := highestLowest(high, low, period)
+	for x := period - 1; x < len(high); x++ {
+		out[x] = (highest[x] + lowest[x]) / 2
+	}
+	return out
+}
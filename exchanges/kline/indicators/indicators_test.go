@@ -0,0 +1,433 @@
+package indicators
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+func expectValues(t *testing.T, name string, received, expected []float64) {
+	t.Helper()
+	if len(received) != len(expected) {
+		t.Fatalf("%s received %d values expected %d", name, len(received), len(expected))
+	}
+	for x := range expected {
+		if math.Abs(received[x]-expected[x]) > 1e-9 {
+			t.Errorf("%s value %d received %v expected %v", name, x, received[x], expected[x])
+		}
+	}
+}
+
+func testItem(n int) *kline.Item {
+	item := &kline.Item{Interval: kline.OneMin}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	price := 100.0
+	for x := 0; x < n; x++ {
+		open := price
+		price += rand.Float64()*4 - 2 // nolint:gosec // no need to import crypo/rand for testing
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   start.Add(time.Duration(x) * time.Minute),
+			Open:   open,
+			High:   math.Max(open, price) + rand.Float64(), // nolint:gosec // no need to import crypo/rand for testing
+			Low:    math.Min(open, price) - rand.Float64(), // nolint:gosec // no need to import crypo/rand for testing
+			Close:  price,
+			Volume: rand.Float64() * 10, // nolint:gosec // no need to import crypo/rand for testing
+		})
+	}
+	return item
+}
+
+func TestParseSource(t *testing.T) {
+	for _, s := range []Source{Open, High, Low, Close, Volume, Typical} {
+		parsed, err := ParseSource(s.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != s {
+			t.Errorf("received %v expected %v", parsed, s)
+		}
+	}
+	if _, err := ParseSource("vol"); err != nil {
+		t.Error(err)
+	}
+	if _, err := ParseSource("bad"); !errors.Is(err, errInvalidSource) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSource)
+	}
+}
+
+func TestSeries(t *testing.T) {
+	item := &kline.Item{Candles: []kline.Candle{
+		{Open: 1, High: 4, Low: 1, Close: 3, Volume: 5},
+		{Open: 3, High: 6, Low: 2, Close: 4, Volume: 7},
+	}}
+	expectValues(t, "close", Close.Series(item), []float64{3, 4})
+	expectValues(t, "typical", Typical.Series(item), []float64{8.0 / 3, 4})
+
+	open, high, low, closing, volume := OHLCV(item)
+	expectValues(t, "open", open, []float64{1, 3})
+	expectValues(t, "high", high, []float64{4, 6})
+	expectValues(t, "low", low, []float64{1, 2})
+	expectValues(t, "closing", closing, []float64{3, 4})
+	expectValues(t, "volume", volume, []float64{5, 7})
+}
+
+func TestApply(t *testing.T) {
+	if _, err := Apply(nil, testItem(1)); !errors.Is(err, errNilStreamer) {
+		t.Errorf("received '%v' expected '%v'", err, errNilStreamer)
+	}
+	if _, err := Apply(NewOBVStream(), &kline.Item{}); !errors.Is(err, errNoCandles) {
+		t.Errorf("received '%v' expected '%v'", err, errNoCandles)
+	}
+}
+
+func TestNewStreams(t *testing.T) {
+	if _, err := NewSMAStream(0, Close); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err := NewVWAPStream(-1); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err := NewMACDStream(26, 12, 9, Close); !errors.Is(err, errInvalidMACDPeriods) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMACDPeriods)
+	}
+	if _, err := NewBollingerStream(20, 0, 2, Sma, Close); !errors.Is(err, errInvalidParameter) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidParameter)
+	}
+	if _, err := NewBollingerStream(20, 2, 2, 5, Close); !errors.Is(err, errInvalidMovingAvg) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMovingAvg)
+	}
+}
+
+// TestStreamsMatchBatch checks every streaming indicator produces the same
+// series as its batch calculation
+func TestStreamsMatchBatch(t *testing.T) {
+	item := testItem(200)
+	_, high, low, closing, volume := OHLCV(item)
+
+	sma, _ := NewSMAStream(14, Close)
+	ema, _ := NewEMAStream(14, Close)
+	wma, _ := NewWMAStream(14, Close)
+	rsi, _ := NewRSIStream(14, Close)
+	atr, _ := NewATRStream(14)
+	vwap, _ := NewVWAPStream(20)
+	cumulativeVWAP, _ := NewVWAPStream(0)
+	macd, _ := NewMACDStream(12, 26, 9, Close)
+	bbands, _ := NewBollingerStream(20, 2, 2, Sma, Close)
+	emaBands, _ := NewBollingerStream(20, 2, 2, Ema, Close)
+	macdLine, macdSignal, macdHistogram := MACD(closing, 12, 26, 9)
+	upper, middle, lower := BollingerBands(closing, 20, 2, 2, Sma)
+	_, emaMiddle, _ := BollingerBands(closing, 20, 2, 2, Ema)
+	wmaValues, err := WMA(closing, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vwapValues, err := VWAP(high, low, closing, volume, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cumulativeVWAPValues, err := VWAP(high, low, closing, volume, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		s        Streamer
+		expected []float64
+	}{
+		{"sma", sma, SMA(closing, 14)},
+		{"ema", ema, EMA(closing, 14)},
+		{"wma", wma, wmaValues},
+		{"rsi", rsi, RSI(closing, 14)},
+		{"atr", atr, ATR(high, low, closing, 14)},
+		{"obv", NewOBVStream(), OBV(closing, volume)},
+		{"vwap", vwap, vwapValues},
+		{"cumulative vwap", cumulativeVWAP, cumulativeVWAPValues},
+		{"macd", macd, macdLine},
+		{"bbands", bbands, middle},
+		{"ema bbands", emaBands, emaMiddle},
+	}
+	for _, tests := range testCases {
+		test := tests
+		t.Run(test.name, func(t *testing.T) {
+			received, err := Apply(test.s, item)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectValues(t, test.name, received, test.expected)
+
+			// applying again resets the stream so the values are unchanged
+			received, err = Apply(test.s, item)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectValues(t, test.name+" reset", received, test.expected)
+		})
+	}
+
+	macd.Reset()
+	bbands.Reset()
+	for x := range item.Candles {
+		if _, ready := macd.Update(item.Candles[x]); ready {
+			expectValues(t, "macd signal", []float64{macd.Signal(), macd.Histogram()},
+				[]float64{macdSignal[x], macdHistogram[x]})
+		}
+		if _, ready := bbands.Update(item.Candles[x]); ready {
+			expectValues(t, "bbands", []float64{bbands.Upper(), bbands.Lower()},
+				[]float64{upper[x], lower[x]})
+		}
+	}
+}
+
+func TestMovingAverages(t *testing.T) {
+	in := []float64{1, 2, 3, 4, 5}
+	wma, err := WMA(in, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "wma", wma, []float64{0, 0, 14.0 / 6, 20.0 / 6, 26.0 / 6})
+	expectValues(t, "sma", smaFrom(in, 2, 1), []float64{0, 0, 2.5, 3.5, 4.5})
+	expectValues(t, "ema", emaFrom(in, 2, 0), []float64{0, 1.5, 2.5, 3.5, 4.5})
+
+	constant := []float64{5, 5, 5, 5, 5, 5, 5, 5}
+	// a linear series is tracked without lag
+	linear := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	testCases := []struct {
+		name     string
+		f        func([]float64, int) ([]float64, error)
+		in       []float64
+		period   int
+		from     int
+		expected []float64
+	}{
+		{"dema", DEMA, constant, 3, 0, []float64{0, 0, 0, 0, 5, 5, 5, 5}},
+		{"tema", TEMA, constant, 3, 0, []float64{0, 0, 0, 0, 0, 0, 5, 5}},
+		{"hma", HMA, constant, 4, 0, []float64{0, 0, 0, 0, 5, 5, 5, 5}},
+		{"linear dema", DEMA, linear, 3, 4, []float64{5, 6, 7, 8, 9, 10}},
+		{"linear tema", TEMA, linear, 3, 6, []float64{7, 8, 9, 10}},
+	}
+	for x := range testCases {
+		received, err := testCases[x].f(testCases[x].in, testCases[x].period)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectValues(t, testCases[x].name, received[testCases[x].from:], testCases[x].expected)
+	}
+
+	for _, f := range []func([]float64, int) ([]float64, error){WMA, DEMA, TEMA, HMA} {
+		if _, err := f(in, 0); !errors.Is(err, errInvalidPeriod) {
+			t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+		}
+	}
+}
+
+func TestOscillators(t *testing.T) {
+	high := []float64{10, 11, 12, 13, 14, 15}
+	low := []float64{8, 9, 10, 11, 12, 13}
+	closing := []float64{9, 10, 11, 12, 13, 14}
+
+	k, d, err := Stochastic(high, low, closing, 3, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "stoch k", k, []float64{0, 0, 75, 75, 75, 75})
+	expectValues(t, "stoch d", d, []float64{0, 0, 0, 75, 75, 75})
+
+	willr, err := WilliamsR(high, low, closing, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "willr", willr, []float64{0, 0, -25, -25, -25, -25})
+	expectValues(t, "rsi", wilderRSI(closing, 3), []float64{0, 0, 0, 100, 100, 100})
+	cci, err := CCI(closing, closing, closing, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "cci", cci, []float64{0, 0, 100, 100, 100, 100})
+
+	k, d, err = StochRSI([]float64{1, 2, 1, 2, 1, 2, 1}, 2, 2, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "stochrsi k", k, []float64{0, 0, 0, 100, 0, 100, 0})
+	expectValues(t, "stochrsi d", d, k)
+
+	adx, plusDI, minusDI, err := ADX(high, low, closing, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "adx", adx, []float64{0, 0, 0, 100, 100, 100})
+	expectValues(t, "plus di", plusDI, []float64{0, 0, 50, 50, 50, 50})
+	expectValues(t, "minus di", minusDI, []float64{0, 0, 0, 0, 0, 0})
+
+	if _, _, err = Stochastic(high, low, closing, 3, 0, 2); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, _, err = Stochastic(high, low[1:], closing, 3, 1, 2); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	if _, _, err = StochRSI(closing, 2, -1, 1, 1); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err = WilliamsR(high, low, closing, 0); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err = WilliamsR(high, low, closing[1:], 3); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	if _, err = CCI(high, low, closing, -1); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err = CCI(high[1:], low, closing, 3); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	if _, _, _, err = ADX(high, low, closing[2:], 2); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+}
+
+func TestChannels(t *testing.T) {
+	high := []float64{10, 12, 11, 13, 12}
+	low := []float64{8, 9, 7, 10, 11}
+	closing := []float64{9, 11, 8, 12, 11}
+
+	upper, middle, lower, err := Donchian(high, low, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "donchian upper", upper, []float64{0, 0, 12, 13, 13})
+	expectValues(t, "donchian middle", middle, []float64{0, 0, 9.5, 10, 10})
+	expectValues(t, "donchian lower", lower, []float64{0, 0, 7, 7, 7})
+
+	conversion, base, spanA, spanB, err := Ichimoku(high, low, 2, 3, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "ichimoku conversion", conversion, []float64{0, 10, 9.5, 10, 11.5})
+	expectValues(t, "ichimoku base", base, []float64{0, 0, 9.5, 10, 10})
+	expectValues(t, "ichimoku span a", spanA, []float64{0, 0, 9.5, 10, 10.75})
+	expectValues(t, "ichimoku span b", spanB, []float64{0, 0, 0, 10, 10})
+
+	upper, middle, lower, err = Keltner(high, low, closing, 2, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// true ranges are 2, 3, 4, 5 and 1 so the atr is 3.5, 4.25 then 2.625
+	expectValues(t, "keltner middle", middle, []float64{0, 0, 26.0 / 3, 98.0 / 9, 296.0 / 27})
+	expectValues(t, "keltner upper", upper, []float64{0, 0, 26.0/3 + 7, 98.0/9 + 8.5, 296.0/27 + 5.25})
+	expectValues(t, "keltner lower", lower, []float64{0, 0, 26.0/3 - 7, 98.0/9 - 8.5, 296.0/27 - 5.25})
+
+	vwap, err := VWAP(closing, closing, closing, []float64{1, 1, 2, 0, 4}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "vwap", vwap, []float64{9, 10, 9, 9, 10})
+	vwap, err = VWAP(closing, closing, closing, []float64{1, 1, 2, 0, 4}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "rolling vwap", vwap, []float64{0, 10, 9, 8, 11})
+
+	if _, _, _, err = Donchian(high, low, 0); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, _, _, err = Donchian(high, low[1:], 3); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	if _, _, _, _, err = Ichimoku(high, low, 2, 0, 4); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, _, _, _, err = Ichimoku(high[1:], low, 2, 3, 4); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	if _, _, _, err = Keltner(high, low, closing, 2, 0, 2); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err = VWAP(closing, closing, closing, []float64{1, 1, 2, 0, 4}, -1); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, err = VWAP(closing, closing, closing, []float64{1}, 0); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+}
+
+func TestTrendIndicators(t *testing.T) {
+	high := []float64{10, 11, 12, 13, 14, 15, 9}
+	low := []float64{9, 10, 11, 12, 13, 14, 8}
+	closing := []float64{9.5, 10.5, 11.5, 12.5, 13.5, 14.5, 8.5}
+
+	if err := CheckPSARParameters(0.2, 0.02); !errors.Is(err, errInvalidAcceleration) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAcceleration)
+	}
+	if err := CheckPSARParameters(-1, 0.2); !errors.Is(err, errInvalidParameter) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidParameter)
+	}
+	if _, err := PSAR(high, low, 0.2, 0.02); !errors.Is(err, errInvalidAcceleration) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAcceleration)
+	}
+	if _, err := PSAR(high, low[1:], 0.02, 0.2); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	sar, err := PSAR(high, low, 0.02, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "psar", sar[:3], []float64{0, 9, 9})
+	for x := 1; x < 6; x++ {
+		if sar[x] > low[x] {
+			t.Errorf("psar %v should be below the low %v in an uptrend", sar[x], low[x])
+		}
+	}
+	if sar[6] != 15 {
+		t.Errorf("psar should reverse to the extreme high of 15 received %v", sar[6])
+	}
+
+	if _, _, err = SuperTrend(high, low, closing, 0, 1); !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	if _, _, err = SuperTrend(high, low, closing[1:], 2, 1); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	line, direction, err := SuperTrend(high, low, closing, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "supertrend direction", direction, []float64{0, 0, 1, 1, 1, 1, -1})
+	if line[5] >= closing[5] || line[6] <= closing[6] {
+		t.Errorf("supertrend should support the uptrend and resist the reversal received %v", line)
+	}
+
+	if _, err = ParsePivotMethod("woodie"); !errors.Is(err, errInvalidPivotMethod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPivotMethod)
+	}
+	if _, _, _, _, _, _, _, err = Pivots([]float64{10, 0}, []float64{8}, []float64{9, 0}, PivotClassic); !errors.Is(err, errSeriesLength) {
+		t.Errorf("received '%v' expected '%v'", err, errSeriesLength)
+	}
+	pivot, r1, r2, r3, s1, s2, s3, err := Pivots([]float64{10, 0}, []float64{8, 0}, []float64{9, 0}, PivotClassic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range [][]float64{pivot, r1, r2, r3, s1, s2, s3} {
+		if v[0] != 0 {
+			t.Error("pivots should be zero without a previous candle")
+		}
+	}
+	expectValues(t, "classic pivots", []float64{pivot[1], r1[1], r2[1], r3[1], s1[1], s2[1], s3[1]},
+		[]float64{9, 10, 11, 12, 8, 7, 6})
+	pivot, r1, r2, r3, s1, s2, s3, err = Pivots([]float64{10, 0}, []float64{8, 0}, []float64{9, 0}, PivotFibonacci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "fibonacci pivots", []float64{pivot[1], r1[1], r2[1], r3[1], s1[1], s2[1], s3[1]},
+		[]float64{9, 9.764, 10.236, 11, 8.236, 7.764, 7})
+	pivot, r1, r2, r3, s1, s2, s3, err = Pivots([]float64{10, 0}, []float64{8, 0}, []float64{9, 0}, PivotCamarilla)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, "camarilla pivots", []float64{pivot[1], r1[1], r2[1], r3[1], s1[1], s2[1], s3[1]},
+		[]float64{9, 9 + 2.2/12, 9 + 2.2/6, 9 + 2.2/4, 9 - 2.2/12, 9 - 2.2/6, 9 - 2.2/4})
+}
@@ -0,0 +1,168 @@
+package indicators
+
+import (
+	"errors"
+
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gct-ta/indicators"
+)
+
+// Sources of the candle value an indicator is calculated from
+const (
+	Open Source = iota + 1
+	High
+	Low
+	Close
+	Volume
+	// Typical is the average of the high, low and close
+	Typical
+)
+
+// Pivot point calculation methods
+const (
+	PivotClassic   = "classic"
+	PivotFibonacci = "fibonacci"
+	PivotCamarilla = "camarilla"
+)
+
+// Moving average types used by Bollinger Bands
+const (
+	Sma = indicators.Sma
+	Ema = indicators.Ema
+)
+
+var (
+	errInvalidSource       = errors.New("invalid candle source")
+	errInvalidPeriod       = errors.New("period must be greater than zero")
+	errInvalidParameter    = errors.New("parameter must be greater than zero")
+	errInvalidMACDPeriods  = errors.New("fast period cannot exceed the slow period")
+	errInvalidMovingAvg    = errors.New("moving average type must be sma or ema")
+	errNilStreamer         = errors.New("streamer cannot be nil")
+	errNoCandles           = errors.New("no candles to calculate indicator from")
+	errInvalidPivotMethod  = errors.New("pivot method must be classic, fibonacci or camarilla")
+	errInvalidAcceleration = errors.New("acceleration step cannot exceed its maximum")
+	errSeriesLength        = errors.New("series must be the same length")
+)
+
+// Source selects which value of a candle an indicator is calculated from
+type Source uint8
+
+// MaType is the moving average type used by Bollinger Bands
+type MaType = indicators.MaType
+
+// Streamer is an indicator which is updated one candle at a time so it can
+// be kept current from a live candle feed without recalculating its history
+type Streamer interface {
+	// Update adds the next closed candle and returns the latest value, ready
+	// is false until enough candles have been added to fill every period
+	Update(c kline.Candle) (value float64, ready bool)
+	// Reset clears all candles added so far
+	Reset()
+}
+
+// window holds the most recent values up to its size
+type window struct {
+	values []float64
+	next   int
+	full   bool
+}
+
+// smaState holds a rolling simple moving average
+type smaState struct {
+	window
+	sum float64
+}
+
+// emaState holds an exponential moving average seeded with the simple moving
+// average of its first period
+type emaState struct {
+	period     int
+	multiplier float64
+	count      int
+	sum        float64
+	value      float64
+}
+
+// SMAStream is a streaming simple moving average
+type SMAStream struct {
+	source Source
+	state  smaState
+}
+
+// EMAStream is a streaming exponential moving average
+type EMAStream struct {
+	source Source
+	state  emaState
+}
+
+// WMAStream is a streaming linearly weighted moving average
+type WMAStream struct {
+	source   Source
+	window   window
+	count    int
+	weighted float64
+	sum      float64
+	divisor  float64
+}
+
+// RSIStream is a streaming relative strength index using Wilder's smoothing
+type RSIStream struct {
+	source   Source
+	period   int
+	count    int
+	previous float64
+	gain     float64
+	loss     float64
+}
+
+// ATRStream is a streaming average true range using Wilder's smoothing
+type ATRStream struct {
+	period    int
+	count     int
+	prevClose float64
+	sum       float64
+	value     float64
+}
+
+// OBVStream is a streaming on balance volume
+type OBVStream struct {
+	count     int
+	prevClose float64
+	value     float64
+}
+
+// VWAPStream is a streaming volume weighted average price, a period of zero
+// weights every candle added
+type VWAPStream struct {
+	period      int
+	count       int
+	priceVolume window
+	volume      window
+	pvSum       float64
+	volumeSum   float64
+}
+
+// MACDStream is a streaming moving average convergence divergence, the
+// values are ready once the signal line has a full period
+type MACDStream struct {
+	source Source
+	fast   emaState
+	slow   emaState
+	signal emaState
+	macd   float64
+}
+
+// BollingerStream is a streaming Bollinger Bands, Update returns the middle
+// band
+type BollingerStream struct {
+	source      Source
+	maType      MaType
+	deviationUp float64
+	deviationDn float64
+	sma         smaState
+	ema         emaState
+	squares     smaState
+	upper       float64
+	middle      float64
+	lower       float64
+}
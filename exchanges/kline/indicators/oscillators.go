@@ -0,0 +1,175 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/thrasher-corp/gct-ta/indicators"
+)
+
+// RSI returns the relative strength index using Wilder's smoothing, the
+// first value is at the period
+func RSI(in []float64, period int) []float64 {
+	return indicators.RSI(in, period)
+}
+
+// MFI returns the money flow index, the volume weighted relative strength of
+// the typical price
+func MFI(high, low, closing, volume []float64, period int) []float64 {
+	return indicators.MFI(high, low, closing, volume, period)
+}
+
+// CorrelationCoefficient returns the Pearson correlation of two series over
+// the period ending at each value
+func CorrelationCoefficient(a, b []float64, period int) []float64 {
+	return indicators.CorrelationCoefficient(a, b, period)
+}
+
+// Stochastic returns the slow %K, the raw %K smoothed over the slowing
+// period, and %D, its simple moving average
+func Stochastic(high, low, closing []float64, periodK, slowing, periodD int) (k, d []float64, err error) {
+	if err = checkPeriods(periodK, slowing, periodD); err != nil {
+		return nil, nil, err
+	}
+	if err = checkLengths(high, low, closing); err != nil {
+		return nil, nil, err
+	}
+	raw := make([]float64, len(closing))
+	highest, lowest := highestLowest(high, low, periodK)
+	for x := periodK - 1; x < len(closing); x++ {
+		if highest[x] != lowest[x] {
+			raw[x] = 100 * (closing[x] - lowest[x]) / (highest[x] - lowest[x])
+		}
+	}
+	k = smaFrom(raw, slowing, periodK-1)
+	d = smaFrom(k, periodD, periodK+slowing-2)
+	return k, d, nil
+}
+
+// StochRSI returns the stochastic oscillator of the relative strength index,
+// %K is smoothed over its period and %D is its simple moving average
+func StochRSI(closing []float64, periodRSI, periodStoch, periodK, periodD int) (k, d []float64, err error) {
+	if err = checkPeriods(periodRSI, periodStoch, periodK, periodD); err != nil {
+		return nil, nil, err
+	}
+	rsi := wilderRSI(closing, periodRSI)
+	raw := make([]float64, len(closing))
+	start := periodRSI + periodStoch - 1
+	if start < len(closing) {
+		highest, lowest := highestLowest(rsi[periodRSI:], rsi[periodRSI:], periodStoch)
+		for x := start; x < len(closing); x++ {
+			y := x - periodRSI
+			if highest[y] != lowest[y] {
+				raw[x] = 100 * (rsi[x] - lowest[y]) / (highest[y] - lowest[y])
+			}
+		}
+	}
+	k = smaFrom(raw, periodK, start)
+	d = smaFrom(k, periodD, start+periodK-1)
+	return k, d, nil
+}
+
+// WilliamsR returns where the close is within the period's range from 0 at
+// the high to -100 at the low
+func WilliamsR(high, low, closing []float64, period int) ([]float64, error) {
+	if err := checkPeriods(period); err != nil {
+		return nil, err
+	}
+	if err := checkLengths(high, low, closing); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(closing))
+	highest, lowest := highestLowest(high, low, period)
+	for x := period - 1; x < len(closing); x++ {
+		if highest[x] != lowest[x] {
+			out[x] = -100 * (highest[x] - closing[x]) / (highest[x] - lowest[x])
+		}
+	}
+	return out, nil
+}
+
+// CCI returns the commodity channel index of the typical price
+func CCI(high, low, closing []float64, period int) ([]float64, error) {
+	if err := checkPeriods(period); err != nil {
+		return nil, err
+	}
+	if err := checkLengths(high, low, closing); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(closing))
+	typical := make([]float64, len(closing))
+	for x := range closing {
+		typical[x] = (high[x] + low[x] + closing[x]) / 3
+	}
+	avg := smaFrom(typical, period, 0)
+	for x := period - 1; x < len(closing); x++ {
+		var deviation float64
+		for y := x - period + 1; y <= x; y++ {
+			deviation += math.Abs(typical[y] - avg[x])
+		}
+		deviation /= float64(period)
+		if deviation != 0 {
+			out[x] = (typical[x] - avg[x]) / (0.015 * deviation)
+		}
+	}
+	return out, nil
+}
+
+// ADX returns the average directional index and the positive and negative
+// directional indicators using Wilder's smoothing, the indicators start at
+// the period and the index at twice the period
+func ADX(high, low, closing []float64, period int) (adx, plusDI, minusDI []float64, err error) {
+	if err = checkPeriods(period); err != nil {
+		return nil, nil, nil, err
+	}
+	if err = checkLengths(high, low, closing); err != nil {
+		return nil, nil, nil, err
+	}
+	adx = make([]float64, len(closing))
+	plusDI = make([]float64, len(closing))
+	minusDI = make([]float64, len(closing))
+	if period >= len(closing) {
+		return adx, plusDI, minusDI, nil
+	}
+	tr := trueRanges(high, low, closing)
+	var trSum, plusSum, minusSum, dxSum float64
+	for x := 1; x < len(closing); x++ {
+		up := high[x] - high[x-1]
+		down := low[x-1] - low[x]
+		var plusDM, minusDM float64
+		if up > down && up > 0 {
+			plusDM = up
+		}
+		if down > up && down > 0 {
+			minusDM = down
+		}
+		if x <= period {
+			trSum += tr[x]
+			plusSum += plusDM
+			minusSum += minusDM
+			if x < period {
+				continue
+			}
+		} else {
+			trSum = trSum - trSum/float64(period) + tr[x]
+			plusSum = plusSum - plusSum/float64(period) + plusDM
+			minusSum = minusSum - minusSum/float64(period) + minusDM
+		}
+		if trSum != 0 {
+			plusDI[x] = 100 * plusSum / trSum
+			minusDI[x] = 100 * minusSum / trSum
+		}
+		var dx float64
+		if total := plusDI[x] + minusDI[x]; total != 0 {
+			dx = 100 * math.Abs(plusDI[x]-minusDI[x]) / total
+		}
+		switch {
+		case x < 2*period-1:
+			dxSum += dx
+		case x == 2*period-1:
+			adx[x] = (dxSum + dx) / float64(period)
+		default:
+			adx[x] = (adx[x-1]*float64(period-1) + dx) / float64(period)
+		}
+	}
+	return adx, plusDI, minusDI, nil
+}
@@ -0,0 +1,436 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+func newWindow(size int) window {
+	return window{values: make([]float64, size)}
+}
+
+// push adds a value returning the value it replaced once the window is full
+func (w *window) push(v float64) (old float64, evicted bool) {
+	if len(w.values) == 0 {
+		return 0, false
+	}
+	old, evicted = w.values[w.next], w.full
+	w.values[w.next] = v
+	w.next++
+	if w.next == len(w.values) {
+		w.next = 0
+		w.full = true
+	}
+	return old, evicted
+}
+
+func (w *window) reset() {
+	for x := range w.values {
+		w.values[x] = 0
+	}
+	w.next = 0
+	w.full = false
+}
+
+func newSMAState(period int) smaState {
+	return smaState{window: newWindow(period)}
+}
+
+func (s *smaState) add(v float64) (float64, bool) {
+	old, evicted := s.push(v)
+	s.sum += v
+	if evicted {
+		s.sum -= old
+	}
+	if !s.full {
+		return 0, false
+	}
+	return s.sum / float64(len(s.values)), true
+}
+
+func (s *smaState) reset() {
+	s.window.reset()
+	s.sum = 0
+}
+
+func newEMAState(period int) emaState {
+	return emaState{period: period, multiplier: 2 / (float64(period) + 1)}
+}
+
+func (e *emaState) add(v float64) (float64, bool) {
+	e.count++
+	switch {
+	case e.count < e.period:
+		e.sum += v
+		return 0, false
+	case e.count == e.period:
+		e.value = (e.sum + v) / float64(e.period)
+	default:
+		e.value = (v-e.value)*e.multiplier + e.value
+	}
+	return e.value, true
+}
+
+func (e *emaState) reset() {
+	e.count = 0
+	e.sum = 0
+	e.value = 0
+}
+
+// NewSMAStream returns a streaming simple moving average of the source
+func NewSMAStream(period int, source Source) (*SMAStream, error) {
+	if period <= 0 {
+		return nil, errInvalidPeriod
+	}
+	return &SMAStream{source: source, state: newSMAState(period)}, nil
+}
+
+// Update adds the next candle and returns the average
+func (s *SMAStream) Update(c kline.Candle) (value float64, ready bool) {
+	return s.state.add(s.source.Value(&c))
+}
+
+// Reset clears all candles added so far
+func (s *SMAStream) Reset() {
+	s.state.reset()
+}
+
+// NewEMAStream returns a streaming exponential moving average of the source
+func NewEMAStream(period int, source Source) (*EMAStream, error) {
+	if period <= 0 {
+		return nil, errInvalidPeriod
+	}
+	return &EMAStream{source: source, state: newEMAState(period)}, nil
+}
+
+// Update adds the next candle and returns the average
+func (e *EMAStream) Update(c kline.Candle) (value float64, ready bool) {
+	return e.state.add(e.source.Value(&c))
+}
+
+// Reset clears all candles added so far
+func (e *EMAStream) Reset() {
+	e.state.reset()
+}
+
+// NewWMAStream returns a streaming linearly weighted moving average of the
+// source
+func NewWMAStream(period int, source Source) (*WMAStream, error) {
+	if period <= 0 {
+		return nil, errInvalidPeriod
+	}
+	return &WMAStream{
+		source:  source,
+		window:  newWindow(period),
+		divisor: float64(period*(period+1)) / 2,
+	}, nil
+}
+
+// Update adds the next candle and returns the average
+func (w *WMAStream) Update(c kline.Candle) (value float64, ready bool) {
+	v := w.source.Value(&c)
+	period := len(w.window.values)
+	// the oldest value loses a weight each candle so the weighted sum drops
+	// by the sum of the window before the new value takes the full weight
+	if w.count < period {
+		w.count++
+		w.weighted += float64(w.count) * v
+	} else {
+		w.weighted += float64(period)*v - w.sum
+	}
+	old, evicted := w.window.push(v)
+	w.sum += v
+	if evicted {
+		w.sum -= old
+	}
+	if !w.window.full {
+		return 0, false
+	}
+	return w.weighted / w.divisor, true
+}
+
+// Reset clears all candles added so far
+func (w *WMAStream) Reset() {
+	w.window.reset()
+	w.count = 0
+	w.weighted = 0
+	w.sum = 0
+}
+
+// NewRSIStream returns a streaming relative strength index of the source
+func NewRSIStream(period int, source Source) (*RSIStream, error) {
+	if period <= 0 {
+		return nil, errInvalidPeriod
+	}
+	return &RSIStream{source: source, period: period}, nil
+}
+
+// Update adds the next candle and returns the index, the first value is
+// ready once the period has a change for each candle
+func (r *RSIStream) Update(c kline.Candle) (value float64, ready bool) {
+	v := r.source.Value(&c)
+	r.count++
+	if r.count == 1 {
+		r.previous = v
+		return 0, false
+	}
+	change := v - r.previous
+	r.previous = v
+	var gain, loss float64
+	if change < 0 {
+		loss = -change
+	} else {
+		gain = change
+	}
+	period := float64(r.period)
+	switch {
+	case r.count <= r.period:
+		r.gain += gain
+		r.loss += loss
+		return 0, false
+	case r.count == r.period+1:
+		r.gain = (r.gain + gain) / period
+		r.loss = (r.loss + loss) / period
+	default:
+		r.gain = (r.gain*(period-1) + gain) / period
+		r.loss = (r.loss*(period-1) + loss) / period
+	}
+	total := r.gain + r.loss
+	if math.Abs(total) < 1e-14 {
+		return 0, true
+	}
+	return 100 * r.gain / total, true
+}
+
+// Reset clears all candles added so far
+func (r *RSIStream) Reset() {
+	r.count = 0
+	r.previous = 0
+	r.gain = 0
+	r.loss = 0
+}
+
+// NewATRStream returns a streaming average true range
+func NewATRStream(period int) (*ATRStream, error) {
+	if period <= 0 {
+		return nil, errInvalidPeriod
+	}
+	return &ATRStream{period: period}, nil
+}
+
+// Update adds the next candle and returns the average, the first value is
+// ready once the period has a true range for each candle
+func (a *ATRStream) Update(c kline.Candle) (value float64, ready bool) {
+	a.count++
+	prevClose := a.prevClose
+	a.prevClose = c.Close
+	if a.count == 1 {
+		return 0, false
+	}
+	tr := trueRange(c.High, c.Low, prevClose)
+	period := float64(a.period)
+	switch {
+	case a.count <= a.period:
+		a.sum += tr
+		return 0, false
+	case a.count == a.period+1:
+		a.value = (a.sum + tr) / period
+	default:
+		a.value = (a.value*(period-1) + tr) / period
+	}
+	return a.value, true
+}
+
+// Reset clears all candles added so far
+func (a *ATRStream) Reset() {
+	a.count = 0
+	a.prevClose = 0
+	a.sum = 0
+	a.value = 0
+}
+
+// NewOBVStream returns a streaming on balance volume
+func NewOBVStream() *OBVStream {
+	return &OBVStream{}
+}
+
+// Update adds the next candle and returns the running volume total
+func (o *OBVStream) Update(c kline.Candle) (value float64, ready bool) {
+	o.count++
+	if o.count > 1 {
+		switch {
+		case c.Close > o.prevClose:
+			o.value += c.Volume
+		case c.Close < o.prevClose:
+			o.value -= c.Volume
+		}
+	}
+	o.prevClose = c.Close
+	return o.value, true
+}
+
+// Reset clears all candles added so far
+func (o *OBVStream) Reset() {
+	o.count = 0
+	o.prevClose = 0
+	o.value = 0
+}
+
+// NewVWAPStream returns a streaming volume weighted average price over the
+// period, a period of zero weights every candle added
+func NewVWAPStream(period int) (*VWAPStream, error) {
+	if period < 0 {
+		return nil, errInvalidPeriod
+	}
+	return &VWAPStream{
+		period:      period,
+		priceVolume: newWindow(period),
+		volume:      newWindow(period),
+	}, nil
+}
+
+// Update adds the next candle and returns the average price, it is zero while
+// there is no volume in the period
+func (v *VWAPStream) Update(c kline.Candle) (value float64, ready bool) {
+	v.count++
+	pv := Typical.Value(&c) * c.Volume
+	if old, evicted := v.priceVolume.push(pv); evicted {
+		v.pvSum -= old
+	}
+	if old, evicted := v.volume.push(c.Volume); evicted {
+		v.volumeSum -= old
+	}
+	v.pvSum += pv
+	v.volumeSum += c.Volume
+	if v.period > 0 && v.count < v.period {
+		return 0, false
+	}
+	if v.volumeSum == 0 {
+		return 0, true
+	}
+	return v.pvSum / v.volumeSum, true
+}
+
+// Reset clears all candles added so far
+func (v *VWAPStream) Reset() {
+	v.count = 0
+	v.priceVolume.reset()
+	v.volume.reset()
+	v.pvSum = 0
+	v.volumeSum = 0
+}
+
+// NewMACDStream returns a streaming moving average convergence divergence of
+// the source
+func NewMACDStream(fastPeriod, slowPeriod, signalPeriod int, source Source) (*MACDStream, error) {
+	if fastPeriod <= 0 || slowPeriod <= 0 || signalPeriod <= 0 {
+		return nil, errInvalidPeriod
+	}
+	if fastPeriod > slowPeriod {
+		return nil, errInvalidMACDPeriods
+	}
+	return &MACDStream{
+		source: source,
+		fast:   newEMAState(fastPeriod),
+		slow:   newEMAState(slowPeriod),
+		signal: newEMAState(signalPeriod),
+	}, nil
+}
+
+// Update adds the next candle and returns the MACD line
+func (m *MACDStream) Update(c kline.Candle) (value float64, ready bool) {
+	v := m.source.Value(&c)
+	fast, _ := m.fast.add(v)
+	slow, ok := m.slow.add(v)
+	if !ok {
+		return 0, false
+	}
+	m.macd = fast - slow
+	if _, ok = m.signal.add(m.macd); !ok {
+		return 0, false
+	}
+	return m.macd, true
+}
+
+// Signal returns the latest signal line value
+func (m *MACDStream) Signal() float64 {
+	return m.signal.value
+}
+
+// Histogram returns the latest MACD line less the signal line
+func (m *MACDStream) Histogram() float64 {
+	return m.macd - m.signal.value
+}
+
+// Reset clears all candles added so far
+func (m *MACDStream) Reset() {
+	m.fast.reset()
+	m.slow.reset()
+	m.signal.reset()
+	m.macd = 0
+}
+
+// NewBollingerStream returns a streaming Bollinger Bands of the source
+func NewBollingerStream(period int, deviationUp, deviationDown float64, maType MaType, source Source) (*BollingerStream, error) {
+	if period <= 0 {
+		return nil, errInvalidPeriod
+	}
+	if deviationUp <= 0 || deviationDown <= 0 {
+		return nil, errInvalidParameter
+	}
+	if maType != Sma && maType != Ema {
+		return nil, errInvalidMovingAvg
+	}
+	return &BollingerStream{
+		source:      source,
+		maType:      maType,
+		deviationUp: deviationUp,
+		deviationDn: deviationDown,
+		sma:         newSMAState(period),
+		ema:         newEMAState(period),
+		squares:     newSMAState(period),
+	}, nil
+}
+
+// Update adds the next candle and returns the middle band
+func (b *BollingerStream) Update(c kline.Candle) (value float64, ready bool) {
+	v := b.source.Value(&c)
+	mean, ok := b.sma.add(v)
+	meanSquares, _ := b.squares.add(v * v)
+	ema, _ := b.ema.add(v)
+	if !ok {
+		return 0, false
+	}
+	var deviation float64
+	if variance := meanSquares - mean*mean; variance >= 1e-14 {
+		deviation = math.Sqrt(variance)
+	}
+	b.middle = mean
+	if b.maType == Ema {
+		b.middle = ema
+	}
+	b.upper = b.middle + deviation*b.deviationUp
+	b.lower = b.middle - deviation*b.deviationDn
+	return b.middle, true
+}
+
+// Upper returns the latest upper band
+func (b *BollingerStream) Upper() float64 {
+	return b.upper
+}
+
+// Lower returns the latest lower band
+func (b *BollingerStream) Lower() float64 {
+	return b.lower
+}
+
+// Reset clears all candles added so far
+func (b *BollingerStream) Reset() {
+	b.sma.reset()
+	b.ema.reset()
+	b.squares.reset()
+	b.upper = 0
+	b.middle = 0
+	b.lower = 0
+}
@@ -3,6 +3,7 @@ package kline
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -306,3 +307,150 @@ func (k *Item) FormatDates() {
 		k.Candles[x].Time = k.Candles[x].Time.UTC()
 	}
 }
+
+// ConvertToNewInterval aggregates candles into a longer interval which must be
+// a multiple of the current interval. Candles are grouped by their time
+// truncated to the new interval, so the first and last candles will be partial
+// if the candle range is not aligned to the new interval. OneMonth and OneYear
+// candles are grouped by calendar month and year in UTC, so they can be built
+// from any interval which divides a day, and years from months
+func (k *Item) ConvertToNewInterval(newInterval Interval) (*Item, error) {
+	if k.Interval <= 0 {
+		return nil, errors.New("current interval unset")
+	}
+	if newInterval <= k.Interval {
+		return nil, ErrCanOnlyUpscaleCandles
+	}
+	if !CanConvert(k.Interval, newInterval) {
+		return nil, ErrWholeNumberScaling
+	}
+
+	candles := make([]Candle, len(k.Candles))
+	copy(candles, k.Candles)
+	sort.Sort(ByDate(candles))
+
+	var out []Candle
+	for i := range candles {
+		t := newInterval.CandleStart(candles[i].Time)
+		if len(out) == 0 || !out[len(out)-1].Time.Equal(t) {
+			c := candles[i]
+			c.Time = t
+			out = append(out, c)
+			continue
+		}
+		c := &out[len(out)-1]
+		if candles[i].High > c.High {
+			c.High = candles[i].High
+		}
+		if candles[i].Low < c.Low {
+			c.Low = candles[i].Low
+		}
+		c.Close = candles[i].Close
+		c.Volume += candles[i].Volume
+	}
+
+	return &Item{
+		Exchange: k.Exchange,
+		Pair:     k.Pair,
+		Asset:    k.Asset,
+		Interval: newInterval,
+		Candles:  out,
+	}, nil
+}
+
+// CanConvert returns whether candles at one interval can be grouped into
+// another, calendar months are built from intervals which divide a day and
+// calendar years from those or months
+func CanConvert(from, to Interval) bool {
+	switch {
+	case to <= from:
+		return false
+	case to == OneYear && from == OneMonth:
+		return true
+	case to == OneMonth || to == OneYear:
+		return OneDay%from == 0
+	}
+	return to%from == 0
+}
+
+// CandleStart returns the open time in UTC of the candle containing t. Multi
+// day candles are aligned to the Unix epoch as exchanges do, apart from weekly
+// candles which open on a Monday
+func (i Interval) CandleStart(t time.Time) time.Time {
+	t = t.UTC()
+	switch {
+	case i == OneMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case i == OneYear:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	case i > OneDay && i%OneWeek != 0:
+		// Truncate measures from the zero time, which is not a whole number
+		// of these intervals before the epoch
+		d := int64(i.Duration() / time.Second)
+		offset := t.Unix() % d
+		if offset < 0 {
+			offset += d
+		}
+		return time.Unix(t.Unix()-offset, 0).UTC()
+	}
+	// the zero time is a Monday, so weeks truncate to Monday
+	return t.Truncate(i.Duration())
+}
+
+// HeikinAshi returns a copy of the item with its candles converted to
+// Heikin-Ashi candles. Candles are sorted by date before converting
+func (k *Item) HeikinAshi() *Item {
+	candles := make([]Candle, len(k.Candles))
+	copy(candles, k.Candles)
+	sort.Sort(ByDate(candles))
+	for i := range candles {
+		if i == 0 {
+			candles[i] = NextHeikinAshi(nil, candles[i])
+			continue
+		}
+		candles[i] = NextHeikinAshi(&candles[i-1], candles[i])
+	}
+	return &Item{
+		Exchange: k.Exchange,
+		Pair:     k.Pair,
+		Asset:    k.Asset,
+		Interval: k.Interval,
+		Candles:  candles,
+	}
+}
+
+// NextHeikinAshi converts a candle into a Heikin-Ashi candle using the
+// previous Heikin-Ashi candle, which is nil for the first candle in a series
+func NextHeikinAshi(prev *Candle, c Candle) Candle {
+	ha := Candle{
+		Time:   c.Time,
+		Close:  (c.Open + c.High + c.Low + c.Close) / 4,
+		Volume: c.Volume,
+	}
+	if prev == nil {
+		ha.Open = (c.Open + c.Close) / 2
+	} else {
+		ha.Open = (prev.Open + prev.Close) / 2
+	}
+	ha.High = math.Max(c.High, math.Max(ha.Open, ha.Close))
+	ha.Low = math.Min(c.Low, math.Min(ha.Open, ha.Close))
+	return ha
+}
+
+// SourceInterval returns the interval candles should be retrieved at in order
+// to build the required interval. This is the required interval itself when it
+// is enabled, otherwise the longest enabled interval it can be converted from
+func (e *ExchangeCapabilitiesEnabled) SourceInterval(required Interval) (Interval, error) {
+	if e.Intervals[required.Word()] {
+		return required, nil
+	}
+	for i := len(SupportedIntervals) - 1; i >= 0; i-- {
+		if !CanConvert(SupportedIntervals[i], required) {
+			continue
+		}
+		if e.Intervals[SupportedIntervals[i].Word()] {
+			return SupportedIntervals[i], nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %v", ErrNoSourceInterval, required)
+}
@@ -78,7 +78,7 @@ func StoreInDatabase(in *Item, force bool) (uint64, error) {
 
 	for x := range in.Candles {
 		databaseCandles.Candles = append(databaseCandles.Candles, candle.Candle{
-			Timestamp: in.Candles[x].Time.Truncate(in.Interval.Duration()),
+			Timestamp: in.Interval.CandleStart(in.Candles[x].Time),
 			Open:      in.Candles[x].Open,
 			High:      in.Candles[x].High,
 			Low:       in.Candles[x].Low,
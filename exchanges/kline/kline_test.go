@@ -445,6 +445,190 @@ func TestItem_SortCandlesByTimestamp(t *testing.T) {
 	}
 }
 
+func TestConvertToNewInterval(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := Item{
+		Exchange: "testExchange",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: OneMin,
+	}
+	_, err := old.ConvertToNewInterval(OneMin)
+	if !errors.Is(err, ErrCanOnlyUpscaleCandles) {
+		t.Errorf("received %v, expected %v", err, ErrCanOnlyUpscaleCandles)
+	}
+	_, err = old.ConvertToNewInterval(Interval(time.Second * 90))
+	if !errors.Is(err, ErrWholeNumberScaling) {
+		t.Errorf("received %v, expected %v", err, ErrWholeNumberScaling)
+	}
+
+	old.Interval = OneMin
+	for x := 29; x >= 0; x-- {
+		old.Candles = append(old.Candles, Candle{
+			Time:   start.Add(time.Duration(x) * time.Minute),
+			Open:   float64(x),
+			High:   float64(x) + 10,
+			Low:    float64(x) - 10,
+			Close:  float64(x) + 1,
+			Volume: 1,
+		})
+	}
+	newItem, err := old.ConvertToNewInterval(FifteenMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newItem.Interval != FifteenMin || newItem.Exchange != old.Exchange {
+		t.Errorf("unexpected item details %+v", newItem)
+	}
+	if len(newItem.Candles) != 2 {
+		t.Fatalf("expected 2 candles received %v", len(newItem.Candles))
+	}
+	expected := []Candle{
+		{Time: start, Open: 0, High: 24, Low: -10, Close: 15, Volume: 15},
+		{Time: start.Add(time.Minute * 15), Open: 15, High: 39, Low: 5, Close: 30, Volume: 15},
+	}
+	for i := range expected {
+		if newItem.Candles[i] != expected[i] {
+			t.Errorf("received %+v, expected %+v", newItem.Candles[i], expected[i])
+		}
+	}
+	if old.Candles[0].Time.Equal(start) {
+		t.Error("expected original candles to be left unsorted")
+	}
+
+	_, err = old.ConvertToNewInterval(OneMonth)
+	if err != nil {
+		t.Error(err)
+	}
+	old.Interval = OneWeek
+	_, err = old.ConvertToNewInterval(OneMonth)
+	if !errors.Is(err, ErrWholeNumberScaling) {
+		t.Errorf("received %v, expected %v", err, ErrWholeNumberScaling)
+	}
+
+	days := Item{Interval: OneDay}
+	for d := start; d.Year() < 2022; d = d.AddDate(0, 0, 1) {
+		days.Candles = append(days.Candles, Candle{Time: d, Open: 1, High: 2, Low: 1, Close: 1, Volume: 1})
+	}
+	months, err := days.ConvertToNewInterval(OneMonth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(months.Candles) != 24 {
+		t.Fatalf("expected 24 candles received %v", len(months.Candles))
+	}
+	if !months.Candles[1].Time.Equal(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)) || months.Candles[1].Volume != 29 {
+		t.Errorf("expected february 2020 to be aligned to the calendar month, received %+v", months.Candles[1])
+	}
+	years, err := months.ConvertToNewInterval(OneYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(years.Candles) != 2 ||
+		!years.Candles[1].Time.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) ||
+		years.Candles[0].Volume != 366 || years.Candles[1].Volume != 365 {
+		t.Errorf("expected candles to be aligned to the calendar year, received %+v", years.Candles)
+	}
+}
+
+func TestCandleStart(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2021, 1, 1, 12, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		interval Interval
+		t        time.Time
+		expected time.Time
+	}{
+		{FifteenMin, tm, time.Date(2021, 1, 1, 12, 30, 0, 0, time.UTC)},
+		{FourHour, tm, time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{OneDay, tm, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// Binance 3d candle open time 1609372800000
+		{ThreeDay, tm, time.Unix(1609372800, 0).UTC()},
+		{FifteenDay, tm, time.Date(2020, 12, 19, 0, 0, 0, 0, time.UTC)},
+		{ThreeDay, time.Date(1969, 12, 31, 1, 0, 0, 0, time.UTC), time.Date(1969, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{OneWeek, tm, time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC)},
+		{OneMonth, tm, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{OneYear, tm, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for x := range testCases {
+		if received := testCases[x].interval.CandleStart(testCases[x].t); !received.Equal(testCases[x].expected) {
+			t.Errorf("%v received %v, expected %v", testCases[x].interval, received, testCases[x].expected)
+		}
+	}
+}
+
+func TestHeikinAshi(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := Item{
+		Exchange: "testExchange",
+		Interval: OneMin,
+		Candles: []Candle{
+			{Time: start.Add(time.Minute), Open: 12, High: 16, Low: 10, Close: 14, Volume: 2},
+			{Time: start, Open: 10, High: 14, Low: 8, Close: 12, Volume: 1},
+		},
+	}
+	ha := k.HeikinAshi()
+	if ha.Interval != OneMin || ha.Exchange != k.Exchange {
+		t.Errorf("unexpected item details %+v", ha)
+	}
+	expected := []Candle{
+		{Time: start, Open: 11, High: 14, Low: 8, Close: 11, Volume: 1},
+		{Time: start.Add(time.Minute), Open: 11, High: 16, Low: 10, Close: 13, Volume: 2},
+	}
+	for i := range expected {
+		if ha.Candles[i] != expected[i] {
+			t.Errorf("received %+v, expected %+v", ha.Candles[i], expected[i])
+		}
+	}
+	if k.Candles[0].Close != 14 {
+		t.Error("expected original candles to be left unchanged")
+	}
+}
+
+func TestSourceInterval(t *testing.T) {
+	e := ExchangeCapabilitiesEnabled{
+		Intervals: map[string]bool{
+			OneMin.Word():     true,
+			FiveMin.Word():    true,
+			OneHour.Word():    true,
+			FourHour.Word():   true,
+			FifteenDay.Word(): false,
+		},
+	}
+	i, err := e.SourceInterval(OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != OneHour {
+		t.Errorf("received %v, expected %v", i, OneHour)
+	}
+	i, err = e.SourceInterval(OneDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != FourHour {
+		t.Errorf("received %v, expected %v", i, FourHour)
+	}
+	i, err = e.SourceInterval(FifteenMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != FiveMin {
+		t.Errorf("received %v, expected %v", i, FiveMin)
+	}
+	i, err = e.SourceInterval(OneMonth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != FourHour {
+		t.Errorf("received %v, expected %v", i, FourHour)
+	}
+	_, err = e.SourceInterval(FifteenSecond)
+	if !errors.Is(err, ErrNoSourceInterval) {
+		t.Errorf("received %v, expected %v", err, ErrNoSourceInterval)
+	}
+}
+
 func setupTest(t *testing.T) {
 	if verbose {
 		testhelpers.EnableVerboseTestOutput()
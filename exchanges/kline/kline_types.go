@@ -1,6 +1,7 @@
 package kline
 
 import (
+	"errors"
 	"time"
 
 	"github.com/idoall/gocryptotrader/currency"
@@ -32,6 +33,42 @@ const (
 	OneYear       = 365 * OneDay
 )
 
+var (
+	// ErrCanOnlyUpscaleCandles returned when attempting to convert candles to
+	// an interval which is not longer than their current interval
+	ErrCanOnlyUpscaleCandles = errors.New("candles can only be converted to a longer interval")
+	// ErrWholeNumberScaling returned when the new interval is not a multiple
+	// of the current interval
+	ErrWholeNumberScaling = errors.New("new interval must be a multiple of the current interval")
+	// ErrNoSourceInterval returned when no enabled interval can be converted
+	// to the requested interval
+	ErrNoSourceInterval = errors.New("no enabled interval can be converted to the requested interval")
+
+	// SupportedIntervals holds every defined interval in ascending order
+	SupportedIntervals = []Interval{
+		FifteenSecond,
+		OneMin,
+		ThreeMin,
+		FiveMin,
+		TenMin,
+		FifteenMin,
+		ThirtyMin,
+		OneHour,
+		TwoHour,
+		FourHour,
+		SixHour,
+		EightHour,
+		TwelveHour,
+		OneDay,
+		ThreeDay,
+		OneWeek,
+		FifteenDay,
+		TwoWeek,
+		OneMonth,
+		OneYear,
+	}
+)
+
 const (
 	// ErrRequestExceedsExchangeLimits locale for exceeding rate limits message
 	ErrRequestExceedsExchangeLimits = "requested data would exceed exchange limits please lower range or use GetHistoricCandlesEx"
@@ -683,7 +683,7 @@ func (k *Kraken) wsProcessSpread(channelData *WebsocketChannelData, data []inter
 
 // wsProcessTrades converts trade data and sends it to the datahandler
 func (k *Kraken) wsProcessTrades(channelData *WebsocketChannelData, data []interface{}) error {
-	if !k.IsSaveTradeDataEnabled() {
+	if !k.IsTradeFeedEnabled() {
 		return nil
 	}
 	var trades []trade.Data
@@ -721,7 +721,7 @@ func (k *Kraken) wsProcessTrades(channelData *WebsocketChannelData, data []inter
 			Side:         tSide,
 		})
 	}
-	return trade.AddTradesToBuffer(k.Name, trades...)
+	return k.AddTradesToBuffer(trades...)
 }
 
 // wsProcessOrderBook determines if the orderbook data is partial or update
@@ -0,0 +1,298 @@
+package liquidation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	liquidationsql "github.com/idoall/gocryptotrader/database/repository/liquidation"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+func init() {
+	service = new(Service)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeToExchangeLiquidations subcribes to all liquidations on an exchange
+func SubscribeToExchangeLiquidations(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.Lock()
+	id, err := service.getExchangeID(exchange)
+	service.Unlock()
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return service.mux.Subscribe(id)
+}
+
+// SubscribeToAllLiquidations subcribes to liquidations across all exchanges
+func SubscribeToAllLiquidations() (dispatch.Pipe, error) {
+	service.Lock()
+	id, err := service.getAllID()
+	service.Unlock()
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return service.mux.Subscribe(id)
+}
+
+// Process validates and publishes incoming liquidations to the exchange and
+// aggregated dispatch streams
+func Process(liquidations ...Data) error {
+	if len(liquidations) == 0 {
+		return nil
+	}
+	var errs common.Errors
+	for i := range liquidations {
+		err := liquidations[i].validate()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = service.publish(&liquidations[i])
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validate checks the liquidation data and normalises the side, amount and
+// timestamp
+func (d *Data) validate() error {
+	if d.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+	if d.Pair.IsEmpty() {
+		return fmt.Errorf("%s %s", d.Exchange, errPairNotSet)
+	}
+	if d.AssetType == "" {
+		return fmt.Errorf("%s %s %s", d.Exchange, d.Pair, errAssetTypeNotSet)
+	}
+	if d.Price <= 0 {
+		return fmt.Errorf("%s %s %s %s", d.Exchange, d.Pair, d.AssetType, errInvalidPrice)
+	}
+	if d.Amount < 0 {
+		d.Amount *= -1
+		d.Side = order.Sell
+	}
+	if d.Amount == 0 {
+		return fmt.Errorf("%s %s %s %s", d.Exchange, d.Pair, d.AssetType, errInvalidAmount)
+	}
+	switch d.Side {
+	case order.Bid:
+		d.Side = order.Buy
+	case order.Ask:
+		d.Side = order.Sell
+	}
+	if d.Timestamp.IsZero() {
+		d.Timestamp = time.Now()
+	}
+	return nil
+}
+
+// publish sends the liquidation to the exchange and aggregated streams
+func (s *Service) publish(d *Data) error {
+	name := strings.ToLower(d.Exchange)
+	s.Lock()
+	exchangeID, err := s.getExchangeID(name)
+	if err != nil {
+		s.Unlock()
+		return err
+	}
+	allID, err := s.getAllID()
+	if err != nil {
+		s.Unlock()
+		return err
+	}
+	s.Unlock()
+	return s.mux.Publish([]uuid.UUID{exchangeID, allID}, d)
+}
+
+// getExchangeID returns the dispatch ID for an exchange liquidation stream,
+// generating a new one if it does not exist. Must be called with the lock
+// held.
+func (s *Service) getExchangeID(exchange string) (uuid.UUID, error) {
+	id, ok := s.Exchange[exchange]
+	if ok {
+		return id, nil
+	}
+	id, err := s.mux.GetID()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	s.Exchange[exchange] = id
+	return id, nil
+}
+
+// getAllID returns the dispatch ID for the aggregated liquidation stream,
+// generating a new one if it does not exist. Must be called with the lock
+// held.
+func (s *Service) getAllID() (uuid.UUID, error) {
+	if s.All != uuid.Nil {
+		return s.All, nil
+	}
+	id, err := s.mux.GetID()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	s.All = id
+	return id, nil
+}
+
+// setup creates the liquidation processor
+func (p *Processor) setup(wg *sync.WaitGroup) {
+	p.mutex.Lock()
+	p.bufferProcessorInterval = BufferProcessorIntervalTime
+	p.mutex.Unlock()
+	go p.Run(wg)
+}
+
+// AddLiquidationsToBuffer will push liquidation data onto the buffer to be
+// saved to the database
+func AddLiquidationsToBuffer(exchangeName string, data ...Data) error {
+	if database.DB == nil || database.DB.Config == nil || !database.DB.Config.Enabled {
+		return nil
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if atomic.AddInt32(&processor.started, 0) == 0 {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		processor.setup(&wg)
+		wg.Wait()
+	}
+	var errs common.Errors
+	var validDatas []Data
+	for i := range data {
+		err := data[i].validate()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v received invalid liquidation data: %w", exchangeName, err))
+			continue
+		}
+		uu, err := uuid.NewV4()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s uuid failed to generate for liquidation: %+v", exchangeName, data[i]))
+			continue
+		}
+		data[i].ID = uu
+		validDatas = append(validDatas, data[i])
+	}
+	processor.mutex.Lock()
+	processor.buffer = append(processor.buffer, validDatas...)
+	processor.mutex.Unlock()
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Run will save liquidation data to the database in batches
+func (p *Processor) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	if !atomic.CompareAndSwapInt32(&p.started, 0, 1) {
+		log.Error(log.DatabaseMgr, "liquidation processor already started")
+		return
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&p.started, 1, 0)
+	}()
+	p.mutex.Lock()
+	ticker := time.NewTicker(p.bufferProcessorInterval)
+	p.mutex.Unlock()
+	for {
+		<-ticker.C
+		p.mutex.Lock()
+		bufferCopy := append(p.buffer[:0:0], p.buffer...)
+		p.buffer = nil
+		p.mutex.Unlock()
+		if len(bufferCopy) == 0 {
+			ticker.Stop()
+			return
+		}
+		err := SaveLiquidationsToDatabase(bufferCopy...)
+		if err != nil {
+			log.Error(log.DatabaseMgr, err)
+		}
+	}
+}
+
+// SaveLiquidationsToDatabase converts liquidations and saves results to the
+// database
+func SaveLiquidationsToDatabase(liquidations ...Data) error {
+	if len(liquidations) == 0 {
+		return nil
+	}
+	sqlData := make([]liquidationsql.Data, len(liquidations))
+	for i := range liquidations {
+		sqlData[i] = liquidationsql.Data{
+			Exchange:  liquidations[i].Exchange,
+			Base:      liquidations[i].Pair.Base.String(),
+			Quote:     liquidations[i].Pair.Quote.String(),
+			AssetType: liquidations[i].AssetType.String(),
+			Side:      liquidations[i].Side.String(),
+			Price:     liquidations[i].Price,
+			Amount:    liquidations[i].Amount,
+			Timestamp: liquidations[i].Timestamp,
+		}
+		if liquidations[i].ID != uuid.Nil {
+			sqlData[i].ID = liquidations[i].ID.String()
+		}
+	}
+	return liquidationsql.Insert(sqlData...)
+}
+
+// GetLiquidationsInRange returns saved liquidations between a set of dates
+func GetLiquidationsInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" || startDate.IsZero() || endDate.IsZero() {
+		return nil, errors.New("invalid arguments received")
+	}
+	results, err := liquidationsql.GetInRange(exchangeName, assetType, base, quote, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Data, len(results))
+	for i := range results {
+		var cp currency.Pair
+		cp, err = currency.NewPairFromStrings(results[i].Base, results[i].Quote)
+		if err != nil {
+			return nil, err
+		}
+		a := asset.Item(results[i].AssetType)
+		if !a.IsValid() {
+			return nil, fmt.Errorf("invalid asset type %v", a)
+		}
+		var s order.Side
+		s, err = order.StringToOrderSide(results[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Data{
+			ID:        uuid.FromStringOrNil(results[i].ID),
+			Exchange:  results[i].Exchange,
+			Pair:      cp.Upper(),
+			AssetType: a,
+			Side:      s,
+			Price:     results[i].Price,
+			Amount:    results[i].Amount,
+			Timestamp: results[i].Timestamp.UTC(),
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,195 @@
+package liquidation
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/database"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func testData() Data {
+	return Data{
+		Exchange:  "Binance",
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Future,
+		Side:      order.Sell,
+		Price:     10000,
+		Amount:    1,
+		Timestamp: time.Now(),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	d := testData()
+	d.Exchange = ""
+	if err := d.validate(); err == nil || err.Error() != errExchangeNameUnset {
+		t.Errorf("expected %v received %v", errExchangeNameUnset, err)
+	}
+
+	d = testData()
+	d.Pair = currency.Pair{}
+	if err := d.validate(); err == nil {
+		t.Error("expected error for empty pair")
+	}
+
+	d = testData()
+	d.AssetType = ""
+	if err := d.validate(); err == nil {
+		t.Error("expected error for empty asset type")
+	}
+
+	d = testData()
+	d.Price = 0
+	if err := d.validate(); err == nil {
+		t.Error("expected error for invalid price")
+	}
+
+	d = testData()
+	d.Amount = 0
+	if err := d.validate(); err == nil {
+		t.Error("expected error for invalid amount")
+	}
+
+	d = testData()
+	d.Side = order.Buy
+	d.Amount = -5
+	if err := d.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Amount != 5 || d.Side != order.Sell {
+		t.Errorf("expected negative amount to be normalised, received %v %v",
+			d.Amount,
+			d.Side)
+	}
+
+	d = testData()
+	d.Side = order.Bid
+	d.Timestamp = time.Time{}
+	if err := d.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Side != order.Buy {
+		t.Errorf("expected side %v received %v", order.Buy, d.Side)
+	}
+	if d.Timestamp.IsZero() {
+		t.Error("expected timestamp to be set")
+	}
+}
+
+func TestProcess(t *testing.T) {
+	exchPipe, err := SubscribeToExchangeLiquidations("binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	allPipe, err := SubscribeToAllLiquidations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Process()
+	if err != nil {
+		t.Error(err)
+	}
+
+	bad := testData()
+	bad.Price = 0
+	err = Process(bad)
+	if err == nil {
+		t.Error("expected error for invalid liquidation")
+	}
+
+	// dispatch drops payloads when a receiver is not ready, so keep
+	// publishing until both streams have received a liquidation
+	received := make(chan Data, 2)
+	for _, p := range []dispatch.Pipe{exchPipe, allPipe} {
+		go func(p dispatch.Pipe) {
+			data := <-p.C
+			l, ok := (*data.(*interface{})).(Data)
+			if !ok {
+				t.Error("unable to type assert liquidation data")
+			}
+			received <- l
+		}(p)
+	}
+	timeout := time.After(time.Second * 2)
+	for count := 0; count < 2; {
+		err = Process(testData())
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case l := <-received:
+			if l.Price != 10000 || l.Side != order.Sell {
+				t.Errorf("unexpected liquidation received %+v", l)
+			}
+			count++
+		case <-timeout:
+			t.Fatal("timed out waiting for liquidation")
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+
+	err = exchPipe.Release()
+	if err != nil {
+		t.Error(err)
+	}
+	err = allPipe.Release()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAddLiquidationsToBuffer(t *testing.T) {
+	err := AddLiquidationsToBuffer("Binance", testData())
+	if err != nil {
+		t.Error(err)
+	}
+	processor.mutex.Lock()
+	if len(processor.buffer) != 0 {
+		t.Error("expected liquidations to be ignored when database is disabled")
+	}
+	processor.mutex.Unlock()
+
+	if database.DB.Config != nil {
+		t.Skip("database config already set")
+	}
+	database.DB.Config = &database.Config{Enabled: true}
+	defer func() { database.DB.Config = nil }()
+	processor.mutex.Lock()
+	processor.bufferProcessorInterval = time.Hour
+	processor.mutex.Unlock()
+
+	bad := testData()
+	bad.Amount = 0
+	err = AddLiquidationsToBuffer("Binance", testData(), bad)
+	if err == nil {
+		t.Error("expected error for invalid liquidation")
+	}
+	processor.mutex.Lock()
+	if len(processor.buffer) != 1 {
+		t.Errorf("expected 1 buffered liquidation received %v", len(processor.buffer))
+	}
+	processor.buffer = nil
+	processor.mutex.Unlock()
+}
+
+func TestGetLiquidationsInRange(t *testing.T) {
+	_, err := GetLiquidationsInRange("", "", "", "", time.Time{}, time.Time{})
+	if err == nil {
+		t.Error("expected error for invalid arguments")
+	}
+}
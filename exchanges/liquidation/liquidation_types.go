@@ -0,0 +1,66 @@
+package liquidation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+// const values for the liquidation package
+const (
+	errExchangeNameUnset = "liquidation exchange name not set"
+	errPairNotSet        = "liquidation currency pair not set"
+	errAssetTypeNotSet   = "liquidation asset type not set"
+	errInvalidPrice      = "liquidation price is invalid"
+	errInvalidAmount     = "liquidation amount is invalid"
+
+	// DefaultProcessorIntervalTime is the default interval in which buffered
+	// liquidations are saved to the database
+	DefaultProcessorIntervalTime = time.Second * 15
+)
+
+// Vars for the liquidation package
+var (
+	service   *Service
+	processor Processor
+	// BufferProcessorIntervalTime is the interval to save liquidation buffer
+	// data to the database
+	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
+)
+
+// Service holds the dispatch IDs for liquidation streams on each individual
+// exchange as well as an aggregated stream for all exchanges
+type Service struct {
+	Exchange map[string]uuid.UUID
+	All      uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// Data defines a forced liquidation event
+type Data struct {
+	ID        uuid.UUID `json:"ID,omitempty"`
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	// Side is the side of the liquidation order, a sell indicates a long
+	// position has been liquidated
+	Side      order.Side
+	Price     float64
+	Amount    float64
+	Timestamp time.Time
+}
+
+// Processor used for processing liquidation data in batches
+// and saving them to the database
+type Processor struct {
+	mutex                   sync.Mutex
+	started                 int32
+	bufferProcessorInterval time.Duration
+	buffer                  []Data
+}
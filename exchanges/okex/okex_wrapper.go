@@ -468,15 +468,15 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		}
 
 		for j := range resp {
-			p := strings.Split(resp[j].InstrumentID, currency.DashDelimiter)
-			nC := currency.NewPairWithDelimiter(p[0]+currency.DashDelimiter+p[1],
-				p[2],
+			parts := strings.Split(resp[j].InstrumentID, currency.DashDelimiter)
+			nC := currency.NewPairWithDelimiter(parts[0]+currency.DashDelimiter+parts[1],
+				parts[2],
 				currency.UnderscoreDelimiter)
 			if !enabled.Contains(nC, true) {
 				continue
 			}
 
-			err = ticker.ProcessTicker(&ticker.Price{
+			tickerPrice := &ticker.Price{
 				Last:         resp[j].Last,
 				High:         resp[j].High24H,
 				Low:          resp[j].Low24H,
@@ -486,7 +486,14 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 				Pair:         nC,
 				LastUpdated:  resp[j].Timestamp,
 				ExchangeName: o.Name,
-				AssetType:    assetType})
+				AssetType:    assetType}
+			if nC.Equal(p) {
+				err = o.setSwapDerivativeData(resp[j].InstrumentID, tickerPrice)
+				if err != nil {
+					return nil, err
+				}
+			}
+			err = ticker.ProcessTicker(tickerPrice)
 			if err != nil {
 				return nil, err
 			}
@@ -504,15 +511,15 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		}
 
 		for j := range resp {
-			p := strings.Split(resp[j].InstrumentID, currency.DashDelimiter)
-			nC := currency.NewPairWithDelimiter(p[0]+currency.DashDelimiter+p[1],
-				p[2],
+			parts := strings.Split(resp[j].InstrumentID, currency.DashDelimiter)
+			nC := currency.NewPairWithDelimiter(parts[0]+currency.DashDelimiter+parts[1],
+				parts[2],
 				currency.UnderscoreDelimiter)
 			if !enabled.Contains(nC, true) {
 				continue
 			}
 
-			err = ticker.ProcessTicker(&ticker.Price{
+			tickerPrice := &ticker.Price{
 				Last:         resp[j].Last,
 				High:         resp[j].High24h,
 				Low:          resp[j].Low24h,
@@ -522,7 +529,14 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 				Pair:         nC,
 				LastUpdated:  resp[j].Timestamp,
 				ExchangeName: o.Name,
-				AssetType:    assetType})
+				AssetType:    assetType}
+			if nC.Equal(p) {
+				err = o.setFuturesDerivativeData(resp[j].InstrumentID, tickerPrice)
+				if err != nil {
+					return nil, err
+				}
+			}
+			err = ticker.ProcessTicker(tickerPrice)
 			if err != nil {
 				return nil, err
 			}
@@ -532,6 +546,59 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 	return ticker.GetTicker(o.Name, p, assetType)
 }
 
+// setSwapDerivativeData fetches and sets the mark price, index price, funding
+// rate and open interest for a perpetual swap ticker
+func (o *OKEX) setSwapDerivativeData(instrumentID string, t *ticker.Price) error {
+	mark, err := o.GetSwapMarkPrice(instrumentID)
+	if err != nil {
+		return err
+	}
+	index, err := o.GetSwapIndices(instrumentID)
+	if err != nil {
+		return err
+	}
+	funding, err := o.GetSwapNextSettlementTime(instrumentID)
+	if err != nil {
+		return err
+	}
+	openInterest, err := o.GetSwapOpenInterest(instrumentID)
+	if err != nil {
+		return err
+	}
+	t.MarkPrice = mark.MarkPrice
+	t.IndexPrice = index.Index
+	t.FundingRate = funding.FundingRate
+	t.NextFundingTime = funding.FundingTime
+	t.OpenInterest = openInterest.Amount
+	return nil
+}
+
+// setFuturesDerivativeData fetches and sets the mark price, index price and
+// open interest for a futures ticker
+func (o *OKEX) setFuturesDerivativeData(instrumentID string, t *ticker.Price) error {
+	mark, err := o.GetFuturesCurrentMarkPrice(instrumentID)
+	if err != nil {
+		return err
+	}
+	// Futures indices are referenced by their underlying e.g. BTC-USD
+	underlying := instrumentID
+	if i := strings.LastIndex(instrumentID, currency.DashDelimiter); i > 0 {
+		underlying = instrumentID[:i]
+	}
+	index, err := o.GetFuturesIndices(underlying)
+	if err != nil {
+		return err
+	}
+	openInterest, err := o.GetFuturesOpenInterests(instrumentID)
+	if err != nil {
+		return err
+	}
+	t.MarkPrice = mark.MarkPrice
+	t.IndexPrice = index.Index
+	t.OpenInterest = openInterest.Amount
+	return nil
+}
+
 // FetchTicker returns the ticker for a currency pair
 func (o *OKEX) FetchTicker(p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
 	if assetType == asset.Index {
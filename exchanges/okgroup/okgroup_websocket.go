@@ -446,7 +446,7 @@ func (o *OKGroup) wsProcessTickers(respRaw []byte) error {
 
 // wsProcessTrades converts trade data and sends it to the datahandler
 func (o *OKGroup) wsProcessTrades(respRaw []byte) error {
-	if !o.IsSaveTradeDataEnabled() {
+	if !o.IsTradeFeedEnabled() {
 		return nil
 	}
 	var response WebsocketTradeResponse
@@ -493,7 +493,7 @@ func (o *OKGroup) wsProcessTrades(respRaw []byte) error {
 			TID:          response.Data[i].TradeID,
 		})
 	}
-	return trade.AddTradesToBuffer(o.Name, trades...)
+	return o.AddTradesToBuffer(trades...)
 }
 
 // wsProcessCandles converts candle data and sends it to the data handler
@@ -0,0 +1,54 @@
+package order
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/dispatch"
+)
+
+func init() {
+	fills = &fillService{
+		exchange: make(map[string]uuid.UUID),
+		mux:      dispatch.GetNewMux(),
+	}
+}
+
+// SubscribeToExchangeFills subscribes to filled and partially filled order
+// updates for an exchange
+func SubscribeToExchangeFills(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	fills.Lock()
+	id, ok := fills.exchange[exchange]
+	if !ok {
+		var err error
+		id, err = fills.mux.GetID()
+		if err != nil {
+			fills.Unlock()
+			return dispatch.Pipe{}, err
+		}
+		fills.exchange[exchange] = id
+	}
+	fills.Unlock()
+	return fills.mux.Subscribe(id)
+}
+
+// PublishFill sends a filled or partially filled order to its exchange fill
+// stream. Exchanges without a stream have never been subscribed to so are
+// skipped
+func PublishFill(d *Detail) error {
+	if d == nil {
+		return fmt.Errorf("%w: order detail is nil", ErrOrderNotFilled)
+	}
+	if d.Status != Filled && d.Status != PartiallyFilled {
+		return fmt.Errorf("%s %s %w", d.Exchange, d.ID, ErrOrderNotFilled)
+	}
+	fills.Lock()
+	id, ok := fills.exchange[strings.ToLower(d.Exchange)]
+	fills.Unlock()
+	if !ok {
+		return nil
+	}
+	return fills.mux.Publish([]uuid.UUID{id}, d)
+}
@@ -0,0 +1,70 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+func TestPublishFill(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := PublishFill(nil)
+	if !errors.Is(err, ErrOrderNotFilled) {
+		t.Errorf("received %v, expected %v", err, ErrOrderNotFilled)
+	}
+	d := &Detail{
+		Exchange:       "FillTest",
+		ID:             "1337",
+		Pair:           currency.NewPair(currency.BTC, currency.USD),
+		AssetType:      asset.Spot,
+		Status:         Active,
+		Amount:         2,
+		ExecutedAmount: 1,
+	}
+	err = PublishFill(d)
+	if !errors.Is(err, ErrOrderNotFilled) {
+		t.Errorf("received %v, expected %v", err, ErrOrderNotFilled)
+	}
+	d.Status = PartiallyFilled
+	err = PublishFill(d)
+	if err != nil {
+		t.Fatalf("expected unsubscribed exchange to be skipped, received %v", err)
+	}
+
+	pipe, err := SubscribeToExchangeFills("filltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+	// the dispatcher drops data on a handshake timeout so publish until a
+	// fill is received
+	for i := 0; i < 10; i++ {
+		err = PublishFill(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case data := <-pipe.C:
+			received := (*data.(*interface{})).(Detail)
+			if received.ID != d.ID || received.ExecutedAmount != d.ExecutedAmount {
+				t.Errorf("received %+v, expected %+v", received, d)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	t.Fatal("expected fill to be published")
+}
@@ -0,0 +1,193 @@
+package order
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	gctmath "github.com/idoall/gocryptotrader/common/math"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+// LoadLimits loads all trading rules levels into memory, replacing any
+// previously loaded level for the same asset and currency pair
+func (e *ExecutionLimits) LoadLimits(levels []MinMaxLevel) error {
+	if len(levels) == 0 {
+		return fmt.Errorf("cannot load limits: %w", ErrExchangeLimitNotLoaded)
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	if e.m == nil {
+		e.m = make(map[asset.Item]map[*currency.Item]map[*currency.Item]*MinMaxLevel)
+	}
+
+	for i := range levels {
+		if levels[i].Pair.IsEmpty() {
+			return fmt.Errorf("cannot load limits: %w", ErrPairIsEmpty)
+		}
+		if !levels[i].Asset.IsValid() {
+			return fmt.Errorf("cannot load limits for %s: %w",
+				levels[i].Pair,
+				ErrAssetNotSet)
+		}
+		if levels[i].MinPrice > 0 &&
+			levels[i].MaxPrice > 0 &&
+			levels[i].MinPrice > levels[i].MaxPrice {
+			return fmt.Errorf("%s %s invalid price limits, minimum %f exceeds maximum %f",
+				levels[i].Asset,
+				levels[i].Pair,
+				levels[i].MinPrice,
+				levels[i].MaxPrice)
+		}
+		if levels[i].MinAmount > 0 &&
+			levels[i].MaxAmount > 0 &&
+			levels[i].MinAmount > levels[i].MaxAmount {
+			return fmt.Errorf("%s %s invalid amount limits, minimum %f exceeds maximum %f",
+				levels[i].Asset,
+				levels[i].Pair,
+				levels[i].MinAmount,
+				levels[i].MaxAmount)
+		}
+
+		m1, ok := e.m[levels[i].Asset]
+		if !ok {
+			m1 = make(map[*currency.Item]map[*currency.Item]*MinMaxLevel)
+			e.m[levels[i].Asset] = m1
+		}
+		m2, ok := m1[levels[i].Pair.Base.Item]
+		if !ok {
+			m2 = make(map[*currency.Item]*MinMaxLevel)
+			m1[levels[i].Pair.Base.Item] = m2
+		}
+		level := levels[i]
+		m2[levels[i].Pair.Quote.Item] = &level
+	}
+	return nil
+}
+
+// GetOrderExecutionLimits returns the trading rules for an asset and currency
+// pair
+func (e *ExecutionLimits) GetOrderExecutionLimits(a asset.Item, cp currency.Pair) (MinMaxLevel, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+	level, err := e.getLevel(a, cp)
+	if err != nil {
+		return MinMaxLevel{}, err
+	}
+	return *level, nil
+}
+
+// CheckOrderExecutionLimits checks an order price and amount against the
+// loaded trading rules. If no rules are loaded for the asset and pair, nil
+// is returned.
+func (e *ExecutionLimits) CheckOrderExecutionLimits(a asset.Item, cp currency.Pair, price, amount float64, orderType Type) error {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+	level, err := e.getLevel(a, cp)
+	if err != nil {
+		if err == ErrExchangeLimitNotLoaded {
+			return nil
+		}
+		return err
+	}
+	err = level.Conforms(price, amount, orderType)
+	if err != nil {
+		return fmt.Errorf("%s %s %w", a, cp, err)
+	}
+	return nil
+}
+
+// getLevel returns the stored level, must be called with the lock held
+func (e *ExecutionLimits) getLevel(a asset.Item, cp currency.Pair) (*MinMaxLevel, error) {
+	if e.m == nil {
+		return nil, ErrExchangeLimitNotLoaded
+	}
+	m1, ok := e.m[a]
+	if !ok {
+		return nil, ErrExchangeLimitNotLoaded
+	}
+	m2, ok := m1[cp.Base.Item]
+	if !ok {
+		return nil, ErrExchangeLimitNotLoaded
+	}
+	level, ok := m2[cp.Quote.Item]
+	if !ok {
+		return nil, ErrExchangeLimitNotLoaded
+	}
+	return level, nil
+}
+
+// Conforms checks a price and amount against the level boundaries. Price
+// boundaries and minimum notional are only checked for limit orders.
+func (m *MinMaxLevel) Conforms(price, amount float64, orderType Type) error {
+	if m.MinAmount > 0 && amount < m.MinAmount {
+		return fmt.Errorf("%w %f, minimum %f",
+			ErrAmountBelowMin,
+			amount,
+			m.MinAmount)
+	}
+	if m.MaxAmount > 0 && amount > m.MaxAmount {
+		return fmt.Errorf("%w %f, maximum %f",
+			ErrAmountExceedsMax,
+			amount,
+			m.MaxAmount)
+	}
+	if orderType != Limit {
+		return nil
+	}
+	if m.MinPrice > 0 && price < m.MinPrice {
+		return fmt.Errorf("%w %f, minimum %f",
+			ErrPriceBelowMin,
+			price,
+			m.MinPrice)
+	}
+	if m.MaxPrice > 0 && price > m.MaxPrice {
+		return fmt.Errorf("%w %f, maximum %f",
+			ErrPriceExceedsMax,
+			price,
+			m.MaxPrice)
+	}
+	if m.MinNotional > 0 && price*amount < m.MinNotional {
+		return fmt.Errorf("%w %f, minimum %f",
+			ErrNotionalValueBelowMin,
+			price*amount,
+			m.MinNotional)
+	}
+	return nil
+}
+
+// ConformToPrice rounds a price to the nearest price step increment
+func (m *MinMaxLevel) ConformToPrice(price float64) float64 {
+	if m.PriceStepIncrementSize <= 0 {
+		return price
+	}
+	steps := math.Round(price / m.PriceStepIncrementSize)
+	return gctmath.RoundFloat(steps*m.PriceStepIncrementSize,
+		decimalPlaces(m.PriceStepIncrementSize))
+}
+
+// ConformToAmount rounds an amount down to the amount step increment so that
+// an order never exceeds the requested amount
+func (m *MinMaxLevel) ConformToAmount(amount float64) float64 {
+	if m.AmountStepIncrementSize <= 0 {
+		return amount
+	}
+	// small tolerance so values already on a step are not floored to the
+	// previous step due to floating point representation
+	steps := math.Floor(amount/m.AmountStepIncrementSize + 1e-9)
+	return gctmath.RoundFloat(steps*m.AmountStepIncrementSize,
+		decimalPlaces(m.AmountStepIncrementSize))
+}
+
+// decimalPlaces returns the number of decimal places of a step size
+func decimalPlaces(step float64) int {
+	s := strconv.FormatFloat(step, 'f', -1, 64)
+	for i := range s {
+		if s[i] == '.' {
+			return len(s) - i - 1
+		}
+	}
+	return 0
+}
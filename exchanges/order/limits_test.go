@@ -0,0 +1,172 @@
+package order
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+var btcusdt = currency.NewPair(currency.BTC, currency.USDT)
+
+func testLevel() MinMaxLevel {
+	return MinMaxLevel{
+		Pair:                    btcusdt,
+		Asset:                   asset.Spot,
+		MinPrice:                0.01,
+		MaxPrice:                1000000,
+		PriceStepIncrementSize:  0.01,
+		MinAmount:               0.000001,
+		MaxAmount:               9000,
+		AmountStepIncrementSize: 0.000001,
+		MinNotional:             10,
+	}
+}
+
+func TestLoadLimits(t *testing.T) {
+	var e ExecutionLimits
+	err := e.LoadLimits(nil)
+	if !errors.Is(err, ErrExchangeLimitNotLoaded) {
+		t.Fatalf("expected %v received %v", ErrExchangeLimitNotLoaded, err)
+	}
+
+	err = e.LoadLimits([]MinMaxLevel{{Asset: asset.Spot}})
+	if !errors.Is(err, ErrPairIsEmpty) {
+		t.Fatalf("expected %v received %v", ErrPairIsEmpty, err)
+	}
+
+	err = e.LoadLimits([]MinMaxLevel{{Pair: btcusdt}})
+	if !errors.Is(err, ErrAssetNotSet) {
+		t.Fatalf("expected %v received %v", ErrAssetNotSet, err)
+	}
+
+	bad := testLevel()
+	bad.MinPrice = 2000000
+	err = e.LoadLimits([]MinMaxLevel{bad})
+	if err == nil {
+		t.Fatal("expected error for invalid price boundaries")
+	}
+
+	bad = testLevel()
+	bad.MinAmount = 10000
+	err = e.LoadLimits([]MinMaxLevel{bad})
+	if err == nil {
+		t.Fatal("expected error for invalid amount boundaries")
+	}
+
+	err = e.LoadLimits([]MinMaxLevel{testLevel()})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetOrderExecutionLimits(t *testing.T) {
+	var e ExecutionLimits
+	_, err := e.GetOrderExecutionLimits(asset.Spot, btcusdt)
+	if !errors.Is(err, ErrExchangeLimitNotLoaded) {
+		t.Fatalf("expected %v received %v", ErrExchangeLimitNotLoaded, err)
+	}
+
+	err = e.LoadLimits([]MinMaxLevel{testLevel()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = e.GetOrderExecutionLimits(asset.Margin, btcusdt)
+	if !errors.Is(err, ErrExchangeLimitNotLoaded) {
+		t.Fatalf("expected %v received %v", ErrExchangeLimitNotLoaded, err)
+	}
+
+	_, err = e.GetOrderExecutionLimits(asset.Spot,
+		currency.NewPair(currency.LTC, currency.USDT))
+	if !errors.Is(err, ErrExchangeLimitNotLoaded) {
+		t.Fatalf("expected %v received %v", ErrExchangeLimitNotLoaded, err)
+	}
+
+	_, err = e.GetOrderExecutionLimits(asset.Spot,
+		currency.NewPair(currency.BTC, currency.USD))
+	if !errors.Is(err, ErrExchangeLimitNotLoaded) {
+		t.Fatalf("expected %v received %v", ErrExchangeLimitNotLoaded, err)
+	}
+
+	level, err := e.GetOrderExecutionLimits(asset.Spot, btcusdt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level.MinNotional != 10 {
+		t.Errorf("expected min notional 10 received %v", level.MinNotional)
+	}
+}
+
+func TestCheckOrderExecutionLimits(t *testing.T) {
+	var e ExecutionLimits
+	err := e.CheckOrderExecutionLimits(asset.Spot, btcusdt, 1, 1, Limit)
+	if err != nil {
+		t.Fatalf("expected no error when limits are not loaded received %v", err)
+	}
+
+	err = e.LoadLimits([]MinMaxLevel{testLevel()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		price     float64
+		amount    float64
+		orderType Type
+		expected  error
+	}{
+		{price: 10000, amount: 1, orderType: Limit},
+		{price: 0.001, amount: 1, orderType: Limit, expected: ErrPriceBelowMin},
+		{price: 2000000, amount: 1, orderType: Limit, expected: ErrPriceExceedsMax},
+		{price: 10000, amount: 0.0000001, orderType: Limit, expected: ErrAmountBelowMin},
+		{price: 10000, amount: 10000, orderType: Limit, expected: ErrAmountExceedsMax},
+		{price: 1, amount: 1, orderType: Limit, expected: ErrNotionalValueBelowMin},
+		{price: 0, amount: 1, orderType: Market},
+		{price: 0, amount: 10000, orderType: Market, expected: ErrAmountExceedsMax},
+	}
+	for i := range tests {
+		err = e.CheckOrderExecutionLimits(asset.Spot,
+			btcusdt,
+			tests[i].price,
+			tests[i].amount,
+			tests[i].orderType)
+		if !errors.Is(err, tests[i].expected) {
+			t.Errorf("test %d expected %v received %v", i, tests[i].expected, err)
+		}
+	}
+}
+
+func TestConformToPrice(t *testing.T) {
+	l := testLevel()
+	if p := l.ConformToPrice(10000.126); p != 10000.13 {
+		t.Errorf("expected 10000.13 received %v", p)
+	}
+	if p := l.ConformToPrice(0.3); p != 0.3 {
+		t.Errorf("expected 0.3 received %v", p)
+	}
+	l.PriceStepIncrementSize = 0
+	if p := l.ConformToPrice(10000.126); p != 10000.126 {
+		t.Errorf("expected 10000.126 received %v", p)
+	}
+	l.PriceStepIncrementSize = 5
+	if p := l.ConformToPrice(10003); p != 10005 {
+		t.Errorf("expected 10005 received %v", p)
+	}
+}
+
+func TestConformToAmount(t *testing.T) {
+	l := testLevel()
+	if a := l.ConformToAmount(1.0000019); a != 1.000001 {
+		t.Errorf("expected 1.000001 received %v", a)
+	}
+	l.AmountStepIncrementSize = 0.1
+	if a := l.ConformToAmount(0.3); a != 0.3 {
+		t.Errorf("expected 0.3 received %v", a)
+	}
+	l.AmountStepIncrementSize = 0
+	if a := l.ConformToAmount(1.0000019); a != 1.0000019 {
+		t.Errorf("expected 1.0000019 received %v", a)
+	}
+}
@@ -1082,3 +1082,51 @@ func TestValidationOnOrderTypes(t *testing.T) {
 		t.Fatal("unexpected error")
 	}
 }
+
+func TestSubmitConformToLimits(t *testing.T) {
+	var s *Submit
+	err := s.ConformToLimits(nil)
+	if !errors.Is(err, ErrSubmissionIsNil) {
+		t.Errorf("expected %v received %v", ErrSubmissionIsNil, err)
+	}
+
+	s = &Submit{
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Spot,
+		Type:      Limit,
+		Side:      Buy,
+		Price:     10000.126,
+		Amount:    0.0123456,
+	}
+	err = s.ConformToLimits(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := &MinMaxLevel{
+		PriceStepIncrementSize:  0.01,
+		AmountStepIncrementSize: 0.0001,
+		MinNotional:             10,
+	}
+	err = s.ConformToLimits(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Price != 10000.13 || s.Amount != 0.0123 {
+		t.Errorf("expected price 10000.13 amount 0.0123 received %v %v",
+			s.Price,
+			s.Amount)
+	}
+
+	s.Amount = 0.00001
+	err = s.ConformToLimits(l)
+	if !errors.Is(err, ErrAmountBelowMin) {
+		t.Errorf("expected %v received %v", ErrAmountBelowMin, err)
+	}
+
+	s.Amount = 0.0001
+	err = s.ConformToLimits(l)
+	if !errors.Is(err, ErrNotionalValueBelowMin) {
+		t.Errorf("expected %v received %v", ErrNotionalValueBelowMin, err)
+	}
+}
@@ -2,9 +2,12 @@ package order
 
 import (
 	"errors"
+	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
 )
 
@@ -21,8 +24,25 @@ var (
 	ErrAmountIsInvalid            = errors.New("order amount is invalid")
 	ErrPriceMustBeSetIfLimitOrder = errors.New("order price must be set if limit order type is desired")
 	ErrOrderIDNotSet              = errors.New("order id or client order id is not set")
+	ErrExchangeLimitNotLoaded     = errors.New("exchange limits not loaded")
+	ErrPriceBelowMin              = errors.New("order price below minimum")
+	ErrPriceExceedsMax            = errors.New("order price exceeds maximum")
+	ErrAmountBelowMin             = errors.New("order amount below minimum")
+	ErrAmountExceedsMax           = errors.New("order amount exceeds maximum")
+	ErrNotionalValueBelowMin      = errors.New("order notional value below minimum")
+	ErrOrderNotFilled             = errors.New("order is not filled or partially filled")
 )
 
+var fills *fillService
+
+// fillService holds the dispatch IDs for the order fill stream of each
+// exchange
+type fillService struct {
+	exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.Mutex
+}
+
 // Submit contains all properties of an order that may be required
 // for an order to be created on an exchange
 // Each exchange has their own requirements, so not all fields
@@ -288,3 +308,24 @@ type ClassificationError struct {
 	OrderID  string
 	Err      error
 }
+
+// MinMaxLevel defines the trading rules for a currency pair on an exchange
+// such as price and amount boundaries and their step increments
+type MinMaxLevel struct {
+	Pair                    currency.Pair
+	Asset                   asset.Item
+	MinPrice                float64
+	MaxPrice                float64
+	PriceStepIncrementSize  float64
+	MinAmount               float64
+	MaxAmount               float64
+	AmountStepIncrementSize float64
+	MinNotional             float64
+}
+
+// ExecutionLimits stores the trading rules for each asset and currency pair
+// on an exchange
+type ExecutionLimits struct {
+	m   map[asset.Item]map[*currency.Item]map[*currency.Item]*MinMaxLevel
+	mtx sync.RWMutex
+}
@@ -60,6 +60,29 @@ func (s *Submit) Validate(opt ...validate.Checker) error {
 	return nil
 }
 
+// ConformToLimits rounds the order price and amount to the step increments of
+// the supplied trading rules and checks the result against the rule
+// boundaries
+func (s *Submit) ConformToLimits(l *MinMaxLevel) error {
+	if s == nil {
+		return ErrSubmissionIsNil
+	}
+	if l == nil {
+		return nil
+	}
+	if s.Type == Limit {
+		s.Price = l.ConformToPrice(s.Price)
+	}
+	s.Amount = l.ConformToAmount(s.Amount)
+	if s.Amount <= 0 {
+		return fmt.Errorf("%w %f after rounding to step size %f",
+			ErrAmountBelowMin,
+			s.Amount,
+			l.AmountStepIncrementSize)
+	}
+	return l.Conforms(s.Price, s.Amount, s.Type)
+}
+
 // UpdateOrderFromDetail Will update an order detail (used in order management)
 // by comparing passed in and existing values
 func (d *Detail) UpdateOrderFromDetail(m *Detail) {
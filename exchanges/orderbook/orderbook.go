@@ -53,6 +53,58 @@ func SubscribeToExchangeOrderbooks(exchange string) (dispatch.Pipe, error) {
 	return service.mux.Subscribe(id)
 }
 
+// CheckStale returns ErrOrderbookStale if the stored orderbook has not
+// received an update within the supplied threshold
+func CheckStale(exchange string, p currency.Pair, a asset.Item, threshold time.Duration) error {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	book, ok := service.Books[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return fmt.Errorf("orderbook item not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	if threshold > 0 && time.Since(book.LastReceived) > threshold {
+		return fmt.Errorf("%s %s %s %w, last update received %s ago",
+			exchange,
+			p,
+			a,
+			ErrOrderbookStale,
+			time.Since(book.LastReceived).Truncate(time.Millisecond))
+	}
+	return nil
+}
+
+// GetStaleOrderbooks returns all orderbooks associated with an exchange which
+// have not received an update within the supplied threshold
+func GetStaleOrderbooks(exchange string, threshold time.Duration) []StaleBook {
+	if threshold <= 0 {
+		return nil
+	}
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	var stale []StaleBook
+	for _, quotes := range service.Books[exchange] {
+		for _, assets := range quotes {
+			for a, book := range assets {
+				if time.Since(book.LastReceived) <= threshold {
+					continue
+				}
+				stale = append(stale, StaleBook{
+					Exchange:     book.b.ExchangeName,
+					Pair:         book.b.Pair,
+					Asset:        a,
+					LastReceived: book.LastReceived,
+				})
+			}
+		}
+	}
+	return stale
+}
+
 // Update stores orderbook data
 func (s *Service) Update(b *Base) error {
 	name := strings.ToLower(b.ExchangeName)
@@ -62,6 +114,8 @@ func (s *Service) Update(b *Base) error {
 		book.b.Bids = b.Bids
 		book.b.Asks = b.Asks
 		book.b.LastUpdated = b.LastUpdated
+		book.LastReceived = time.Now()
+		book.history.add(b, book.LastReceived)
 		ids := append(book.Assoc, book.Main)
 		s.Unlock()
 		return s.mux.Publish(ids, b)
@@ -107,10 +161,14 @@ func (s *Service) SetNewData(b *Base, fmtName string) error {
 	cpyBook.Asks = make([]Item, len(b.Asks))
 	copy(cpyBook.Asks, b.Asks)
 
-	s.Books[fmtName][b.Pair.Base.Item][b.Pair.Quote.Item][b.AssetType] = &Book{
-		b:     &cpyBook,
-		Main:  singleID,
-		Assoc: ids}
+	book := &Book{
+		b:            &cpyBook,
+		Main:         singleID,
+		Assoc:        ids,
+		LastReceived: time.Now(),
+		history:      newHistory(s.historySize)}
+	book.history.add(b, book.LastReceived)
+	s.Books[fmtName][b.Pair.Base.Item][b.Pair.Quote.Item][b.AssetType] = book
 	return nil
 }
 
@@ -0,0 +1,108 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+// SetHistoryBufferSize sets the amount of top of book snapshots retained in
+// memory for each exchange, pair and asset. Existing history is discarded. A
+// size of zero or less disables history storage
+func SetHistoryBufferSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	service.Lock()
+	defer service.Unlock()
+	service.historySize = size
+	for _, bases := range service.Books {
+		for _, quotes := range bases {
+			for _, assets := range quotes {
+				for _, b := range assets {
+					b.history = newHistory(size)
+				}
+			}
+		}
+	}
+}
+
+// GetHistory returns stored top of book snapshots, ordered from oldest to
+// newest, for an exchange, pair and asset. Snapshots received before the since
+// time are excluded and if limit is greater than zero only the most recent
+// limit snapshots are returned
+func GetHistory(exchange string, p currency.Pair, a asset.Item, limit int, since time.Time) ([]TopOfBook, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	if service.historySize == 0 {
+		return nil, errors.New(errHistoryDisabled)
+	}
+	book, ok := service.Books[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return nil, fmt.Errorf("orderbook item not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	return book.history.retrieve(limit, since), nil
+}
+
+func newHistory(size int) *history {
+	if size <= 0 {
+		return nil
+	}
+	return &history{items: make([]TopOfBook, size)}
+}
+
+// add stores the top of book of the supplied orderbook, overwriting the oldest
+// entry when full
+func (h *history) add(b *Base, received time.Time) {
+	if h == nil {
+		return
+	}
+	t := TopOfBook{LastUpdated: received}
+	if len(b.Bids) > 0 {
+		t.BidPrice = b.Bids[0].Price
+		t.BidAmount = b.Bids[0].Amount
+	}
+	if len(b.Asks) > 0 {
+		t.AskPrice = b.Asks[0].Price
+		t.AskAmount = b.Asks[0].Amount
+	}
+	h.items[h.next] = t
+	h.next++
+	if h.next == len(h.items) {
+		h.next = 0
+		h.full = true
+	}
+}
+
+// retrieve returns a copy of the stored snapshots ordered from oldest to
+// newest
+func (h *history) retrieve(limit int, since time.Time) []TopOfBook {
+	if h == nil {
+		return nil
+	}
+	var ordered []TopOfBook
+	if h.full {
+		ordered = append(ordered, h.items[h.next:]...)
+	}
+	ordered = append(ordered, h.items[:h.next]...)
+
+	resp := make([]TopOfBook, 0, len(ordered))
+	for i := range ordered {
+		if !since.IsZero() && ordered[i].LastUpdated.Before(since) {
+			continue
+		}
+		resp = append(resp, ordered[i])
+	}
+	if limit > 0 && len(resp) > limit {
+		resp = resp[len(resp)-limit:]
+	}
+	return resp
+}
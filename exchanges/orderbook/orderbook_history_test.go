@@ -0,0 +1,68 @@
+package orderbook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+func TestGetHistory(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.LTC)
+	SetHistoryBufferSize(0)
+	_, err := GetHistory("historytest", p, asset.Spot, 0, time.Time{})
+	if err == nil {
+		t.Error("expected error when history is disabled")
+	}
+
+	SetHistoryBufferSize(3)
+	defer SetHistoryBufferSize(0)
+	_, err = GetHistory("historytest", p, asset.Spot, 0, time.Time{})
+	if err == nil {
+		t.Error("expected error for unknown orderbook")
+	}
+
+	for i := 1; i <= 5; i++ {
+		b := Base{
+			Pair:         p,
+			ExchangeName: "historytest",
+			AssetType:    asset.Spot,
+			Bids:         []Item{{Price: float64(i), Amount: 1}, {Price: 0.5, Amount: 1}},
+			Asks:         []Item{{Price: float64(i + 10), Amount: 2}},
+		}
+		err = b.Process()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h, err := GetHistory("historytest", p, asset.Spot, 0, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 3 {
+		t.Fatalf("expected 3 snapshots, received %d", len(h))
+	}
+	for i := range h {
+		if h[i].BidPrice != float64(i+3) || h[i].AskPrice != float64(i+13) {
+			t.Errorf("unexpected top of book %+v", h[i])
+		}
+	}
+
+	h, err = GetHistory("historytest", p, asset.Spot, 2, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 2 || h[1].BidPrice != 5 {
+		t.Error("expected most recent snapshots to be returned")
+	}
+
+	h, err = GetHistory("historytest", p, asset.Spot, 0, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 0 {
+		t.Error("expected no snapshots after future time")
+	}
+}
@@ -1,6 +1,7 @@
 package orderbook
 
 import (
+	"errors"
 	"log"
 	"math/rand"
 	"os"
@@ -134,6 +135,57 @@ func TestSubscribeToExchangeOrderbooks(t *testing.T) {
 	}
 }
 
+func TestCheckStale(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.XRP)
+	err := CheckStale("StaleTest", p, asset.Spot, time.Minute)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	b := Base{
+		Pair:         p,
+		AssetType:    asset.Spot,
+		ExchangeName: "StaleTest",
+		Bids:         []Item{{Price: 1, Amount: 1}},
+	}
+	err = b.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = CheckStale("StaleTest", p, asset.Spot, time.Minute)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(GetStaleOrderbooks("StaleTest", time.Minute)) != 0 {
+		t.Error("expected no stale orderbooks")
+	}
+
+	time.Sleep(time.Millisecond * 10)
+	err = CheckStale("StaleTest", p, asset.Spot, time.Millisecond)
+	if !errors.Is(err, ErrOrderbookStale) {
+		t.Errorf("received %v, expected %v", err, ErrOrderbookStale)
+	}
+
+	stale := GetStaleOrderbooks("StaleTest", time.Millisecond)
+	if len(stale) != 1 {
+		t.Fatalf("expected 1 stale orderbook, received %d", len(stale))
+	}
+	if !stale[0].Pair.Equal(p) || stale[0].Asset != asset.Spot {
+		t.Error("unexpected stale orderbook returned")
+	}
+
+	err = b.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = CheckStale("StaleTest", p, asset.Spot, time.Second)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestVerify(t *testing.T) {
 	t.Parallel()
 	b := Base{
@@ -1,6 +1,7 @@
 package orderbook
 
 import (
+	"errors"
 	"sync"
 	"time"
 
@@ -16,11 +17,16 @@ const (
 	errPairNotSet        = "orderbook currency pair not set"
 	errAssetTypeNotSet   = "orderbook asset type not set"
 	errNoOrderbook       = "orderbook bids and asks are empty"
+	errHistoryDisabled   = "orderbook history is disabled"
 )
 
 // Vars for the orderbook package
 var (
 	service *Service
+
+	// ErrOrderbookStale defines an error when an orderbook has not received an
+	// update within its allowed threshold
+	ErrOrderbookStale = errors.New("orderbook is stale")
 )
 
 func init() {
@@ -32,9 +38,36 @@ func init() {
 
 // Book defines an orderbook with its links to different dispatch outputs
 type Book struct {
-	b     *Base
-	Main  uuid.UUID
-	Assoc []uuid.UUID
+	b            *Base
+	Main         uuid.UUID
+	Assoc        []uuid.UUID
+	LastReceived time.Time
+	history      *history
+}
+
+// TopOfBook defines a snapshot of the best bid and ask of an orderbook
+type TopOfBook struct {
+	BidPrice    float64   `json:"bidPrice"`
+	BidAmount   float64   `json:"bidAmount"`
+	AskPrice    float64   `json:"askPrice"`
+	AskAmount   float64   `json:"askAmount"`
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// history defines a fixed size ring buffer of top of book snapshots
+type history struct {
+	items []TopOfBook
+	next  int
+	full  bool
+}
+
+// StaleBook defines an orderbook which has not received an update within its
+// allowed threshold
+type StaleBook struct {
+	Exchange     string
+	Pair         currency.Pair
+	Asset        asset.Item
+	LastReceived time.Time
 }
 
 // Service holds orderbook information for each individual exchange
@@ -42,6 +75,9 @@ type Service struct {
 	Books    map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Book
 	Exchange map[string]uuid.UUID
 	mux      *dispatch.Mux
+	// historySize defines the amount of top of book snapshots retained for
+	// each orderbook, zero disables history storage
+	historySize int
 	sync.RWMutex
 }
 
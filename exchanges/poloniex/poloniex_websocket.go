@@ -312,7 +312,7 @@ func (p *Poloniex) wsHandleData(respRaw []byte) error {
 							return err
 						}
 					case "t":
-						if !p.IsSaveTradeDataEnabled() {
+						if !p.IsTradeFeedEnabled() {
 							return nil
 						}
 						currencyPair := currencyIDMap[channelID]
@@ -119,7 +119,13 @@ func (s *Service) Update(p *Price) error {
 		ticker.PriceATH = p.PriceATH
 		ticker.Open = p.Open
 		ticker.Close = p.Close
+		ticker.MarkPrice = p.MarkPrice
+		ticker.IndexPrice = p.IndexPrice
+		ticker.FundingRate = p.FundingRate
+		ticker.NextFundingTime = p.NextFundingTime
+		ticker.OpenInterest = p.OpenInterest
 		ticker.LastUpdated = p.LastUpdated
+		ticker.history.add(p)
 		ids := append(ticker.Assoc, ticker.Main)
 		s.Unlock()
 		return s.mux.Publish(ids, p)
@@ -161,9 +167,12 @@ func (s *Service) SetItemID(p *Price, fmtName string) error {
 		return err
 	}
 
-	s.Tickers[fmtName][p.Pair.Base.Item][p.Pair.Quote.Item][p.AssetType] = &Ticker{Price: *p,
-		Main:  singleID,
-		Assoc: ids}
+	t := &Ticker{Price: *p,
+		Main:    singleID,
+		Assoc:   ids,
+		history: newHistory(s.historySize)}
+	t.history.add(p)
+	s.Tickers[fmtName][p.Pair.Base.Item][p.Pair.Quote.Item][p.AssetType] = t
 	return nil
 }
 
@@ -0,0 +1,98 @@
+package ticker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+// SetHistoryBufferSize sets the amount of ticker snapshots retained in memory
+// for each exchange, pair and asset. Existing history is discarded. A size of
+// zero or less disables history storage
+func SetHistoryBufferSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	service.Lock()
+	defer service.Unlock()
+	service.historySize = size
+	for _, bases := range service.Tickers {
+		for _, quotes := range bases {
+			for _, assets := range quotes {
+				for _, t := range assets {
+					t.history = newHistory(size)
+				}
+			}
+		}
+	}
+}
+
+// GetHistory returns stored ticker snapshots, ordered from oldest to newest,
+// for an exchange, pair and asset. Snapshots updated before the since time are
+// excluded and if limit is greater than zero only the most recent limit
+// snapshots are returned
+func GetHistory(exchange string, p currency.Pair, a asset.Item, limit int, since time.Time) ([]Price, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	if service.historySize == 0 {
+		return nil, errors.New(errHistoryDisabled)
+	}
+	t, ok := service.Tickers[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return nil, fmt.Errorf("ticker item not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	return t.history.retrieve(limit, since), nil
+}
+
+func newHistory(size int) *history {
+	if size <= 0 {
+		return nil
+	}
+	return &history{items: make([]Price, size)}
+}
+
+// add stores a ticker snapshot, overwriting the oldest entry when full
+func (h *history) add(p *Price) {
+	if h == nil {
+		return
+	}
+	h.items[h.next] = *p
+	h.next++
+	if h.next == len(h.items) {
+		h.next = 0
+		h.full = true
+	}
+}
+
+// retrieve returns a copy of the stored snapshots ordered from oldest to
+// newest
+func (h *history) retrieve(limit int, since time.Time) []Price {
+	if h == nil {
+		return nil
+	}
+	var ordered []Price
+	if h.full {
+		ordered = append(ordered, h.items[h.next:]...)
+	}
+	ordered = append(ordered, h.items[:h.next]...)
+
+	resp := make([]Price, 0, len(ordered))
+	for i := range ordered {
+		if !since.IsZero() && ordered[i].LastUpdated.Before(since) {
+			continue
+		}
+		resp = append(resp, ordered[i])
+	}
+	if limit > 0 && len(resp) > limit {
+		resp = resp[len(resp)-limit:]
+	}
+	return resp
+}
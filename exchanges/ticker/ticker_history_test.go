@@ -0,0 +1,68 @@
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+func TestGetHistory(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.LTC)
+	SetHistoryBufferSize(0)
+	_, err := GetHistory("historytest", p, asset.Spot, 0, time.Time{})
+	if err == nil {
+		t.Error("expected error when history is disabled")
+	}
+
+	SetHistoryBufferSize(3)
+	defer SetHistoryBufferSize(0)
+	_, err = GetHistory("historytest", p, asset.Spot, 0, time.Time{})
+	if err == nil {
+		t.Error("expected error for unknown ticker")
+	}
+
+	start := time.Now()
+	for i := 1; i <= 5; i++ {
+		err = ProcessTicker(&Price{
+			Pair:         p,
+			ExchangeName: "historytest",
+			AssetType:    asset.Spot,
+			Last:         float64(i),
+			LastUpdated:  start.Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h, err := GetHistory("historytest", p, asset.Spot, 0, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 3 {
+		t.Fatalf("expected 3 snapshots, received %d", len(h))
+	}
+	for i := range h {
+		if h[i].Last != float64(i+3) {
+			t.Errorf("expected last %v, received %v", i+3, h[i].Last)
+		}
+	}
+
+	h, err = GetHistory("historytest", p, asset.Spot, 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 1 || h[0].Last != 5 {
+		t.Error("expected most recent snapshot to be returned")
+	}
+
+	h, err = GetHistory("historytest", p, asset.Spot, 0, start.Add(time.Second*4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 2 || h[0].Last != 4 {
+		t.Error("expected snapshots since time to be returned")
+	}
+}
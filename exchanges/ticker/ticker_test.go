@@ -407,3 +407,46 @@ func TestGetAssociation(t *testing.T) {
 
 	service.mux = cpyMux
 }
+
+func TestProcessTickerDerivativeFields(t *testing.T) {
+	p, err := currency.NewPairFromStrings("BTC", "PERP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tick := Price{
+		Last:            1000,
+		MarkPrice:       1001,
+		IndexPrice:      999,
+		FundingRate:     0.0001,
+		NextFundingTime: time.Now().Add(time.Hour),
+		OpenInterest:    1337,
+		Pair:            p,
+		ExchangeName:    "derivativeTest",
+		AssetType:       asset.PerpetualSwap,
+	}
+	err = ProcessTicker(&tick)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tick.MarkPrice = 1002
+	tick.FundingRate = -0.0002
+	tick.OpenInterest = 1338
+	err = ProcessTicker(&tick)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := GetTicker("derivativeTest", p, asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.MarkPrice != 1002 ||
+		result.IndexPrice != 999 ||
+		result.FundingRate != -0.0002 ||
+		result.OpenInterest != 1338 ||
+		!result.NextFundingTime.Equal(tick.NextFundingTime) {
+		t.Errorf("unexpected derivative ticker values %+v", result)
+	}
+}
@@ -16,6 +16,7 @@ const (
 	errPairNotSet        = "ticker currency pair not set"
 	errAssetTypeNotSet   = "ticker asset type not set"
 	errTickerPriceIsNil  = "ticker price is nil"
+	errHistoryDisabled   = "ticker history is disabled"
 )
 
 // Vars for the ticker package
@@ -28,6 +29,9 @@ type Service struct {
 	Tickers  map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Ticker
 	Exchange map[string]uuid.UUID
 	mux      *dispatch.Mux
+	// historySize defines the amount of ticker snapshots retained for each
+	// ticker, zero disables history storage
+	historySize int
 	sync.RWMutex
 }
 
@@ -47,11 +51,26 @@ type Price struct {
 	ExchangeName string        `json:"exchangeName"`
 	AssetType    asset.Item    `json:"assetType"`
 	LastUpdated  time.Time
+	// Derivative specific fields, only populated for futures and perpetual
+	// asset types when supplied by the exchange
+	MarkPrice       float64   `json:"MarkPrice,omitempty"`
+	IndexPrice      float64   `json:"IndexPrice,omitempty"`
+	FundingRate     float64   `json:"FundingRate,omitempty"`
+	NextFundingTime time.Time `json:"NextFundingTime,omitempty"`
+	OpenInterest    float64   `json:"OpenInterest,omitempty"`
 }
 
 // Ticker struct holds the ticker information for a currency pair and type
 type Ticker struct {
 	Price
-	Main  uuid.UUID
-	Assoc []uuid.UUID
+	Main    uuid.UUID
+	Assoc   []uuid.UUID
+	history *history
+}
+
+// history defines a fixed size ring buffer of ticker snapshots
+type history struct {
+	items []Price
+	next  int
+	full  bool
 }
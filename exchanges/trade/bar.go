@@ -0,0 +1,347 @@
+package trade
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+// Validate checks the bar config is usable by a BarBuilder
+func (c *BarConfig) Validate() error {
+	switch c.Type {
+	case TimeBar:
+		if c.Interval <= 0 {
+			return errInvalidBarInterval
+		}
+	case TickBar, VolumeBar, DollarBar, RenkoBar:
+		if c.Threshold <= 0 {
+			return fmt.Errorf("%s %w", c.Type, errInvalidBarThreshold)
+		}
+	default:
+		return fmt.Errorf("%w '%s'", errInvalidBarType, c.Type)
+	}
+	return nil
+}
+
+// String returns a short description of the bar config
+func (c *BarConfig) String() string {
+	var s string
+	if c.Type == TimeBar {
+		s = c.Interval.Short()
+	} else {
+		s = fmt.Sprintf("%s %v", c.Type, c.Threshold)
+	}
+	if c.HeikinAshi {
+		s += " heikin-ashi"
+	}
+	return s
+}
+
+// NewBarBuilder returns a BarBuilder for the supplied config
+func NewBarBuilder(cfg BarConfig) (*BarBuilder, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &BarBuilder{config: cfg}, nil
+}
+
+// Add processes trades in the order received and returns any bars they
+// complete. Trades are expected in ascending time order, a time bar trade
+// which is older than the current bar is added to the current bar
+func (b *BarBuilder) Add(trades ...Data) []kline.Candle {
+	var completed []kline.Candle
+	for i := range trades {
+		switch b.config.Type {
+		case RenkoBar:
+			completed = append(completed, b.addRenko(&trades[i])...)
+		case TimeBar:
+			t := trades[i].Timestamp.Truncate(b.config.Interval.Duration()).UTC()
+			if b.open && t.After(b.current.Time) {
+				completed = append(completed, b.closeBar())
+			}
+			b.update(&trades[i], t)
+		default:
+			b.update(&trades[i], trades[i].Timestamp)
+			switch b.config.Type {
+			case TickBar:
+				b.accumulated++
+			case VolumeBar:
+				b.accumulated += trades[i].Amount
+			case DollarBar:
+				b.accumulated += trades[i].Amount * trades[i].Price
+			}
+			if b.accumulated >= b.config.Threshold {
+				completed = append(completed, b.closeBar())
+			}
+		}
+	}
+	return completed
+}
+
+// Current returns the bar currently being built. Renko bricks are only
+// created once complete so there is never a current renko bar
+func (b *BarBuilder) Current() (kline.Candle, bool) {
+	if !b.open || b.config.Type == RenkoBar {
+		return kline.Candle{}, false
+	}
+	if b.config.HeikinAshi {
+		return kline.NextHeikinAshi(b.lastHeikinAshi, b.current), true
+	}
+	return b.current, true
+}
+
+// update adds a trade to the current bar, opening a new bar at the supplied
+// time if required
+func (b *BarBuilder) update(d *Data, t time.Time) {
+	price := math.Abs(d.Price)
+	amount := math.Abs(d.Amount)
+	if !b.open {
+		b.current = kline.Candle{
+			Time:   t,
+			Open:   price,
+			High:   price,
+			Low:    price,
+			Close:  price,
+			Volume: amount,
+		}
+		b.open = true
+		return
+	}
+	if price > b.current.High {
+		b.current.High = price
+	}
+	if price < b.current.Low {
+		b.current.Low = price
+	}
+	b.current.Close = price
+	b.current.Volume += amount
+}
+
+// closeBar completes the current bar
+func (b *BarBuilder) closeBar() kline.Candle {
+	b.open = false
+	b.accumulated = 0
+	return b.smooth(b.current)
+}
+
+// addRenko creates bricks for each threshold the trade price has moved beyond
+// the previous brick. The open and close of the previous brick are held in
+// the current candle, with both set to the first trade price before the first
+// brick. The volume traded since the previous brick is given to the first
+// brick created
+func (b *BarBuilder) addRenko(d *Data) []kline.Candle {
+	price := math.Abs(d.Price)
+	if !b.open {
+		b.current = kline.Candle{Open: price, Close: price}
+		b.open = true
+	}
+	b.accumulated += math.Abs(d.Amount)
+	size := b.config.Threshold
+	var bricks []kline.Candle
+	for {
+		top := math.Max(b.current.Open, b.current.Close)
+		bottom := math.Min(b.current.Open, b.current.Close)
+		var brick kline.Candle
+		switch {
+		case price >= top+size:
+			brick = kline.Candle{Open: top, Close: top + size, Low: top, High: top + size}
+		case price <= bottom-size:
+			brick = kline.Candle{Open: bottom, Close: bottom - size, Low: bottom - size, High: bottom}
+		default:
+			return bricks
+		}
+		brick.Time = d.Timestamp
+		brick.Volume = b.accumulated
+		b.accumulated = 0
+		b.current = brick
+		bricks = append(bricks, b.smooth(brick))
+	}
+}
+
+// smooth converts a completed bar to Heikin-Ashi when configured
+func (b *BarBuilder) smooth(c kline.Candle) kline.Candle {
+	if !b.config.HeikinAshi {
+		return c
+	}
+	ha := kline.NextHeikinAshi(b.lastHeikinAshi, c)
+	b.lastHeikinAshi = &ha
+	return ha
+}
+
+// ConvertTradesToBars sorts trades by date and converts them into bars using
+// the supplied config. The final bar is included even if it is incomplete
+func ConvertTradesToBars(cfg BarConfig, trades ...Data) (kline.Item, error) {
+	if len(trades) == 0 {
+		return kline.Item{}, errNoTradesSupplied
+	}
+	b, err := NewBarBuilder(cfg)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	sorted := make([]Data, len(trades))
+	copy(sorted, trades)
+	sort.Stable(ByDate(sorted))
+
+	item := newBarItem(sorted[0].Exchange, sorted[0].CurrencyPair, sorted[0].AssetType, &cfg)
+	item.Candles = b.Add(sorted...)
+	if c, ok := b.Current(); ok {
+		item.Candles = append(item.Candles, c)
+	}
+	return item, nil
+}
+
+// GetBarsInRange retrieves trades from the database and converts them into
+// bars using the supplied config
+func GetBarsInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time, cfg BarConfig) (kline.Item, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return kline.Item{}, err
+	}
+	trades, err := GetTradesInRange(exchangeName, assetType, base, quote, startDate, endDate)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return ConvertTradesToBars(cfg, trades...)
+}
+
+// GetLiveBars returns the completed bars built from the live trades received
+// for the exchange, pair and asset, whether or not they are saved to the
+// database. The first request for a config starts tracking, so no bars are
+// returned until trades are received. Series which are not requested within
+// LiveBarExpiry stop being tracked and at most MaxLiveBarSeries series are
+// tracked at once
+func GetLiveBars(exchangeName string, p currency.Pair, a asset.Item, cfg BarConfig) (kline.Item, error) {
+	key, err := newLiveBarKey(exchangeName, p, a, &cfg)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	item := newBarItem(exchangeName, p, a, &cfg)
+	now := time.Now()
+	liveBars.m.Lock()
+	defer liveBars.m.Unlock()
+	liveBars.expire(now)
+	s, ok := liveBars.series[key]
+	if !ok {
+		if MaxLiveBarSeries > 0 && len(liveBars.series) >= MaxLiveBarSeries {
+			return kline.Item{}, fmt.Errorf("%w of %d", errLiveBarLimitReached, MaxLiveBarSeries)
+		}
+		var b *BarBuilder
+		b, err = NewBarBuilder(cfg)
+		if err != nil {
+			return kline.Item{}, err
+		}
+		liveBars.series[key] = &liveBarSeries{builder: b, requested: now}
+		return item, nil
+	}
+	s.requested = now
+	item.Candles = make([]kline.Candle, len(s.bars))
+	copy(item.Candles, s.bars)
+	return item, nil
+}
+
+// StopLiveBars stops building live bars for the exchange, pair, asset and
+// config
+func StopLiveBars(exchangeName string, p currency.Pair, a asset.Item, cfg BarConfig) error {
+	key, err := newLiveBarKey(exchangeName, p, a, &cfg)
+	if err != nil {
+		return err
+	}
+	liveBars.m.Lock()
+	defer liveBars.m.Unlock()
+	if _, ok := liveBars.series[key]; !ok {
+		return fmt.Errorf("%s %s %s %s %w", exchangeName, a, p, cfg.String(), errLiveBarsNotTracked)
+	}
+	delete(liveBars.series, key)
+	return nil
+}
+
+// tracks returns whether any live bar series is tracking the exchange
+func (l *liveBarTracker) tracks(exchangeName string) bool {
+	exchangeName = strings.ToLower(exchangeName)
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.expire(time.Now())
+	for k := range l.series {
+		if k.exchange == exchangeName {
+			return true
+		}
+	}
+	return false
+}
+
+// expire stops tracking series which have not been requested within
+// LiveBarExpiry, the lock must be held
+func (l *liveBarTracker) expire(now time.Time) {
+	if LiveBarExpiry <= 0 {
+		return
+	}
+	for k, s := range l.series {
+		if now.Sub(s.requested) > LiveBarExpiry {
+			delete(l.series, k)
+		}
+	}
+}
+
+// add passes trades to every live bar series tracking their exchange, pair
+// and asset
+func (l *liveBarTracker) add(trades ...Data) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if len(l.series) == 0 {
+		return
+	}
+	for i := range trades {
+		for k, s := range l.series {
+			if k.asset != trades[i].AssetType ||
+				k.exchange != strings.ToLower(trades[i].Exchange) ||
+				k.pair != liveBarPair(trades[i].CurrencyPair) {
+				continue
+			}
+			s.bars = append(s.bars, s.builder.Add(trades[i])...)
+			if LiveBarLimit > 0 && len(s.bars) > LiveBarLimit {
+				s.bars = s.bars[len(s.bars)-LiveBarLimit:]
+			}
+		}
+	}
+}
+
+func newLiveBarKey(exchangeName string, p currency.Pair, a asset.Item, cfg *BarConfig) (liveBarKey, error) {
+	if exchangeName == "" || p.IsEmpty() || !a.IsValid() {
+		return liveBarKey{}, fmt.Errorf("%w: exchange, pair and asset required", errInvalidLiveBarRequest)
+	}
+	err := cfg.Validate()
+	if err != nil {
+		return liveBarKey{}, err
+	}
+	return liveBarKey{
+		exchange: strings.ToLower(exchangeName),
+		pair:     liveBarPair(p),
+		asset:    a,
+		config:   *cfg,
+	}, nil
+}
+
+// liveBarPair returns a pair string which ignores the delimiter and case
+// used by the exchange
+func liveBarPair(p currency.Pair) string {
+	return p.Base.Upper().String() + "-" + p.Quote.Upper().String()
+}
+
+func newBarItem(exchangeName string, p currency.Pair, a asset.Item, cfg *BarConfig) kline.Item {
+	item := kline.Item{
+		Exchange: exchangeName,
+		Pair:     p,
+		Asset:    a,
+	}
+	if cfg.Type == TimeBar {
+		item.Interval = cfg.Interval
+	}
+	return item
+}
@@ -0,0 +1,239 @@
+package trade
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+)
+
+var barStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func barTrades(prices ...float64) []Data {
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	trades := make([]Data, len(prices))
+	for i := range prices {
+		trades[i] = Data{
+			Exchange:     "test",
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+			Price:        prices[i],
+			Amount:       2,
+			Timestamp:    barStart.Add(time.Duration(i) * time.Second),
+		}
+	}
+	return trades
+}
+
+func TestBarConfigValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		cfg BarConfig
+		err error
+	}{
+		{BarConfig{}, errInvalidBarType},
+		{BarConfig{Type: TimeBar}, errInvalidBarInterval},
+		{BarConfig{Type: TimeBar, Interval: kline.OneMin}, nil},
+		{BarConfig{Type: TickBar}, errInvalidBarThreshold},
+		{BarConfig{Type: RenkoBar, Threshold: -1}, errInvalidBarThreshold},
+		{BarConfig{Type: DollarBar, Threshold: 1}, nil},
+	}
+	for i := range tests {
+		err := tests[i].cfg.Validate()
+		if !errors.Is(err, tests[i].err) {
+			t.Errorf("%+v received %v, expected %v", tests[i].cfg, err, tests[i].err)
+		}
+	}
+}
+
+func TestBarBuilder(t *testing.T) {
+	t.Parallel()
+	_, err := NewBarBuilder(BarConfig{Type: "bad"})
+	if !errors.Is(err, errInvalidBarType) {
+		t.Errorf("received %v, expected %v", err, errInvalidBarType)
+	}
+
+	b, err := NewBarBuilder(BarConfig{Type: TickBar, Threshold: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bars := b.Add(barTrades(10, 12, 9, 11, 13)...)
+	if len(bars) != 1 {
+		t.Fatalf("expected 1 bar received %v", len(bars))
+	}
+	expected := kline.Candle{Time: barStart, Open: 10, High: 12, Low: 9, Close: 9, Volume: 6}
+	if bars[0] != expected {
+		t.Errorf("received %+v, expected %+v", bars[0], expected)
+	}
+	c, ok := b.Current()
+	if !ok {
+		t.Fatal("expected current bar")
+	}
+	expected = kline.Candle{Time: barStart.Add(time.Second * 3), Open: 11, High: 13, Low: 11, Close: 13, Volume: 4}
+	if c != expected {
+		t.Errorf("received %+v, expected %+v", c, expected)
+	}
+}
+
+func TestConvertTradesToBars(t *testing.T) {
+	t.Parallel()
+	_, err := ConvertTradesToBars(BarConfig{Type: TickBar, Threshold: 1})
+	if !errors.Is(err, errNoTradesSupplied) {
+		t.Errorf("received %v, expected %v", err, errNoTradesSupplied)
+	}
+
+	trades := barTrades(10, 20, 30, 40)
+	// reversed to ensure trades are sorted
+	trades[0], trades[3] = trades[3], trades[0]
+	item, err := ConvertTradesToBars(BarConfig{Type: VolumeBar, Threshold: 3}, trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Exchange != "test" || item.Asset != asset.Spot || item.Interval != 0 {
+		t.Errorf("unexpected item details %+v", item)
+	}
+	if len(item.Candles) != 2 ||
+		item.Candles[0].Open != 10 ||
+		item.Candles[0].Close != 20 ||
+		item.Candles[1].Open != 30 ||
+		item.Candles[1].Close != 40 {
+		t.Errorf("unexpected volume bars %+v", item.Candles)
+	}
+
+	item, err = ConvertTradesToBars(BarConfig{Type: DollarBar, Threshold: 50}, barTrades(10, 20, 30)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(item.Candles) != 2 || item.Candles[0].Close != 20 || item.Candles[1].Open != 30 {
+		t.Errorf("unexpected dollar bars %+v", item.Candles)
+	}
+
+	item, err = ConvertTradesToBars(BarConfig{Type: TimeBar, Interval: kline.Interval(time.Second * 2)}, barTrades(10, 20, 30)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Interval != kline.Interval(time.Second*2) ||
+		len(item.Candles) != 2 ||
+		!item.Candles[1].Time.Equal(barStart.Add(time.Second*2)) ||
+		item.Candles[0].Volume != 4 {
+		t.Errorf("unexpected time bars %+v", item.Candles)
+	}
+
+	item, err = ConvertTradesToBars(BarConfig{Type: TickBar, Threshold: 1, HeikinAshi: true}, barTrades(10, 20)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(item.Candles) != 2 || item.Candles[0].Open != 10 || item.Candles[1].Open != 10 || item.Candles[1].Close != 20 {
+		t.Errorf("unexpected heikin-ashi bars %+v", item.Candles)
+	}
+}
+
+func TestRenkoBars(t *testing.T) {
+	t.Parallel()
+	item, err := ConvertTradesToBars(BarConfig{Type: RenkoBar, Threshold: 10}, barTrades(100, 105, 125, 115, 95, 79)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []kline.Candle{
+		{Time: barStart.Add(time.Second * 2), Open: 100, High: 110, Low: 100, Close: 110, Volume: 6},
+		{Time: barStart.Add(time.Second * 2), Open: 110, High: 120, Low: 110, Close: 120},
+		{Time: barStart.Add(time.Second * 4), Open: 110, High: 110, Low: 100, Close: 100, Volume: 4},
+		{Time: barStart.Add(time.Second * 5), Open: 100, High: 100, Low: 90, Close: 90, Volume: 2},
+		{Time: barStart.Add(time.Second * 5), Open: 90, High: 90, Low: 80, Close: 80},
+	}
+	if len(item.Candles) != len(expected) {
+		t.Fatalf("expected %v bricks received %+v", len(expected), item.Candles)
+	}
+	for i := range expected {
+		if item.Candles[i] != expected[i] {
+			t.Errorf("received %+v, expected %+v", item.Candles[i], expected[i])
+		}
+	}
+}
+
+func TestLiveBars(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XRP, currency.USD)
+	cfg := BarConfig{Type: TickBar, Threshold: 2}
+	_, err := GetLiveBars("", cp, asset.Spot, cfg)
+	if !errors.Is(err, errInvalidLiveBarRequest) {
+		t.Errorf("received %v, expected %v", err, errInvalidLiveBarRequest)
+	}
+	err = StopLiveBars("liveTest", cp, asset.Spot, cfg)
+	if !errors.Is(err, errLiveBarsNotTracked) {
+		t.Errorf("received %v, expected %v", err, errLiveBarsNotTracked)
+	}
+
+	item, err := GetLiveBars("liveTest", cp, asset.Spot, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(item.Candles) != 0 {
+		t.Errorf("expected no bars received %v", len(item.Candles))
+	}
+
+	trades := barTrades(1, 2, 3, 4, 5)
+	for i := range trades {
+		trades[i].Exchange = "LIVETEST"
+		trades[i].CurrencyPair = currency.NewPairWithDelimiter("xrp", "usd", "_")
+	}
+	if !IsTradeFeedActive("LIVETEST") {
+		t.Error("expected tracked live bars to activate the trade feed")
+	}
+	// live bars are built without the database
+	err = PublishTrades("LIVETEST", trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item, err = GetLiveBars("liveTest", cp, asset.Spot, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(item.Candles) != 2 || item.Candles[1].Close != 4 {
+		t.Errorf("unexpected live bars %+v", item.Candles)
+	}
+
+	err = StopLiveBars("liveTest", cp, asset.Spot, cfg)
+	if err != nil {
+		t.Error(err)
+	}
+	if IsTradeFeedActive("liveTest") {
+		t.Error("expected stopped live bars to deactivate the trade feed")
+	}
+}
+
+func TestLiveBarLimits(t *testing.T) {
+	cfg := BarConfig{Type: TickBar, Threshold: 2}
+	limit := MaxLiveBarSeries
+	MaxLiveBarSeries = 1
+	defer func() { MaxLiveBarSeries = limit }()
+
+	_, err := GetLiveBars("limitTest", currency.NewPair(currency.BTC, currency.USD), asset.Spot, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = GetLiveBars("limitTest", currency.NewPair(currency.ETH, currency.USD), asset.Spot, cfg)
+	if !errors.Is(err, errLiveBarLimitReached) {
+		t.Errorf("received %v, expected %v", err, errLiveBarLimitReached)
+	}
+
+	liveBars.m.Lock()
+	for _, s := range liveBars.series {
+		s.requested = time.Now().Add(-LiveBarExpiry - time.Minute)
+	}
+	liveBars.m.Unlock()
+	if IsTradeFeedActive("limitTest") {
+		t.Error("expected expired live bars to stop being tracked")
+	}
+	_, err = GetLiveBars("limitTest", currency.NewPair(currency.ETH, currency.USD), asset.Spot, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = StopLiveBars("limitTest", currency.NewPair(currency.ETH, currency.USD), asset.Spot, cfg)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,60 @@
+package trade
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/dispatch"
+)
+
+func init() {
+	service = new(Service)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeToExchangeTrades subscribes to the trades received for an
+// exchange. Once subscribed, the exchange's trades are received whether or
+// not they are saved to the database
+func SubscribeToExchangeTrades(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.Lock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		var err error
+		id, err = service.mux.GetID()
+		if err != nil {
+			service.Unlock()
+			return dispatch.Pipe{}, err
+		}
+		service.Exchange[exchange] = id
+	}
+	service.Unlock()
+	return service.mux.Subscribe(id)
+}
+
+// publish sends trades to their exchange stream. Exchanges without a stream
+// have never been subscribed to so are skipped
+func (s *Service) publish(trades ...Data) error {
+	for i := range trades {
+		s.Lock()
+		id, ok := s.Exchange[strings.ToLower(trades[i].Exchange)]
+		s.Unlock()
+		if !ok {
+			continue
+		}
+		err := s.mux.Publish([]uuid.UUID{id}, &trades[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasStream returns whether the exchange's trades have been subscribed to
+func (s *Service) hasStream(exchange string) bool {
+	s.Lock()
+	defer s.Unlock()
+	_, ok := s.Exchange[strings.ToLower(exchange)]
+	return ok
+}
@@ -0,0 +1,59 @@
+package trade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+)
+
+func TestSubscribeToExchangeTrades(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := Data{
+		Exchange:     "StreamTest",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    time.Now(),
+	}
+	err := service.publish(d)
+	if err != nil {
+		t.Fatalf("expected unsubscribed exchange to be skipped, received %v", err)
+	}
+
+	pipe, err := SubscribeToExchangeTrades("streamtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+	// the dispatcher drops data on a handshake timeout so publish until a
+	// trade is received
+	for i := 0; i < 10; i++ {
+		err = service.publish(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case data := <-pipe.C:
+			received := (*data.(*interface{})).(Data)
+			if received.Price != d.Price || !received.CurrencyPair.Equal(d.CurrencyPair) {
+				t.Errorf("received %+v, expected %+v", received, d)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	t.Fatal("expected trade to be published")
+}
@@ -1,9 +1,12 @@
 package trade
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -23,26 +26,119 @@ import (
 func (p *Processor) setup(wg *sync.WaitGroup) {
 	p.mutex.Lock()
 	p.bufferProcessorInterval = BufferProcessorIntervalTime
+	if p.flush == nil {
+		p.flush = make(chan struct{}, 1)
+	}
 	p.mutex.Unlock()
 	go p.Run(wg)
 }
 
-// AddTradesToBuffer will push trade data onto the buffer
+// SetupWriteAheadLog persists every received trade to dir until it has been
+// saved to the database. Trades left over from a previous run are queued to
+// be saved again
+func SetupWriteAheadLog(dir string) error {
+	processor.mutex.Lock()
+	if processor.wal != nil {
+		processor.mutex.Unlock()
+		return errWriteAheadLogAlreadySetup
+	}
+	wal, recovered, err := openWriteAheadLog(dir)
+	if err != nil {
+		processor.mutex.Unlock()
+		return err
+	}
+	processor.wal = wal
+	now := time.Now()
+	for i := range recovered {
+		processor.markSeen(&recovered[i], now)
+	}
+	processor.buffer = append(processor.buffer, recovered...)
+	processor.mutex.Unlock()
+
+	if len(recovered) == 0 {
+		return nil
+	}
+	log.Debugf(log.Trade, "Recovered %d unsaved trades from write-ahead log\n", len(recovered))
+	if database.DB == nil || database.DB.Config == nil || !database.DB.Config.Enabled {
+		return nil
+	}
+	if atomic.AddInt32(&processor.started, 0) == 0 {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		processor.setup(&wg)
+		wg.Wait()
+	}
+	return nil
+}
+
+// CloseWriteAheadLog closes the active write-ahead log segment. Unsaved
+// trades remain on disk for the next run
+func CloseWriteAheadLog() error {
+	processor.mutex.Lock()
+	defer processor.mutex.Unlock()
+	if processor.wal == nil {
+		return nil
+	}
+	err := processor.wal.close()
+	processor.wal = nil
+	return err
+}
+
+// GetStats returns trade processor throughput and backpressure metrics
+func GetStats() Stats {
+	processor.mutex.Lock()
+	defer processor.mutex.Unlock()
+	s := processor.stats
+	s.Buffered = len(processor.buffer)
+	if processor.wal != nil {
+		s.PendingSegments = len(processor.wal.sealed)
+	}
+	return s
+}
+
+// AddTradesToBuffer will push trade data onto the buffer to be saved to the
+// database. Trades are also passed to live bars and trade stream subscribers,
+// including when the database is disabled
 func AddTradesToBuffer(exchangeName string, data ...Data) error {
 	if database.DB == nil || database.DB.Config == nil || !database.DB.Config.Enabled {
+		return PublishTrades(exchangeName, data...)
+	}
+	return processor.add(exchangeName, true, data...)
+}
+
+// PublishTrades passes trades to live bars and trade stream subscribers
+// without saving them to the database. Trades are dropped when nothing is
+// consuming the exchange's trades
+func PublishTrades(exchangeName string, data ...Data) error {
+	if !IsTradeFeedActive(exchangeName) {
 		return nil
 	}
+	return processor.add(exchangeName, false, data...)
+}
+
+// IsTradeFeedActive returns whether live bars or trade stream subscribers
+// are consuming trades for the exchange, so trades should be processed even
+// when they are not saved
+func IsTradeFeedActive(exchangeName string) bool {
+	return liveBars.tracks(exchangeName) || service.hasStream(exchangeName)
+}
+
+// add validates and deduplicates trades before passing them to live bars and
+// the trade stream. When save is set the trades are also written to the
+// write-ahead log and buffered to be saved to the database
+func (p *Processor) add(exchangeName string, save bool, data ...Data) error {
 	if len(data) == 0 {
 		return nil
 	}
 	var errs common.Errors
-	if atomic.AddInt32(&processor.started, 0) == 0 {
+	if save && atomic.AddInt32(&p.started, 0) == 0 {
 		var wg sync.WaitGroup
 		wg.Add(1)
-		processor.setup(&wg)
+		p.setup(&wg)
 		wg.Wait()
 	}
 	var validDatas []Data
+	var invalid int64
 	for i := range data {
 		if data[i].Price == 0 ||
 			data[i].Amount == 0 ||
@@ -50,6 +146,7 @@ func AddTradesToBuffer(exchangeName string, data ...Data) error {
 			data[i].Exchange == "" ||
 			data[i].Timestamp.IsZero() {
 			errs = append(errs, fmt.Errorf("%v received invalid trade data: %+v", exchangeName, data[i]))
+			invalid++
 			continue
 		}
 
@@ -74,9 +171,52 @@ func AddTradesToBuffer(exchangeName string, data ...Data) error {
 		data[i].ID = uu
 		validDatas = append(validDatas, data[i])
 	}
-	processor.mutex.Lock()
-	processor.buffer = append(processor.buffer, validDatas...)
-	processor.mutex.Unlock()
+
+	p.mutex.Lock()
+	p.stats.Received += int64(len(data))
+	p.stats.Invalid += invalid
+	now := time.Now()
+	if !save && now.Sub(p.lastPrune) > DeduplicationWindow {
+		// unsaved trades are not pruned by save
+		p.pruneSeen(now)
+	}
+	unique := validDatas[:0]
+	for i := range validDatas {
+		if !p.markSeen(&validDatas[i], now) {
+			p.stats.Duplicates++
+			continue
+		}
+		unique = append(unique, validDatas[i])
+	}
+	if save {
+		if p.wal != nil && len(unique) > 0 {
+			err := p.wal.append(unique...)
+			if err != nil {
+				// without a durable record the trades cannot be guaranteed
+				// to be saved, so they are forgotten to allow redelivery
+				for i := range unique {
+					delete(p.seen, unique[i].dedupeKey())
+				}
+				p.mutex.Unlock()
+				return append(errs, fmt.Errorf("%s trades not written to write-ahead log: %w", exchangeName, err))
+			}
+		}
+		p.buffer = append(p.buffer, unique...)
+		if len(p.buffer) >= MaxBufferSize {
+			p.stats.BackpressureEvents++
+			select {
+			case p.flush <- struct{}{}:
+			default:
+			}
+		}
+	}
+	p.mutex.Unlock()
+	liveBars.add(unique...)
+	// stream failures are logged as they do not affect saving the trades
+	err := service.publish(unique...)
+	if err != nil {
+		log.Errorf(log.Trade, "%s trades not published to the trade stream: %v\n", exchangeName, err)
+	}
 	if len(errs) > 0 {
 		return errs
 	}
@@ -95,24 +235,190 @@ func (p *Processor) Run(wg *sync.WaitGroup) {
 	}()
 	p.mutex.Lock()
 	ticker := time.NewTicker(p.bufferProcessorInterval)
+	flush := p.flush
 	p.mutex.Unlock()
+	defer ticker.Stop()
 	for {
-		<-ticker.C
-		p.mutex.Lock()
-		bufferCopy := append(p.buffer[:0:0], p.buffer...)
-		p.buffer = nil
-		p.mutex.Unlock()
-		if len(bufferCopy) == 0 {
-			ticker.Stop()
+		select {
+		case <-ticker.C:
+		case <-flush:
+		}
+		if !p.save() {
 			return
 		}
-		err := SaveTradesToDatabase(bufferCopy...)
+	}
+}
+
+// save writes the buffer to the database. Trades are only removed from the
+// write-ahead log once saved and are put back on the buffer when saving
+// fails so they are retried. Failures while the database cannot be reached
+// are retried indefinitely. After MaxSaveAttempts consecutive failures with
+// the database reachable the trades are saved one at a time and any which the
+// database still rejects are dead lettered so they cannot block the buffer.
+// It returns false when there is nothing to save
+func (p *Processor) save() bool {
+	p.mutex.Lock()
+	bufferCopy := append(p.buffer[:0:0], p.buffer...)
+	p.buffer = nil
+	p.pruneSeen(time.Now())
+	if len(bufferCopy) == 0 {
+		p.mutex.Unlock()
+		return false
+	}
+	if p.wal != nil {
+		err := p.wal.seal()
+		if err != nil {
+			log.Errorf(log.Trade, "Trade write-ahead log seal failed: %v\n", err)
+		}
+	}
+	individually := p.failedAttempts >= MaxSaveAttempts
+	p.mutex.Unlock()
+
+	start := time.Now()
+	var unsaved, rejected []Data
+	var rejectedBatch bool
+	if individually {
+		unsaved, rejected = p.saveIndividually(bufferCopy)
+	} else {
+		err := p.insert(bufferCopy...)
 		if err != nil {
 			log.Error(log.Trade, err)
+			unsaved = bufferCopy
+			rejectedBatch = !p.unavailable(err)
+		}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(unsaved) > 0 {
+		p.stats.FailedSaves++
+		p.stats.Persisted += int64(len(bufferCopy) - len(unsaved))
+		p.buffer = append(unsaved, p.buffer...)
+		if rejectedBatch {
+			p.failedAttempts++
+		}
+		return true
+	}
+	if len(rejected) > 0 {
+		p.stats.FailedSaves++
+		p.stats.DeadLettered += int64(len(rejected))
+		p.deadLetter(rejected)
+	}
+	p.failedAttempts = 0
+	p.stats.Persisted += int64(len(bufferCopy) - len(rejected))
+	p.stats.LastSave = start
+	p.stats.LastSaveDuration = time.Since(start)
+	if p.wal != nil {
+		err := p.wal.commit()
+		if err != nil {
+			log.Errorf(log.Trade, "Trade write-ahead log cleanup failed: %v\n", err)
+		}
+	}
+	return true
+}
+
+// saveIndividually saves each trade on its own and returns the trades which
+// the database rejected. When the database becomes unavailable the trades not
+// yet saved are returned as unsaved so they are retried
+func (p *Processor) saveIndividually(trades []Data) (unsaved, rejected []Data) {
+	for i := range trades {
+		err := p.insert(trades[i])
+		if err == nil {
+			continue
+		}
+		if p.unavailable(err) {
+			log.Errorf(log.Trade, "Trades could not be saved, database unavailable: %v\n", err)
+			return append(rejected, trades[i:]...), nil
+		}
+		log.Errorf(log.Trade, "Trade %+v could not be saved: %v\n", trades[i], err)
+		rejected = append(rejected, trades[i])
+	}
+	return nil, rejected
+}
+
+// insert saves trades to the database
+func (p *Processor) insert(trades ...Data) error {
+	if p.insertTrades != nil {
+		return p.insertTrades(trades...)
+	}
+	return SaveTradesToDatabase(trades...)
+}
+
+// unavailable returns whether a save failed because the database could not
+// be reached rather than because it rejected the trades
+func (p *Processor) unavailable(err error) bool {
+	if errors.Is(err, database.ErrDatabaseSupportDisabled) ||
+		errors.Is(err, tradesql.ErrTransaction) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, sql.ErrTxDone) {
+		return true
+	}
+	if p.databaseReachable != nil {
+		return !p.databaseReachable()
+	}
+	return !databaseReachable()
+}
+
+// databaseReachable returns whether the database connection responds
+func databaseReachable() bool {
+	database.DB.Mu.RLock()
+	defer database.DB.Mu.RUnlock()
+	return database.DB.SQL != nil && database.DB.Connected && database.DB.SQL.Ping() == nil
+}
+
+// deadLetter stores trades which repeatedly failed to save alongside the
+// write-ahead log so they can be inspected and imported manually, they are
+// dropped when no write-ahead log is setup
+func (p *Processor) deadLetter(trades []Data) {
+	if p.wal == nil {
+		log.Errorf(log.Trade, "Dropped %d trades which could not be saved to the database\n", len(trades))
+		return
+	}
+	err := p.wal.deadLetter(trades...)
+	if err != nil {
+		log.Errorf(log.Trade, "Dropped %d trades which could not be saved to the database or dead lettered: %v\n", len(trades), err)
+		return
+	}
+	log.Warnf(log.Trade, "Moved %d trades which could not be saved to the database to %s\n", len(trades), p.wal.deadLetterPath())
+}
+
+// markSeen records a trade for deduplication and returns false when it has
+// already been received within the DeduplicationWindow
+func (p *Processor) markSeen(d *Data, received time.Time) bool {
+	if p.seen == nil {
+		p.seen = make(map[string]time.Time)
+	}
+	key := d.dedupeKey()
+	if _, ok := p.seen[key]; ok {
+		return false
+	}
+	p.seen[key] = received
+	return true
+}
+
+func (p *Processor) pruneSeen(now time.Time) {
+	p.lastPrune = now
+	for k, v := range p.seen {
+		if now.Sub(v) > DeduplicationWindow {
+			delete(p.seen, k)
 		}
 	}
 }
 
+// dedupeKey identifies a trade by its exchange trade ID, falling back to the
+// trade details when the exchange does not provide one
+func (d *Data) dedupeKey() string {
+	key := d.Exchange + "|" + d.AssetType.String() + "|" + d.CurrencyPair.Upper().String() + "|"
+	if d.TID != "" {
+		return key + d.TID
+	}
+	return key + strconv.FormatFloat(d.Price, 'f', -1, 64) +
+		"|" + strconv.FormatFloat(d.Amount, 'f', -1, 64) +
+		"|" + d.Side.String() +
+		"|" + strconv.FormatInt(d.Timestamp.UnixNano(), 10)
+}
+
 // SaveTradesToDatabase converts trades and saves results to database
 func SaveTradesToDatabase(trades ...Data) error {
 	sqlTrades, err := tradeToSQLData(trades...)
@@ -143,9 +449,14 @@ func tradeToSQLData(trades ...Data) ([]tradesql.Data, error) {
 	sort.Sort(ByDate(trades))
 	var results []tradesql.Data
 	for i := range trades {
-		tradeID, err := uuid.NewV4()
-		if err != nil {
-			return nil, err
+		// reusing the buffered ID makes saving a recovered trade again a no-op
+		tradeID := trades[i].ID
+		if tradeID == uuid.Nil {
+			var err error
+			tradeID, err = uuid.NewV4()
+			if err != nil {
+				return nil, err
+			}
 		}
 		results = append(results, tradesql.Data{
 			ID:        tradeID.String(),
@@ -227,7 +538,7 @@ func groupTradesToInterval(interval kline.Interval, times ...Data) map[int64][]D
 }
 
 func getNearestInterval(t time.Time, interval kline.Interval) int64 {
-	return t.Truncate(interval.Duration()).UTC().Unix()
+	return interval.CandleStart(t).Unix()
 }
 
 func classifyOHLCV(t time.Time, datas ...Data) (c kline.Candle) {
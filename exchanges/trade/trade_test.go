@@ -1,6 +1,10 @@
 package trade
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -249,3 +253,191 @@ func TestGetTradesInRange(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestMarkSeen(t *testing.T) {
+	t.Parallel()
+	var p Processor
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	now := time.Now()
+	d := Data{
+		TID:          "1337",
+		Exchange:     "test!",
+		CurrencyPair: cp,
+		AssetType:    asset.Spot,
+		Price:        1337,
+		Amount:       1337,
+		Timestamp:    now,
+	}
+	if !p.markSeen(&d, now) {
+		t.Error("expected new trade to be unseen")
+	}
+	if p.markSeen(&d, now) {
+		t.Error("expected duplicate trade to be seen")
+	}
+
+	noTID := d
+	noTID.TID = ""
+	if !p.markSeen(&noTID, now) {
+		t.Error("expected trade without TID to be unseen")
+	}
+	if p.markSeen(&noTID, now) {
+		t.Error("expected duplicate trade without TID to be seen")
+	}
+	noTID.Amount = 1
+	if !p.markSeen(&noTID, now) {
+		t.Error("expected differing trade without TID to be unseen")
+	}
+
+	p.pruneSeen(now.Add(DeduplicationWindow + time.Second))
+	if len(p.seen) != 0 {
+		t.Errorf("expected all trades to be pruned, %v remain", len(p.seen))
+	}
+	if !p.markSeen(&d, now) {
+		t.Error("expected pruned trade to be unseen")
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	t.Parallel()
+	s := GetStats()
+	if s.Received < 0 || s.Buffered < 0 {
+		t.Errorf("unexpected stats %+v", s)
+	}
+}
+
+func TestSaveDeadLetter(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-trade-deadletter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	w, _, err := openWriteAheadLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+
+	d := Data{
+		TID:          "1337",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    time.Now().UTC().Truncate(time.Second),
+	}
+	// a reachable database which rejects the trade
+	p := Processor{
+		wal:    w,
+		buffer: []Data{d},
+		insertTrades: func(...Data) error {
+			return errors.New("exchange name/uuid not set, cannot insert")
+		},
+		databaseReachable: func() bool { return true },
+	}
+	for i := 0; i < MaxSaveAttempts; i++ {
+		if !p.save() {
+			t.Fatal("expected buffered trades to be saved")
+		}
+		if len(p.buffer) != 1 {
+			t.Fatalf("expected failed trade to be retried, buffer holds %v", len(p.buffer))
+		}
+	}
+	if !p.save() {
+		t.Fatal("expected buffered trades to be saved")
+	}
+	if len(p.buffer) != 0 {
+		t.Errorf("expected failed trade to be dead lettered, buffer holds %v", len(p.buffer))
+	}
+	if p.stats.DeadLettered != 1 || p.failedAttempts != 0 {
+		t.Errorf("unexpected stats %+v after dead lettering", p.stats)
+	}
+	trades, err := readSegment(w.deadLetterPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].TID != d.TID {
+		t.Errorf("expected dead lettered trade %+v, received %+v", d, trades)
+	}
+	if p.save() {
+		t.Error("expected nothing left to save")
+	}
+}
+
+func TestSaveDatabaseOutage(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-trade-outage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	w, _, err := openWriteAheadLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+
+	d := Data{
+		TID:          "1337",
+		Exchange:     "test",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    time.Now().UTC().Truncate(time.Second),
+	}
+	err = w.append(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reachable := false
+	insertErr := errors.New("dial tcp 127.0.0.1:5432: connect: connection refused")
+	var saved []Data
+	p := Processor{
+		wal:    w,
+		buffer: []Data{d},
+		insertTrades: func(trades ...Data) error {
+			if insertErr != nil {
+				return insertErr
+			}
+			saved = append(saved, trades...)
+			return nil
+		},
+		databaseReachable: func() bool { return reachable },
+	}
+
+	// an outage lasting well beyond MaxSaveAttempts save intervals, followed
+	// by transactions failing once the database can be reached
+	for i := 0; i < MaxSaveAttempts*4; i++ {
+		if i == MaxSaveAttempts*2 {
+			reachable = true
+			insertErr = fmt.Errorf("%w: commit driver: bad connection", sqltrade.ErrTransaction)
+		}
+		if !p.save() {
+			t.Fatal("expected buffered trades to be saved")
+		}
+		if len(p.buffer) != 1 {
+			t.Fatalf("expected trade to be retried, buffer holds %v", len(p.buffer))
+		}
+	}
+	if p.stats.DeadLettered != 0 || p.failedAttempts != 0 {
+		t.Errorf("expected nothing to be dead lettered during an outage, received stats %+v and %v failed attempts", p.stats, p.failedAttempts)
+	}
+	if _, err = os.Stat(w.deadLetterPath()); !os.IsNotExist(err) {
+		t.Errorf("expected no dead letter file, received %v", err)
+	}
+	if len(w.sealed) == 0 {
+		t.Error("expected trade to be kept in the write-ahead log")
+	}
+
+	insertErr = nil
+	if !p.save() {
+		t.Fatal("expected buffered trades to be saved")
+	}
+	if len(p.buffer) != 0 || len(saved) != 1 || saved[0].TID != d.TID || p.stats.Persisted != 1 {
+		t.Errorf("expected trade to be saved once the database recovered, received %+v", p.stats)
+	}
+	if len(w.sealed) != 0 {
+		t.Error("expected write-ahead log to be committed")
+	}
+}
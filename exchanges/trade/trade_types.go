@@ -1,22 +1,83 @@
 package trade
 
 import (
+	"errors"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 )
 
-const DefaultProcessorIntervalTime = time.Second * 15
+const (
+	DefaultProcessorIntervalTime = time.Second * 15
+	// DefaultDeduplicationWindow is how long a received trade is remembered
+	// to filter duplicates received via both REST and websocket
+	DefaultDeduplicationWindow = time.Minute * 15
+	// DefaultMaxBufferSize is the number of buffered trades which triggers
+	// an early save to the database
+	DefaultMaxBufferSize = 50000
+	// DefaultMaxSaveAttempts is the number of consecutive saves of the
+	// buffer rejected by a reachable database before failing trades are dead
+	// lettered
+	DefaultMaxSaveAttempts = 5
+
+	// DefaultLiveBarLimit is the number of completed bars kept for each
+	// live bar series
+	DefaultLiveBarLimit = 1000
+	// DefaultMaxLiveBarSeries is the number of live bar series which can be
+	// tracked at once
+	DefaultMaxLiveBarSeries = 100
+	// DefaultLiveBarExpiry is how long a live bar series is tracked without
+	// being requested
+	DefaultLiveBarExpiry = time.Hour * 24
+
+	writeAheadLogPrefix    = "trades-"
+	writeAheadLogExtension = ".wal"
+	deadLetterFile         = "trades.deadletter"
+)
 
 var (
 	processor Processor
+	service   *Service
 	// BufferProcessorIntervalTime is the interval to save trade buffer data to the database.
 	// Change this by changing the runtime param `-tradeprocessinginterval=15s`
 	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
+	// DeduplicationWindow is how long trades are remembered for deduplication
+	DeduplicationWindow = DefaultDeduplicationWindow
+	// MaxBufferSize is the buffer length which triggers an early save
+	MaxBufferSize = DefaultMaxBufferSize
+	// MaxSaveAttempts is the number of consecutive saves rejected by a
+	// reachable database before trades are saved one at a time and failing
+	// trades are dead lettered
+	MaxSaveAttempts = DefaultMaxSaveAttempts
+
+	// LiveBarLimit is the number of completed bars kept for each live bar
+	// series, older bars are discarded
+	LiveBarLimit = DefaultLiveBarLimit
+	// MaxLiveBarSeries is the number of live bar series which can be tracked
+	// at once, zero disables the limit
+	MaxLiveBarSeries = DefaultMaxLiveBarSeries
+	// LiveBarExpiry is how long a live bar series is tracked without being
+	// requested, zero disables expiry
+	LiveBarExpiry = DefaultLiveBarExpiry
+
+	liveBars = liveBarTracker{series: make(map[liveBarKey]*liveBarSeries)}
+
+	errNoTradesSupplied            = errors.New("no trades supplied")
+	errInvalidBarType              = errors.New("invalid bar type")
+	errInvalidBarThreshold         = errors.New("bar threshold must be greater than zero")
+	errInvalidBarInterval          = errors.New("time bars require an interval greater than zero")
+	errLiveBarsNotTracked          = errors.New("live bars are not being tracked")
+	errLiveBarLimitReached         = errors.New("reached live bar series limit")
+	errInvalidLiveBarRequest       = errors.New("invalid live bar request")
+	errWriteAheadLogDirectoryUnset = errors.New("write-ahead log directory not set")
+	errWriteAheadLogAlreadySetup   = errors.New("write-ahead log already setup")
 )
 
 // Data defines trade data
@@ -32,6 +93,74 @@ type Data struct {
 	Timestamp    time.Time
 }
 
+// BarType defines how trades are grouped into bars
+type BarType string
+
+// Supported bar types
+const (
+	// TimeBar closes a bar at the end of each interval
+	TimeBar BarType = "time"
+	// TickBar closes a bar once the threshold number of trades is reached
+	TickBar BarType = "tick"
+	// VolumeBar closes a bar once the threshold amount has been traded
+	VolumeBar BarType = "volume"
+	// DollarBar closes a bar once the threshold notional value, price
+	// multiplied by amount, has been traded
+	DollarBar BarType = "dollar"
+	// RenkoBar creates a brick each time price moves the threshold beyond
+	// the previous brick, reversals require a move of two bricks
+	RenkoBar BarType = "renko"
+)
+
+// BarConfig defines how a BarBuilder converts trades into bars. Threshold is
+// used by tick, volume, dollar and renko bars and Interval by time bars. When
+// HeikinAshi is set completed bars are converted to Heikin-Ashi candles
+type BarConfig struct {
+	Type       BarType
+	Threshold  float64
+	Interval   kline.Interval
+	HeikinAshi bool
+}
+
+// BarBuilder converts a stream of trades into bars
+type BarBuilder struct {
+	config  BarConfig
+	current kline.Candle
+	open    bool
+	// accumulated holds the trades, amount or notional value of the current
+	// bar, or the volume since the last brick for renko bars
+	accumulated    float64
+	lastHeikinAshi *kline.Candle
+}
+
+// liveBarKey identifies a live bar series
+type liveBarKey struct {
+	exchange string
+	pair     string
+	asset    asset.Item
+	config   BarConfig
+}
+
+// liveBarSeries holds the completed bars of a live bar series
+type liveBarSeries struct {
+	builder   *BarBuilder
+	bars      []kline.Candle
+	requested time.Time
+}
+
+// liveBarTracker builds bars from live trades
+type liveBarTracker struct {
+	m      sync.Mutex
+	series map[liveBarKey]*liveBarSeries
+}
+
+// Service holds the dispatch IDs for the live trade stream of each exchange
+type Service struct {
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.Mutex
+}
+
 // Processor used for processing trade data in batches
 // and saving them to the database
 type Processor struct {
@@ -39,6 +168,43 @@ type Processor struct {
 	started                 int32
 	bufferProcessorInterval time.Duration
 	buffer                  []Data
+	// seen holds the time each trade key was received for deduplication
+	seen  map[string]time.Time
+	wal   *writeAheadLog
+	flush chan struct{}
+	stats Stats
+	// failedAttempts is the number of consecutive saves rejected by a
+	// reachable database
+	failedAttempts int
+	lastPrune      time.Time
+	// insertTrades and databaseReachable replace the database in tests
+	insertTrades      func(...Data) error
+	databaseReachable func() bool
+}
+
+// Stats contains trade processor throughput and backpressure metrics
+type Stats struct {
+	Received           int64
+	Invalid            int64
+	Duplicates         int64
+	Persisted          int64
+	FailedSaves        int64
+	DeadLettered       int64
+	BackpressureEvents int64
+	Buffered           int
+	PendingSegments    int
+	LastSave           time.Time
+	LastSaveDuration   time.Duration
+}
+
+// writeAheadLog stores received trades on disk until they are saved to the
+// database. Trades are appended to the active segment, which is sealed when
+// a save begins and removed once the save succeeds
+type writeAheadLog struct {
+	dir      string
+	active   *os.File
+	sequence int64
+	sealed   []string
 }
 
 // ByDate sorts trades by date ascending
@@ -0,0 +1,164 @@
+package trade
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// openWriteAheadLog opens the write-ahead log in dir and returns any trades
+// left over from segments which were never saved to the database
+func openWriteAheadLog(dir string) (*writeAheadLog, []Data, error) {
+	if dir == "" {
+		return nil, nil, errWriteAheadLogDirectoryUnset
+	}
+	err := common.CreateDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	segments, err := filepath.Glob(filepath.Join(dir, writeAheadLogPrefix+"*"+writeAheadLogExtension))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(segments)
+
+	w := &writeAheadLog{
+		dir:      dir,
+		sequence: time.Now().UnixNano(),
+	}
+	var recovered []Data
+	for i := range segments {
+		var trades []Data
+		trades, err = readSegment(segments[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		recovered = append(recovered, trades...)
+		w.sealed = append(w.sealed, segments[i])
+	}
+	err = w.openSegment()
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, recovered, nil
+}
+
+// readSegment decodes every complete trade in a segment. A partially written
+// final line from a crash is skipped as it was never acknowledged
+func readSegment(path string) ([]Data, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var trades []Data
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var d Data
+		err = json.Unmarshal(line, &d)
+		if err != nil {
+			log.Warnf(log.Trade, "skipping unreadable trade in write-ahead log %s: %v\n", path, err)
+			continue
+		}
+		trades = append(trades, d)
+	}
+	return trades, scanner.Err()
+}
+
+func (w *writeAheadLog) openSegment() error {
+	w.sequence++
+	name := filepath.Join(w.dir, fmt.Sprintf("%s%020d%s", writeAheadLogPrefix, w.sequence, writeAheadLogExtension))
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	w.active = f
+	return nil
+}
+
+// append durably writes trades to the active segment
+func (w *writeAheadLog) append(trades ...Data) error {
+	return writeTrades(w.active, trades...)
+}
+
+// writeTrades durably writes trades to f as one JSON document per line
+func writeTrades(f *os.File, trades ...Data) error {
+	var sb strings.Builder
+	for i := range trades {
+		b, err := json.Marshal(trades[i])
+		if err != nil {
+			return err
+		}
+		sb.Write(b)
+		sb.WriteByte('\n')
+	}
+	_, err := f.WriteString(sb.String())
+	if err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// deadLetter appends trades which could not be saved to the dead letter file
+func (w *writeAheadLog) deadLetter(trades ...Data) error {
+	f, err := os.OpenFile(w.deadLetterPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	err = writeTrades(f, trades...)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (w *writeAheadLog) deadLetterPath() string {
+	return filepath.Join(w.dir, deadLetterFile)
+}
+
+// seal closes the active segment so that everything written so far can be
+// removed after a successful save, and opens a new active segment
+func (w *writeAheadLog) seal() error {
+	name := w.active.Name()
+	err := w.active.Close()
+	if err != nil {
+		return err
+	}
+	w.sealed = append(w.sealed, name)
+	return w.openSegment()
+}
+
+// commit removes sealed segments once their trades are saved
+func (w *writeAheadLog) commit() error {
+	var errs common.Errors
+	for i := range w.sealed {
+		err := os.Remove(w.sealed[i])
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	w.sealed = nil
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (w *writeAheadLog) close() error {
+	return w.active.Close()
+}
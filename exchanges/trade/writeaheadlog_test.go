@@ -0,0 +1,122 @@
+package trade
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+func TestWriteAheadLog(t *testing.T) {
+	t.Parallel()
+	_, _, err := openWriteAheadLog("")
+	if !errors.Is(err, errWriteAheadLogDirectoryUnset) {
+		t.Errorf("received %v, expected %v", err, errWriteAheadLogDirectoryUnset)
+	}
+
+	dir, err := ioutil.TempDir("", "gct-trade-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, recovered, err := openWriteAheadLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recovered) != 0 {
+		t.Errorf("expected no recovered trades, received %v", len(recovered))
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := Data{
+		ID:           id,
+		TID:          "1337",
+		Exchange:     "test!",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Side:         order.Buy,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    time.Now().UTC().Truncate(time.Second),
+	}
+	err = w.append(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.seal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d2 := d
+	d2.TID = "1338"
+	err = w.append(d2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// simulate a crash part way through writing a trade
+	_, err = w.active.WriteString(`{"ID":"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, recovered, err = openWriteAheadLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recovered) != 2 {
+		t.Fatalf("expected 2 recovered trades, received %v", len(recovered))
+	}
+	if recovered[0].ID != d.ID ||
+		recovered[0].TID != d.TID ||
+		!recovered[0].CurrencyPair.Equal(d.CurrencyPair) ||
+		recovered[0].AssetType != d.AssetType ||
+		recovered[0].Side != d.Side ||
+		!recovered[0].Timestamp.Equal(d.Timestamp) {
+		t.Errorf("recovered trade %+v does not match %+v", recovered[0], d)
+	}
+	if recovered[1].TID != d2.TID {
+		t.Errorf("received %v, expected %v", recovered[1].TID, d2.TID)
+	}
+
+	err = w.commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	segments, err := filepath.Glob(filepath.Join(dir, writeAheadLogPrefix+"*"+writeAheadLogExtension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 1 {
+		t.Errorf("expected only the active segment to remain, received %v", segments)
+	}
+	w, recovered, err = openWriteAheadLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recovered) != 0 {
+		t.Errorf("expected committed trades not to be recovered, received %v", len(recovered))
+	}
+	err = w.close()
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -215,7 +215,7 @@ func (z *ZB) wsHandleData(respRaw []byte) error {
 			Status:   order.Cancelled,
 		}
 	case strings.Contains(result.Channel, "trades"):
-		if !z.IsSaveTradeDataEnabled() {
+		if !z.IsTradeFeedEnabled() {
 			return nil
 		}
 		var tradeData WsTrades
@@ -250,7 +250,7 @@ func (z *ZB) wsHandleData(respRaw []byte) error {
 				TID:          strconv.FormatInt(tradeData.Data[i].TID, 10),
 			})
 		}
-		return trade.AddTradesToBuffer(z.Name, trades...)
+		return z.AddTradesToBuffer(trades...)
 	default:
 		z.Websocket.DataHandler <- stream.UnhandledMessageWarning{
 			Message: z.Name +
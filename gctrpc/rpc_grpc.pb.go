@@ -78,6 +78,9 @@ type GoCryptoTraderClient interface {
 	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
 	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GCTScriptGetState(ctx context.Context, in *GCTScriptStateRequest, opts ...grpc.CallOption) (*GCTScriptStateResponse, error)
+	GCTScriptClearState(ctx context.Context, in *GCTScriptClearStateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GCTScriptBacktest(ctx context.Context, in *GCTScriptBacktestRequest, opts ...grpc.CallOption) (*GCTScriptBacktestResponse, error)
 	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
 	SetExchangeAsset(ctx context.Context, in *SetExchangeAssetRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	SetAllExchangePairs(ctx context.Context, in *SetExchangeAllPairsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
@@ -95,6 +98,21 @@ type GoCryptoTraderClient interface {
 	FindMissingSavedCandleIntervals(ctx context.Context, in *FindMissingCandlePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(ctx context.Context, in *FindMissingTradePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetTickerHistory(ctx context.Context, in *GetTickerHistoryRequest, opts ...grpc.CallOption) (*GetTickerHistoryResponse, error)
+	GetOrderbookHistory(ctx context.Context, in *GetOrderbookHistoryRequest, opts ...grpc.CallOption) (*GetOrderbookHistoryResponse, error)
+	GetLiquidationStream(ctx context.Context, in *GetLiquidationStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiquidationStreamClient, error)
+	GetOrderExecutionLimits(ctx context.Context, in *GetOrderExecutionLimitsRequest, opts ...grpc.CallOption) (*GetOrderExecutionLimitsResponse, error)
+	UpsertDataHistoryJob(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobResponse, error)
+	GetDataHistoryJobDetails(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
+	GetActiveDataHistoryJobs(ctx context.Context, in *GetActiveDataHistoryJobsRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error)
+	GetDataHistoryJobsBetween(ctx context.Context, in *GetDataHistoryJobsBetweenRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error)
+	DeleteDataHistoryJob(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ValidateSavedCandles(ctx context.Context, in *ValidateSavedCandlesRequest, opts ...grpc.CallOption) (*ValidateSavedCandlesResponse, error)
+	ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (*ExportDataResponse, error)
+	ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error)
+	GetOpenInterestHistory(ctx context.Context, in *GetOpenInterestHistoryRequest, opts ...grpc.CallOption) (*GetOpenInterestHistoryResponse, error)
+	GetLongShortRatioHistory(ctx context.Context, in *GetLongShortRatioHistoryRequest, opts ...grpc.CallOption) (*GetLongShortRatioHistoryResponse, error)
+	GetTradeProcessorStats(ctx context.Context, in *GetTradeProcessorStatsRequest, opts ...grpc.CallOption) (*GetTradeProcessorStatsResponse, error)
 }
 
 type goCryptoTraderClient struct {
@@ -769,6 +787,33 @@ func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GCTScriptGetState(ctx context.Context, in *GCTScriptStateRequest, opts ...grpc.CallOption) (*GCTScriptStateResponse, error) {
+	out := new(GCTScriptStateResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptGetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptClearState(ctx context.Context, in *GCTScriptClearStateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptClearState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptBacktest(ctx context.Context, in *GCTScriptBacktestRequest, opts ...grpc.CallOption) (*GCTScriptBacktestResponse, error) {
+	out := new(GCTScriptBacktestResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptBacktest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
 	out := new(GetHistoricCandlesResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
@@ -945,6 +990,164 @@ func (c *goCryptoTraderClient) SetExchangeTradeProcessing(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetTickerHistory(ctx context.Context, in *GetTickerHistoryRequest, opts ...grpc.CallOption) (*GetTickerHistoryResponse, error) {
+	out := new(GetTickerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbookHistory(ctx context.Context, in *GetOrderbookHistoryRequest, opts ...grpc.CallOption) (*GetOrderbookHistoryResponse, error) {
+	out := new(GetOrderbookHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbookHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLiquidationStream(ctx context.Context, in *GetLiquidationStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiquidationStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetLiquidationStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetLiquidationStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetLiquidationStreamClient interface {
+	Recv() (*LiquidationResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetLiquidationStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetLiquidationStreamClient) Recv() (*LiquidationResponse, error) {
+	m := new(LiquidationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderExecutionLimits(ctx context.Context, in *GetOrderExecutionLimitsRequest, opts ...grpc.CallOption) (*GetOrderExecutionLimitsResponse, error) {
+	out := new(GetOrderExecutionLimitsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderExecutionLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) UpsertDataHistoryJob(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobResponse, error) {
+	out := new(UpsertDataHistoryJobResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/UpsertDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJobDetails(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error) {
+	out := new(DataHistoryJob)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJobDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetActiveDataHistoryJobs(ctx context.Context, in *GetActiveDataHistoryJobsRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error) {
+	out := new(DataHistoryJobs)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetActiveDataHistoryJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJobsBetween(ctx context.Context, in *GetDataHistoryJobsBetweenRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error) {
+	out := new(DataHistoryJobs)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJobsBetween", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DeleteDataHistoryJob(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DeleteDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ValidateSavedCandles(ctx context.Context, in *ValidateSavedCandlesRequest, opts ...grpc.CallOption) (*ValidateSavedCandlesResponse, error) {
+	out := new(ValidateSavedCandlesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ValidateSavedCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (*ExportDataResponse, error) {
+	out := new(ExportDataResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ExportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error) {
+	out := new(ImportDataResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ImportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOpenInterestHistory(ctx context.Context, in *GetOpenInterestHistoryRequest, opts ...grpc.CallOption) (*GetOpenInterestHistoryResponse, error) {
+	out := new(GetOpenInterestHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOpenInterestHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLongShortRatioHistory(ctx context.Context, in *GetLongShortRatioHistoryRequest, opts ...grpc.CallOption) (*GetLongShortRatioHistoryResponse, error) {
+	out := new(GetLongShortRatioHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLongShortRatioHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTradeProcessorStats(ctx context.Context, in *GetTradeProcessorStatsRequest, opts ...grpc.CallOption) (*GetTradeProcessorStatsResponse, error) {
+	out := new(GetTradeProcessorStatsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTradeProcessorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServer is the server API for GoCryptoTrader service.
 // All implementations must embed UnimplementedGoCryptoTraderServer
 // for forward compatibility
@@ -1010,6 +1213,9 @@ type GoCryptoTraderServer interface {
 	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
 	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
 	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
+	GCTScriptGetState(context.Context, *GCTScriptStateRequest) (*GCTScriptStateResponse, error)
+	GCTScriptClearState(context.Context, *GCTScriptClearStateRequest) (*GenericResponse, error)
+	GCTScriptBacktest(context.Context, *GCTScriptBacktestRequest) (*GCTScriptBacktestResponse, error)
 	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
 	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
 	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
@@ -1027,6 +1233,21 @@ type GoCryptoTraderServer interface {
 	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
 	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
+	GetTickerHistory(context.Context, *GetTickerHistoryRequest) (*GetTickerHistoryResponse, error)
+	GetOrderbookHistory(context.Context, *GetOrderbookHistoryRequest) (*GetOrderbookHistoryResponse, error)
+	GetLiquidationStream(*GetLiquidationStreamRequest, GoCryptoTrader_GetLiquidationStreamServer) error
+	GetOrderExecutionLimits(context.Context, *GetOrderExecutionLimitsRequest) (*GetOrderExecutionLimitsResponse, error)
+	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
+	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	GetActiveDataHistoryJobs(context.Context, *GetActiveDataHistoryJobsRequest) (*DataHistoryJobs, error)
+	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
+	DeleteDataHistoryJob(context.Context, *GetDataHistoryJobDetailsRequest) (*GenericResponse, error)
+	ValidateSavedCandles(context.Context, *ValidateSavedCandlesRequest) (*ValidateSavedCandlesResponse, error)
+	ExportData(context.Context, *ExportDataRequest) (*ExportDataResponse, error)
+	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
+	GetOpenInterestHistory(context.Context, *GetOpenInterestHistoryRequest) (*GetOpenInterestHistoryResponse, error)
+	GetLongShortRatioHistory(context.Context, *GetLongShortRatioHistoryRequest) (*GetLongShortRatioHistoryResponse, error)
+	GetTradeProcessorStats(context.Context, *GetTradeProcessorStatsRequest) (*GetTradeProcessorStatsResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServer()
 }
 
@@ -1217,6 +1438,15 @@ func (UnimplementedGoCryptoTraderServer) GCTScriptListAll(context.Context, *GCTS
 func (UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GCTScriptGetState(context.Context, *GCTScriptStateRequest) (*GCTScriptStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptGetState not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptClearState(context.Context, *GCTScriptClearStateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptClearState not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptBacktest(context.Context, *GCTScriptBacktestRequest) (*GCTScriptBacktestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptBacktest not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
 }
@@ -1268,6 +1498,51 @@ func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.
 func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetTickerHistory(context.Context, *GetTickerHistoryRequest) (*GetTickerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickerHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookHistory(context.Context, *GetOrderbookHistoryRequest) (*GetOrderbookHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLiquidationStream(*GetLiquidationStreamRequest, GoCryptoTrader_GetLiquidationStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLiquidationStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderExecutionLimits(context.Context, *GetOrderExecutionLimitsRequest) (*GetOrderExecutionLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderExecutionLimits not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJob not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetActiveDataHistoryJobs(context.Context, *GetActiveDataHistoryJobsRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDataHistoryJobs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobsBetween not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) DeleteDataHistoryJob(context.Context, *GetDataHistoryJobDetailsRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDataHistoryJob not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ValidateSavedCandles(context.Context, *ValidateSavedCandlesRequest) (*ValidateSavedCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSavedCandles not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ExportData(context.Context, *ExportDataRequest) (*ExportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOpenInterestHistory(context.Context, *GetOpenInterestHistoryRequest) (*GetOpenInterestHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInterestHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLongShortRatioHistory(context.Context, *GetLongShortRatioHistoryRequest) (*GetLongShortRatioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLongShortRatioHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTradeProcessorStats(context.Context, *GetTradeProcessorStatsRequest) (*GetTradeProcessorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeProcessorStats not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
 
 // UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
@@ -2394,6 +2669,60 @@ func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GCTScriptGetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptGetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptGetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptGetState(ctx, req.(*GCTScriptStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptClearState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptClearStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptClearState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptClearState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptClearState(ctx, req.(*GCTScriptClearStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptBacktest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptBacktestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptBacktest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptBacktest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptBacktest(ctx, req.(*GCTScriptBacktestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
@@ -2703,6 +3032,279 @@ func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GetTickerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickerHistory(ctx, req.(*GetTickerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbookHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbookHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbookHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbookHistory(ctx, req.(*GetOrderbookHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetLiquidationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLiquidationStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetLiquidationStream(m, &goCryptoTraderGetLiquidationStreamServer{stream})
+}
+
+type GoCryptoTrader_GetLiquidationStreamServer interface {
+	Send(*LiquidationResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetLiquidationStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetLiquidationStreamServer) Send(m *LiquidationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetOrderExecutionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderExecutionLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderExecutionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderExecutionLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderExecutionLimits(ctx, req.(*GetOrderExecutionLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_UpsertDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDataHistoryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/UpsertDataHistoryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, req.(*UpsertDataHistoryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDataHistoryJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, req.(*GetDataHistoryJobDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetActiveDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveDataHistoryJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetActiveDataHistoryJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, req.(*GetActiveDataHistoryJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDataHistoryJobsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobsBetweenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobsBetween",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, req.(*GetDataHistoryJobsBetweenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DeleteDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DeleteDataHistoryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DeleteDataHistoryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DeleteDataHistoryJob(ctx, req.(*GetDataHistoryJobDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ValidateSavedCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSavedCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ValidateSavedCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ValidateSavedCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ValidateSavedCandles(ctx, req.(*ValidateSavedCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ExportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ExportData(ctx, req.(*ExportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ImportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ImportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ImportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ImportData(ctx, req.(*ImportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOpenInterestHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenInterestHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOpenInterestHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOpenInterestHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOpenInterestHistory(ctx, req.(*GetOpenInterestHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetLongShortRatioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLongShortRatioHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetLongShortRatioHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLongShortRatioHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetLongShortRatioHistory(ctx, req.(*GetLongShortRatioHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTradeProcessorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeProcessorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTradeProcessorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTradeProcessorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTradeProcessorStats(ctx, req.(*GetTradeProcessorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gctrpc.GoCryptoTrader",
 	HandlerType: (*GoCryptoTraderServer)(nil),
@@ -2931,6 +3533,18 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GCTScriptAutoLoadToggle",
 			Handler:    _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler,
 		},
+		{
+			MethodName: "GCTScriptGetState",
+			Handler:    _GoCryptoTrader_GCTScriptGetState_Handler,
+		},
+		{
+			MethodName: "GCTScriptClearState",
+			Handler:    _GoCryptoTrader_GCTScriptClearState_Handler,
+		},
+		{
+			MethodName: "GCTScriptBacktest",
+			Handler:    _GoCryptoTrader_GCTScriptBacktest_Handler,
+		},
 		{
 			MethodName: "GetHistoricCandles",
 			Handler:    _GoCryptoTrader_GetHistoricCandles_Handler,
@@ -2995,6 +3609,62 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetExchangeTradeProcessing",
 			Handler:    _GoCryptoTrader_SetExchangeTradeProcessing_Handler,
 		},
+		{
+			MethodName: "GetTickerHistory",
+			Handler:    _GoCryptoTrader_GetTickerHistory_Handler,
+		},
+		{
+			MethodName: "GetOrderbookHistory",
+			Handler:    _GoCryptoTrader_GetOrderbookHistory_Handler,
+		},
+		{
+			MethodName: "GetOrderExecutionLimits",
+			Handler:    _GoCryptoTrader_GetOrderExecutionLimits_Handler,
+		},
+		{
+			MethodName: "UpsertDataHistoryJob",
+			Handler:    _GoCryptoTrader_UpsertDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "GetDataHistoryJobDetails",
+			Handler:    _GoCryptoTrader_GetDataHistoryJobDetails_Handler,
+		},
+		{
+			MethodName: "GetActiveDataHistoryJobs",
+			Handler:    _GoCryptoTrader_GetActiveDataHistoryJobs_Handler,
+		},
+		{
+			MethodName: "GetDataHistoryJobsBetween",
+			Handler:    _GoCryptoTrader_GetDataHistoryJobsBetween_Handler,
+		},
+		{
+			MethodName: "DeleteDataHistoryJob",
+			Handler:    _GoCryptoTrader_DeleteDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "ValidateSavedCandles",
+			Handler:    _GoCryptoTrader_ValidateSavedCandles_Handler,
+		},
+		{
+			MethodName: "ExportData",
+			Handler:    _GoCryptoTrader_ExportData_Handler,
+		},
+		{
+			MethodName: "ImportData",
+			Handler:    _GoCryptoTrader_ImportData_Handler,
+		},
+		{
+			MethodName: "GetOpenInterestHistory",
+			Handler:    _GoCryptoTrader_GetOpenInterestHistory_Handler,
+		},
+		{
+			MethodName: "GetLongShortRatioHistory",
+			Handler:    _GoCryptoTrader_GetLongShortRatioHistory_Handler,
+		},
+		{
+			MethodName: "GetTradeProcessorStats",
+			Handler:    _GoCryptoTrader_GetTradeProcessorStats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3027,6 +3697,11 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetLiquidationStream",
+			Handler:       _GoCryptoTrader_GetLiquidationStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -56,6 +56,35 @@ func WriteAsCSV(args ...objects.Object) (objects.Object, error) {
 			temp, err = convertSMA(args[i])
 		case indicators.CorrelationCoefficient:
 			temp, err = convertCorrelationCoefficient(args[i])
+		case indicators.StochasticOscillator:
+			temp, err = convertStochastic(args[i])
+		case indicators.StochasticRelativeStrengthIndex:
+			temp, err = convertStochRSI(args[i])
+		case indicators.AverageDirectionalIndex:
+			temp, err = convertADX(args[i])
+		case indicators.IchimokuCloud:
+			temp, err = convertIchimoku(args[i])
+		case indicators.ParabolicSAR:
+			temp, err = convertPSAR(args[i])
+		case indicators.KeltnerChannels:
+			temp, err = convertKeltner(args[i])
+		case indicators.DonchianChannels:
+			temp, err = convertDonchian(args[i])
+		case indicators.VolumeWeightedAveragePrice:
+			temp, err = convertVWAP(args[i])
+		case indicators.SuperTrendIndicator:
+			temp, err = convertSuperTrend(args[i])
+		case indicators.CommodityChannelIndex:
+			temp, err = convertCCI(args[i])
+		case indicators.WilliamsPercentRange:
+			temp, err = convertWilliamsR(args[i])
+		case indicators.WeightedMovingAverage,
+			indicators.HullMovingAverage,
+			indicators.DoubleExponentialMovingAverage,
+			indicators.TripleExponentialMovingAverage:
+			temp, err = convertMovingAverage(args[i])
+		case indicators.PivotPoints:
+			temp, err = convertPivots(args[i])
 		case indicators.OHLCV:
 			temp, err = convertOHLCV(args[i])
 			front = true
@@ -416,6 +445,257 @@ func convertCorrelationCoefficient(a objects.Object) ([][]string, error) {
 	return bucket, nil
 }
 
+// convertSeries appends a row for each indicator value to the header rows,
+// values holding more than one series are written to a column each
+func convertSeries(header [][]string, values []objects.Object) ([][]string, error) {
+	bucket := header
+	for x := range values {
+		var row []string
+		if _, ok := values[x].(*objects.Array); !ok {
+			val, ok := objects.ToString(values[x])
+			if !ok {
+				return nil, errors.New("cannot convert object to string")
+			}
+			bucket = append(bucket, []string{val})
+			continue
+		}
+		element := values[x].Iterate()
+		for element.Next() {
+			val, ok := objects.ToString(element.Value())
+			if !ok {
+				return nil, errors.New("cannot convert object to string")
+			}
+			row = append(row, val)
+		}
+		bucket = append(bucket, row)
+	}
+	return bucket, nil
+}
+
+func convertStochastic(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.Stochastic)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.StochasticOscillator,
+			fmt.Sprintf("PeriodK:%d Slowing:%d PeriodD:%d", obj.PeriodK, obj.Slowing, obj.PeriodD),
+		},
+		{
+			"%K", "%D",
+		},
+	}, obj.Value)
+}
+
+func convertStochRSI(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.StochRSI)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.StochasticRelativeStrengthIndex,
+			fmt.Sprintf("PeriodRSI:%d PeriodStoch:%d PeriodK:%d PeriodD:%d",
+				obj.PeriodRSI,
+				obj.PeriodStoch,
+				obj.PeriodK,
+				obj.PeriodD),
+		},
+		{
+			"%K", "%D",
+		},
+	}, obj.Value)
+}
+
+func convertADX(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.ADX)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.AverageDirectionalIndex, fmt.Sprintf("Period:%d", obj.Period), "",
+		},
+		{
+			"ADX", "+DI", "-DI",
+		},
+	}, obj.Value)
+}
+
+func convertIchimoku(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.Ichimoku)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.IchimokuCloud,
+			fmt.Sprintf("Conversion:%d Base:%d SpanB:%d",
+				obj.PeriodConversion,
+				obj.PeriodBase,
+				obj.PeriodSpanB),
+			"", "",
+		},
+		{
+			"Conversion_Line", "Base_Line", "Leading_Span_A", "Leading_Span_B",
+		},
+	}, obj.Value)
+}
+
+func convertPSAR(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.PSAR)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.ParabolicSAR,
+		},
+		{
+			fmt.Sprintf("Step:%f Maximum:%f", obj.Step, obj.Maximum),
+		},
+	}, obj.Value)
+}
+
+func convertKeltner(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.Keltner)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.KeltnerChannels,
+			fmt.Sprintf("PeriodEMA:%d PeriodATR:%d Multiplier:%f",
+				obj.PeriodEMA,
+				obj.PeriodATR,
+				obj.Multiplier),
+			"",
+		},
+		{
+			"Upper_Band", "Middle_Band", "Lower_Band",
+		},
+	}, obj.Value)
+}
+
+func convertDonchian(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.Donchian)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.DonchianChannels, fmt.Sprintf("Period:%d", obj.Period), "",
+		},
+		{
+			"Upper_Band", "Middle_Band", "Lower_Band",
+		},
+	}, obj.Value)
+}
+
+func convertVWAP(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.VWAP)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.VolumeWeightedAveragePrice,
+		},
+		{
+			fmt.Sprintf("Period:%d", obj.Period),
+		},
+	}, obj.Value)
+}
+
+func convertSuperTrend(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.SuperTrend)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.SuperTrendIndicator,
+			fmt.Sprintf("Period:%d Multiplier:%f", obj.Period, obj.Multiplier),
+		},
+		{
+			"SuperTrend", "Direction",
+		},
+	}, obj.Value)
+}
+
+func convertCCI(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.CCI)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.CommodityChannelIndex,
+		},
+		{
+			fmt.Sprintf("Period:%d", obj.Period),
+		},
+	}, obj.Value)
+}
+
+func convertWilliamsR(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.WilliamsR)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.WilliamsPercentRange,
+		},
+		{
+			fmt.Sprintf("Period:%d", obj.Period),
+		},
+	}, obj.Value)
+}
+
+// convertMovingAverage converts the weighted, Hull, double and triple
+// exponential moving averages which share a period
+func convertMovingAverage(a objects.Object) ([][]string, error) {
+	var period int
+	var values []objects.Object
+	switch obj := objects.ToInterface(a).(type) {
+	case *indicators.WMA:
+		period, values = obj.Period, obj.Value
+	case *indicators.HMA:
+		period, values = obj.Period, obj.Value
+	case *indicators.DEMA:
+		period, values = obj.Period, obj.Value
+	case *indicators.TEMA:
+		period, values = obj.Period, obj.Value
+	default:
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			a.TypeName(),
+		},
+		{
+			fmt.Sprintf("Period:%d", period),
+		},
+	}, values)
+}
+
+func convertPivots(a objects.Object) ([][]string, error) {
+	obj, ok := objects.ToInterface(a).(*indicators.Pivots)
+	if !ok {
+		return nil, errors.New("casting failure")
+	}
+	return convertSeries([][]string{
+		{
+			indicators.PivotPoints, "Method:" + obj.Method, "", "", "", "", "",
+		},
+		{
+			"Pivot", "R1", "R2", "R3", "S1", "S2", "S3",
+		},
+	}, obj.Value)
+}
+
 func convertOHLCV(a objects.Object) ([][]string, error) {
 	obj, ok := objects.ToInterface(a).(*OHLCV)
 	if !ok {
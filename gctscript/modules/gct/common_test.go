@@ -21,6 +21,22 @@ var (
 	rsiPayload         = &indicators.RSI{Array: oneElement}
 	smaPayload         = &indicators.SMA{Array: oneElement}
 	correlationPayload = &indicators.Correlation{Array: oneElement}
+	stochPayload       = &indicators.Stochastic{Array: multiElement(2)}
+	stochRSIPayload    = &indicators.StochRSI{Array: multiElement(2)}
+	adxPayload         = &indicators.ADX{Array: threeElement}
+	ichimokuPayload    = &indicators.Ichimoku{Array: multiElement(4)}
+	psarPayload        = &indicators.PSAR{Array: oneElement}
+	keltnerPayload     = &indicators.Keltner{Array: threeElement}
+	donchianPayload    = &indicators.Donchian{Array: threeElement}
+	vwapPayload        = &indicators.VWAP{Array: oneElement}
+	superTrendPayload  = &indicators.SuperTrend{Array: multiElement(2)}
+	cciPayload         = &indicators.CCI{Array: oneElement}
+	williamsRPayload   = &indicators.WilliamsR{Array: oneElement}
+	wmaPayload         = &indicators.WMA{Array: oneElement}
+	hmaPayload         = &indicators.HMA{Array: oneElement}
+	demaPayload        = &indicators.DEMA{Array: oneElement}
+	temaPayload        = &indicators.TEMA{Array: oneElement}
+	pivotsPayload      = &indicators.Pivots{Array: multiElement(7)}
 	ohlcPayload        = &OHLCV{Map: ohlcdata}
 	unhandled          = &objects.Array{}
 
@@ -112,6 +128,19 @@ var (
 	}
 )
 
+// multiElement returns five indicator values each holding a value per series
+func multiElement(series int) objects.Array {
+	var a objects.Array
+	for x := 1; x <= 5; x++ {
+		values := make([]objects.Object, series)
+		for y := range values {
+			values[y] = &objects.Float{Value: float64(x*10 + y + 1)}
+		}
+		a.Value = append(a.Value, &objects.Array{Value: values})
+	}
+	return a
+}
+
 func TestCommonWriteToCSV(t *testing.T) {
 	t.Parallel()
 
@@ -159,6 +188,22 @@ func TestCommonWriteToCSV(t *testing.T) {
 		rsiPayload,
 		smaPayload,
 		correlationPayload,
+		stochPayload,
+		stochRSIPayload,
+		adxPayload,
+		ichimokuPayload,
+		psarPayload,
+		keltnerPayload,
+		donchianPayload,
+		vwapPayload,
+		superTrendPayload,
+		cciPayload,
+		williamsRPayload,
+		wmaPayload,
+		hmaPayload,
+		demaPayload,
+		temaPayload,
+		pivotsPayload,
 		ohlcPayload)
 	if err != nil {
 		t.Fatal(err)
@@ -2,6 +2,7 @@ package gct
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	objects "github.com/d5/tengo/v2"
@@ -10,28 +11,167 @@ import (
 	"github.com/idoall/gocryptotrader/exchanges/asset"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/gctscript/modules/ta/indicators"
 	"github.com/idoall/gocryptotrader/gctscript/wrappers"
 	"github.com/idoall/gocryptotrader/portfolio/withdraw"
 )
 
-var exchangeModule = map[string]objects.Object{
-	"orderbook":      &objects.UserFunction{Name: "orderbook", Value: ExchangeOrderbook},
-	"ticker":         &objects.UserFunction{Name: "ticker", Value: ExchangeTicker},
-	"exchanges":      &objects.UserFunction{Name: "exchanges", Value: ExchangeExchanges},
-	"pairs":          &objects.UserFunction{Name: "pairs", Value: ExchangePairs},
-	"accountinfo":    &objects.UserFunction{Name: "accountinfo", Value: ExchangeAccountInfo},
-	"depositaddress": &objects.UserFunction{Name: "depositaddress", Value: ExchangeDepositAddress},
-	"orderquery":     &objects.UserFunction{Name: "orderquery", Value: ExchangeOrderQuery},
-	"ordercancel":    &objects.UserFunction{Name: "ordercancel", Value: ExchangeOrderCancel},
-	"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
-	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
-	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
-	"ohlcv":          &objects.UserFunction{Name: "ohlcv", Value: exchangeOHLCV},
+var exchangeModule = ExchangeModule(nil)
+
+// exchangeWrapper binds the exchange module functions to the wrapper they
+// call, the default wrapper is used when unset
+type exchangeWrapper struct {
+	w modules.Exchange
+}
+
+func (e exchangeWrapper) wrapper() modules.Exchange {
+	if e.w != nil {
+		return e.w
+	}
+	return wrappers.GetWrapper()
+}
+
+// ExchangeModule returns the exchange module with its functions calling the
+// wrapper, a nil wrapper uses the default wrapper
+func ExchangeModule(w modules.Exchange) map[string]objects.Object {
+	e := exchangeWrapper{w: w}
+	return map[string]objects.Object{
+		"orderbook":        &objects.UserFunction{Name: "orderbook", Value: e.orderbook},
+		"ticker":           &objects.UserFunction{Name: "ticker", Value: e.ticker},
+		"tickerhistory":    &objects.UserFunction{Name: "tickerhistory", Value: e.tickerHistory},
+		"orderbookhistory": &objects.UserFunction{Name: "orderbookhistory", Value: e.orderbookHistory},
+		"exchanges":        &objects.UserFunction{Name: "exchanges", Value: e.exchanges},
+		"pairs":            &objects.UserFunction{Name: "pairs", Value: e.pairs},
+		"accountinfo":      &objects.UserFunction{Name: "accountinfo", Value: e.accountInfo},
+		"depositaddress":   &objects.UserFunction{Name: "depositaddress", Value: e.depositAddress},
+		"orderquery":       &objects.UserFunction{Name: "orderquery", Value: e.orderQuery},
+		"ordercancel":      &objects.UserFunction{Name: "ordercancel", Value: e.orderCancel},
+		"ordersubmit":      &objects.UserFunction{Name: "ordersubmit", Value: e.orderSubmit},
+		"orderhistory":     &objects.UserFunction{Name: "orderhistory", Value: e.orderHistory},
+		"activeorders":     &objects.UserFunction{Name: "activeorders", Value: e.activeOrders},
+		"fundinghistory":   &objects.UserFunction{Name: "fundinghistory", Value: e.fundingHistory},
+		"recenttrades":     &objects.UserFunction{Name: "recenttrades", Value: e.recentTrades},
+		"historictrades":   &objects.UserFunction{Name: "historictrades", Value: e.historicTrades},
+		"withdrawcrypto":   &objects.UserFunction{Name: "withdrawcrypto", Value: e.withdrawCrypto},
+		"withdrawfiat":     &objects.UserFunction{Name: "withdrawfiat", Value: e.withdrawFiat},
+		"ohlcv":            &objects.UserFunction{Name: "ohlcv", Value: e.ohlcv},
+		"bars":             &objects.UserFunction{Name: "bars", Value: e.bars},
+		"livebars":         &objects.UserFunction{Name: "livebars", Value: e.liveBars},
+	}
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
 func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.orderbook(args...)
+}
+
+// ExchangeTicker returns ticker data for requested exchange and currency pair
+func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.ticker(args...)
+}
+
+// ExchangeTickerHistory returns the stored ticker history for requested
+// exchange and currency pair
+func ExchangeTickerHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.tickerHistory(args...)
+}
+
+// ExchangeOrderbookHistory returns the stored top of book history for
+// requested exchange and currency pair
+func ExchangeOrderbookHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.orderbookHistory(args...)
+}
+
+// ExchangeExchanges returns list of exchanges either enabled or all
+func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.exchanges(args...)
+}
+
+// ExchangePairs returns currency pairs for requested exchange
+func ExchangePairs(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.pairs(args...)
+}
+
+// ExchangeAccountInfo returns account information for requested exchange
+func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.accountInfo(args...)
+}
+
+// ExchangeDepositAddress returns deposit address (if supported by exchange)
+func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.depositAddress(args...)
+}
+
+// ExchangeOrderQuery query order on exchange
+func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.orderQuery(args...)
+}
+
+// ExchangeOrderCancel cancels order on requested exchange
+func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.orderCancel(args...)
+}
+
+// ExchangeOrderSubmit submit order on exchange
+func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.orderSubmit(args...)
+}
+
+// ExchangeOrderHistory returns the orders on exchange which are no longer open
+func ExchangeOrderHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.orderHistory(args...)
+}
+
+// ExchangeActiveOrders returns the open orders on exchange
+func ExchangeActiveOrders(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.activeOrders(args...)
+}
+
+// ExchangeFundingHistory returns the deposits and withdrawals on exchange
+func ExchangeFundingHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.fundingHistory(args...)
+}
+
+// ExchangeRecentTrades returns the most recent trades on exchange
+func ExchangeRecentTrades(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.recentTrades(args...)
+}
+
+// ExchangeHistoricTrades returns the trades on exchange between two times
+func ExchangeHistoricTrades(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.historicTrades(args...)
+}
+
+// ExchangeWithdrawCrypto submit request to withdraw crypto assets
+func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.withdrawCrypto(args...)
+}
+
+// ExchangeWithdrawFiat submit request to withdraw fiat assets
+func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.withdrawFiat(args...)
+}
+
+func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.ohlcv(args...)
+}
+
+// exchangeBars returns bars built from trades saved to the database, such as
+// volume, tick, dollar and renko bars
+func exchangeBars(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.bars(args...)
+}
+
+// exchangeLiveBars returns bars built from trades received since the bars
+// were first requested
+func exchangeLiveBars(args ...objects.Object) (objects.Object, error) {
+	return exchangeWrapper{}.liveBars(args...)
+}
+
+// orderbook returns orderbook for requested exchange & currencypair
+func (e exchangeWrapper) orderbook(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -63,7 +203,7 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	ob, err := wrappers.GetWrapper().Orderbook(exchangeName, pair, assetType)
+	ob, err := e.wrapper().Orderbook(exchangeName, pair, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +236,8 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
-// ExchangeTicker returns ticker data for requested exchange and currency pair
-func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
+// ticker returns ticker data for requested exchange and currency pair
+func (e exchangeWrapper) ticker(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -129,12 +269,12 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	tx, err := wrappers.GetWrapper().Ticker(exchangeName, pair, assetType)
+	tx, err := e.wrapper().Ticker(exchangeName, pair, assetType)
 	if err != nil {
 		return nil, err
 	}
 
-	data := make(map[string]objects.Object, 14)
+	data := make(map[string]objects.Object, 19)
 	data["exchange"] = &objects.String{Value: tx.ExchangeName}
 	data["last"] = &objects.Float{Value: tx.Last}
 	data["High"] = &objects.Float{Value: tx.High}
@@ -149,14 +289,126 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	data["pair"] = &objects.String{Value: tx.Pair.String()}
 	data["asset"] = &objects.String{Value: tx.AssetType.String()}
 	data["updated"] = &objects.Time{Value: tx.LastUpdated}
+	data["markprice"] = &objects.Float{Value: tx.MarkPrice}
+	data["indexprice"] = &objects.Float{Value: tx.IndexPrice}
+	data["fundingrate"] = &objects.Float{Value: tx.FundingRate}
+	data["nextfundingtime"] = &objects.Time{Value: tx.NextFundingTime}
+	data["openinterest"] = &objects.Float{Value: tx.OpenInterest}
 
 	return &objects.Map{
 		Value: data,
 	}, nil
 }
 
-// ExchangeExchanges returns list of exchanges either enabled or all
-func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
+// tickerHistory returns the stored ticker history for requested
+// exchange and currency pair
+func (e exchangeWrapper) tickerHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, limit, since, err := parseHistoryArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := e.wrapper().TickerHistory(exchangeName, pair, assetType, limit, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var r objects.Array
+	for x := range history {
+		data := make(map[string]objects.Object, 8)
+		data["last"] = &objects.Float{Value: history[x].Last}
+		data["high"] = &objects.Float{Value: history[x].High}
+		data["low"] = &objects.Float{Value: history[x].Low}
+		data["bid"] = &objects.Float{Value: history[x].Bid}
+		data["ask"] = &objects.Float{Value: history[x].Ask}
+		data["volume"] = &objects.Float{Value: history[x].Volume}
+		data["quotevolume"] = &objects.Float{Value: history[x].QuoteVolume}
+		data["updated"] = &objects.Time{Value: history[x].LastUpdated}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+
+	return &r, nil
+}
+
+// orderbookHistory returns the stored top of book history for
+// requested exchange and currency pair
+func (e exchangeWrapper) orderbookHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, limit, since, err := parseHistoryArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := e.wrapper().OrderbookHistory(exchangeName, pair, assetType, limit, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var r objects.Array
+	for x := range history {
+		data := make(map[string]objects.Object, 5)
+		data["bidprice"] = &objects.Float{Value: history[x].BidPrice}
+		data["bidamount"] = &objects.Float{Value: history[x].BidAmount}
+		data["askprice"] = &objects.Float{Value: history[x].AskPrice}
+		data["askamount"] = &objects.Float{Value: history[x].AskAmount}
+		data["updated"] = &objects.Time{Value: history[x].LastUpdated}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+
+	return &r, nil
+}
+
+// parseHistoryArgs converts the shared exchange, currency pair, delimiter,
+// asset, limit and since arguments of the history functions
+func parseHistoryArgs(args ...objects.Object) (exchangeName string, pair currency.Pair, assetType asset.Item, limit int, since time.Time, err error) {
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		err = fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+		return
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		err = fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+		return
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		err = fmt.Errorf(ErrParameterConvertFailed, delimiter)
+		return
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		err = fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+		return
+	}
+	limit, ok = objects.ToInt(args[4])
+	if !ok {
+		err = fmt.Errorf(ErrParameterConvertFailed, limit)
+		return
+	}
+	since, ok = objects.ToTime(args[5])
+	if !ok {
+		err = fmt.Errorf(ErrParameterConvertFailed, since)
+		return
+	}
+
+	pair, err = currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return
+	}
+	assetType, err = asset.New(assetTypeParam)
+	return
+}
+
+// exchanges returns list of exchanges either enabled or all
+func (e exchangeWrapper) exchanges(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -165,7 +417,7 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, enabledOnly)
 	}
-	rtnValue := wrappers.GetWrapper().Exchanges(enabledOnly)
+	rtnValue := e.wrapper().Exchanges(enabledOnly)
 
 	r := objects.Array{}
 	for x := range rtnValue {
@@ -175,8 +427,8 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	return &r, nil
 }
 
-// ExchangePairs returns currency pairs for requested exchange
-func ExchangePairs(args ...objects.Object) (objects.Object, error) {
+// pairs returns currency pairs for requested exchange
+func (e exchangeWrapper) pairs(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -198,7 +450,7 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	rtnValue, err := wrappers.GetWrapper().Pairs(exchangeName, enabledOnly, assetType)
+	rtnValue, err := e.wrapper().Pairs(exchangeName, enabledOnly, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -211,8 +463,8 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 	return &r, nil
 }
 
-// ExchangeAccountInfo returns account information for requested exchange
-func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
+// accountInfo returns account information for requested exchange
+func (e exchangeWrapper) accountInfo(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -221,7 +473,7 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
 	}
-	rtnValue, err := wrappers.GetWrapper().AccountInformation(exchangeName)
+	rtnValue, err := e.wrapper().AccountInformation(exchangeName)
 	if err != nil {
 		return nil, err
 	}
@@ -246,8 +498,8 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
-// ExchangeOrderQuery query order on exchange
-func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
+// orderQuery query order on exchange
+func (e exchangeWrapper) orderQuery(args ...objects.Object) (objects.Object, error) {
 	if len(args) < 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -289,47 +541,242 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	orderDetails, err := wrappers.GetWrapper().QueryOrder(exchangeName, orderID, pair, assetType)
+	orderDetails, err := e.wrapper().QueryOrder(exchangeName, orderID, pair, assetType)
 	if err != nil {
 		return nil, err
 	}
 
+	return orderToObject(orderDetails), nil
+}
+
+// orderToObject converts order details to a script map
+func orderToObject(o *order.Detail) *objects.Map {
 	var tradeHistory objects.Array
-	for x := range orderDetails.Trades {
+	for x := range o.Trades {
 		temp := make(map[string]objects.Object, 7)
-		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
-		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
-		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
-		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
-		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
-		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
-		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
+		temp["timestamp"] = &objects.Time{Value: o.Trades[x].Timestamp}
+		temp["price"] = &objects.Float{Value: o.Trades[x].Price}
+		temp["fee"] = &objects.Float{Value: o.Trades[x].Fee}
+		temp["amount"] = &objects.Float{Value: o.Trades[x].Amount}
+		temp["type"] = &objects.String{Value: o.Trades[x].Type.String()}
+		temp["side"] = &objects.String{Value: o.Trades[x].Side.String()}
+		temp["description"] = &objects.String{Value: o.Trades[x].Description}
 		tradeHistory.Value = append(tradeHistory.Value, &objects.Map{Value: temp})
 	}
 
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: orderDetails.Exchange}
-	data["id"] = &objects.String{Value: orderDetails.ID}
-	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
-	data["currencypair"] = &objects.String{Value: orderDetails.Pair.String()}
-	data["price"] = &objects.Float{Value: orderDetails.Price}
-	data["amount"] = &objects.Float{Value: orderDetails.Amount}
-	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
-	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
-	data["fee"] = &objects.Float{Value: orderDetails.Fee}
-	data["side"] = &objects.String{Value: orderDetails.Side.String()}
-	data["type"] = &objects.String{Value: orderDetails.Type.String()}
-	data["date"] = &objects.String{Value: orderDetails.Date.String()}
-	data["status"] = &objects.String{Value: orderDetails.Status.String()}
+	data := make(map[string]objects.Object, 15)
+	data["exchange"] = &objects.String{Value: o.Exchange}
+	data["id"] = &objects.String{Value: o.ID}
+	data["accountid"] = &objects.String{Value: o.AccountID}
+	data["currencypair"] = &objects.String{Value: o.Pair.String()}
+	data["asset"] = &objects.String{Value: o.AssetType.String()}
+	data["price"] = &objects.Float{Value: o.Price}
+	data["amount"] = &objects.Float{Value: o.Amount}
+	data["amountexecuted"] = &objects.Float{Value: o.ExecutedAmount}
+	data["amountremaining"] = &objects.Float{Value: o.RemainingAmount}
+	data["fee"] = &objects.Float{Value: o.Fee}
+	data["side"] = &objects.String{Value: o.Side.String()}
+	data["type"] = &objects.String{Value: o.Type.String()}
+	data["date"] = &objects.String{Value: o.Date.String()}
+	data["status"] = &objects.String{Value: o.Status.String()}
 	data["trades"] = &tradeHistory
 
 	return &objects.Map{
 		Value: data,
-	}, nil
+	}
 }
 
-// ExchangeOrderCancel cancels order on requested exchange
-func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
+// orderHistory returns the orders on exchange which are no longer open,
+// optionally only those placed between two times
+func (e exchangeWrapper) orderHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 && len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, request, err := parseOrdersRequest(args...)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := e.wrapper().OrderHistory(exchangeName, request)
+	if err != nil {
+		return nil, err
+	}
+	return ordersToObject(orders), nil
+}
+
+// activeOrders returns the open orders on exchange, optionally only those
+// placed between two times
+func (e exchangeWrapper) activeOrders(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 && len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, request, err := parseOrdersRequest(args...)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := e.wrapper().ActiveOrders(exchangeName, request)
+	if err != nil {
+		return nil, err
+	}
+	return ordersToObject(orders), nil
+}
+
+// parseOrdersRequest converts exchange, pair, delimiter, asset and optional
+// start and end arguments, an empty pair requests orders for all pairs
+func parseOrdersRequest(args ...objects.Object) (string, *order.GetOrdersRequest, error) {
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return "", nil, err
+	}
+
+	request := &order.GetOrdersRequest{
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+		AssetType: assetType,
+	}
+	if currencyPair != "" {
+		pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+		if err != nil {
+			return "", nil, err
+		}
+		request.Pairs = currency.Pairs{pair}
+	}
+	if len(args) == 6 {
+		request.StartTicks, ok = objects.ToTime(args[4])
+		if !ok {
+			return "", nil, fmt.Errorf(ErrParameterConvertFailed, args[4])
+		}
+		request.EndTicks, ok = objects.ToTime(args[5])
+		if !ok {
+			return "", nil, fmt.Errorf(ErrParameterConvertFailed, args[5])
+		}
+	}
+	return exchangeName, request, nil
+}
+
+func ordersToObject(orders []order.Detail) *objects.Array {
+	r := objects.Array{}
+	for x := range orders {
+		r.Value = append(r.Value, orderToObject(&orders[x]))
+	}
+	return &r
+}
+
+// fundingHistory returns the deposits and withdrawals on exchange
+func (e exchangeWrapper) fundingHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	history, err := e.wrapper().FundingHistory(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range history {
+		data := make(map[string]objects.Object, 14)
+		data["exchange"] = &objects.String{Value: history[x].ExchangeName}
+		data["status"] = &objects.String{Value: history[x].Status}
+		data["transferid"] = &objects.String{Value: history[x].TransferID}
+		data["description"] = &objects.String{Value: history[x].Description}
+		data["timestamp"] = &objects.Time{Value: history[x].Timestamp}
+		data["currency"] = &objects.String{Value: history[x].Currency}
+		data["amount"] = &objects.Float{Value: history[x].Amount}
+		data["fee"] = &objects.Float{Value: history[x].Fee}
+		data["transfertype"] = &objects.String{Value: history[x].TransferType}
+		data["cryptotoaddress"] = &objects.String{Value: history[x].CryptoToAddress}
+		data["cryptofromaddress"] = &objects.String{Value: history[x].CryptoFromAddress}
+		data["cryptotxid"] = &objects.String{Value: history[x].CryptoTxID}
+		data["bankto"] = &objects.String{Value: history[x].BankTo}
+		data["bankfrom"] = &objects.String{Value: history[x].BankFrom}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+	return &r, nil
+}
+
+// recentTrades returns the most recent trades on exchange for the currency
+// pair
+func (e exchangeWrapper) recentTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args...)
+	if err != nil {
+		return nil, err
+	}
+	trades, err := e.wrapper().RecentTrades(exchangeName, pair, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return tradesToObject(trades), nil
+}
+
+// historicTrades returns the trades on exchange for the currency pair between
+// two times
+func (e exchangeWrapper) historicTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+	trades, err := e.wrapper().HistoricTrades(exchangeName, pair, assetType, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return tradesToObject(trades), nil
+}
+
+func tradesToObject(trades []trade.Data) *objects.Array {
+	r := objects.Array{}
+	for x := range trades {
+		data := make(map[string]objects.Object, 8)
+		data["id"] = &objects.String{Value: trades[x].TID}
+		data["exchange"] = &objects.String{Value: trades[x].Exchange}
+		data["currencypair"] = &objects.String{Value: trades[x].CurrencyPair.String()}
+		data["asset"] = &objects.String{Value: trades[x].AssetType.String()}
+		data["side"] = &objects.String{Value: trades[x].Side.String()}
+		data["price"] = &objects.Float{Value: trades[x].Price}
+		data["amount"] = &objects.Float{Value: trades[x].Amount}
+		data["timestamp"] = &objects.Time{Value: trades[x].Timestamp}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+	return &r
+}
+
+// orderCancel cancels order on requested exchange
+func (e exchangeWrapper) orderCancel(args ...objects.Object) (objects.Object, error) {
 	if len(args) < 2 || len(args) > 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -376,7 +823,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	}
 
 	var isCancelled bool
-	isCancelled, err = wrappers.GetWrapper().CancelOrder(exchangeName, orderID, cp, a)
+	isCancelled, err = e.wrapper().CancelOrder(exchangeName, orderID, cp, a)
 	if err != nil {
 		return nil, err
 	}
@@ -387,8 +834,8 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	return objects.FalseValue, nil
 }
 
-// ExchangeOrderSubmit submit order on exchange
-func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
+// orderSubmit submit order on exchange
+func (e exchangeWrapper) orderSubmit(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 9 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -449,7 +896,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 		Exchange:  exchangeName,
 	}
 
-	rtn, err := wrappers.GetWrapper().SubmitOrder(tempSubmit)
+	rtn, err := e.wrapper().SubmitOrder(tempSubmit)
 	if err != nil {
 		return nil, err
 	}
@@ -467,8 +914,8 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
-// ExchangeDepositAddress returns deposit address (if supported by exchange)
-func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
+// depositAddress returns deposit address (if supported by exchange)
+func (e exchangeWrapper) depositAddress(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -484,7 +931,7 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 	currCode := currency.NewCode(currencyCode)
 
-	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, currCode)
+	rtn, err := e.wrapper().DepositAddress(exchangeName, currCode)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +939,8 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 	return &objects.String{Value: rtn}, nil
 }
 
-// ExchangeWithdrawCrypto submit request to withdraw crypto assets
-func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
+// withdrawCrypto submit request to withdraw crypto assets
+func (e exchangeWrapper) withdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 7 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -539,7 +986,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 		Amount:      amount,
 	}
 
-	rtn, err := wrappers.GetWrapper().WithdrawalCryptoFunds(withdrawRequest)
+	rtn, err := e.wrapper().WithdrawalCryptoFunds(withdrawRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -547,8 +994,8 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	return &objects.String{Value: rtn}, nil
 }
 
-// ExchangeWithdrawFiat submit request to withdraw fiat assets
-func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
+// withdrawFiat submit request to withdraw fiat assets
+func (e exchangeWrapper) withdrawFiat(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 5 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -581,7 +1028,7 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 		Amount:      amount,
 	}
 
-	rtn, err := wrappers.GetWrapper().WithdrawalFiatFunds(bankAccountID, withdrawRequest)
+	rtn, err := e.wrapper().WithdrawalFiatFunds(bankAccountID, withdrawRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -599,7 +1046,7 @@ func (o *OHLCV) TypeName() string {
 	return indicators.OHLCV
 }
 
-func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
+func (e exchangeWrapper) ohlcv(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 7 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -648,11 +1095,133 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	ret, err := wrappers.GetWrapper().OHLCV(exchangeName, pair, assetType, startTime, endTime, kline.Interval(interval))
+	ret, err := e.wrapper().OHLCV(exchangeName, pair, assetType, startTime, endTime, kline.Interval(interval))
 	if err != nil {
 		return nil, err
 	}
 
+	return toOHLCV(&ret, ret.Interval.String()), nil
+}
+
+// bars returns bars built from trades saved to the database, such as
+// volume, tick, dollar and renko bars
+func (e exchangeWrapper) bars(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 8 && len(args) != 9 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+	cfg, err := parseBarConfig(args[6:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := e.wrapper().Bars(exchangeName, pair, assetType, startTime, endTime, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return toOHLCV(&ret, cfg.String()), nil
+}
+
+// liveBars returns bars built from trades received since the bars
+// were first requested
+func (e exchangeWrapper) liveBars(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 && len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := parseBarConfig(args[4:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := e.wrapper().LiveBars(exchangeName, pair, assetType, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return toOHLCV(&ret, cfg.String()), nil
+}
+
+// parseExchangePairAsset converts exchange, pair, delimiter and asset
+// arguments
+func parseExchangePairAsset(args ...objects.Object) (string, currency.Pair, asset.Item, error) {
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return "", currency.Pair{}, "", err
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return "", currency.Pair{}, "", err
+	}
+	return exchangeName, pair, assetType, nil
+}
+
+// parseBarConfig converts bar type, size and optional heikin-ashi arguments.
+// Size is an interval such as 1h for time bars, otherwise the bar threshold
+func parseBarConfig(args ...objects.Object) (trade.BarConfig, error) {
+	barType, ok := objects.ToString(args[0])
+	if !ok {
+		return trade.BarConfig{}, fmt.Errorf(ErrParameterConvertFailed, barType)
+	}
+	cfg := trade.BarConfig{Type: trade.BarType(strings.ToLower(barType))}
+	if cfg.Type == trade.TimeBar {
+		intervalStr, ok := objects.ToString(args[1])
+		if !ok {
+			return trade.BarConfig{}, fmt.Errorf(ErrParameterConvertFailed, intervalStr)
+		}
+		interval, err := parseInterval(intervalStr)
+		if err != nil {
+			return trade.BarConfig{}, err
+		}
+		cfg.Interval = kline.Interval(interval)
+	} else {
+		cfg.Threshold, ok = objects.ToFloat64(args[1])
+		if !ok {
+			return trade.BarConfig{}, fmt.Errorf(ErrParameterConvertFailed, args[1])
+		}
+	}
+	if len(args) > 2 {
+		cfg.HeikinAshi, ok = objects.ToBool(args[2])
+		if !ok {
+			return trade.BarConfig{}, fmt.Errorf(ErrParameterConvertFailed, args[2])
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// toOHLCV converts kline data to an OHLCV object usable by indicators
+func toOHLCV(ret *kline.Item, intervals string) *OHLCV {
 	var candles objects.Array
 	for x := range ret.Candles {
 		candle := &objects.Array{}
@@ -671,12 +1240,12 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	retValue["exchange"] = &objects.String{Value: ret.Exchange}
 	retValue["pair"] = &objects.String{Value: ret.Pair.String()}
 	retValue["asset"] = &objects.String{Value: ret.Asset.String()}
-	retValue["intervals"] = &objects.String{Value: ret.Interval.String()}
+	retValue["intervals"] = &objects.String{Value: intervals}
 	retValue["candles"] = &candles
 
 	c := new(OHLCV)
 	c.Value = retValue
-	return c, nil
+	return c
 }
 
 // parseInterval will parse the interval param of indictors that have them and convert to time.Duration
@@ -0,0 +1,315 @@
+package gct
+
+import (
+	"fmt"
+	"strings"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers"
+)
+
+var futuresModule = FuturesModule(nil)
+
+// futuresWrapper binds the futures module functions to the wrapper they call,
+// the default wrapper is used when unset
+type futuresWrapper struct {
+	w modules.Futures
+}
+
+func (f futuresWrapper) wrapper() modules.Futures {
+	if f.w != nil {
+		return f.w
+	}
+	return wrappers.GetWrapper()
+}
+
+// FuturesModule returns the futures module with its functions calling the
+// wrapper, a nil wrapper uses the default wrapper
+func FuturesModule(w modules.Futures) map[string]objects.Object {
+	f := futuresWrapper{w: w}
+	return map[string]objects.Object{
+		"positions":     &objects.UserFunction{Name: "positions", Value: f.positions},
+		"setleverage":   &objects.UserFunction{Name: "setleverage", Value: f.setLeverage},
+		"setmargintype": &objects.UserFunction{Name: "setmargintype", Value: f.setMarginType},
+		"fundingrates":  &objects.UserFunction{Name: "fundingrates", Value: f.fundingRates},
+		"premiumindex":  &objects.UserFunction{Name: "premiumindex", Value: f.premiumIndex},
+		"ordersubmit":   &objects.UserFunction{Name: "ordersubmit", Value: f.orderSubmit},
+		"closeposition": &objects.UserFunction{Name: "closeposition", Value: f.closePosition},
+	}
+}
+
+// positions returns the derivatives positions for the pair, an empty pair
+// returns all open positions
+func (f futuresWrapper) positions(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return nil, err
+	}
+	var pair currency.Pair
+	if currencyPair != "" {
+		pair, err = currency.NewPairDelimiter(currencyPair, delimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	positions, err := f.wrapper().Positions(exchangeName, pair, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range positions {
+		data := make(map[string]objects.Object, 11)
+		data["exchange"] = &objects.String{Value: positions[x].Exchange}
+		data["currencypair"] = &objects.String{Value: positions[x].Pair.String()}
+		data["asset"] = &objects.String{Value: positions[x].AssetType.String()}
+		data["amount"] = &objects.Float{Value: positions[x].Amount}
+		data["entryprice"] = &objects.Float{Value: positions[x].EntryPrice}
+		data["markprice"] = &objects.Float{Value: positions[x].MarkPrice}
+		data["liquidationprice"] = &objects.Float{Value: positions[x].LiquidationPrice}
+		data["unrealisedpnl"] = &objects.Float{Value: positions[x].UnrealisedPNL}
+		data["leverage"] = &objects.Float{Value: positions[x].Leverage}
+		data["margintype"] = &objects.String{Value: positions[x].MarginType.String()}
+		data["isolatedmargin"] = &objects.Float{Value: positions[x].IsolatedMargin}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+	return &r, nil
+}
+
+// setLeverage sets the leverage used by the pair's positions
+func (f futuresWrapper) setLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	leverage, ok := objects.ToFloat64(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[4])
+	}
+
+	err = f.wrapper().SetLeverage(exchangeName, pair, assetType, leverage)
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+// setMarginType sets whether the pair's positions use isolated or crossed
+// margin
+func (f futuresWrapper) setMarginType(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	marginTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, marginTypeParam)
+	}
+	marginType, err := futures.StringToMarginType(marginTypeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.wrapper().SetMarginType(exchangeName, pair, assetType, marginType)
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+// fundingRates returns the pair's funding rates between two times
+func (f futuresWrapper) fundingRates(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+
+	rates, err := f.wrapper().FundingRates(exchangeName, pair, assetType, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range rates {
+		data := make(map[string]objects.Object, 2)
+		data["rate"] = &objects.Float{Value: rates[x].Rate}
+		data["time"] = &objects.Time{Value: rates[x].Time}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+	return &r, nil
+}
+
+// premiumIndex returns the pair's mark and index price with its latest
+// funding rate
+func (f futuresWrapper) premiumIndex(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	premium, err := f.wrapper().PremiumIndex(exchangeName, pair, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 9)
+	data["exchange"] = &objects.String{Value: premium.Exchange}
+	data["currencypair"] = &objects.String{Value: premium.Pair.String()}
+	data["asset"] = &objects.String{Value: premium.AssetType.String()}
+	data["markprice"] = &objects.Float{Value: premium.MarkPrice}
+	data["indexprice"] = &objects.Float{Value: premium.IndexPrice}
+	data["fundingrate"] = &objects.Float{Value: premium.FundingRate}
+	data["interestrate"] = &objects.Float{Value: premium.InterestRate}
+	data["nextfundingtime"] = &objects.Time{Value: premium.NextFundingTime}
+	data["updated"] = &objects.Time{Value: premium.Time}
+	return &objects.Map{Value: data}, nil
+}
+
+// orderSubmit submits a derivatives order, a reduce only order can only
+// decrease the size of the position
+func (f futuresWrapper) orderSubmit(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 10 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	orderType, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderType)
+	}
+	orderSide, ok := objects.ToString(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderSide)
+	}
+	orderPrice, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderPrice)
+	}
+	orderAmount, ok := objects.ToFloat64(args[7])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderAmount)
+	}
+	orderClientID, ok := objects.ToString(args[8])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderClientID)
+	}
+	reduceOnly, ok := objects.ToBool(args[9])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[9])
+	}
+
+	return f.submit(&futures.Submit{
+		Submit: order.Submit{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+			Type:      order.Type(strings.ToUpper(orderType)),
+			Side:      order.Side(strings.ToUpper(orderSide)),
+			Price:     orderPrice,
+			Amount:    orderAmount,
+			ClientID:  orderClientID,
+		},
+		ReduceOnly: reduceOnly,
+	})
+}
+
+// closePosition submits a reduce only market order for the pair's open
+// position
+func (f futuresWrapper) closePosition(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 && len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4]...)
+	if err != nil {
+		return nil, err
+	}
+	var orderClientID string
+	if len(args) == 5 {
+		var ok bool
+		orderClientID, ok = objects.ToString(args[4])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, orderClientID)
+		}
+	}
+
+	return f.submit(&futures.Submit{
+		Submit: order.Submit{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+			Type:      order.Market,
+			ClientID:  orderClientID,
+		},
+		ClosePosition: true,
+	})
+}
+
+func (f futuresWrapper) submit(s *futures.Submit) (objects.Object, error) {
+	rtn, err := f.wrapper().SubmitFuturesOrder(s)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 2)
+	data["orderid"] = &objects.String{Value: rtn.OrderID}
+	if rtn.IsOrderPlaced {
+		data["isorderplaced"] = objects.TrueValue
+	} else {
+		data["isorderplaced"] = objects.FalseValue
+	}
+	return &objects.Map{Value: data}, nil
+}
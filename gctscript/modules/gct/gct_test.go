@@ -9,6 +9,7 @@ import (
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
 )
@@ -29,6 +30,9 @@ var (
 	assetType = &objects.String{
 		Value: "SPOT",
 	}
+	futuresAsset = &objects.String{
+		Value: "futures",
+	}
 	orderID = &objects.String{
 		Value: "1235",
 	}
@@ -82,6 +86,46 @@ func TestExchangeTicker(t *testing.T) {
 	}
 }
 
+func TestExchangeTickerHistory(t *testing.T) {
+	t.Parallel()
+	limit := &objects.Int{Value: 10}
+	since := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	_, err := ExchangeTickerHistory(exch, currencyPair, delimiter, assetType, limit, since)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeTickerHistory(exchError, currencyPair, delimiter, assetType, limit, since)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+
+	_, err = ExchangeTickerHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeOrderbookHistory(t *testing.T) {
+	t.Parallel()
+	limit := &objects.Int{Value: 10}
+	since := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	_, err := ExchangeOrderbookHistory(exch, currencyPair, delimiter, assetType, limit, since)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeOrderbookHistory(exchError, currencyPair, delimiter, assetType, limit, since)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+
+	_, err = ExchangeOrderbookHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
 func TestExchangeExchanges(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +207,128 @@ func TestExchangeOrderQuery(t *testing.T) {
 	}
 }
 
+func TestExchangeOrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	ret, err := ExchangeOrderHistory(exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orders, ok := ret.(*objects.Array)
+	if !ok || len(orders.Value) != 1 {
+		t.Fatalf("expected one order received %v", ret)
+	}
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	_, err = ExchangeOrderHistory(exch, blank, delimiter, assetType, start, end)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeOrderHistory(exch, currencyPair, delimiter, assetType, start, blank)
+	if err == nil {
+		t.Error("expected error for invalid end time")
+	}
+
+	_, err = ExchangeOrderHistory(exchError, currencyPair, delimiter, assetType)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeActiveOrders(exch)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	_, err = ExchangeActiveOrders(exch, blank, delimiter, assetType)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeActiveOrders(exch, currencyPair, delimiter, blank)
+	if err == nil {
+		t.Error("expected error for invalid asset")
+	}
+
+	_, err = ExchangeActiveOrders(exchError, currencyPair, delimiter, assetType)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeFundingHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	_, err = ExchangeFundingHistory(exch)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeFundingHistory(exchError)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeRecentTrades(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeRecentTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	ret, err := ExchangeRecentTrades(exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trades, ok := ret.(*objects.Array)
+	if !ok || len(trades.Value) == 0 {
+		t.Fatalf("expected trades received %v", ret)
+	}
+
+	_, err = ExchangeRecentTrades(exchError, currencyPair, delimiter, assetType)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeHistoricTrades(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeHistoricTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	_, err = ExchangeHistoricTrades(exch, currencyPair, delimiter, assetType, start, end)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeHistoricTrades(exch, currencyPair, delimiter, assetType, end, start)
+	if err == nil {
+		t.Error("expected error for end before start")
+	}
+
+	_, err = ExchangeHistoricTrades(exchError, currencyPair, delimiter, assetType, start, end)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
 func TestExchangeOrderCancel(t *testing.T) {
 	t.Parallel()
 	_, err := ExchangeOrderCancel()
@@ -228,6 +394,212 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	}
 }
 
+func TestExchangeBars(t *testing.T) {
+	t.Parallel()
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	volume := &objects.String{Value: "volume"}
+	size := &objects.Int{Value: 50}
+	ret, err := exchangeBars(exch, currencyPair, delimiter, assetType, start, end, volume, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, ok := ret.(*OHLCV)
+	if !ok {
+		t.Fatalf("expected OHLCV received %T", ret)
+	}
+	if s, _ := objects.ToString(o.Value["intervals"]); s != "volume 50" {
+		t.Errorf("received %v, expected volume 50", s)
+	}
+
+	_, err = exchangeBars(exch, currencyPair, delimiter, assetType, start, end,
+		&objects.String{Value: "time"}, &objects.String{Value: "1m"}, tv)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = exchangeBars(exch, currencyPair, delimiter, assetType, start, end, &objects.String{Value: "bad"}, size)
+	if err == nil {
+		t.Error("expected error for invalid bar type")
+	}
+
+	_, err = exchangeBars(exchError, currencyPair, delimiter, assetType, start, end, volume, size)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+
+	_, err = exchangeBars()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeLiveBars(t *testing.T) {
+	t.Parallel()
+	renko := &objects.String{Value: "renko"}
+	size := &objects.Float{Value: 10}
+	_, err := exchangeLiveBars(exch, currencyPair, delimiter, assetType, renko, size, fv)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = exchangeLiveBars(exch, currencyPair, delimiter, assetType, renko, &objects.Int{Value: 0})
+	if err == nil {
+		t.Error("expected error for invalid threshold")
+	}
+
+	_, err = exchangeLiveBars(exchError, currencyPair, delimiter, assetType, renko, size)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+
+	_, err = exchangeLiveBars()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestFuturesPositions(t *testing.T) {
+	t.Parallel()
+	f := futuresWrapper{}
+	_, err := f.positions()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	ret, err := f.positions(exch, blank, delimiter, futuresAsset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions, ok := ret.(*objects.Array)
+	if !ok || len(positions.Value) != 1 {
+		t.Fatalf("expected one position received %v", ret)
+	}
+	p, ok := positions.Value[0].(*objects.Map)
+	if !ok {
+		t.Fatalf("expected map received %T", positions.Value[0])
+	}
+	if s, _ := objects.ToString(p.Value["margintype"]); s != "crossed" {
+		t.Errorf("received %v, expected crossed", s)
+	}
+
+	_, err = f.positions(exch, currencyPair, delimiter, blank)
+	if err == nil {
+		t.Error("expected error for invalid asset")
+	}
+
+	_, err = f.positions(exchError, currencyPair, delimiter, futuresAsset)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestFuturesSettings(t *testing.T) {
+	t.Parallel()
+	f := futuresWrapper{}
+	_, err := f.setLeverage(exch, currencyPair, delimiter, futuresAsset)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	_, err = f.setLeverage(exch, currencyPair, delimiter, futuresAsset, &objects.Int{Value: 10})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = f.setLeverage(exch, currencyPair, delimiter, futuresAsset, &objects.Int{Value: 0})
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received %v, expected %v", err, futures.ErrInvalidLeverage)
+	}
+
+	_, err = f.setMarginType(exch, currencyPair, delimiter, futuresAsset)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	_, err = f.setMarginType(exch, currencyPair, delimiter, futuresAsset, &objects.String{Value: "ISOLATED"})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = f.setMarginType(exch, currencyPair, delimiter, futuresAsset, blank)
+	if !errors.Is(err, futures.ErrInvalidMarginType) {
+		t.Errorf("received %v, expected %v", err, futures.ErrInvalidMarginType)
+	}
+	_, err = f.setMarginType(exchError, currencyPair, delimiter, futuresAsset, &objects.String{Value: "cross"})
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestFuturesFunding(t *testing.T) {
+	t.Parallel()
+	f := futuresWrapper{}
+	_, err := f.fundingRates()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	start := &objects.Time{Value: time.Now().Add(-time.Hour * 48)}
+	end := &objects.Time{Value: time.Now()}
+	ret, err := f.fundingRates(exch, currencyPair, delimiter, futuresAsset, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates, ok := ret.(*objects.Array)
+	if !ok || len(rates.Value) != 6 {
+		t.Errorf("expected six funding rates received %v", ret)
+	}
+
+	_, err = f.premiumIndex()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	_, err = f.premiumIndex(exch, currencyPair, delimiter, futuresAsset)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = f.premiumIndex(exchError, currencyPair, delimiter, futuresAsset)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestFuturesOrderSubmit(t *testing.T) {
+	t.Parallel()
+	f := futuresWrapper{}
+	_, err := f.orderSubmit()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	orderType := &objects.String{Value: "limit"}
+	orderSide := &objects.String{Value: "sell"}
+	price := &objects.Float{Value: 1}
+	amount := &objects.Float{Value: 1}
+	ret, err := f.orderSubmit(exch, currencyPair, delimiter, futuresAsset, orderType, orderSide, price, amount, orderID, tv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := ret.(*objects.Map)
+	if !ok || m.Value["isorderplaced"] != objects.TrueValue {
+		t.Errorf("expected order to be placed received %v", ret)
+	}
+
+	_, err = f.orderSubmit(exch, currencyPair, delimiter, futuresAsset, orderType, orderSide, price, &objects.Float{}, orderID, tv)
+	if err == nil {
+		t.Error("expected error for invalid amount")
+	}
+
+	_, err = f.closePosition()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	_, err = f.closePosition(exch, currencyPair, delimiter, futuresAsset)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = f.closePosition(exchError, currencyPair, delimiter, futuresAsset, orderID)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
 func TestAllModuleNames(t *testing.T) {
 	t.Parallel()
 	x := AllModuleNames()
@@ -332,3 +704,128 @@ func TestParseInterval(t *testing.T) {
 		}
 	}
 }
+
+func TestState(t *testing.T) {
+	t.Parallel()
+	s := scriptState{script: "statetest.gct"}
+	key := &objects.String{Value: "position"}
+	_, err := s.Get()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v, expected %v", err, objects.ErrWrongNumArguments)
+	}
+	_, err = s.Get(blank)
+	if !errors.Is(err, errStateKeyInvalid) {
+		t.Errorf("received %v, expected %v", err, errStateKeyInvalid)
+	}
+	v, err := s.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != objects.UndefinedValue {
+		t.Errorf("received %v, expected undefined for an unset key", v)
+	}
+	v, err = s.Get(key, tv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != tv {
+		t.Errorf("received %v, expected the default value", v)
+	}
+
+	_, err = s.Set(key, &objects.UserFunction{Name: "fn"})
+	if !errors.Is(err, errStateTypeNotValid) {
+		t.Errorf("received %v, expected %v", err, errStateTypeNotValid)
+	}
+	position := &objects.Map{Value: map[string]objects.Object{
+		"amount": &objects.Float{Value: 1.5},
+		"price":  &objects.Int{Value: 1337},
+		"open":   tv,
+		"fills":  &objects.Array{Value: []objects.Object{&objects.String{Value: "1"}}},
+	}}
+	_, err = s.Set(key, position)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = s.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Equals(position) {
+		t.Errorf("received %v, expected %v", v, position)
+	}
+
+	// state is scoped to the script
+	v, err = scriptState{script: "other.gct"}.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != objects.UndefinedValue {
+		t.Errorf("received %v, expected another script's state to be hidden", v)
+	}
+
+	keys, err := s.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !keys.Equals(&objects.Array{Value: []objects.Object{key}}) {
+		t.Errorf("received %v, expected [%v]", keys, key)
+	}
+	_, err = s.Delete(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = s.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != objects.UndefinedValue {
+		t.Errorf("received %v, expected a deleted key to be undefined", v)
+	}
+
+	_, err = scriptState{script: ""}.Set(key, tv)
+	if err == nil {
+		t.Error("expected wrapper error to be returned")
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+	n := scriptNotify{script: "notifytest.gct"}
+	message := &objects.String{Value: "price crossed"}
+	_, err := n.Send(message)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v, expected %v", err, objects.ErrWrongNumArguments)
+	}
+	_, err = n.Send(&objects.String{Value: "urgent"}, message)
+	if !errors.Is(err, errSeverityInvalid) {
+		t.Errorf("received %v, expected %v", err, errSeverityInvalid)
+	}
+	_, err = n.Send(&objects.String{Value: "WARNING"}, message)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = n.severity(modules.SeverityCritical)(blank)
+	if err == nil {
+		t.Error("expected an empty message to return an error")
+	}
+	_, err = NotifyModule("notifytest.gct", nil)["info"].(*objects.UserFunction).Value(message)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = n.Publish(blank)
+	if !errors.Is(err, errEventNameEmpty) {
+		t.Errorf("received %v, expected %v", err, errEventNameEmpty)
+	}
+	_, err = n.Publish(&objects.String{Value: "signal"}, &objects.UserFunction{Name: "fn"})
+	if !errors.Is(err, errStateTypeNotValid) {
+		t.Errorf("received %v, expected %v", err, errStateTypeNotValid)
+	}
+	_, err = n.Publish(&objects.String{Value: "signal"}, &objects.Map{Value: map[string]objects.Object{
+		"side":  &objects.String{Value: "buy"},
+		"price": &objects.Float{Value: 1337},
+	}})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,127 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers"
+)
+
+// maxNotificationLength is the longest message a script can send, longer
+// messages are truncated
+const maxNotificationLength = 2000
+
+var (
+	errSeverityInvalid = errors.New("severity must be info, warning or critical")
+	errEventNameEmpty  = errors.New("event name must be a non-empty string")
+)
+
+// scriptNotify holds the script a notify module is scoped to and the wrapper
+// its notifications are sent with, the default wrapper is used when unset
+type scriptNotify struct {
+	script string
+	w      modules.Notify
+}
+
+// NotifyModule returns the notify module for a script. Notifications and
+// events are sent under the script's name, a nil wrapper uses the default
+// wrapper
+func NotifyModule(script string, w modules.Notify) map[string]objects.Object {
+	n := scriptNotify{script: script, w: w}
+	return map[string]objects.Object{
+		"send":     &objects.UserFunction{Name: "send", Value: n.Send},
+		"info":     &objects.UserFunction{Name: "info", Value: n.severity(modules.SeverityInfo)},
+		"warning":  &objects.UserFunction{Name: "warning", Value: n.severity(modules.SeverityWarning)},
+		"critical": &objects.UserFunction{Name: "critical", Value: n.severity(modules.SeverityCritical)},
+		"publish":  &objects.UserFunction{Name: "publish", Value: n.Publish},
+	}
+}
+
+// Send relays a message with a severity to the enabled communication
+// services
+func (n scriptNotify) Send(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	severityParam, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	severity, err := stringToSeverity(severityParam)
+	if err != nil {
+		return nil, err
+	}
+	return n.send(severity, args[1])
+}
+
+// severity returns a function relaying a message with a fixed severity
+func (n scriptNotify) severity(severity modules.Severity) objects.CallableFunc {
+	return func(args ...objects.Object) (objects.Object, error) {
+		if len(args) != 1 {
+			return nil, objects.ErrWrongNumArguments
+		}
+		return n.send(severity, args[0])
+	}
+}
+
+func (n scriptNotify) send(severity modules.Severity, o objects.Object) (objects.Object, error) {
+	message, ok := objects.ToString(o)
+	if !ok || message == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "message")
+	}
+	if len(message) > maxNotificationLength {
+		message = message[:maxNotificationLength]
+	}
+	err := n.wrapper().Notify(&modules.Notification{
+		Script:   n.script,
+		Severity: severity,
+		Message:  message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+// Publish broadcasts a named event with optional data to websocket clients,
+// data follows the same rules as values saved with the state module
+func (n scriptNotify) Publish(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	event, ok := args[0].(*objects.String)
+	if !ok || event.Value == "" {
+		return nil, errEventNameEmpty
+	}
+	var data interface{}
+	if len(args) == 2 {
+		var err error
+		data, err = stateInterface(args[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := n.wrapper().PublishEvent(n.script, event.Value, data)
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+func (n scriptNotify) wrapper() modules.Notify {
+	if n.w != nil {
+		return n.w
+	}
+	return wrappers.GetWrapper()
+}
+
+func stringToSeverity(s string) (modules.Severity, error) {
+	switch severity := modules.Severity(strings.ToLower(s)); severity {
+	case modules.SeverityInfo, modules.SeverityWarning, modules.SeverityCritical:
+		return severity, nil
+	}
+	return "", fmt.Errorf("%s %w", s, errSeverityInvalid)
+}
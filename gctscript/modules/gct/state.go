@@ -0,0 +1,232 @@
+package gct
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	// maxStateKeyLength matches the state key column size
+	maxStateKeyLength = 255
+	// maxStateValueSize is the maximum size of a JSON encoded state value
+	maxStateValueSize = 1 << 20
+)
+
+var (
+	errStateKeyInvalid   = errors.New("state key must be a non-empty string of up to 255 characters")
+	errStateValueTooBig  = errors.New("state value exceeds the maximum size")
+	errStateTypeNotValid = errors.New("state value type is not supported")
+)
+
+// scriptState holds the script a state module is scoped to and the wrapper
+// its values are saved with, the default wrapper is used when unset
+type scriptState struct {
+	script string
+	w      modules.State
+}
+
+// StateModule returns the state module for a script. Saved values are scoped
+// to the script so it cannot read or change the state of other scripts, a nil
+// wrapper uses the default wrapper
+func StateModule(script string, w modules.State) map[string]objects.Object {
+	s := scriptState{script: script, w: w}
+	return map[string]objects.Object{
+		"get":    &objects.UserFunction{Name: "get", Value: s.Get},
+		"set":    &objects.UserFunction{Name: "set", Value: s.Set},
+		"delete": &objects.UserFunction{Name: "delete", Value: s.Delete},
+		"list":   &objects.UserFunction{Name: "list", Value: s.List},
+	}
+}
+
+// Get returns the saved value for a key, the optional second argument is
+// returned when no value is saved
+func (s scriptState) Get(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	key, err := stateKey(args[0])
+	if err != nil {
+		return nil, err
+	}
+	value, found, err := s.wrapper().GetState(s.script, key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		if len(args) == 2 {
+			return args[1], nil
+		}
+		return objects.UndefinedValue, nil
+	}
+	return decodeState(value)
+}
+
+// Set saves a value for a key replacing any value already saved
+func (s scriptState) Set(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	key, err := stateKey(args[0])
+	if err != nil {
+		return nil, err
+	}
+	value, err := encodeState(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", key, err)
+	}
+	return nil, s.wrapper().SetState(s.script, key, value)
+}
+
+// Delete removes the saved value for a key
+func (s scriptState) Delete(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	key, err := stateKey(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return nil, s.wrapper().DeleteState(s.script, key)
+}
+
+// List returns the keys with saved values
+func (s scriptState) List(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 0 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	keys, err := s.wrapper().StateKeys(s.script)
+	if err != nil {
+		return nil, err
+	}
+	r := &objects.Array{Value: make([]objects.Object, len(keys))}
+	for i := range keys {
+		r.Value[i] = &objects.String{Value: keys[i]}
+	}
+	return r, nil
+}
+
+func (s scriptState) wrapper() modules.State {
+	if s.w != nil {
+		return s.w
+	}
+	return wrappers.GetWrapper()
+}
+
+func stateKey(o objects.Object) (string, error) {
+	key, ok := o.(*objects.String)
+	if !ok || key.Value == "" || len(key.Value) > maxStateKeyLength {
+		return "", errStateKeyInvalid
+	}
+	return key.Value, nil
+}
+
+// encodeState JSON encodes a value, times are saved as RFC3339 strings
+func encodeState(o objects.Object) (string, error) {
+	v, err := stateInterface(o)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	if len(b) > maxStateValueSize {
+		return "", errStateValueTooBig
+	}
+	return string(b), nil
+}
+
+func stateInterface(o objects.Object) (interface{}, error) {
+	switch v := o.(type) {
+	case *objects.Int:
+		return v.Value, nil
+	case *objects.Float:
+		return v.Value, nil
+	case *objects.String:
+		return v.Value, nil
+	case *objects.Bool:
+		return !v.IsFalsy(), nil
+	case *objects.Char:
+		return string(v.Value), nil
+	case *objects.Time:
+		return v.Value.Format(time.RFC3339Nano), nil
+	case *objects.Undefined:
+		return nil, nil
+	case *objects.Array:
+		return stateArray(v.Value)
+	case *objects.ImmutableArray:
+		return stateArray(v.Value)
+	case *objects.Map:
+		return stateMap(v.Value)
+	case *objects.ImmutableMap:
+		return stateMap(v.Value)
+	}
+	return nil, fmt.Errorf("%w: %s", errStateTypeNotValid, o.TypeName())
+}
+
+func stateArray(arr []objects.Object) (interface{}, error) {
+	r := make([]interface{}, len(arr))
+	for i := range arr {
+		v, err := stateInterface(arr[i])
+		if err != nil {
+			return nil, err
+		}
+		r[i] = v
+	}
+	return r, nil
+}
+
+func stateMap(m map[string]objects.Object) (interface{}, error) {
+	r := make(map[string]interface{}, len(m))
+	for k, o := range m {
+		v, err := stateInterface(o)
+		if err != nil {
+			return nil, err
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+// decodeState converts a JSON encoded value back to a script object, whole
+// numbers are returned as ints
+func decodeState(value string) (objects.Object, error) {
+	d := json.NewDecoder(bytes.NewBufferString(value))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return objects.FromInterface(stateNumbers(v))
+}
+
+func stateNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if !strings.ContainsAny(t.String(), ".eE") {
+			if i, err := t.Int64(); err == nil {
+				return i
+			}
+		}
+		f, _ := t.Float64()
+		return f
+	case []interface{}:
+		for i := range t {
+			t[i] = stateNumbers(t[i])
+		}
+	case map[string]interface{}:
+		for k := range t {
+			t[k] = stateNumbers(t[k])
+		}
+	}
+	return v
+}
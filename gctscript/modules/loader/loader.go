@@ -3,6 +3,7 @@ package loader
 import (
 	"github.com/d5/tengo/v2"
 	"github.com/d5/tengo/v2/stdlib"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/gctscript/modules/gct"
 	"github.com/idoall/gocryptotrader/gctscript/modules/ta"
 )
@@ -38,6 +39,23 @@ func GetModuleMap() *tengo.ModuleMap {
 	return modules
 }
 
+// GetScriptModuleMap returns the module map for a script, including the
+// modules scoped to it. When a wrapper is set the exchange, futures, state and
+// notify modules call it in place of the default wrapper
+func GetScriptModuleMap(script string, w modules.GCT) *tengo.ModuleMap {
+	moduleMap := GetModuleMap()
+	if w != nil {
+		moduleMap.AddBuiltinModule("exchange", gct.ExchangeModule(w))
+		moduleMap.AddBuiltinModule("futures", gct.FuturesModule(w))
+		moduleMap.AddBuiltinModule("state", gct.StateModule(script, w))
+		moduleMap.AddBuiltinModule("notify", gct.NotifyModule(script, w))
+		return moduleMap
+	}
+	moduleMap.AddBuiltinModule("state", gct.StateModule(script, nil))
+	moduleMap.AddBuiltinModule("notify", gct.NotifyModule(script, nil))
+	return moduleMap
+}
+
 // SetDefaultScriptOutput sets the output folder
 func SetDefaultScriptOutput(path string) {
 	gct.OutputDir = path
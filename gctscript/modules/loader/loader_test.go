@@ -3,6 +3,8 @@ package loader
 import (
 	"reflect"
 	"testing"
+
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
 )
 
 func TestGetModuleMap(t *testing.T) {
@@ -16,3 +18,21 @@ func TestGetModuleMap(t *testing.T) {
 		t.Fatal("expected GetModuleMap() to contain module results instead received 0 value")
 	}
 }
+
+func TestGetScriptModuleMap(t *testing.T) {
+	x := GetScriptModuleMap("script.gct", nil)
+	if x.Get("state") == nil || x.Get("notify") == nil {
+		t.Fatal("expected GetScriptModuleMap() to contain the state and notify modules")
+	}
+	if x.Len() != GetModuleMap().Len()+2 {
+		t.Fatal("expected GetScriptModuleMap() to contain all modules")
+	}
+
+	x = GetScriptModuleMap("script.gct", validator.Wrapper{})
+	if x.Get("exchange") == nil || x.Get("futures") == nil || x.Get("state") == nil || x.Get("notify") == nil {
+		t.Fatal("expected GetScriptModuleMap() to contain the exchange, futures, state and notify modules")
+	}
+	if x.Len() != GetModuleMap().Len()+2 {
+		t.Fatal("expected GetScriptModuleMap() to replace the exchange and futures modules")
+	}
+}
@@ -0,0 +1,54 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// ADXModule average directional index indicator commands
+var ADXModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: adx},
+}
+
+// AverageDirectionalIndex is the string constant
+const AverageDirectionalIndex = "Average Directional Index"
+
+// ADX defines a custom Average Directional Index indicator tengo object type
+type ADX struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *ADX) TypeName() string {
+	return AverageDirectionalIndex
+}
+
+func adx(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(ADX)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	adx, plusDI, minusDI, err := indicators.ADX(ohlcvData[2], ohlcvData[3], ohlcvData[4], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(adx, plusDI, minusDI)
+	return r, nil
+}
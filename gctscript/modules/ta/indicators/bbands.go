@@ -6,9 +6,9 @@ import (
 	"strings"
 
 	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
 	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
-	"github.com/thrasher-corp/gct-ta/indicators"
 )
 
 // BBandsModule bollinger bands indicator commands
@@ -121,7 +121,7 @@ func bbands(args ...objects.Object) (objects.Object, error) {
 	r.STDDevUp = inNbDevUp
 	r.MAType = MAType
 
-	retUpper, retMiddle, retLower := indicators.BBANDS(ohlcvData[selector], inTimePeriod, inNbDevDn, inNbDevDn, MAType)
+	retUpper, retMiddle, retLower := indicators.BollingerBands(ohlcvData[selector], inTimePeriod, inNbDevDn, inNbDevDn, MAType)
 	for x := range retMiddle {
 		temp := &objects.Array{}
 		temp.Value = append(temp.Value, &objects.Float{Value: retMiddle[x]})
@@ -0,0 +1,54 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// CCIModule commodity channel index indicator commands
+var CCIModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: cci},
+}
+
+// CommodityChannelIndex is the string constant
+const CommodityChannelIndex = "Commodity Channel Index"
+
+// CCI defines a custom Commodity Channel Index indicator tengo object type
+type CCI struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *CCI) TypeName() string {
+	return CommodityChannelIndex
+}
+
+func cci(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(CCI)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	values, err := indicators.CCI(ohlcvData[2], ohlcvData[3], ohlcvData[4], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(values)
+	return r, nil
+}
@@ -0,0 +1,54 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// DonchianModule donchian channels indicator commands
+var DonchianModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: donchian},
+}
+
+// DonchianChannels is the string constant
+const DonchianChannels = "Donchian Channels"
+
+// Donchian defines a custom Donchian Channels indicator tengo object type
+type Donchian struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Donchian) TypeName() string {
+	return DonchianChannels
+}
+
+func donchian(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Donchian)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	upper, middle, lower, err := indicators.Donchian(ohlcvData[2], ohlcvData[3], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(upper, middle, lower)
+	return r, nil
+}
@@ -6,9 +6,9 @@ import (
 	"strings"
 
 	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
 	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
-	"github.com/thrasher-corp/gct-ta/indicators"
 )
 
 // EMAModule EMA indicator commands
@@ -0,0 +1,54 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// HMAModule hull moving average indicator commands
+var HMAModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: hma},
+}
+
+// HullMovingAverage is the string constant
+const HullMovingAverage = "Hull Moving Average"
+
+// HMA defines a custom Hull Moving Average indicator tengo object type
+type HMA struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *HMA) TypeName() string {
+	return HullMovingAverage
+}
+
+func hma(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(HMA)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	values, err := indicators.HMA(ohlcvData[4], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(values)
+	return r, nil
+}
@@ -0,0 +1,57 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// IchimokuModule ichimoku cloud indicator commands
+var IchimokuModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: ichimoku},
+}
+
+// IchimokuCloud is the string constant
+const IchimokuCloud = "Ichimoku Cloud"
+
+// Ichimoku defines a custom Ichimoku Cloud indicator tengo object type
+type Ichimoku struct {
+	objects.Array
+	PeriodConversion, PeriodBase, PeriodSpanB int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Ichimoku) TypeName() string {
+	return IchimokuCloud
+}
+
+func ichimoku(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Ichimoku)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.PeriodConversion = periods[0]
+	r.PeriodBase = periods[1]
+	r.PeriodSpanB = periods[2]
+
+	conversion, base, spanA, spanB, err := indicators.Ichimoku(ohlcvData[2], ohlcvData[3], r.PeriodConversion, r.PeriodBase, r.PeriodSpanB)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(conversion, base, spanA, spanB)
+	return r, nil
+}
@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"strings"
 
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
 	"github.com/idoall/gocryptotrader/gctscript/modules"
-	"github.com/thrasher-corp/gct-ta/indicators"
 )
 
 // OHLCV locale string for OHLCV data conversion failure
 const OHLCV = "OHLCV data"
 
-var errInvalidSelector = errors.New("invalid selector")
+var (
+	errInvalidSelector  = errors.New("invalid selector")
+	errInvalidPeriod    = errors.New("period must be greater than zero")
+	errInvalidParameter = errors.New("parameter must be greater than zero")
+)
 
 func toFloat64(data interface{}) (float64, error) {
 	switch d := data.(type) {
@@ -59,3 +64,84 @@ func ParseMAType(in string) (indicators.MaType, error) {
 		return 0, errInvalidSelector
 	}
 }
+
+// parseOHLCV converts script candles to series indexed by their position in
+// each candle, open is 1, high 2, low 3, close 4 and volume 5
+func parseOHLCV(in objects.Object) ([][]float64, error) {
+	candles, ok := objects.ToInterface(in).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, OHLCV)
+	}
+	ohlcvData := make([][]float64, 6)
+	var allErrors []string
+	for x := range candles {
+		candle, ok := candles[x].([]interface{})
+		if !ok || len(candle) < 6 {
+			return nil, fmt.Errorf(modules.ErrParameterConvertFailed, OHLCV)
+		}
+		for y := 1; y < 6; y++ {
+			value, err := toFloat64(candle[y])
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+			ohlcvData[y] = append(ohlcvData[y], value)
+		}
+	}
+	if len(allErrors) > 0 {
+		return nil, errors.New(strings.Join(allErrors, ", "))
+	}
+	return ohlcvData, nil
+}
+
+// parsePeriods converts script arguments to periods which must be positive
+func parsePeriods(args ...objects.Object) ([]int, error) {
+	periods := make([]int, len(args))
+	for x := range args {
+		period, ok := objects.ToInt(args[x])
+		if !ok {
+			return nil, fmt.Errorf(modules.ErrParameterConvertFailed, args[x])
+		}
+		if period <= 0 {
+			return nil, errInvalidPeriod
+		}
+		periods[x] = period
+	}
+	return periods, nil
+}
+
+// parseParameters converts script arguments to floats which must be positive
+func parseParameters(args ...objects.Object) ([]float64, error) {
+	params := make([]float64, len(args))
+	for x := range args {
+		param, ok := objects.ToFloat64(args[x])
+		if !ok {
+			return nil, fmt.Errorf(modules.ErrParameterConvertFailed, args[x])
+		}
+		if param <= 0 {
+			return nil, errInvalidParameter
+		}
+		params[x] = param
+	}
+	return params, nil
+}
+
+// seriesToObjects returns a tengo float for each value of a series, when more
+// than one series is supplied each element holds an array of their values
+func seriesToObjects(series ...[]float64) []objects.Object {
+	if len(series) == 0 {
+		return nil
+	}
+	out := make([]objects.Object, len(series[0]))
+	for x := range series[0] {
+		if len(series) == 1 {
+			out[x] = &objects.Float{Value: series[0][x]}
+			continue
+		}
+		values := make([]objects.Object, len(series))
+		for y := range series {
+			values[y] = &objects.Float{Value: series[y][x]}
+		}
+		out[x] = &objects.Array{Value: values}
+	}
+	return out
+}
@@ -561,3 +561,85 @@ func TestParseMAType(t *testing.T) {
 		})
 	}
 }
+
+func TestIndicatorArguments(t *testing.T) {
+	period := &objects.Int{Value: 3}
+	multiplier := &objects.Float{Value: 2}
+	testCases := []struct {
+		name string
+		f    objects.CallableFunc
+		args []objects.Object
+	}{
+		{"stoch", stoch, []objects.Object{period, period, period}},
+		{"stochrsi", stochrsi, []objects.Object{period, period, period, period}},
+		{"adx", adx, []objects.Object{period}},
+		{"ichimoku", ichimoku, []objects.Object{period, period, period}},
+		{"psar", psar, []objects.Object{&objects.Float{Value: 0.02}, &objects.Float{Value: 0.2}}},
+		{"keltner", keltner, []objects.Object{period, period, multiplier}},
+		{"donchian", donchian, []objects.Object{period}},
+		{"vwap", vwap, []objects.Object{period}},
+		{"supertrend", supertrend, []objects.Object{period, multiplier}},
+		{"cci", cci, []objects.Object{period}},
+		{"willr", willr, []objects.Object{period}},
+		{"wma", wma, []objects.Object{period}},
+		{"hma", hma, []objects.Object{period}},
+		{"dema", dema, []objects.Object{period}},
+		{"tema", tema, []objects.Object{period}},
+		{"pivots", pivots, []objects.Object{&objects.String{Value: PivotFibonacci}}},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.f()
+			if !errors.Is(err, objects.ErrWrongNumArguments) {
+				t.Errorf("received '%v' expected '%v'", err, objects.ErrWrongNumArguments)
+			}
+
+			_, err = test.f(append([]objects.Object{ohlcvDataInvalid}, test.args...)...)
+			if err == nil {
+				t.Error("expected conversion failed error")
+			}
+
+			_, err = test.f(append([]objects.Object{&objects.String{Value: testString}}, test.args...)...)
+			if err == nil || err.Error() != "OHLCV data failed conversion" {
+				t.Errorf("received '%v' expected 'OHLCV data failed conversion'", err)
+			}
+
+			invalid := make([]objects.Object, len(test.args))
+			for x := range invalid {
+				invalid[x] = &objects.Int{Value: -1}
+			}
+			_, err = test.f(append([]objects.Object{ohlcvData}, invalid...)...)
+			if err == nil {
+				t.Error("expected invalid parameter error")
+			}
+
+			ret, err := test.f(append([]objects.Object{ohlcvData}, test.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count(ret) != len(ohlcvData.Value) {
+				t.Fatalf("expected a value for each of the %d candles", len(ohlcvData.Value))
+			}
+
+			validator.IsTestExecution.Store(true)
+			ret, err = test.f(append([]objects.Object{ohlcvData}, test.args...)...)
+			validator.IsTestExecution.Store(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count(ret) != 0 {
+				t.Error("expected empty Array on test execution received data")
+			}
+		})
+	}
+}
+
+func count(o objects.Object) int {
+	var n int
+	for i := o.Iterate(); i.Next(); {
+		n++
+	}
+	return n
+}
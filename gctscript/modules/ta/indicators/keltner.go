@@ -0,0 +1,62 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// KeltnerModule keltner channels indicator commands
+var KeltnerModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: keltner},
+}
+
+// KeltnerChannels is the string constant
+const KeltnerChannels = "Keltner Channels"
+
+// Keltner defines a custom Keltner Channels indicator tengo object type
+type Keltner struct {
+	objects.Array
+	PeriodEMA, PeriodATR int
+	Multiplier           float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *Keltner) TypeName() string {
+	return KeltnerChannels
+}
+
+func keltner(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Keltner)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1:3]...)
+	if err != nil {
+		return nil, err
+	}
+	params, err := parseParameters(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	r.PeriodEMA = periods[0]
+	r.PeriodATR = periods[1]
+	r.Multiplier = params[0]
+
+	upper, middle, lower, err := indicators.Keltner(ohlcvData[2], ohlcvData[3], ohlcvData[4], r.PeriodEMA, r.PeriodATR, r.Multiplier)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(upper, middle, lower)
+	return r, nil
+}
@@ -0,0 +1,68 @@
+package indicators
+
+import (
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// PivotsModule pivot points indicator commands
+var PivotsModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: pivots},
+}
+
+// Pivot point calculation methods
+const (
+	PivotClassic   = indicators.PivotClassic
+	PivotFibonacci = indicators.PivotFibonacci
+	PivotCamarilla = indicators.PivotCamarilla
+)
+
+// PivotPoints is the string constant
+const PivotPoints = "Pivot Points"
+
+// Pivots defines a custom Pivot Points indicator tengo object type
+type Pivots struct {
+	objects.Array
+	Method string
+}
+
+// TypeName returns the name of the custom type.
+func (o *Pivots) TypeName() string {
+	return PivotPoints
+}
+
+func pivots(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Pivots)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	method, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, args[1])
+	}
+	method, err = indicators.ParsePivotMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Method = method
+	pivot, r1, r2, r3, s1, s2, s3, err := indicators.Pivots(ohlcvData[2], ohlcvData[3], ohlcvData[4], r.Method)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(pivot, r1, r2, r3, s1, s2, s3)
+	return r, nil
+}
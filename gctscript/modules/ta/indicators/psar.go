@@ -0,0 +1,60 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// PSARModule parabolic sar indicator commands
+var PSARModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: psar},
+}
+
+// ParabolicSAR is the string constant
+const ParabolicSAR = "Parabolic SAR"
+
+// PSAR defines a custom Parabolic SAR indicator tengo object type
+type PSAR struct {
+	objects.Array
+	Step, Maximum float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *PSAR) TypeName() string {
+	return ParabolicSAR
+}
+
+func psar(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(PSAR)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	params, err := parseParameters(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+	err = indicators.CheckPSARParameters(params[0], params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Step = params[0]
+	r.Maximum = params[1]
+
+	values, err := indicators.PSAR(ohlcvData[2], ohlcvData[3], r.Step, r.Maximum)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(values)
+	return r, nil
+}
@@ -0,0 +1,57 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// StochasticModule stochastic oscillator indicator commands
+var StochasticModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: stoch},
+}
+
+// StochasticOscillator is the string constant
+const StochasticOscillator = "Stochastic Oscillator"
+
+// Stochastic defines a custom Stochastic Oscillator indicator tengo object type
+type Stochastic struct {
+	objects.Array
+	PeriodK, Slowing, PeriodD int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Stochastic) TypeName() string {
+	return StochasticOscillator
+}
+
+func stoch(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Stochastic)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.PeriodK = periods[0]
+	r.Slowing = periods[1]
+	r.PeriodD = periods[2]
+
+	k, d, err := indicators.Stochastic(ohlcvData[2], ohlcvData[3], ohlcvData[4], r.PeriodK, r.Slowing, r.PeriodD)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(k, d)
+	return r, nil
+}
@@ -0,0 +1,59 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// StochRSIModule stochastic relative strength index indicator commands
+var StochRSIModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: stochrsi},
+}
+
+// StochasticRelativeStrengthIndex is the string constant
+const StochasticRelativeStrengthIndex = "Stochastic Relative Strength Index"
+
+// StochRSI defines a custom Stochastic Relative Strength Index indicator tengo object
+// type
+type StochRSI struct {
+	objects.Array
+	PeriodRSI, PeriodStoch, PeriodK, PeriodD int
+}
+
+// TypeName returns the name of the custom type.
+func (o *StochRSI) TypeName() string {
+	return StochasticRelativeStrengthIndex
+}
+
+func stochrsi(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(StochRSI)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.PeriodRSI = periods[0]
+	r.PeriodStoch = periods[1]
+	r.PeriodK = periods[2]
+	r.PeriodD = periods[3]
+
+	k, d, err := indicators.StochRSI(ohlcvData[4], r.PeriodRSI, r.PeriodStoch, r.PeriodK, r.PeriodD)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(k, d)
+	return r, nil
+}
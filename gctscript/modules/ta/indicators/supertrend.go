@@ -0,0 +1,61 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// SuperTrendModule supertrend indicator commands
+var SuperTrendModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: supertrend},
+}
+
+// SuperTrendIndicator is the string constant
+const SuperTrendIndicator = "SuperTrend"
+
+// SuperTrend defines a custom SuperTrend indicator tengo object type
+type SuperTrend struct {
+	objects.Array
+	Period     int
+	Multiplier float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *SuperTrend) TypeName() string {
+	return SuperTrendIndicator
+}
+
+func supertrend(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(SuperTrend)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+	params, err := parseParameters(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	r.Multiplier = params[0]
+
+	line, direction, err := indicators.SuperTrend(ohlcvData[2], ohlcvData[3], ohlcvData[4], r.Period, r.Multiplier)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(line, direction)
+	return r, nil
+}
@@ -0,0 +1,54 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// TEMAModule triple exponential moving average indicator commands
+var TEMAModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: tema},
+}
+
+// TripleExponentialMovingAverage is the string constant
+const TripleExponentialMovingAverage = "Triple Exponential Moving Average"
+
+// TEMA defines a custom Triple Exponential Moving Average indicator tengo object type
+type TEMA struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *TEMA) TypeName() string {
+	return TripleExponentialMovingAverage
+}
+
+func tema(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(TEMA)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	values, err := indicators.TEMA(ohlcvData[4], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(values)
+	return r, nil
+}
@@ -0,0 +1,60 @@
+package indicators
+
+import (
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// VWAPModule volume weighted average price indicator commands
+var VWAPModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: vwap},
+}
+
+// VolumeWeightedAveragePrice is the string constant
+const VolumeWeightedAveragePrice = "Volume Weighted Average Price"
+
+// VWAP defines a custom Volume Weighted Average Price indicator tengo object type
+type VWAP struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *VWAP) TypeName() string {
+	return VolumeWeightedAveragePrice
+}
+
+func vwap(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(VWAP)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	period, ok := objects.ToInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, args[1])
+	}
+	if period < 0 {
+		return nil, errInvalidPeriod
+	}
+
+	r.Period = period
+	values, err := indicators.VWAP(ohlcvData[2], ohlcvData[3], ohlcvData[4], ohlcvData[5], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(values)
+	return r, nil
+}
@@ -0,0 +1,54 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/exchanges/kline/indicators"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// WMAModule weighted moving average indicator commands
+var WMAModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: wma},
+}
+
+// WeightedMovingAverage is the string constant
+const WeightedMovingAverage = "Weighted Moving Average"
+
+// WMA defines a custom Weighted Moving Average indicator tengo object type
+type WMA struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *WMA) TypeName() string {
+	return WeightedMovingAverage
+}
+
+func wma(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(WMA)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcvData, err := parseOHLCV(args[0])
+	if err != nil {
+		return nil, err
+	}
+	periods, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = periods[0]
+	values, err := indicators.WMA(ohlcvData[4], r.Period)
+	if err != nil {
+		return nil, err
+	}
+	r.Value = seriesToObjects(values)
+	return r, nil
+}
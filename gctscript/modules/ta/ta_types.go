@@ -16,4 +16,20 @@ var Modules = map[string]map[string]tengo.Object{
 	"indicator/mfi":                    indicators.MfiModule,
 	"indicator/atr":                    indicators.AtrModule,
 	"indicator/correlationcoefficient": indicators.CorrelationCoefficientModule,
+	"indicator/stoch":                  indicators.StochasticModule,
+	"indicator/stochrsi":               indicators.StochRSIModule,
+	"indicator/adx":                    indicators.ADXModule,
+	"indicator/ichimoku":               indicators.IchimokuModule,
+	"indicator/psar":                   indicators.PSARModule,
+	"indicator/keltner":                indicators.KeltnerModule,
+	"indicator/donchian":               indicators.DonchianModule,
+	"indicator/vwap":                   indicators.VWAPModule,
+	"indicator/supertrend":             indicators.SuperTrendModule,
+	"indicator/cci":                    indicators.CCIModule,
+	"indicator/willr":                  indicators.WilliamsRModule,
+	"indicator/wma":                    indicators.WMAModule,
+	"indicator/hma":                    indicators.HMAModule,
+	"indicator/dema":                   indicators.DEMAModule,
+	"indicator/tema":                   indicators.TEMAModule,
+	"indicator/pivots":                 indicators.PivotsModule,
 }
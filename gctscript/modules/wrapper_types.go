@@ -6,10 +6,12 @@ import (
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
 	"github.com/idoall/gocryptotrader/portfolio/withdraw"
 )
 
@@ -26,6 +28,9 @@ var Wrapper GCT
 // GCT interface requirements
 type GCT interface {
 	Exchange
+	State
+	Futures
+	Notify
 }
 
 // Exchange interface requirements
@@ -34,15 +39,88 @@ type Exchange interface {
 	IsEnabled(exch string) bool
 	Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error)
 	Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error)
+	TickerHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]ticker.Price, error)
+	OrderbookHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]orderbook.TopOfBook, error)
 	Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error)
 	QueryOrder(exch, orderid string, pair currency.Pair, assetType asset.Item) (*order.Detail, error)
+	OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error)
+	ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error)
 	SubmitOrder(submit *order.Submit) (*order.SubmitResponse, error)
 	CancelOrder(exch, orderid string, pair currency.Pair, item asset.Item) (bool, error)
 	AccountInformation(exch string) (account.Holdings, error)
+	FundingHistory(exch string) ([]FundHistory, error)
 	DepositAddress(exch string, currencyCode currency.Code) (string, error)
 	WithdrawalFiatFunds(bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(request *withdraw.Request) (out string, err error)
+	RecentTrades(exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error)
+	HistoricTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error)
 	OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	Bars(exch string, pair currency.Pair, item asset.Item, start, end time.Time, cfg trade.BarConfig) (kline.Item, error)
+	LiveBars(exch string, pair currency.Pair, item asset.Item, cfg trade.BarConfig) (kline.Item, error)
+}
+
+// FundHistory holds a deposit or withdrawal on an exchange account, it
+// matches the exchange package's FundHistory which cannot be imported here
+type FundHistory struct {
+	ExchangeName      string
+	Status            string
+	TransferID        string
+	Description       string
+	Timestamp         time.Time
+	Currency          string
+	Amount            float64
+	Fee               float64
+	TransferType      string
+	CryptoToAddress   string
+	CryptoFromAddress string
+	CryptoTxID        string
+	BankTo            string
+	BankFrom          string
+}
+
+// State interface requirements, values are JSON encoded and scoped to the
+// script that saved them
+type State interface {
+	GetState(script, key string) (value string, found bool, err error)
+	SetState(script, key, value string) error
+	DeleteState(script, key string) error
+	StateKeys(script string) ([]string, error)
+}
+
+// Futures interface requirements for derivatives trading
+type Futures interface {
+	Positions(exch string, pair currency.Pair, item asset.Item) ([]futures.Position, error)
+	SetLeverage(exch string, pair currency.Pair, item asset.Item, leverage float64) error
+	SetMarginType(exch string, pair currency.Pair, item asset.Item, marginType futures.MarginType) error
+	FundingRates(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]futures.FundingRate, error)
+	PremiumIndex(exch string, pair currency.Pair, item asset.Item) (*futures.PremiumIndex, error)
+	SubmitFuturesOrder(submit *futures.Submit) (*order.SubmitResponse, error)
+}
+
+// Severity levels of a script notification
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Severity is how urgent a script notification is
+type Severity string
+
+// Notification is a message sent by a script to the communication relayers
+type Notification struct {
+	Script   string
+	Severity Severity
+	Message  string
+}
+
+// Notify interface requirements, notifications are relayed to the enabled
+// communication services and events are published to websocket clients.
+// ReleaseScript is called once a script is no longer running
+type Notify interface {
+	Notify(n *Notification) error
+	PublishEvent(script, event string, data interface{}) error
+	ReleaseScript(script string)
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
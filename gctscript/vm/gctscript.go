@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers"
 	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
 	"github.com/idoall/gocryptotrader/log"
 )
@@ -41,7 +42,15 @@ func (g *GctScriptManager) Validate(file string) (err error) {
 	if err != nil {
 		return
 	}
-	return tempVM.Run()
+	err = tempVM.Run()
+	if err != nil {
+		return
+	}
+	err = tempVM.parseSchedule()
+	if err != nil {
+		return
+	}
+	return tempVM.parsePipeline()
 }
 
 // ShutdownAll shutdown all
@@ -68,14 +77,32 @@ func (g *GctScriptManager) ShutdownAll() (err error) {
 
 // RemoveVM remove VM from list
 func (g *GctScriptManager) RemoveVM(id uuid.UUID) error {
-	if _, f := AllVMSync.Load(id); !f {
+	v, f := AllVMSync.Load(id)
+	if !f {
 		return fmt.Errorf(ErrNoVMFound, id.String())
 	}
 
 	AllVMSync.Delete(id)
 	VMSCount.remove()
+	releaseScript(v.(*VM).ShortName())
 	if g.config.Verbose {
 		log.Debugf(log.GCTScriptMgr, "VM %v removed from AllVMs", id)
 	}
 	return nil
 }
+
+// releaseScript releases the wrapper's resources held for a script once no
+// virtual machine is running it
+func releaseScript(script string) {
+	var running bool
+	AllVMSync.Range(func(_, v interface{}) bool {
+		running = v.(*VM).ShortName() == script
+		return !running
+	})
+	if running {
+		return
+	}
+	if w := wrappers.GetWrapper(); w != nil {
+		w.ReleaseScript(script)
+	}
+}
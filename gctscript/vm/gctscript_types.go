@@ -15,6 +15,7 @@ const (
 type Config struct {
 	Enabled            bool          `json:"enabled"`
 	ScriptTimeout      time.Duration `json:"timeout"`
+	EventTimeout       time.Duration `json:"event_timeout"`
 	MaxVirtualMachines uint8         `json:"max_virtual_machines"`
 	AllowImports       bool          `json:"allow_imports"`
 	AutoLoad           []string      `json:"auto_load"`
@@ -31,6 +32,9 @@ type Error struct {
 var (
 	// ScriptPath path to load/save scripts
 	ScriptPath string
+	// SchedulePath path the schedule state of timer and scheduled scripts is
+	// saved to, it is only kept in memory when unset
+	SchedulePath string
 )
 
 var (
@@ -38,4 +42,17 @@ var (
 	ErrScriptingDisabled = errors.New("scripting is disabled")
 	// ErrNoVMLoaded error message displayed if a virtual machine has not been initialised
 	ErrNoVMLoaded = errors.New("no virtual machine loaded")
+
+	errInvalidEvent       = errors.New("invalid script event")
+	errInvalidEventType   = errors.New("unsupported script event type")
+	errEventExchangeUnset = errors.New("script event exchange not set")
+	errEventPairUnset     = errors.New("script event requires a currency pair and asset type")
+	errEventIntervalUnset = errors.New("script event requires a kline interval of at least one second")
+	errEventTimeout       = errors.New("script event run timed out")
+	errNoBacktestVM       = errors.New("unable to create virtual machine for backtest")
+	errNoBacktestWrapper  = errors.New("backtest wrapper not set")
+	errInvalidSchedule    = errors.New("invalid script schedule")
+	errScheduleAndTimer   = errors.New("script cannot declare both a schedule and a timer")
+	errInvalidPipeline    = errors.New("invalid script pipeline")
+	errPipelineNoVM       = errors.New("unable to create virtual machine for pipeline script")
 )
@@ -20,6 +20,9 @@ type GctScriptManager struct {
 	shutdown chan struct{}
 	// Optional values to override stored config ('nil' if not overridden)
 	MaxVirtualMachines *uint8
+
+	scheduleLock sync.Mutex
+	schedules    map[string]ScheduleState
 }
 
 // NewManager creates a new instance of script manager
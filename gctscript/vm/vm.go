@@ -48,6 +48,7 @@ func (g *GctScriptManager) NewVM() (vm *VM) {
 		Script:     pool.Get().(*tengo.Script),
 		config:     g.config,
 		unregister: func() error { return g.RemoveVM(newUUID) },
+		manager:    g,
 	}
 	return
 }
@@ -88,9 +89,37 @@ func (vm *VM) Load(file string) error {
 	if err != nil {
 		return err
 	}
+	// event is set to the triggering market event when the script runs for
+	// one of its declared events
+	err = vm.Script.Add("event", nil)
+	if err != nil {
+		return err
+	}
+	// input is set to the output of the previous script when the script runs
+	// in a pipeline
+	err = vm.Script.Add("input", nil)
+	if err != nil {
+		return err
+	}
+
+	vm.globals, err = parseScheduleGlobals(code)
+	if err != nil {
+		return &Error{
+			Action: "Load: schedule",
+			Script: file,
+			Cause:  err,
+		}
+	}
 
-	vm.Script.SetImports(loader.GetModuleMap())
 	vm.Hash = vm.getHash()
+	err = vm.loadLimits()
+	if err != nil {
+		return &Error{
+			Action: "Load: limits",
+			Script: file,
+			Cause:  err,
+		}
+	}
 
 	if vm.config.AllowImports {
 		if vm.config.Verbose {
@@ -118,6 +147,7 @@ func (vm *VM) Run() (err error) {
 	err = vm.Compiled.Run()
 	if err != nil {
 		vm.event(StatusFailure, TypeExecute)
+		vm.runViolation(err)
 		return Error{
 			Action: "Run",
 			Cause:  err,
@@ -129,11 +159,13 @@ func (vm *VM) Run() (err error) {
 
 // RunCtx runs compiled byte code with context.Context support.
 func (vm *VM) RunCtx() (err error) {
+	vm.runLock.Lock()
+	defer vm.runLock.Unlock()
 	if vm.ctx == nil {
 		vm.ctx = context.Background()
 	}
 
-	ct, cancel := context.WithTimeout(vm.ctx, vm.config.ScriptTimeout)
+	ct, cancel := context.WithTimeout(vm.ctx, vm.timeout(vm.config.ScriptTimeout))
 	defer cancel()
 
 	if vm.config.Verbose {
@@ -143,6 +175,7 @@ func (vm *VM) RunCtx() (err error) {
 	err = vm.Compiled.RunContext(ct)
 	if err != nil {
 		vm.event(StatusFailure, TypeExecute)
+		vm.runViolation(err)
 		return Error{
 			Action: "RunCtx",
 			Cause:  err,
@@ -167,6 +200,25 @@ func (vm *VM) CompileAndRun() {
 		return
 	}
 
+	err = vm.parseSchedule()
+	if err != nil {
+		log.Error(log.GCTScriptMgr, Error{
+			Action: "CompileAndRun: schedule",
+			Script: vm.File,
+			Cause:  err,
+		})
+		err = vm.unregister()
+		if err != nil {
+			log.Error(log.GCTScriptMgr, err)
+		}
+		return
+	}
+	if vm.cron != nil {
+		// scheduled scripts first run at their next scheduled time
+		vm.runner()
+		return
+	}
+
 	err = vm.RunCtx()
 	if err != nil {
 		log.Error(log.GCTScriptMgr, err)
@@ -176,6 +228,16 @@ func (vm *VM) CompileAndRun() {
 		}
 		return
 	}
+	err = vm.declare()
+	if err != nil {
+		log.Error(log.GCTScriptMgr, err)
+		err = vm.Shutdown()
+		if err != nil {
+			log.Error(log.GCTScriptMgr, err)
+		}
+		return
+	}
+	vm.runPipeline()
 	if vm.Compiled.Get("timer").String() != "" {
 		vm.T, err = time.ParseDuration(vm.Compiled.Get("timer").String())
 		if err != nil {
@@ -195,15 +257,40 @@ func (vm *VM) CompileAndRun() {
 			return
 		}
 		vm.runner()
-	} else {
+	}
+	if vm.S == nil {
 		err = vm.Shutdown()
 		if err != nil {
 			log.Error(log.GCTScriptMgr, err)
 		}
-		return
 	}
 }
 
+// declare reads the events and pipeline set by the script's first run and
+// starts its event runner
+func (vm *VM) declare() error {
+	subs, err := parseEvents(vm.Compiled.Get("events"))
+	if err != nil {
+		return Error{
+			Action: "CompileAndRun: events",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+	err = vm.parsePipeline()
+	if err != nil {
+		return Error{
+			Action: "CompileAndRun: pipeline",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+	if len(subs) > 0 {
+		vm.eventRunner(subs)
+	}
+	return nil
+}
+
 // Shutdown shuts down current VM
 func (vm *VM) Shutdown() error {
 	if vm == nil {
@@ -241,7 +328,8 @@ func (vm *VM) ShortName() string {
 }
 
 func (vm *VM) event(status, executionType string) {
-	if validator.IsTestExecution.Load() == true {
+	// backtests run a script once per candle so their runs are not recorded
+	if validator.IsTestExecution.Load() == true || vm.wrapper != nil {
 		return
 	}
 
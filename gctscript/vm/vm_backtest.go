@@ -0,0 +1,50 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/backtest"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// Backtest runs a script once per candle of a backtest wrapper, which is used
+// in place of live exchanges, and returns the script's performance
+func (g *GctScriptManager) Backtest(ctx context.Context, file string, w *backtest.Wrapper) (*backtest.Report, error) {
+	if !g.Started() {
+		return nil, ErrScriptingDisabled
+	}
+	if w == nil {
+		return nil, errNoBacktestWrapper
+	}
+	vm := g.New()
+	if vm == nil {
+		return nil, errNoBacktestVM
+	}
+	defer func() {
+		if errShutdown := vm.Shutdown(); errShutdown != nil {
+			log.Error(log.GCTScriptMgr, errShutdown)
+		}
+	}()
+	vm.wrapper = w
+	vm.ctx = ctx
+
+	err := vm.Load(file)
+	if err != nil {
+		return nil, err
+	}
+	err = vm.Compile()
+	if err != nil {
+		return nil, err
+	}
+	for w.Next() {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		err = vm.RunCtx()
+		if err != nil {
+			return nil, fmt.Errorf("backtest run at %s: %w", w.Clock(), err)
+		}
+	}
+	return w.Report(), nil
+}
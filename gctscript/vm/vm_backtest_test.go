@@ -0,0 +1,82 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/backtest"
+)
+
+var testScriptBacktest = filepath.Join("..", "..", "testdata", "gctscript", "backtest.gct")
+
+func TestBacktest(t *testing.T) {
+	manager := GctScriptManager{
+		config: configHelper(true, true, maxTestVirtualMachines),
+	}
+	_, err := manager.Backtest(context.Background(), testScriptBacktest, nil)
+	if !errors.Is(err, ErrScriptingDisabled) {
+		t.Errorf("received %v, expected %v", err, ErrScriptingDisabled)
+	}
+	manager.started = 1
+	_, err = manager.Backtest(context.Background(), testScriptBacktest, nil)
+	if !errors.Is(err, errNoBacktestWrapper) {
+		t.Errorf("received %v, expected %v", err, errNoBacktestWrapper)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := &backtest.Config{
+		Exchange:   "backtest",
+		Pair:       currency.NewPair(currency.BTC, currency.USD),
+		Asset:      asset.Spot,
+		Interval:   kline.OneHour,
+		Start:      start,
+		End:        start.Add(3 * time.Hour),
+		QuoteFunds: 1000,
+	}
+	item := &kline.Item{
+		Candles: []kline.Candle{
+			{Time: start, Open: 100, High: 100, Low: 100, Close: 100},
+			{Time: start.Add(time.Hour), Open: 100, High: 110, Low: 100, Close: 110},
+			{Time: start.Add(2 * time.Hour), Open: 110, High: 120, Low: 110, Close: 120},
+		},
+	}
+	w, err := backtest.NewWithCandles(cfg, item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	running := VMSCount.Len()
+	report, err := manager.Backtest(context.Background(), testScriptBacktest, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VMSCount.Len() != running {
+		t.Error("expected backtest virtual machine to be removed")
+	}
+	if report.Candles != 3 || report.FilledOrders != 1 {
+		t.Fatalf("received %+v, expected one filled order over three candles", report)
+	}
+	if report.FinalBase != 1 || report.FinalQuote != 900 || report.ProfitLoss != 20 {
+		t.Errorf("received %+v, expected to buy at the second candle's open", report)
+	}
+	runs, found, err := w.GetState(filepath.Base(testScriptBacktest), "runs")
+	if err != nil || !found || runs != "3" {
+		t.Errorf("received %v %v %v, expected state to be kept in memory between runs", runs, found, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w, err = backtest.NewWithCandles(cfg, item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = manager.Backtest(ctx, testScriptBacktest, w)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("received %v, expected %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,451 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/orderbook"
+	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// parseEvents converts the events declared by a script into subscriptions
+func parseEvents(v *tengo.Variable) ([]*eventSubscription, error) {
+	if v == nil || v.Value() == nil {
+		return nil, nil
+	}
+	declared, ok := v.Value().([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: events must be an array", errInvalidEvent)
+	}
+	if len(declared) > maxEventSubscriptions {
+		return nil, fmt.Errorf("%w: %d events declared, maximum is %d",
+			errInvalidEvent, len(declared), maxEventSubscriptions)
+	}
+	subs := make([]*eventSubscription, len(declared))
+	for i := range declared {
+		m, ok := declared[i].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: event %d must be a map", errInvalidEvent, i)
+		}
+		sub, err := parseEvent(m)
+		if err != nil {
+			return nil, fmt.Errorf("event %d %w", i, err)
+		}
+		subs[i] = sub
+	}
+	return subs, nil
+}
+
+func parseEvent(m map[string]interface{}) (*eventSubscription, error) {
+	var fields [6]string
+	for i, k := range []string{"type", "exchange", "pair", "delimiter", "asset", "interval"} {
+		if m[k] == nil {
+			continue
+		}
+		s, ok := m[k].(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be a string", errInvalidEvent, k)
+		}
+		fields[i] = s
+	}
+	sub := &eventSubscription{
+		Type:     strings.ToLower(fields[0]),
+		Exchange: strings.ToLower(fields[1]),
+		// derivatives asset types are exchange specific so are not checked
+		// against the supported asset list
+		Asset: asset.Item(strings.ToLower(fields[4])),
+	}
+	switch sub.Type {
+	case EventTicker, EventOrderbook, EventTrade, EventKline, EventOrderFill:
+	default:
+		return nil, fmt.Errorf("%w '%s'", errInvalidEventType, fields[0])
+	}
+	if sub.Exchange == "" {
+		return nil, fmt.Errorf("%s %w", sub.Type, errEventExchangeUnset)
+	}
+	if fields[2] != "" {
+		delimiter := fields[3]
+		if delimiter == "" {
+			delimiter = currency.DashDelimiter
+		}
+		var err error
+		sub.Pair, err = currency.NewPairDelimiter(fields[2], delimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if sub.Type == EventKline {
+		if sub.Pair.IsEmpty() || sub.Asset == "" {
+			return nil, fmt.Errorf("%s %s %w", sub.Type, sub.Exchange, errEventPairUnset)
+		}
+		interval, err := parseEventInterval(fields[5])
+		if err != nil {
+			return nil, err
+		}
+		sub.bars, err = trade.NewBarBuilder(trade.BarConfig{
+			Type:     trade.TimeBar,
+			Interval: kline.Interval(interval),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sub, nil
+}
+
+// parseEventInterval parses a kline interval, days and weeks are supported in
+// addition to standard durations
+func parseEventInterval(in string) (time.Duration, error) {
+	switch in {
+	case "":
+		return 0, errEventIntervalUnset
+	case "1d":
+		in = "24h"
+	case "3d":
+		in = "72h"
+	case "1w":
+		in = "168h"
+	}
+	d, err := time.ParseDuration(in)
+	if err != nil {
+		return 0, err
+	}
+	if d < time.Second {
+		return 0, fmt.Errorf("%w: %v", errEventIntervalUnset, d)
+	}
+	return d, nil
+}
+
+// batched returns whether every update is passed to the script rather than
+// only the latest
+func (e *eventSubscription) batched() bool {
+	return e.Type == EventTrade || e.Type == EventKline || e.Type == EventOrderFill
+}
+
+func (e *eventSubscription) subscribe() (dispatch.Pipe, error) {
+	switch e.Type {
+	case EventTicker:
+		return ticker.SubscribeToExchangeTickers(e.Exchange)
+	case EventOrderbook:
+		return orderbook.SubscribeToExchangeOrderbooks(e.Exchange)
+	case EventTrade, EventKline:
+		return trade.SubscribeToExchangeTrades(e.Exchange)
+	default:
+		return order.SubscribeToExchangeFills(e.Exchange)
+	}
+}
+
+func (e *eventSubscription) matches(p currency.Pair, a asset.Item) bool {
+	if !e.Pair.IsEmpty() && !e.Pair.Equal(p) {
+		return false
+	}
+	return e.Asset == "" || strings.EqualFold(e.Asset.String(), a.String())
+}
+
+// handle filters data received from a dispatch pipe and queues it for the
+// script, it returns whether anything was queued
+func (e *eventSubscription) handle(data interface{}) bool {
+	switch d := data.(type) {
+	case ticker.Price:
+		if !e.matches(d.Pair, d.AssetType) {
+			return false
+		}
+		e.push(tickerToObject(&d))
+	case orderbook.Base:
+		if !e.matches(d.Pair, d.AssetType) {
+			return false
+		}
+		e.push(orderbookToObject(&d))
+	case trade.Data:
+		if !e.matches(d.CurrencyPair, d.AssetType) {
+			return false
+		}
+		if e.Type == EventTrade {
+			e.push(tradeToObject(&d))
+			return true
+		}
+		candles := e.bars.Add(d)
+		if len(candles) == 0 {
+			return false
+		}
+		for i := range candles {
+			e.push(candleToObject(&candles[i]))
+		}
+	case order.Detail:
+		if !e.matches(d.Pair, d.AssetType) {
+			return false
+		}
+		e.push(fillToObject(&d))
+	default:
+		return false
+	}
+	return true
+}
+
+// push queues an update, the latest ticker or orderbook replaces any update
+// the script has not yet received while trades, klines and fills are kept up
+// to the batch limit
+func (e *eventSubscription) push(obj tengo.Object) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if !e.batched() {
+		if e.latest != nil {
+			e.coalesced++
+		}
+		e.latest = obj
+		return
+	}
+	e.pending = append(e.pending, obj)
+	if len(e.pending) > eventBatchLimit {
+		dropped := len(e.pending) - eventBatchLimit
+		e.pending = e.pending[dropped:]
+		e.coalesced += int64(dropped)
+	}
+}
+
+// take returns the queued updates as an event for the script
+func (e *eventSubscription) take() (*tengo.Map, bool) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	var data tengo.Object
+	if e.batched() {
+		if len(e.pending) == 0 {
+			return nil, false
+		}
+		data = &tengo.Array{Value: e.pending}
+		e.pending = nil
+	} else {
+		if e.latest == nil {
+			return nil, false
+		}
+		data = e.latest
+		e.latest = nil
+	}
+	event := make(map[string]tengo.Object, 6)
+	event["type"] = &tengo.String{Value: e.Type}
+	event["exchange"] = &tengo.String{Value: e.Exchange}
+	event["pair"] = &tengo.String{Value: e.Pair.String()}
+	event["asset"] = &tengo.String{Value: e.Asset.String()}
+	event["data"] = data
+	event["coalesced"] = &tengo.Int{Value: e.coalesced}
+	e.coalesced = 0
+	return &tengo.Map{Value: event}, true
+}
+
+// eventRunner subscribes to the events declared by a script and runs the
+// script for each of them until the VM is shut down. Updates that arrive while
+// the script is running are coalesced into its next run
+func (vm *VM) eventRunner(subs []*eventSubscription) {
+	if vm.S == nil {
+		vm.S = make(chan struct{}, 1)
+	}
+	signal := make(chan struct{}, 1)
+	for i := range subs {
+		go vm.eventReader(subs[i], signal)
+	}
+	go func() {
+		for {
+			select {
+			case <-vm.S:
+				return
+			case <-signal:
+				for i := range subs {
+					event, ok := subs[i].take()
+					if !ok {
+						continue
+					}
+					err := vm.runEvent(event)
+					if err != nil {
+						log.Error(log.GCTScriptMgr, err)
+						continue
+					}
+					vm.runPipeline()
+				}
+			}
+		}
+	}()
+}
+
+// eventReader reads a subscription's dispatch pipe, exchanges that have not
+// processed any data yet are subscribed to once they do
+func (vm *VM) eventReader(sub *eventSubscription, signal chan<- struct{}) {
+	var pipe dispatch.Pipe
+	var err error
+	for {
+		pipe, err = sub.subscribe()
+		if err == nil {
+			break
+		}
+		if vm.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Script: %s ID: %v %s event subscription waiting: %v",
+				vm.ShortName(), vm.ID, sub.Type, err)
+		}
+		select {
+		case <-vm.S:
+			return
+		case <-time.After(eventResubscribeDelay):
+		}
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Error(log.GCTScriptMgr, err)
+		}
+	}()
+	for {
+		select {
+		case <-vm.S:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			p, ok := data.(*interface{})
+			if !ok || !sub.handle(*p) {
+				continue
+			}
+			select {
+			case signal <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// runEvent runs the compiled script with the event global set, only failed
+// runs are recorded as script events to avoid a database write per update
+func (vm *VM) runEvent(event *tengo.Map) error {
+	vm.runLock.Lock()
+	defer vm.runLock.Unlock()
+	if vm.ctx == nil {
+		vm.ctx = context.Background()
+	}
+	timeout := vm.config.EventTimeout
+	if timeout <= 0 {
+		timeout = vm.config.ScriptTimeout
+	}
+	timeout = vm.timeout(timeout)
+	ct, cancel := context.WithTimeout(vm.ctx, timeout)
+	defer cancel()
+
+	err := vm.Compiled.Set("event", event)
+	if err == nil {
+		err = vm.Compiled.RunContext(ct)
+		// clear the event so timer runs can tell they were not triggered by
+		// one
+		if errSet := vm.Compiled.Set("event", nil); errSet != nil && err == nil {
+			err = errSet
+		}
+	}
+	if err != nil {
+		vm.event(StatusFailure, TypeExecute)
+		vm.runViolation(err)
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("%w after %v", errEventTimeout, timeout)
+		}
+		return Error{
+			Action: "RunEvent",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+	return nil
+}
+
+func tickerToObject(tx *ticker.Price) tengo.Object {
+	data := make(map[string]tengo.Object, 18)
+	data["exchange"] = &tengo.String{Value: tx.ExchangeName}
+	data["pair"] = &tengo.String{Value: tx.Pair.String()}
+	data["asset"] = &tengo.String{Value: tx.AssetType.String()}
+	data["last"] = &tengo.Float{Value: tx.Last}
+	data["high"] = &tengo.Float{Value: tx.High}
+	data["low"] = &tengo.Float{Value: tx.Low}
+	data["bid"] = &tengo.Float{Value: tx.Bid}
+	data["ask"] = &tengo.Float{Value: tx.Ask}
+	data["volume"] = &tengo.Float{Value: tx.Volume}
+	data["quotevolume"] = &tengo.Float{Value: tx.QuoteVolume}
+	data["open"] = &tengo.Float{Value: tx.Open}
+	data["close"] = &tengo.Float{Value: tx.Close}
+	data["updated"] = &tengo.Time{Value: tx.LastUpdated}
+	data["markprice"] = &tengo.Float{Value: tx.MarkPrice}
+	data["indexprice"] = &tengo.Float{Value: tx.IndexPrice}
+	data["fundingrate"] = &tengo.Float{Value: tx.FundingRate}
+	data["nextfundingtime"] = &tengo.Time{Value: tx.NextFundingTime}
+	data["openinterest"] = &tengo.Float{Value: tx.OpenInterest}
+	return &tengo.Map{Value: data}
+}
+
+func orderbookToObject(ob *orderbook.Base) tengo.Object {
+	levels := func(items []orderbook.Item) *tengo.Array {
+		if len(items) > eventOrderbookDepth {
+			items = items[:eventOrderbookDepth]
+		}
+		arr := &tengo.Array{Value: make([]tengo.Object, len(items))}
+		for i := range items {
+			temp := make(map[string]tengo.Object, 2)
+			temp["amount"] = &tengo.Float{Value: items[i].Amount}
+			temp["price"] = &tengo.Float{Value: items[i].Price}
+			arr.Value[i] = &tengo.Map{Value: temp}
+		}
+		return arr
+	}
+	data := make(map[string]tengo.Object, 6)
+	data["exchange"] = &tengo.String{Value: ob.ExchangeName}
+	data["pair"] = &tengo.String{Value: ob.Pair.String()}
+	data["asset"] = &tengo.String{Value: ob.AssetType.String()}
+	data["asks"] = levels(ob.Asks)
+	data["bids"] = levels(ob.Bids)
+	data["updated"] = &tengo.Time{Value: ob.LastUpdated}
+	return &tengo.Map{Value: data}
+}
+
+func tradeToObject(t *trade.Data) tengo.Object {
+	data := make(map[string]tengo.Object, 7)
+	data["id"] = &tengo.String{Value: t.TID}
+	data["pair"] = &tengo.String{Value: t.CurrencyPair.String()}
+	data["asset"] = &tengo.String{Value: t.AssetType.String()}
+	data["side"] = &tengo.String{Value: t.Side.String()}
+	data["price"] = &tengo.Float{Value: t.Price}
+	data["amount"] = &tengo.Float{Value: t.Amount}
+	data["timestamp"] = &tengo.Time{Value: t.Timestamp}
+	return &tengo.Map{Value: data}
+}
+
+func candleToObject(c *kline.Candle) tengo.Object {
+	data := make(map[string]tengo.Object, 6)
+	data["time"] = &tengo.Time{Value: c.Time}
+	data["open"] = &tengo.Float{Value: c.Open}
+	data["high"] = &tengo.Float{Value: c.High}
+	data["low"] = &tengo.Float{Value: c.Low}
+	data["close"] = &tengo.Float{Value: c.Close}
+	data["volume"] = &tengo.Float{Value: c.Volume}
+	return &tengo.Map{Value: data}
+}
+
+func fillToObject(d *order.Detail) tengo.Object {
+	data := make(map[string]tengo.Object, 13)
+	data["exchange"] = &tengo.String{Value: d.Exchange}
+	data["id"] = &tengo.String{Value: d.ID}
+	data["pair"] = &tengo.String{Value: d.Pair.String()}
+	data["asset"] = &tengo.String{Value: d.AssetType.String()}
+	data["price"] = &tengo.Float{Value: d.Price}
+	data["amount"] = &tengo.Float{Value: d.Amount}
+	data["amountexecuted"] = &tengo.Float{Value: d.ExecutedAmount}
+	data["amountremaining"] = &tengo.Float{Value: d.RemainingAmount}
+	data["fee"] = &tengo.Float{Value: d.Fee}
+	data["side"] = &tengo.String{Value: d.Side.String()}
+	data["type"] = &tengo.String{Value: d.Type.String()}
+	data["status"] = &tengo.String{Value: d.Status.String()}
+	data["updated"] = &tengo.Time{Value: d.LastUpdated}
+	return &tengo.Map{Value: data}
+}
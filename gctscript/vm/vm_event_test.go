@@ -0,0 +1,246 @@
+package vm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/dispatch"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+)
+
+var (
+	testScriptEvents        = filepath.Join("..", "..", "testdata", "gctscript", "events.gct")
+	testScriptInvalidEvents = filepath.Join("..", "..", "testdata", "gctscript", "invalid_events.gct")
+)
+
+func TestParseEvents(t *testing.T) {
+	t.Parallel()
+	subs, err := parseEvents(&tengo.Variable{})
+	if err != nil || subs != nil {
+		t.Fatalf("expected undefined events to be ignored, received %v %v", subs, err)
+	}
+
+	events := func(declared ...map[string]interface{}) *tengo.Variable {
+		arr := make([]interface{}, len(declared))
+		for i := range declared {
+			arr[i] = declared[i]
+		}
+		v, err := tengo.NewVariable("events", arr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	_, err = parseEvents(events(map[string]interface{}{"type": "weather", "exchange": "test"}))
+	if !errors.Is(err, errInvalidEventType) {
+		t.Errorf("received %v, expected %v", err, errInvalidEventType)
+	}
+	_, err = parseEvents(events(map[string]interface{}{"type": "ticker"}))
+	if !errors.Is(err, errEventExchangeUnset) {
+		t.Errorf("received %v, expected %v", err, errEventExchangeUnset)
+	}
+	_, err = parseEvents(events(map[string]interface{}{"type": "ticker", "exchange": 1}))
+	if !errors.Is(err, errInvalidEvent) {
+		t.Errorf("received %v, expected %v", err, errInvalidEvent)
+	}
+	_, err = parseEvents(events(map[string]interface{}{"type": "kline", "exchange": "test", "interval": "1m"}))
+	if !errors.Is(err, errEventPairUnset) {
+		t.Errorf("received %v, expected %v", err, errEventPairUnset)
+	}
+	_, err = parseEvents(events(map[string]interface{}{"type": "kline", "exchange": "test", "pair": "BTC-USD", "asset": "spot"}))
+	if !errors.Is(err, errEventIntervalUnset) {
+		t.Errorf("received %v, expected %v", err, errEventIntervalUnset)
+	}
+
+	subs, err = parseEvents(events(
+		map[string]interface{}{"type": "Ticker", "exchange": "Test", "pair": "BTC_USD", "delimiter": "_", "asset": "spot"},
+		map[string]interface{}{"type": "kline", "exchange": "test", "pair": "BTC-USD", "asset": "spot", "interval": "1d"},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 2 {
+		t.Fatalf("received %v subscriptions, expected 2", len(subs))
+	}
+	if subs[0].Type != EventTicker || subs[0].Exchange != "test" ||
+		!subs[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) || subs[0].Asset != asset.Spot {
+		t.Errorf("unexpected subscription %+v", subs[0])
+	}
+	if subs[1].bars == nil {
+		t.Error("expected kline subscription to build bars")
+	}
+}
+
+func TestEventSubscriptionCoalescing(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	sub := &eventSubscription{Type: EventTicker, Exchange: "test", Pair: p}
+	if _, ok := sub.take(); ok {
+		t.Fatal("expected no event to be queued")
+	}
+	if sub.handle(ticker.Price{Pair: currency.NewPair(currency.ETH, currency.USD), Last: 1}) {
+		t.Error("expected ticker for another pair to be ignored")
+	}
+	for i := 1; i <= 3; i++ {
+		if !sub.handle(ticker.Price{Pair: p, AssetType: asset.Spot, Last: float64(i)}) {
+			t.Fatal("expected ticker to be queued")
+		}
+	}
+	event, ok := sub.take()
+	if !ok {
+		t.Fatal("expected event to be queued")
+	}
+	data := event.Value["data"].(*tengo.Map)
+	if last := data.Value["last"].(*tengo.Float).Value; last != 3 {
+		t.Errorf("received last %v, expected the latest ticker", last)
+	}
+	if c := event.Value["coalesced"].(*tengo.Int).Value; c != 2 {
+		t.Errorf("received coalesced %v, expected 2", c)
+	}
+
+	sub = &eventSubscription{Type: EventTrade, Exchange: "test"}
+	for i := 0; i < eventBatchLimit+5; i++ {
+		sub.handle(trade.Data{CurrencyPair: p, AssetType: asset.Spot, Price: float64(i)})
+	}
+	event, ok = sub.take()
+	if !ok {
+		t.Fatal("expected event to be queued")
+	}
+	trades := event.Value["data"].(*tengo.Array).Value
+	if len(trades) != eventBatchLimit {
+		t.Errorf("received %v trades, expected %v", len(trades), eventBatchLimit)
+	}
+	if first := trades[0].(*tengo.Map).Value["price"].(*tengo.Float).Value; first != 5 {
+		t.Errorf("received first trade price %v, expected the oldest trades to be dropped", first)
+	}
+	if c := event.Value["coalesced"].(*tengo.Int).Value; c != 5 {
+		t.Errorf("received coalesced %v, expected 5", c)
+	}
+
+	sub = &eventSubscription{Type: EventOrderFill, Exchange: "test", Asset: asset.Spot}
+	if sub.handle(order.Detail{Pair: p, AssetType: asset.Margin, Status: order.Filled}) {
+		t.Error("expected fill for another asset to be ignored")
+	}
+	if !sub.handle(order.Detail{Pair: p, AssetType: asset.Spot, Status: order.Filled}) {
+		t.Error("expected fill to be queued")
+	}
+}
+
+func TestEventKlines(t *testing.T) {
+	t.Parallel()
+	sub, err := parseEvent(map[string]interface{}{
+		"type":     "kline",
+		"exchange": "test",
+		"pair":     "BTC-USD",
+		"asset":    "spot",
+		"interval": "1m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if sub.handle(trade.Data{CurrencyPair: p, AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: start}) {
+		t.Error("expected no kline until the interval closes")
+	}
+	if !sub.handle(trade.Data{CurrencyPair: p, AssetType: asset.Spot, Price: 2, Amount: 1, Timestamp: start.Add(time.Minute)}) {
+		t.Fatal("expected closed kline to be queued")
+	}
+	event, ok := sub.take()
+	if !ok {
+		t.Fatal("expected event to be queued")
+	}
+	candles := event.Value["data"].(*tengo.Array).Value
+	if len(candles) != 1 {
+		t.Fatalf("received %v klines, expected 1", len(candles))
+	}
+	if c := candles[0].(*tengo.Map).Value["close"].(*tengo.Float).Value; c != 1 {
+		t.Errorf("received close %v, expected 1", c)
+	}
+}
+
+func TestVMWithInvalidEvents(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	VM := manager.New()
+	if VM == nil {
+		t.Fatal("Failed to allocate new VM exiting")
+	}
+	err := VM.Load(testScriptInvalidEvents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	VM.CompileAndRun()
+	err = VM.Shutdown()
+	if err == nil {
+		t.Fatal("VM should not be running with invalid events")
+	}
+}
+
+func TestVMWithEvents(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tick := &ticker.Price{
+		ExchangeName: "eventtest",
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         1337,
+	}
+	err := ticker.ProcessTicker(tick)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	VM := manager.New()
+	if VM == nil {
+		t.Fatal("Failed to allocate new VM exiting")
+	}
+	err = VM.Load(testScriptEvents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	VM.CompileAndRun()
+	if VM.S == nil {
+		t.Fatal("expected VM to keep running for its events")
+	}
+	defer func() {
+		if err := VM.Shutdown(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// the dispatcher drops data on a handshake timeout so publish until the
+	// script receives a ticker
+	for i := 0; i < 20; i++ {
+		err = ticker.ProcessTicker(tick)
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(100 * time.Millisecond)
+		VM.runLock.Lock()
+		received := VM.Compiled.Get("received").Float()
+		VM.runLock.Unlock()
+		if received == tick.Last {
+			return
+		}
+	}
+	t.Fatal("expected script to run for ticker event")
+}
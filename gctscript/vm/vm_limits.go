@@ -0,0 +1,78 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/gctscript/modules/loader"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/sandbox"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// maxViolationLength matches the execution status column size
+const maxViolationLength = 255
+
+// loadLimits loads the limits saved for the script and sets its modules,
+// scripts with limits call a sandbox wrapper which enforces them
+func (vm *VM) loadLimits() error {
+	var err error
+	vm.limits, err = sandbox.LoadLimits(vm.File)
+	if err != nil {
+		return err
+	}
+	w := vm.wrapper
+	if vm.limits != nil {
+		if vm.limits.MaxAllocs > 0 {
+			vm.Script.SetMaxAllocs(vm.limits.MaxAllocs)
+		}
+		if w == nil {
+			w = wrappers.GetWrapper()
+		}
+		var s *sandbox.Wrapper
+		s, err = sandbox.New(w, vm.ShortName(), vm.limits, vm.violation)
+		if err != nil {
+			return err
+		}
+		w = s
+	}
+	vm.Script.SetImports(loader.GetScriptModuleMap(vm.ShortName(), w))
+	return nil
+}
+
+// timeout returns the script's max runtime when set in place of the
+// configured timeout
+func (vm *VM) timeout(configured time.Duration) time.Duration {
+	if vm.limits != nil && vm.limits.MaxRuntime > 0 {
+		return vm.limits.MaxRuntime
+	}
+	return configured
+}
+
+// runViolation records a failed run which exceeded the script's allocation or
+// runtime limit
+func (vm *VM) runViolation(err error) {
+	if vm.limits == nil {
+		return
+	}
+	switch {
+	case errors.Is(err, tengo.ErrObjectAllocLimit):
+		vm.violation(fmt.Errorf("%w: %v allocations", sandbox.ErrLimitExceeded, vm.limits.MaxAllocs))
+	case errors.Is(err, context.DeadlineExceeded) && vm.limits.MaxRuntime > 0:
+		vm.violation(fmt.Errorf("%w: %v runtime", sandbox.ErrLimitExceeded, vm.limits.MaxRuntime))
+	}
+}
+
+// violation logs a limit exceeded by the script and records it as a script
+// event
+func (vm *VM) violation(err error) {
+	log.Warnf(log.GCTScriptMgr, "Script: %s ID: %v %v", vm.ShortName(), vm.ID, err)
+	status := err.Error()
+	if len(status) > maxViolationLength {
+		status = status[:maxViolationLength]
+	}
+	vm.event(status, TypeViolation)
+}
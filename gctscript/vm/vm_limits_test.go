@@ -0,0 +1,89 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/sandbox"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+)
+
+func writeLimitedScript(t *testing.T, dir, name, code string, l *sandbox.Limits) string {
+	t.Helper()
+	file := filepath.Join(dir, name)
+	err := ioutil.WriteFile(file, []byte(code), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sandbox.SaveLimits(file, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestVMLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+
+	file := writeLimitedScript(t, dir, "allocs.gct", `a := []
+for i := 0; i < 100; i++ {
+	a = append(a, [i])
+}`, &sandbox.Limits{MaxAllocs: 10})
+	err = manager.Validate(file)
+	if !errors.Is(err, tengo.ErrObjectAllocLimit) {
+		t.Errorf("received %v, expected %v", err, tengo.ErrObjectAllocLimit)
+	}
+
+	file = writeLimitedScript(t, dir, "exchange.gct", `exch := import("exchange")
+t := exch.ticker("Bitstamp", "BTC-USD", "-", "SPOT")`, &sandbox.Limits{Exchanges: []string{"Binance"}})
+	err = manager.Validate(file)
+	if !errors.Is(err, sandbox.ErrLimitExceeded) {
+		t.Errorf("received %v, expected %v", err, sandbox.ErrLimitExceeded)
+	}
+	err = sandbox.SaveLimits(file, &sandbox.Limits{Exchanges: []string{"Bitstamp"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = manager.Validate(file)
+	if err != nil {
+		t.Error(err)
+	}
+
+	file = writeLimitedScript(t, dir, "runtime.gct", `for {}`, &sandbox.Limits{MaxRuntime: time.Millisecond})
+	validator.IsTestExecution.Store(true)
+	defer validator.IsTestExecution.Store(false)
+	testVM := manager.New()
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.timeout(time.Minute) != time.Millisecond {
+		t.Errorf("received %v, expected the script's max runtime", testVM.timeout(time.Minute))
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.RunCtx()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("received %v, expected %v", err, context.DeadlineExceeded)
+	}
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,110 @@
+package vm
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/d5/tengo/v2"
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// parsePipeline reads the scripts a script's output is passed to after each
+// successful run
+func (vm *VM) parsePipeline() error {
+	v := vm.Compiled.Get("pipeline")
+	if v.Value() == nil {
+		return nil
+	}
+	declared, ok := v.Value().([]interface{})
+	if !ok {
+		return fmt.Errorf("%w: pipeline must be an array of script names", errInvalidPipeline)
+	}
+	if len(declared) > maxPipelineLength {
+		return fmt.Errorf("%w: %d scripts declared, maximum is %d",
+			errInvalidPipeline, len(declared), maxPipelineLength)
+	}
+	seen := map[string]bool{vm.ShortName(): true}
+	pipeline := make([]string, len(declared))
+	for i := range declared {
+		name, ok := declared[i].(string)
+		if !ok || name == "" {
+			return fmt.Errorf("%w: script %d must be a name", errInvalidPipeline, i)
+		}
+		if filepath.Ext(name) != common.GctExt {
+			name += common.GctExt
+		}
+		if filepath.Base(name) != name {
+			return fmt.Errorf("%w: %s must be in the script's directory", errInvalidPipeline, name)
+		}
+		if seen[name] {
+			return fmt.Errorf("%w: %s runs more than once", errInvalidPipeline, name)
+		}
+		seen[name] = true
+		pipeline[i] = name
+	}
+	vm.Pipeline = pipeline
+	return nil
+}
+
+// runPipeline runs the script's pipeline in order, each script receives the
+// previous script's output as its input. The pipeline stops at the first
+// script which fails or leaves its output unset
+func (vm *VM) runPipeline() {
+	if len(vm.Pipeline) == 0 || vm.manager == nil {
+		return
+	}
+	input := vm.output()
+	for i := range vm.Pipeline {
+		if input == nil {
+			if vm.config.Verbose {
+				log.Debugf(log.GCTScriptMgr, "Script: %s ID: %v pipeline stopped before %s, output not set",
+					vm.ShortName(), vm.ID, vm.Pipeline[i])
+			}
+			return
+		}
+		var err error
+		input, err = vm.runStage(vm.Pipeline[i], input)
+		if err != nil {
+			log.Error(log.GCTScriptMgr, Error{
+				Action: "Pipeline: " + vm.ShortName(),
+				Script: vm.Pipeline[i],
+				Cause:  err,
+			})
+			return
+		}
+	}
+}
+
+// runStage runs a pipeline script once in a new VM with its input set and
+// returns its output, the stage's own timer, schedule, events and pipeline
+// are not started
+func (vm *VM) runStage(name string, input tengo.Object) (tengo.Object, error) {
+	stage := vm.manager.New()
+	if stage == nil {
+		return nil, errPipelineNoVM
+	}
+	err := stage.Load(filepath.Join(vm.Path, name))
+	if err == nil {
+		err = stage.Compile()
+	}
+	if err == nil {
+		err = stage.Compiled.Set("input", input)
+	}
+	if err != nil {
+		if errUnregister := stage.unregister(); errUnregister != nil {
+			log.Error(log.GCTScriptMgr, errUnregister)
+		}
+		return nil, err
+	}
+	stage.ctx = vm.ctx
+	err = stage.RunCtx()
+	var output tengo.Object
+	if err == nil {
+		output = stage.output()
+	}
+	if errShutdown := stage.Shutdown(); errShutdown != nil {
+		log.Error(log.GCTScriptMgr, errShutdown)
+	}
+	return output, err
+}
@@ -0,0 +1,119 @@
+package vm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/d5/tengo/v2"
+)
+
+func TestParsePipeline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+
+	for name, code := range map[string]string{
+		"map.gct":       `pipeline := {a: "b"}`,
+		"number.gct":    `pipeline := [1]`,
+		"self.gct":      `pipeline := ["self"]`,
+		"duplicate.gct": `pipeline := ["a", "a.gct"]`,
+		"path.gct":      `pipeline := ["../a"]`,
+		"long.gct":      `pipeline := ["a","b","c","d","e","f","g","h","i","j","k"]`,
+	} {
+		err = manager.Validate(writeLimitedScript(t, dir, name, code, nil))
+		if !errors.Is(err, errInvalidPipeline) {
+			t.Errorf("%s received %v, expected %v", name, err, errInvalidPipeline)
+		}
+	}
+
+	file := writeLimitedScript(t, dir, "head.gct", `pipeline := ["signal", "execute.gct"]`, nil)
+	testVM := manager.NewVM()
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.parsePipeline()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(testVM.Pipeline) != 2 || testVM.Pipeline[0] != "signal.gct" || testVM.Pipeline[1] != "execute.gct" {
+		t.Errorf("unexpected pipeline %v", testVM.Pipeline)
+	}
+}
+
+func TestRunPipeline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+
+	writeLimitedScript(t, dir, "double.gct", `output := {value: input.value * 2}`, nil)
+	writeLimitedScript(t, dir, "triple.gct", `output := {value: input.value * 3}`, nil)
+	writeLimitedScript(t, dir, "skip.gct", `x := input`, nil)
+	writeLimitedScript(t, dir, "broken.gct", `output := input.value.missing()`, nil)
+	file := writeLimitedScript(t, dir, "head.gct", `output := {value: 21}
+pipeline := ["double", "triple", "skip", "broken"]`, nil)
+
+	head := manager.New()
+	err = head.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vmCount := VMSCount.Len()
+
+	input := &tengo.Map{Value: map[string]tengo.Object{"value": &tengo.Int{Value: 21}}}
+	output, err := head.runStage("double.gct", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := output.(*tengo.Map)
+	if !ok || m.Value["value"].(*tengo.Int).Value != 42 {
+		t.Errorf("received %v, expected the input to be doubled", output)
+	}
+	output, err = head.runStage("skip.gct", input)
+	if err != nil || output != nil {
+		t.Errorf("received %v %v, expected no output", output, err)
+	}
+	_, err = head.runStage("broken.gct", input)
+	if err == nil {
+		t.Error("expected failing pipeline script to return an error")
+	}
+	_, err = head.runStage("missing.gct", input)
+	if err == nil {
+		t.Error("expected missing pipeline script to return an error")
+	}
+	if VMSCount.Len() != vmCount {
+		t.Errorf("received %v VMs, expected pipeline VMs to be removed", VMSCount.Len())
+	}
+
+	// the pipeline stops at skip which does not set an output so broken is
+	// never run
+	head.CompileAndRun()
+	if len(head.Pipeline) != 4 {
+		t.Errorf("unexpected pipeline %v", head.Pipeline)
+	}
+	if VMSCount.Len() != vmCount-1 {
+		t.Errorf("received %v VMs, expected the head script to have finished", VMSCount.Len())
+	}
+}
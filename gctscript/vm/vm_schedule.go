@@ -0,0 +1,404 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/log"
+)
+
+// cronSchedule is a parsed five field cron expression, each field is a bit set
+// of the values it matches
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// a day matches when either day field matches unless one of them is
+	// unrestricted, as with standard cron
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	cronFields = [5]cronField{
+		{min: 0, max: 59},
+		{min: 0, max: 23},
+		{min: 1, max: 31},
+		{min: 1, max: 12, names: map[string]uint{
+			"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+			"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+		}},
+		// sunday can be written as 0 or 7
+		{min: 0, max: 7, names: map[string]uint{
+			"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+		}},
+	}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// parseCron parses a standard five field cron expression of minute, hour, day
+// of month, month and day of week or one of the @ descriptors
+func parseCron(expr string) (*cronSchedule, error) {
+	spec := strings.TrimSpace(expr)
+	if d, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = d
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("%w '%s': expected %d fields", errInvalidSchedule, expr, len(cronFields))
+	}
+	var sets [5]uint64
+	for i := range fields {
+		set, err := parseCronField(fields[i], &cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w '%s': %v", errInvalidSchedule, expr, err)
+		}
+		sets[i] = set
+	}
+	if sets[4]&(1<<7) != 0 {
+		sets[4] = sets[4]&^(1<<7) | 1
+	}
+	return &cronSchedule{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     sets[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps
+func parseCronField(field string, f *cronField) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, uint64(1)
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			s, err := strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step '%s'", part)
+			}
+			rng, step = part[:i], s
+		}
+		var start, end uint
+		switch {
+		case rng == "*":
+			start, end = f.min, f.max
+		case strings.IndexByte(rng, '-') > 0:
+			i := strings.IndexByte(rng, '-')
+			var err error
+			if start, err = f.value(rng[:i]); err != nil {
+				return 0, err
+			}
+			if end, err = f.value(rng[i+1:]); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range '%s'", rng)
+			}
+		default:
+			var err error
+			if start, err = f.value(rng); err != nil {
+				return 0, err
+			}
+			end = start
+			// a single value with a step repeats until the end of the field
+			if step > 1 {
+				end = f.max
+			}
+		}
+		for v := uint64(start); v <= uint64(end); v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func (f *cronField) value(in string) (uint, error) {
+	if v, ok := f.names[strings.ToLower(in)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(in, 10, 8)
+	if err != nil || uint(v) < f.min || uint(v) > f.max {
+		return 0, fmt.Errorf("value '%s' out of range %d-%d", in, f.min, f.max)
+	}
+	return uint(v), nil
+}
+
+// next returns the first minute after t matching the schedule in t's
+// location, the zero time is returned when nothing matches within five years
+func (c *cronSchedule) next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		var n time.Time
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			n = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.dayMatches(t):
+			n = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			n = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			n = t.Add(time.Minute)
+		default:
+			return t
+		}
+		// dates in a daylight saving gap can normalise backwards
+		if !n.After(t) {
+			n = t.Add(time.Minute)
+		}
+		t = n
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// scheduleGlobals are read from a script's source so its schedule is known
+// before it first runs
+var scheduleGlobals = []string{"schedule", "timezone", "jitter", "timer"}
+
+// parseScheduleGlobals returns the schedule globals assigned at the top level
+// of a script's source. A schedule, timezone and jitter must be string
+// literals, a timer is recorded as declared whatever its value
+func parseScheduleGlobals(code []byte) (map[string]string, error) {
+	fileSet := parser.NewFileSet()
+	file, err := parser.NewParser(fileSet.AddFile("(main)", -1, len(code)), code, nil).ParseFile()
+	if err != nil {
+		return nil, err
+	}
+	globals := make(map[string]string)
+	for i := range file.Stmts {
+		assign, ok := file.Stmts[i].(*parser.AssignStmt)
+		if !ok || len(assign.LHS) != 1 || len(assign.RHS) != 1 {
+			continue
+		}
+		ident, ok := assign.LHS[0].(*parser.Ident)
+		if !ok || !common.StringDataCompare(scheduleGlobals, ident.Name) {
+			continue
+		}
+		lit, ok := assign.RHS[0].(*parser.StringLit)
+		switch {
+		case ok:
+			globals[ident.Name] = lit.Value
+		case ident.Name == "timer":
+			globals[ident.Name] = ""
+		default:
+			return nil, fmt.Errorf("%w: %s must be a string", errInvalidSchedule, ident.Name)
+		}
+	}
+	return globals, nil
+}
+
+// parseSchedule reads the schedule, timezone and jitter globals declared by a
+// script, a schedule cannot be declared alongside a timer
+func (vm *VM) parseSchedule() error {
+	vm.location = time.UTC
+	if tz := vm.globals["timezone"]; tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidSchedule, err)
+		}
+		vm.location = loc
+	}
+	if jitter := vm.globals["jitter"]; jitter != "" {
+		d, err := time.ParseDuration(jitter)
+		if err != nil {
+			return fmt.Errorf("%w: jitter %v", errInvalidSchedule, err)
+		}
+		if d < 0 {
+			return fmt.Errorf("%w: jitter cannot be negative", errInvalidSchedule)
+		}
+		vm.jitter = d
+	}
+	expr := vm.globals["schedule"]
+	if expr == "" {
+		return nil
+	}
+	if _, ok := vm.globals["timer"]; ok {
+		return errScheduleAndTimer
+	}
+	c, err := parseCron(expr)
+	if err != nil {
+		return err
+	}
+	if c.next(time.Now().In(vm.location)).IsZero() {
+		return fmt.Errorf("%w '%s': never runs", errInvalidSchedule, expr)
+	}
+	vm.cron = c
+	vm.Schedule = expr + " " + vm.location.String()
+	return nil
+}
+
+// nextRun returns when a repeating script next runs after now, timer scripts
+// run a timer after their last run so restarts do not shorten the interval
+func (vm *VM) nextRun(now, last time.Time) time.Time {
+	var next time.Time
+	if vm.cron != nil {
+		next = vm.cron.next(now.In(vm.location))
+		if next.IsZero() {
+			return next
+		}
+	} else {
+		next = now.Add(vm.T)
+		if !last.IsZero() && last.Add(vm.T).After(now) {
+			next = last.Add(vm.T)
+		}
+	}
+	if vm.jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(vm.jitter)))) // nolint:gosec // no need to import crypo/rand
+	}
+	return next
+}
+
+// ScheduleState is saved after each timer or scheduled run so it survives
+// restarts
+type ScheduleState struct {
+	LastRun    time.Time `json:"last_run"`
+	LastStatus string    `json:"last_status"`
+	NextRun    time.Time `json:"next_run"`
+	Runs       int64     `json:"runs"`
+}
+
+// ScheduleState returns the script's schedule state
+func (vm *VM) ScheduleState() ScheduleState {
+	vm.stateLock.RLock()
+	defer vm.stateLock.RUnlock()
+	return vm.state
+}
+
+// loadScheduleState loads the schedule state saved by the script's previous
+// runs from its manager
+func (vm *VM) loadScheduleState() {
+	if vm.manager == nil {
+		return
+	}
+	s, ok := vm.manager.scheduleState(vm.ShortName())
+	if !ok {
+		return
+	}
+	vm.stateLock.Lock()
+	vm.state = s
+	vm.stateLock.Unlock()
+}
+
+func (vm *VM) setNextRun(next time.Time) {
+	vm.stateLock.Lock()
+	vm.NextRun = next
+	vm.state.NextRun = next
+	vm.stateLock.Unlock()
+}
+
+// saveRun records a timer or scheduled run and saves the schedule state with
+// the script's manager
+func (vm *VM) saveRun(start time.Time, status string) {
+	vm.stateLock.Lock()
+	vm.state.LastRun = start
+	vm.state.LastStatus = status
+	vm.state.Runs++
+	s := vm.state
+	vm.stateLock.Unlock()
+	if vm.manager == nil {
+		return
+	}
+	err := vm.manager.saveScheduleState(vm.ShortName(), s)
+	if err != nil {
+		log.Error(log.GCTScriptMgr, Error{
+			Action: "Schedule: save",
+			Script: vm.File,
+			Cause:  err,
+		})
+	}
+}
+
+// scheduleState returns the schedule state saved for a script
+func (g *GctScriptManager) scheduleState(script string) (ScheduleState, bool) {
+	g.scheduleLock.Lock()
+	defer g.scheduleLock.Unlock()
+	g.loadSchedules()
+	s, ok := g.schedules[script]
+	return s, ok
+}
+
+// saveScheduleState saves a script's schedule state, the state of every
+// script is written to the SchedulePath when set so it survives restarts
+func (g *GctScriptManager) saveScheduleState(script string, s ScheduleState) error {
+	g.scheduleLock.Lock()
+	defer g.scheduleLock.Unlock()
+	g.loadSchedules()
+	g.schedules[script] = s
+	if SchedulePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(g.schedules, "", " ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(SchedulePath, scheduleFile), data, 0644)
+}
+
+// loadSchedules loads the saved schedule states on first use, the schedule
+// lock must be held
+func (g *GctScriptManager) loadSchedules() {
+	if g.schedules != nil {
+		return
+	}
+	g.schedules = make(map[string]ScheduleState)
+	if SchedulePath == "" {
+		return
+	}
+	file := filepath.Join(SchedulePath, scheduleFile)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error(log.GCTScriptMgr, err)
+		}
+		return
+	}
+	err = json.Unmarshal(data, &g.schedules)
+	if err != nil {
+		log.Errorf(log.GCTScriptMgr, "%s %v", file, err)
+		g.schedules = make(map[string]ScheduleState)
+	}
+}
+
+// output returns a copy of the output global set by the script's last run or
+// nil when it is unset
+func (vm *VM) output() tengo.Object {
+	v := vm.Compiled.Get("output")
+	if v.Value() == nil {
+		return nil
+	}
+	return v.Object().Copy()
+}
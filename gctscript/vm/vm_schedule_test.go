@@ -0,0 +1,212 @@
+package vm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * foo *",
+		"@sometimes",
+	} {
+		_, err := parseCron(expr)
+		if !errors.Is(err, errInvalidSchedule) {
+			t.Errorf("%s received %v, expected %v", expr, err, errInvalidSchedule)
+		}
+	}
+
+	c, err := parseCron("0,30 9-17/4 * jan-mar MON-fri")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.minute != 1|1<<30 {
+		t.Errorf("unexpected minutes %b", c.minute)
+	}
+	if c.hour != 1<<9|1<<13|1<<17 {
+		t.Errorf("unexpected hours %b", c.hour)
+	}
+	if c.month != 1<<1|1<<2|1<<3 {
+		t.Errorf("unexpected months %b", c.month)
+	}
+	if c.dow != 1<<1|1<<2|1<<3|1<<4|1<<5 {
+		t.Errorf("unexpected days of week %b", c.dow)
+	}
+
+	c, err = parseCron("0 0 * * 7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.dow != 1 {
+		t.Errorf("expected 7 to be parsed as sunday, received %b", c.dow)
+	}
+
+	_, err = parseCron("@Daily")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	t.Parallel()
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	start := time.Date(2021, 1, 1, 10, 0, 30, 0, time.UTC)
+	for _, tc := range []struct {
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"5 0 * * *", start, time.Date(2021, 1, 2, 0, 5, 0, 0, time.UTC)},
+		{"*/15 * * * *", start, time.Date(2021, 1, 1, 10, 15, 0, 0, time.UTC)},
+		{"@hourly", start, time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"0 12 * * 1", start, time.Date(2021, 1, 4, 12, 0, 0, 0, time.UTC)},
+		// either day field matches when both are restricted
+		{"0 0 13 * 5", start, time.Date(2021, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", start, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"30 9 * * *", start.In(newYork), time.Date(2021, 1, 1, 9, 30, 0, 0, newYork)},
+		// 02:30 does not exist when clocks go forward
+		{"30 2 * * *", time.Date(2021, 3, 13, 12, 0, 0, 0, newYork), time.Date(2021, 3, 15, 2, 30, 0, 0, newYork)},
+		{"0 0 30 2 *", start, time.Time{}},
+	} {
+		c, err := parseCron(tc.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next := c.next(tc.from); !next.Equal(tc.expected) {
+			t.Errorf("%s from %v received %v, expected %v", tc.expr, tc.from, next, tc.expected)
+		}
+	}
+}
+
+func TestNextRun(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	vm := &VM{T: time.Hour}
+	if next := vm.nextRun(now, time.Time{}); !next.Equal(now.Add(time.Hour)) {
+		t.Errorf("received %v, expected %v", next, now.Add(time.Hour))
+	}
+	last := now.Add(-time.Minute * 10)
+	if next := vm.nextRun(now, last); !next.Equal(last.Add(time.Hour)) {
+		t.Errorf("received %v, expected the timer to run an hour after its last run", next)
+	}
+	if next := vm.nextRun(now, now.Add(-time.Hour*2)); !next.Equal(now.Add(time.Hour)) {
+		t.Errorf("received %v, expected %v", next, now.Add(time.Hour))
+	}
+
+	vm.jitter = time.Minute
+	for i := 0; i < 10; i++ {
+		next := vm.nextRun(now, time.Time{})
+		if next.Before(now.Add(time.Hour)) || !next.Before(now.Add(time.Hour+time.Minute)) {
+			t.Fatalf("received %v, expected jitter of up to a minute", next)
+		}
+	}
+
+	c, err := parseCron("5 0 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm = &VM{cron: c, location: time.UTC}
+	from := time.Date(2021, 1, 1, 10, 0, 0, 0, time.FixedZone("test", 3600))
+	if next := vm.nextRun(from, time.Time{}); !next.Equal(time.Date(2021, 1, 2, 0, 5, 0, 0, time.UTC)) {
+		t.Errorf("received %v, expected the schedule to run in its time zone", next)
+	}
+}
+
+func TestVMSchedule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+
+	for name, code := range map[string]string{
+		"both.gct":     "timer := \"1m\"\nschedule := \"@daily\"",
+		"timezone.gct": "schedule := \"@daily\"\ntimezone := \"Mars/Olympus_Mons\"",
+		"jitter.gct":   "schedule := \"@daily\"\njitter := \"-1s\"",
+		"never.gct":    "schedule := \"0 0 31 2 *\"",
+	} {
+		err = manager.Validate(writeLimitedScript(t, dir, name, code, nil))
+		if !errors.Is(err, errInvalidSchedule) && !errors.Is(err, errScheduleAndTimer) {
+			t.Errorf("%s received %v, expected an invalid schedule", name, err)
+		}
+	}
+
+	for name, code := range map[string]string{
+		"computed.gct": "schedule := \"@\" + \"daily\"",
+		"number.gct":   "schedule := \"@daily\"\njitter := 30",
+	} {
+		err = manager.Validate(writeLimitedScript(t, dir, name, code, nil))
+		if !errors.Is(err, errInvalidSchedule) {
+			t.Errorf("%s received %v, expected %v", name, err, errInvalidSchedule)
+		}
+	}
+
+	SchedulePath = dir
+	defer func() { SchedulePath = "" }()
+	file := writeLimitedScript(t, dir, "daily.gct", "schedule := \"5 0 * * *\"\ntimezone := \"UTC\"\njitter := \"30s\"\nran := true", nil)
+	err = manager.Validate(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := time.Now().Add(-time.Hour).Truncate(time.Second)
+	err = manager.saveScheduleState("daily.gct", ScheduleState{LastRun: last, LastStatus: StatusSuccess, Runs: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testVM := manager.New()
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVM.CompileAndRun()
+	if testVM.Schedule != "5 0 * * * UTC" {
+		t.Errorf("received %s, expected the schedule and time zone", testVM.Schedule)
+	}
+	if testVM.Compiled.Get("ran").Value() != nil {
+		t.Error("expected scheduled script not to run until its next scheduled time")
+	}
+	state := testVM.ScheduleState()
+	if !state.LastRun.Equal(last) || state.LastStatus != StatusSuccess || state.Runs != 3 {
+		t.Errorf("expected saved schedule state to be loaded, received %+v", state)
+	}
+	if state.NextRun.Hour() != 0 || state.NextRun.Minute() != 5 || !state.NextRun.After(time.Now()) {
+		t.Errorf("unexpected next run %v", state.NextRun)
+	}
+
+	testVM.saveRun(time.Now(), StatusFailure)
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// schedule state is saved to the schedule path, not next to the script
+	if _, err = os.Stat(filepath.Join(dir, scheduleFile)); err != nil {
+		t.Error(err)
+	}
+	restarted := GctScriptManager{}
+	reloaded, ok := restarted.scheduleState("daily.gct")
+	if !ok || reloaded.Runs != 4 || reloaded.LastStatus != StatusFailure {
+		t.Errorf("expected schedule state to be saved, received %+v", reloaded)
+	}
+}
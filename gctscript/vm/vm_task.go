@@ -6,21 +6,54 @@ import (
 	"github.com/idoall/gocryptotrader/log"
 )
 
+// runner runs the script on its timer or schedule until the VM is shut down
+// or a run fails
 func (vm *VM) runner() {
-	vm.S = make(chan struct{}, 1)
-	waitTime := time.NewTicker(vm.T)
-	vm.NextRun = time.Now().Add(vm.T)
+	if vm.S == nil {
+		vm.S = make(chan struct{}, 1)
+	}
+	vm.loadScheduleState()
+	next := vm.nextRun(time.Now(), vm.ScheduleState().LastRun)
+	if next.IsZero() {
+		log.Errorf(log.GCTScriptMgr, "Script: %s ID: %v schedule %s has no future runs",
+			vm.ShortName(), vm.ID, vm.Schedule)
+		return
+	}
+	vm.setNextRun(next)
 
+	// scheduled scripts are not run when started so their events and pipeline
+	// are read after their first run
+	declared := vm.cron == nil
 	go func() {
 		for {
+			waitTime := time.NewTimer(time.Until(next))
 			select {
 			case <-waitTime.C:
-				vm.NextRun = time.Now().Add(vm.T)
+				start := time.Now()
 				err := vm.RunCtx()
 				if err != nil {
+					vm.setNextRun(time.Time{})
+					vm.saveRun(start, StatusFailure)
 					log.Error(log.GCTScriptMgr, err)
 					return
 				}
+				if !declared {
+					declared = true
+					err = vm.declare()
+					if err != nil {
+						vm.setNextRun(time.Time{})
+						vm.saveRun(start, StatusFailure)
+						log.Error(log.GCTScriptMgr, err)
+						return
+					}
+				}
+				next = vm.nextRun(time.Now(), start)
+				vm.setNextRun(next)
+				vm.saveRun(start, StatusSuccess)
+				vm.runPipeline()
+				if next.IsZero() {
+					return
+				}
 			case <-vm.S:
 				waitTime.Stop()
 				return
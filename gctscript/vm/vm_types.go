@@ -7,6 +7,11 @@ import (
 
 	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/sandbox"
 )
 
 const (
@@ -25,11 +30,41 @@ const (
 	TypeStop = "stop"
 	// TypeRead text to display in script_event table when a script contents is read
 	TypeRead = "read"
+	// TypeViolation text to display in script_event table when a script exceeds
+	// one of its limits, the status is the limit exceeded
+	TypeViolation = "violation"
 
 	// StatusSuccess text to display in script_event table on successful execution
 	StatusSuccess = "success"
 	// StatusFailure text to display in script_event table when script execution fails
 	StatusFailure = "failure"
+
+	// EventTicker runs a script on ticker updates
+	EventTicker = "ticker"
+	// EventOrderbook runs a script on orderbook updates
+	EventOrderbook = "orderbook"
+	// EventTrade runs a script on trades received by the trade processor
+	EventTrade = "trade"
+	// EventKline runs a script when a candle built from received trades closes
+	EventKline = "kline"
+	// EventOrderFill runs a script when an order is filled or partially filled
+	EventOrderFill = "orderfill"
+
+	maxEventSubscriptions = 20
+	// eventBatchLimit is the maximum amount of trades, klines or fills passed
+	// to a single script run, the oldest are dropped first
+	eventBatchLimit = 500
+	// eventOrderbookDepth is the maximum amount of levels per orderbook side
+	// passed to a script
+	eventOrderbookDepth   = 50
+	eventResubscribeDelay = 5 * time.Second
+
+	// maxPipelineLength is the maximum amount of scripts a script's output
+	// can be passed through
+	maxPipelineLength = 10
+	// scheduleFile is the file in the SchedulePath the schedule state of
+	// every script is saved to
+	scheduleFile = "schedules.json"
 )
 
 type vmscount int32
@@ -60,4 +95,39 @@ type VM struct {
 	S          chan struct{}
 	config     *Config
 	unregister func() error
+	// runLock stops timer and event runs of the compiled script overlapping
+	runLock sync.Mutex
+	// wrapper replaces the default wrapper for the script's exchange and state
+	// modules, this is set for backtests
+	wrapper modules.GCT
+	// limits are set when the script was uploaded with limits
+	limits *sandbox.Limits
+	// manager creates the VMs the script's pipeline runs in
+	manager *GctScriptManager
+	// Schedule is the cron expression and time zone the script runs on
+	Schedule string
+	// Pipeline is the scripts the script's output is passed to after each run
+	Pipeline []string
+	// globals are the schedule globals declared in the script's source
+	globals   map[string]string
+	cron      *cronSchedule
+	location  *time.Location
+	jitter    time.Duration
+	stateLock sync.RWMutex
+	state     ScheduleState
+}
+
+// eventSubscription holds a market event declared by a script and the updates
+// queued for its next run
+type eventSubscription struct {
+	Type     string
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+
+	bars      *trade.BarBuilder
+	m         sync.Mutex
+	latest    tengo.Object
+	pending   []tengo.Object
+	coalesced int64
 }
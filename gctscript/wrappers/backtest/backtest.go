@@ -0,0 +1,768 @@
+package backtest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/account"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/orderbook"
+	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/portfolio/withdraw"
+)
+
+// New returns a backtest wrapper stepping through the config's candles loaded
+// from the database
+func New(cfg *Config) (*Wrapper, error) {
+	err := cfg.validate()
+	if err != nil {
+		return nil, err
+	}
+	item, err := kline.LoadFromDatabase(cfg.Exchange, cfg.Pair, cfg.Asset, cfg.Interval, cfg.Start, cfg.End)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithCandles(cfg, &item)
+}
+
+// NewWithCandles returns a backtest wrapper stepping through the candles
+// supplied, other periods and intervals requested by scripts are loaded from
+// the database
+func NewWithCandles(cfg *Config, item *kline.Item) (*Wrapper, error) {
+	w := &Wrapper{load: kline.LoadFromDatabase}
+	err := w.setup(cfg, item)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (c *Config) validate() error {
+	if c == nil {
+		return errConfigUnset
+	}
+	if c.Exchange == "" {
+		return errExchangeUnset
+	}
+	if c.Pair.IsEmpty() || c.Asset == "" {
+		return errPairUnset
+	}
+	if c.Interval <= 0 {
+		return errInvalidInterval
+	}
+	if !c.Start.Before(c.End) {
+		return errInvalidTimes
+	}
+	if c.BaseFunds < 0 || c.QuoteFunds < 0 || c.BaseFunds+c.QuoteFunds == 0 {
+		return errInvalidFunds
+	}
+	if c.Fee < 0 || c.Fee >= 1 {
+		return errInvalidFee
+	}
+	return nil
+}
+
+func (w *Wrapper) setup(cfg *Config, item *kline.Item) error {
+	err := cfg.validate()
+	if err != nil {
+		return err
+	}
+	if item == nil || len(item.Candles) == 0 {
+		return fmt.Errorf("%s %s %s %s %w", cfg.Exchange, cfg.Pair, cfg.Asset, cfg.Interval.Short(), errNoCandles)
+	}
+	w.cfg = *cfg
+	w.candles = make([]kline.Candle, len(item.Candles))
+	copy(w.candles, item.Candles)
+	sort.Slice(w.candles, func(i, j int) bool {
+		return w.candles[i].Time.Before(w.candles[j].Time)
+	})
+	w.index = -1
+	w.base = cfg.BaseFunds
+	w.quote = cfg.QuoteFunds
+	w.startingValue = w.value(w.candles[0].Open)
+	w.peak = w.startingValue
+	w.state = make(map[string]map[string]string)
+	w.notifications = nil
+	w.events = nil
+	return nil
+}
+
+// Next steps the clock to the close of the next candle and fills open orders
+// against it, it returns false once every candle has been stepped through
+func (w *Wrapper) Next() bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.index+1 >= len(w.candles) {
+		return false
+	}
+	w.index++
+	c := &w.candles[w.index]
+	w.clock = c.Time.Add(w.cfg.Interval.Duration())
+	w.fillOrders(c)
+
+	equity := w.value(c.Close)
+	if equity > w.peak {
+		w.peak = equity
+	}
+	if w.peak > 0 {
+		drawdown := (w.peak - equity) / w.peak * 100
+		if drawdown > w.maxDrawdown {
+			w.maxDrawdown = drawdown
+		}
+	}
+	return true
+}
+
+// Clock returns the simulated time, which is the close time of the current
+// candle
+func (w *Wrapper) Clock() time.Time {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.clock
+}
+
+// Report returns the performance of the script over the candles stepped
+// through so far
+func (w *Wrapper) Report() *Report {
+	w.m.Lock()
+	defer w.m.Unlock()
+	first := w.candles[0]
+	r := &Report{
+		Exchange:           w.cfg.Exchange,
+		Pair:               w.cfg.Pair,
+		Asset:              w.cfg.Asset,
+		Interval:           w.cfg.Interval,
+		Start:              first.Time,
+		End:                first.Time,
+		Candles:            w.index + 1,
+		StartingBase:       w.cfg.BaseFunds,
+		StartingQuote:      w.cfg.QuoteFunds,
+		FinalBase:          w.base,
+		FinalQuote:         w.quote,
+		StartingValue:      w.startingValue,
+		FinalValue:         w.startingValue,
+		MaxDrawdownPercent: w.maxDrawdown,
+		Fees:               w.fees,
+		Orders:             make([]order.Detail, len(w.orders)),
+		Notifications:      append([]Notification(nil), w.notifications...),
+		Events:             append([]Event(nil), w.events...),
+	}
+	if w.index >= 0 {
+		last := w.candles[w.index]
+		r.End = w.clock
+		r.FinalValue = w.value(last.Close)
+		if first.Open > 0 {
+			r.BuyAndHoldPercent = (last.Close - first.Open) / first.Open * 100
+		}
+	}
+	r.ProfitLoss = r.FinalValue - r.StartingValue
+	if r.StartingValue > 0 {
+		r.ReturnPercent = r.ProfitLoss / r.StartingValue * 100
+	}
+	for i := range w.orders {
+		r.Orders[i] = *w.orders[i]
+		if w.orders[i].Status == order.Filled {
+			r.FilledOrders++
+		}
+	}
+	return r
+}
+
+// value returns the simulated balances in the quote currency
+func (w *Wrapper) value(price float64) float64 {
+	return w.base*price + w.quote
+}
+
+// fillOrders fills open orders which trade within a candle, orders are
+// rejected when the balances no longer cover them
+func (w *Wrapper) fillOrders(c *kline.Candle) {
+	for _, o := range w.orders {
+		if o.Status != order.New {
+			continue
+		}
+		price, ok := fillPrice(o, c)
+		if !ok {
+			continue
+		}
+		cost := price * o.Amount
+		fee := cost * w.cfg.Fee
+		o.LastUpdated = c.Time
+		if o.Side == order.Buy {
+			if cost+fee > w.quote {
+				o.Status = order.Rejected
+				continue
+			}
+			w.base += o.Amount
+			w.quote -= cost + fee
+		} else {
+			if o.Amount > w.base {
+				o.Status = order.Rejected
+				continue
+			}
+			w.base -= o.Amount
+			w.quote += cost - fee
+		}
+		w.fees += fee
+		if o.Type == order.Market {
+			o.Price = price
+		}
+		o.Status = order.Filled
+		o.ExecutedAmount = o.Amount
+		o.RemainingAmount = 0
+		o.Cost = cost
+		o.Fee = fee
+		o.CloseTime = c.Time
+		o.Trades = append(o.Trades, order.TradeHistory{
+			Price:     price,
+			Amount:    o.Amount,
+			Fee:       fee,
+			Exchange:  o.Exchange,
+			TID:       o.ID,
+			Type:      o.Type,
+			Side:      o.Side,
+			Timestamp: c.Time,
+			IsMaker:   o.Type == order.Limit,
+		})
+	}
+}
+
+// fillPrice returns the price an order fills at within a candle. Market orders
+// fill at the open, limit orders fill once the candle trades through their
+// price or at the open when it opens through it
+func fillPrice(o *order.Detail, c *kline.Candle) (float64, bool) {
+	switch {
+	case o.Type == order.Market:
+		return c.Open, true
+	case o.Side == order.Buy && c.Low <= o.Price:
+		return math.Min(o.Price, c.Open), true
+	case o.Side == order.Sell && c.High >= o.Price:
+		return math.Max(o.Price, c.Open), true
+	}
+	return 0, false
+}
+
+// holds returns the balances held by open orders, market orders are valued
+// at the current close
+func (w *Wrapper) holds() (base, quote float64) {
+	for _, o := range w.orders {
+		if o.Status != order.New {
+			continue
+		}
+		if o.Side != order.Buy {
+			base += o.Amount
+			continue
+		}
+		price := o.Price
+		if o.Type == order.Market {
+			price = w.candles[w.index].Close
+		}
+		quote += price * o.Amount * (1 + w.cfg.Fee)
+	}
+	return base, quote
+}
+
+func (w *Wrapper) checkMarket(exch string, pair currency.Pair, item asset.Item) error {
+	if !strings.EqualFold(exch, w.cfg.Exchange) || !pair.Equal(w.cfg.Pair) || item != w.cfg.Asset {
+		return fmt.Errorf("%s %s %s %w", exch, pair, item, errMarketNotBacktested)
+	}
+	if w.index < 0 {
+		return errNotStarted
+	}
+	return nil
+}
+
+// Exchanges returns the backtested exchange
+func (w *Wrapper) Exchanges(_ bool) []string {
+	return []string{w.cfg.Exchange}
+}
+
+// IsEnabled returns whether the exchange is being backtested
+func (w *Wrapper) IsEnabled(exch string) bool {
+	return strings.EqualFold(exch, w.cfg.Exchange)
+}
+
+// Orderbook returns a single level orderbook at the current close
+func (w *Wrapper) Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	err := w.checkMarket(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	c := w.candles[w.index]
+	return &orderbook.Base{
+		Pair:         w.cfg.Pair,
+		Bids:         []orderbook.Item{{Price: c.Close, Amount: c.Volume}},
+		Asks:         []orderbook.Item{{Price: c.Close, Amount: c.Volume}},
+		LastUpdated:  w.clock,
+		AssetType:    w.cfg.Asset,
+		ExchangeName: w.cfg.Exchange,
+	}, nil
+}
+
+// Ticker returns a ticker built from the current candle
+func (w *Wrapper) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	err := w.checkMarket(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	tx := w.ticker(&w.candles[w.index])
+	return &tx, nil
+}
+
+func (w *Wrapper) ticker(c *kline.Candle) ticker.Price {
+	return ticker.Price{
+		Last:         c.Close,
+		High:         c.High,
+		Low:          c.Low,
+		Bid:          c.Close,
+		Ask:          c.Close,
+		Volume:       c.Volume,
+		Open:         c.Open,
+		Close:        c.Close,
+		Pair:         w.cfg.Pair,
+		ExchangeName: w.cfg.Exchange,
+		AssetType:    w.cfg.Asset,
+		LastUpdated:  c.Time.Add(w.cfg.Interval.Duration()),
+	}
+}
+
+// history returns the candles stepped through which closed after since, up to
+// the most recent limit when limit is positive
+func (w *Wrapper) history(limit int, since time.Time) []kline.Candle {
+	candles := w.candles[:w.index+1]
+	start := sort.Search(len(candles), func(i int) bool {
+		return candles[i].Time.Add(w.cfg.Interval.Duration()).After(since)
+	})
+	candles = candles[start:]
+	if limit > 0 && len(candles) > limit {
+		candles = candles[len(candles)-limit:]
+	}
+	return candles
+}
+
+// TickerHistory returns tickers built from the candles stepped through
+func (w *Wrapper) TickerHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]ticker.Price, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	err := w.checkMarket(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	candles := w.history(limit, since)
+	resp := make([]ticker.Price, len(candles))
+	for i := range candles {
+		resp[i] = w.ticker(&candles[i])
+	}
+	return resp, nil
+}
+
+// OrderbookHistory returns top of book snapshots at the close of the candles
+// stepped through
+func (w *Wrapper) OrderbookHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]orderbook.TopOfBook, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	err := w.checkMarket(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	candles := w.history(limit, since)
+	resp := make([]orderbook.TopOfBook, len(candles))
+	for i := range candles {
+		resp[i] = orderbook.TopOfBook{
+			BidPrice:    candles[i].Close,
+			BidAmount:   candles[i].Volume,
+			AskPrice:    candles[i].Close,
+			AskAmount:   candles[i].Volume,
+			LastUpdated: candles[i].Time.Add(w.cfg.Interval.Duration()),
+		}
+	}
+	return resp, nil
+}
+
+// Pairs returns the backtested currency pair
+func (w *Wrapper) Pairs(exch string, _ bool, item asset.Item) (*currency.Pairs, error) {
+	if !strings.EqualFold(exch, w.cfg.Exchange) || item != w.cfg.Asset {
+		return nil, fmt.Errorf("%s %s %w", exch, item, errMarketNotBacktested)
+	}
+	pairs := currency.Pairs{w.cfg.Pair}
+	return &pairs, nil
+}
+
+// QueryOrder returns a simulated order
+func (w *Wrapper) QueryOrder(exch, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	o, err := w.getOrder(exch, orderID)
+	if err != nil {
+		return nil, err
+	}
+	d := *o
+	return &d, nil
+}
+
+func (w *Wrapper) getOrder(exch, orderID string) (*order.Detail, error) {
+	if !strings.EqualFold(exch, w.cfg.Exchange) {
+		return nil, fmt.Errorf("%s %w", exch, errMarketNotBacktested)
+	}
+	for _, o := range w.orders {
+		if o.ID == orderID {
+			return o, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %w", orderID, errOrderNotFound)
+}
+
+// OrderHistory returns the simulated orders which are no longer open
+// matching the request
+func (w *Wrapper) OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	return w.filterOrders(exch, request, func(s order.Status) bool { return s != order.New })
+}
+
+// ActiveOrders returns the open simulated orders matching the request
+func (w *Wrapper) ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	return w.filterOrders(exch, request, func(s order.Status) bool { return s == order.New })
+}
+
+func (w *Wrapper) filterOrders(exch string, request *order.GetOrdersRequest, include func(order.Status) bool) ([]order.Detail, error) {
+	if request == nil {
+		return nil, errNilRequest
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	if !strings.EqualFold(exch, w.cfg.Exchange) {
+		return nil, fmt.Errorf("%s %w", exch, errMarketNotBacktested)
+	}
+	var orders []order.Detail
+	for _, o := range w.orders {
+		if include(o.Status) {
+			orders = append(orders, *o)
+		}
+	}
+	order.FilterOrdersByType(&orders, request.Type)
+	order.FilterOrdersBySide(&orders, request.Side)
+	order.FilterOrdersByTickRange(&orders, request.StartTicks, request.EndTicks)
+	order.FilterOrdersByCurrencies(&orders, request.Pairs)
+	return orders, nil
+}
+
+// SubmitOrder opens a simulated order which is filled against the candles
+// that follow
+func (w *Wrapper) SubmitOrder(s *order.Submit) (*order.SubmitResponse, error) {
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+	side := s.Side
+	switch side {
+	case order.Bid:
+		side = order.Buy
+	case order.Ask:
+		side = order.Sell
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	err = w.checkMarket(s.Exchange, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	baseHold, quoteHold := w.holds()
+	if side == order.Buy {
+		price := s.Price
+		if s.Type == order.Market {
+			price = w.candles[w.index].Close
+		}
+		if price*s.Amount*(1+w.cfg.Fee) > w.quote-quoteHold {
+			return nil, fmt.Errorf("%w to buy %v %s", errInsufficientFunds, s.Amount, w.cfg.Pair.Base)
+		}
+	} else if s.Amount > w.base-baseHold {
+		return nil, fmt.Errorf("%w to sell %v %s", errInsufficientFunds, s.Amount, w.cfg.Pair.Base)
+	}
+
+	w.nextID++
+	id := strconv.FormatInt(w.nextID, 10)
+	w.orders = append(w.orders, &order.Detail{
+		Price:           s.Price,
+		Amount:          s.Amount,
+		RemainingAmount: s.Amount,
+		Exchange:        w.cfg.Exchange,
+		ID:              id,
+		ClientID:        s.ClientID,
+		Type:            s.Type,
+		Side:            side,
+		Status:          order.New,
+		AssetType:       w.cfg.Asset,
+		Date:            w.clock,
+		LastUpdated:     w.clock,
+		Pair:            w.cfg.Pair,
+	})
+	return &order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       id,
+	}, nil
+}
+
+// CancelOrder cancels an open simulated order
+func (w *Wrapper) CancelOrder(exch, orderID string, _ currency.Pair, _ asset.Item) (bool, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	o, err := w.getOrder(exch, orderID)
+	if err != nil {
+		return false, err
+	}
+	if o.Status != order.New {
+		return false, fmt.Errorf("%s %w", orderID, errOrderNotOpen)
+	}
+	o.Status = order.Cancelled
+	o.LastUpdated = w.clock
+	return true, nil
+}
+
+// AccountInformation returns the simulated balances, amounts held by open
+// orders are included in each total
+func (w *Wrapper) AccountInformation(exch string) (account.Holdings, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if !strings.EqualFold(exch, w.cfg.Exchange) {
+		return account.Holdings{}, fmt.Errorf("%s %w", exch, errMarketNotBacktested)
+	}
+	var baseHold, quoteHold float64
+	if w.index >= 0 {
+		baseHold, quoteHold = w.holds()
+	}
+	return account.Holdings{
+		Exchange: w.cfg.Exchange,
+		Accounts: []account.SubAccount{
+			{
+				ID: AccountID,
+				Currencies: []account.Balance{
+					{
+						CurrencyName: w.cfg.Pair.Base,
+						TotalValue:   w.base,
+						Hold:         baseHold,
+					},
+					{
+						CurrencyName: w.cfg.Pair.Quote,
+						TotalValue:   w.quote,
+						Hold:         quoteHold,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// FundingHistory is not supported when backtesting
+func (w *Wrapper) FundingHistory(_ string) ([]modules.FundHistory, error) {
+	return nil, errNotSupported
+}
+
+// DepositAddress is not supported when backtesting
+func (w *Wrapper) DepositAddress(_ string, _ currency.Code) (string, error) {
+	return "", errNotSupported
+}
+
+// WithdrawalFiatFunds is not supported when backtesting
+func (w *Wrapper) WithdrawalFiatFunds(_ string, _ *withdraw.Request) (string, error) {
+	return "", errNotSupported
+}
+
+// WithdrawalCryptoFunds is not supported when backtesting
+func (w *Wrapper) WithdrawalCryptoFunds(_ *withdraw.Request) (string, error) {
+	return "", errNotSupported
+}
+
+// OHLCV returns candles closed by the simulated clock. Periods ending after
+// the clock, such as those relative to times.now(), are moved back to end at
+// the clock
+func (w *Wrapper) OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	err := w.checkMarket(exch, pair, item)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	if end.After(w.clock) {
+		// rounding drops the drift between separate times.now() calls
+		start = start.Add(w.clock.Sub(end)).Round(time.Second)
+		end = w.clock
+	}
+
+	candles := w.candles[:w.index+1]
+	if interval != w.cfg.Interval || start.Before(w.cfg.Start) {
+		var loaded kline.Item
+		loaded, err = w.load(w.cfg.Exchange, w.cfg.Pair, w.cfg.Asset, interval, start, end)
+		if err != nil {
+			return kline.Item{}, err
+		}
+		loaded.SortCandlesByTimestamp(false)
+		candles = loaded.Candles
+	}
+
+	resp := kline.Item{
+		Exchange: w.cfg.Exchange,
+		Pair:     w.cfg.Pair,
+		Asset:    w.cfg.Asset,
+		Interval: interval,
+	}
+	for i := range candles {
+		if candles[i].Time.Before(start) || candles[i].Time.Add(interval.Duration()).After(end) {
+			continue
+		}
+		resp.Candles = append(resp.Candles, candles[i])
+	}
+	return resp, nil
+}
+
+// RecentTrades is not supported when backtesting
+func (w *Wrapper) RecentTrades(_ string, _ currency.Pair, _ asset.Item) ([]trade.Data, error) {
+	return nil, errNotSupported
+}
+
+// HistoricTrades is not supported when backtesting
+func (w *Wrapper) HistoricTrades(_ string, _ currency.Pair, _ asset.Item, _, _ time.Time) ([]trade.Data, error) {
+	return nil, errNotSupported
+}
+
+// Bars is not supported when backtesting as bars are built from saved trades
+func (w *Wrapper) Bars(_ string, _ currency.Pair, _ asset.Item, _, _ time.Time, _ trade.BarConfig) (kline.Item, error) {
+	return kline.Item{}, errNotSupported
+}
+
+// LiveBars is not supported when backtesting
+func (w *Wrapper) LiveBars(_ string, _ currency.Pair, _ asset.Item, _ trade.BarConfig) (kline.Item, error) {
+	return kline.Item{}, errNotSupported
+}
+
+// Positions is not supported when backtesting
+func (w *Wrapper) Positions(_ string, _ currency.Pair, _ asset.Item) ([]futures.Position, error) {
+	return nil, errNotSupported
+}
+
+// SetLeverage is not supported when backtesting
+func (w *Wrapper) SetLeverage(_ string, _ currency.Pair, _ asset.Item, _ float64) error {
+	return errNotSupported
+}
+
+// SetMarginType is not supported when backtesting
+func (w *Wrapper) SetMarginType(_ string, _ currency.Pair, _ asset.Item, _ futures.MarginType) error {
+	return errNotSupported
+}
+
+// FundingRates is not supported when backtesting
+func (w *Wrapper) FundingRates(_ string, _ currency.Pair, _ asset.Item, _, _ time.Time) ([]futures.FundingRate, error) {
+	return nil, errNotSupported
+}
+
+// PremiumIndex is not supported when backtesting
+func (w *Wrapper) PremiumIndex(_ string, _ currency.Pair, _ asset.Item) (*futures.PremiumIndex, error) {
+	return nil, errNotSupported
+}
+
+// SubmitFuturesOrder is not supported when backtesting
+func (w *Wrapper) SubmitFuturesOrder(_ *futures.Submit) (*order.SubmitResponse, error) {
+	return nil, errNotSupported
+}
+
+// GetState returns a script's saved value for a key, state is held in memory
+// for the backtest only
+func (w *Wrapper) GetState(script, key string) (string, bool, error) {
+	if script == "" {
+		return "", false, errScriptNameUnset
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	v, ok := w.state[script][key]
+	return v, ok, nil
+}
+
+// SetState saves a script's value for a key
+func (w *Wrapper) SetState(script, key, value string) error {
+	if script == "" {
+		return errScriptNameUnset
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.state[script] == nil {
+		w.state[script] = make(map[string]string)
+	}
+	w.state[script][key] = value
+	return nil
+}
+
+// DeleteState removes a script's saved value for a key
+func (w *Wrapper) DeleteState(script, key string) error {
+	if script == "" {
+		return errScriptNameUnset
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	delete(w.state[script], key)
+	return nil
+}
+
+// StateKeys returns the keys a script has saved values for
+func (w *Wrapper) StateKeys(script string) ([]string, error) {
+	if script == "" {
+		return nil, errScriptNameUnset
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	keys := make([]string, 0, len(w.state[script]))
+	for k := range w.state[script] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Notify records a script's notification with the simulated time
+func (w *Wrapper) Notify(n *modules.Notification) error {
+	if n == nil {
+		return errNilNotification
+	}
+	if n.Script == "" {
+		return errScriptNameUnset
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.notifications = append(w.notifications, Notification{
+		Time:     w.clock,
+		Script:   n.Script,
+		Severity: n.Severity,
+		Message:  n.Message,
+	})
+	return nil
+}
+
+// ReleaseScript does nothing as backtest notifications are not rate limited
+func (w *Wrapper) ReleaseScript(_ string) {}
+
+// PublishEvent records a script's event with the simulated time
+func (w *Wrapper) PublishEvent(script, event string, data interface{}) error {
+	if script == "" {
+		return errScriptNameUnset
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.events = append(w.events, Event{
+		Time:   w.clock,
+		Script: script,
+		Event:  event,
+		Data:   data,
+	})
+	return nil
+}
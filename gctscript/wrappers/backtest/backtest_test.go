@@ -0,0 +1,405 @@
+package backtest
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+)
+
+var (
+	_ modules.GCT = &Wrapper{}
+
+	testPair  = currency.NewPair(currency.BTC, currency.USD)
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// testCandles are hourly candles opening at 100, rising to 120 and falling
+// back to 100
+var testCandles = []kline.Candle{
+	{Time: testStart, Open: 100, High: 105, Low: 95, Close: 100, Volume: 1},
+	{Time: testStart.Add(time.Hour), Open: 100, High: 112, Low: 99, Close: 110, Volume: 1},
+	{Time: testStart.Add(2 * time.Hour), Open: 110, High: 121, Low: 109, Close: 120, Volume: 1},
+	{Time: testStart.Add(3 * time.Hour), Open: 120, High: 120, Low: 99, Close: 100, Volume: 1},
+}
+
+// testLoad returns the test candles within a period in descending order to
+// ensure they are sorted
+func testLoad(exch string, pair currency.Pair, a asset.Item, i kline.Interval, start, end time.Time) (kline.Item, error) {
+	item := kline.Item{Exchange: exch, Pair: pair, Asset: a, Interval: i}
+	if i != kline.OneHour {
+		return item, nil
+	}
+	for x := len(testCandles) - 1; x >= 0; x-- {
+		if !testCandles[x].Time.Before(start) && testCandles[x].Time.Before(end) {
+			item.Candles = append(item.Candles, testCandles[x])
+		}
+	}
+	return item, nil
+}
+
+func testWrapper(t *testing.T, cfg *Config) *Wrapper {
+	t.Helper()
+	item, err := testLoad(cfg.Exchange, cfg.Pair, cfg.Asset, cfg.Interval, cfg.Start, cfg.End)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &Wrapper{load: testLoad}
+	err = w.setup(cfg, &item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func testConfig() *Config {
+	return &Config{
+		Exchange:   "test",
+		Pair:       testPair,
+		Asset:      asset.Spot,
+		Interval:   kline.OneHour,
+		Start:      testStart,
+		End:        testStart.Add(4 * time.Hour),
+		QuoteFunds: 1000,
+		Fee:        0.001,
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+	var cfg *Config
+	if err := cfg.validate(); !errors.Is(err, errConfigUnset) {
+		t.Errorf("received %v, expected %v", err, errConfigUnset)
+	}
+	cfg = testConfig()
+	cfg.Exchange = ""
+	if err := cfg.validate(); !errors.Is(err, errExchangeUnset) {
+		t.Errorf("received %v, expected %v", err, errExchangeUnset)
+	}
+	cfg = testConfig()
+	cfg.Asset = ""
+	if err := cfg.validate(); !errors.Is(err, errPairUnset) {
+		t.Errorf("received %v, expected %v", err, errPairUnset)
+	}
+	cfg = testConfig()
+	cfg.Interval = 0
+	if err := cfg.validate(); !errors.Is(err, errInvalidInterval) {
+		t.Errorf("received %v, expected %v", err, errInvalidInterval)
+	}
+	cfg = testConfig()
+	cfg.End = cfg.Start
+	if err := cfg.validate(); !errors.Is(err, errInvalidTimes) {
+		t.Errorf("received %v, expected %v", err, errInvalidTimes)
+	}
+	cfg = testConfig()
+	cfg.QuoteFunds = 0
+	if err := cfg.validate(); !errors.Is(err, errInvalidFunds) {
+		t.Errorf("received %v, expected %v", err, errInvalidFunds)
+	}
+	cfg = testConfig()
+	cfg.Fee = 1
+	if err := cfg.validate(); !errors.Is(err, errInvalidFee) {
+		t.Errorf("received %v, expected %v", err, errInvalidFee)
+	}
+	if err := testConfig().validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNoCandles(t *testing.T) {
+	t.Parallel()
+	_, err := NewWithCandles(testConfig(), &kline.Item{})
+	if !errors.Is(err, errNoCandles) {
+		t.Errorf("received %v, expected %v", err, errNoCandles)
+	}
+	_, err = NewWithCandles(nil, &kline.Item{})
+	if !errors.Is(err, errConfigUnset) {
+		t.Errorf("received %v, expected %v", err, errConfigUnset)
+	}
+}
+
+func TestMarketData(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t, testConfig())
+	_, err := w.Ticker("test", testPair, asset.Spot)
+	if !errors.Is(err, errNotStarted) {
+		t.Errorf("received %v, expected %v", err, errNotStarted)
+	}
+	if !w.Next() || !w.Next() {
+		t.Fatal("expected candles to step through")
+	}
+	if !w.Clock().Equal(testStart.Add(2 * time.Hour)) {
+		t.Errorf("received clock %v, expected the close of the second candle", w.Clock())
+	}
+
+	_, err = w.Ticker("test", currency.NewPair(currency.ETH, currency.USD), asset.Spot)
+	if !errors.Is(err, errMarketNotBacktested) {
+		t.Errorf("received %v, expected %v", err, errMarketNotBacktested)
+	}
+	tx, err := w.Ticker("Test", testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Last != 110 {
+		t.Errorf("received last %v, expected 110", tx.Last)
+	}
+	ob, err := w.Orderbook("test", testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) != 1 || ob.Bids[0].Price != 110 {
+		t.Errorf("received %+v, expected a single level at the close", ob.Bids)
+	}
+
+	history, err := w.TickerHistory("test", testPair, asset.Spot, 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Last != 110 {
+		t.Errorf("received %+v, expected the latest ticker only", history)
+	}
+	books, err := w.OrderbookHistory("test", testPair, asset.Spot, 0, testStart.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(books) != 1 {
+		t.Errorf("received %v snapshots, expected 1 after since", len(books))
+	}
+
+	// a period ending in the future is moved back to end at the clock
+	candles, err := w.OHLCV("test", testPair, asset.Spot, testStart.Add(24*time.Hour), testStart.Add(26*time.Hour), kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles.Candles) != 2 || candles.Candles[1].Close != 110 {
+		t.Errorf("received %+v, expected the two closed candles", candles.Candles)
+	}
+	candles, err = w.OHLCV("test", testPair, asset.Spot, testStart.Add(-time.Hour), testStart.Add(2*time.Hour), kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles.Candles) != 2 {
+		t.Errorf("received %v candles, expected 2 loaded from before the start", len(candles.Candles))
+	}
+
+	_, err = w.Bars("test", testPair, asset.Spot, testStart, testStart, trade.BarConfig{})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestOrders(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t, testConfig())
+	w.Next()
+
+	submit := &order.Submit{
+		Exchange:  "test",
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    100,
+	}
+	_, err := w.SubmitOrder(submit)
+	if !errors.Is(err, errInsufficientFunds) {
+		t.Errorf("received %v, expected %v", err, errInsufficientFunds)
+	}
+	submit.Amount = 5
+	buy, err := w.SubmitOrder(submit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	limit, err := w.SubmitOrder(&order.Submit{
+		Exchange:  "test",
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Ask,
+		Type:      order.Limit,
+		Price:     115,
+		Amount:    5,
+	})
+	if !errors.Is(err, errInsufficientFunds) {
+		t.Errorf("received %v, expected %v", err, errInsufficientFunds)
+	}
+	if limit != nil {
+		t.Error("expected sell to be rejected before buying")
+	}
+
+	w.Next()
+	o, err := w.QueryOrder("test", buy.OrderID, testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != order.Filled || o.Price != 100 || o.Fee != 0.5 {
+		t.Errorf("received %+v, expected market buy to fill at the next open", o)
+	}
+
+	sell, err := w.SubmitOrder(&order.Submit{
+		Exchange:  "test",
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Price:     115,
+		Amount:    5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel, err := w.SubmitOrder(&order.Submit{
+		Exchange:  "test",
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     50,
+		Amount:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	holdings, err := w.AccountInformation("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holdings.Accounts[0].Currencies[0].Hold != 5 {
+		t.Errorf("received %+v, expected sell to hold base", holdings.Accounts[0].Currencies[0])
+	}
+	_, err = w.CancelOrder("test", cancel.OrderID, testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.CancelOrder("test", cancel.OrderID, testPair, asset.Spot)
+	if !errors.Is(err, errOrderNotOpen) {
+		t.Errorf("received %v, expected %v", err, errOrderNotOpen)
+	}
+	active, err := w.ActiveOrders("test", &order.GetOrdersRequest{AssetType: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 1 || active[0].ID != sell.OrderID {
+		t.Errorf("received %+v, expected the open sell", active)
+	}
+	history, err := w.OrderHistory("test", &order.GetOrdersRequest{Side: order.Buy, AssetType: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Errorf("received %+v, expected the filled and cancelled buys", history)
+	}
+	_, err = w.OrderHistory("nope", &order.GetOrdersRequest{})
+	if !errors.Is(err, errMarketNotBacktested) {
+		t.Errorf("received %v, expected %v", err, errMarketNotBacktested)
+	}
+	_, err = w.ActiveOrders("test", nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received %v, expected %v", err, errNilRequest)
+	}
+	_, err = w.QueryOrder("test", "1337", testPair, asset.Spot)
+	if !errors.Is(err, errOrderNotFound) {
+		t.Errorf("received %v, expected %v", err, errOrderNotFound)
+	}
+
+	for w.Next() {
+	}
+	o, err = w.QueryOrder("test", sell.OrderID, testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != order.Filled || len(o.Trades) != 1 || o.Trades[0].Price != 115 {
+		t.Errorf("received %+v, expected limit sell to fill at its price", o)
+	}
+
+	r := w.Report()
+	if r.Candles != len(testCandles) || r.FilledOrders != 2 || len(r.Orders) != 3 {
+		t.Errorf("received %+v, unexpected report", r)
+	}
+	if r.FinalBase != 0 || math.Abs(r.FinalQuote-1073.925) > 1e-9 {
+		t.Errorf("received base %v quote %v, expected 0 and 1073.925", r.FinalBase, r.FinalQuote)
+	}
+	if math.Abs(r.ReturnPercent-7.3925) > 1e-9 {
+		t.Errorf("received return %v, expected 7.3925", r.ReturnPercent)
+	}
+	if r.BuyAndHoldPercent != 0 {
+		t.Errorf("received buy and hold %v, expected 0", r.BuyAndHoldPercent)
+	}
+	if math.Abs(r.Fees-1.075) > 1e-9 {
+		t.Errorf("received fees %v, expected 1.075", r.Fees)
+	}
+	if !r.End.Equal(testStart.Add(4 * time.Hour)) {
+		t.Errorf("received end %v, expected the last close", r.End)
+	}
+}
+
+func TestState(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t, testConfig())
+	err := w.SetState("", "key", "1")
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	err = w.SetState("test.gct", "key", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, found, err := w.GetState("test.gct", "key")
+	if err != nil || !found || v != "1" {
+		t.Errorf("received %v %v %v, expected saved value", v, found, err)
+	}
+	keys, err := w.StateKeys("test.gct")
+	if err != nil || len(keys) != 1 {
+		t.Errorf("received %v %v, expected one key", keys, err)
+	}
+	err = w.DeleteState("test.gct", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, found, err = w.GetState("test.gct", "key")
+	if err != nil || found {
+		t.Errorf("received %v %v, expected value to be deleted", found, err)
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t, testConfig())
+	err := w.Notify(nil)
+	if !errors.Is(err, errNilNotification) {
+		t.Errorf("received %v, expected %v", err, errNilNotification)
+	}
+	err = w.PublishEvent("", "signal", nil)
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	if !w.Next() {
+		t.Fatal("expected candles to step through")
+	}
+	err = w.Notify(&modules.Notification{
+		Script:   "test.gct",
+		Severity: modules.SeverityCritical,
+		Message:  "hello",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.PublishEvent("test.gct", "signal", "buy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := w.Report()
+	if len(r.Notifications) != 1 || !r.Notifications[0].Time.Equal(w.Clock()) {
+		t.Errorf("received %+v, expected one notification at the simulated time", r.Notifications)
+	}
+	if len(r.Events) != 1 || r.Events[0].Data != "buy" {
+		t.Errorf("received %+v, expected one event", r.Events)
+	}
+}
@@ -0,0 +1,122 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/idoall/gocryptotrader/common"
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+)
+
+// AccountID is the sub account id simulated balances are returned under
+const AccountID = "backtest"
+
+var (
+	errConfigUnset         = errors.New("backtest config not set")
+	errExchangeUnset       = errors.New("backtest exchange not set")
+	errPairUnset           = errors.New("backtest currency pair and asset type not set")
+	errInvalidInterval     = errors.New("backtest interval must be set")
+	errInvalidTimes        = errors.New("backtest start time must be before its end time")
+	errInvalidFunds        = errors.New("backtest requires positive base or quote funds")
+	errInvalidFee          = errors.New("backtest fee must be between 0 and 1")
+	errNoCandles           = errors.New("no candles found for backtest period")
+	errNotStarted          = errors.New("backtest has not started")
+	errMarketNotBacktested = errors.New("market is not being backtested")
+	errInsufficientFunds   = errors.New("insufficient funds")
+	errOrderNotFound       = errors.New("order not found")
+	errOrderNotOpen        = errors.New("order is not open")
+	errNilRequest          = errors.New("order request is nil")
+	errScriptNameUnset     = errors.New("script name not set")
+	errNilNotification     = errors.New("notification is nil")
+	errNotSupported        = fmt.Errorf("%w when backtesting", common.ErrFunctionNotSupported)
+)
+
+// Config holds the market a script is backtested against, the period and its
+// starting funds
+type Config struct {
+	Exchange   string
+	Pair       currency.Pair
+	Asset      asset.Item
+	Interval   kline.Interval
+	Start      time.Time
+	End        time.Time
+	BaseFunds  float64
+	QuoteFunds float64
+	// Fee is the rate charged on the cost of each fill, 0.001 is 0.1%
+	Fee float64
+}
+
+// Wrapper is a GCT wrapper for backtesting scripts. Market data is returned
+// from the database as of a simulated clock which is stepped one candle at a
+// time, submitted orders are filled against the candles that follow
+type Wrapper struct {
+	cfg     Config
+	candles []kline.Candle
+	load    func(exchange string, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (kline.Item, error)
+
+	m             sync.Mutex
+	index         int
+	clock         time.Time
+	base          float64
+	quote         float64
+	fees          float64
+	startingValue float64
+	peak          float64
+	maxDrawdown   float64
+	nextID        int64
+	orders        []*order.Detail
+	state         map[string]map[string]string
+	notifications []Notification
+	events        []Event
+}
+
+// Report holds the performance of a backtested script, values are in the
+// quote currency
+type Report struct {
+	Exchange           string
+	Pair               currency.Pair
+	Asset              asset.Item
+	Interval           kline.Interval
+	Start              time.Time
+	End                time.Time
+	Candles            int
+	StartingBase       float64
+	StartingQuote      float64
+	FinalBase          float64
+	FinalQuote         float64
+	StartingValue      float64
+	FinalValue         float64
+	ProfitLoss         float64
+	ReturnPercent      float64
+	BuyAndHoldPercent  float64
+	MaxDrawdownPercent float64
+	Fees               float64
+	FilledOrders       int
+	Orders             []order.Detail
+	Notifications      []Notification
+	Events             []Event
+}
+
+// Notification is a notification sent by a script during a backtest, it is
+// recorded in the report in place of being relayed
+type Notification struct {
+	Time     time.Time
+	Script   string
+	Severity modules.Severity
+	Message  string
+}
+
+// Event is an event published by a script during a backtest, it is recorded
+// in the report in place of being broadcast
+type Event struct {
+	Time   time.Time
+	Script string
+	Event  string
+	Data   interface{}
+}
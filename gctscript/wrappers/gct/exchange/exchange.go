@@ -16,6 +16,8 @@ import (
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/portfolio/banking"
 	"github.com/idoall/gocryptotrader/portfolio/withdraw"
 )
@@ -63,6 +65,18 @@ func (e Exchange) Ticker(exch string, pair currency.Pair, item asset.Item) (*tic
 	return ex.FetchTicker(pair, item)
 }
 
+// TickerHistory returns the stored ticker history for provided currency pair &
+// asset type
+func (e Exchange) TickerHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]ticker.Price, error) {
+	return ticker.GetHistory(exch, pair, item, limit, since)
+}
+
+// OrderbookHistory returns the stored top of book history for provided
+// currency pair & asset type
+func (e Exchange) OrderbookHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]orderbook.TopOfBook, error) {
+	return orderbook.GetHistory(exch, pair, item, limit, since)
+}
+
 // Pairs returns either all or enabled currency pairs
 func (e Exchange) Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error) {
 	x, err := engine.Bot.Config.GetExchangeConfig(exch)
@@ -91,6 +105,24 @@ func (e Exchange) QueryOrder(exch, orderID string, pair currency.Pair, assetType
 	return &o, nil
 }
 
+// OrderHistory returns the exchange's order history matching the request
+func (e Exchange) OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOrderHistory(request)
+}
+
+// ActiveOrders returns the exchange's open orders matching the request
+func (e Exchange) ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetActiveOrders(request)
+}
+
 // SubmitOrder submit new order on exchange
 func (e Exchange) SubmitOrder(submit *order.Submit) (*order.SubmitResponse, error) {
 	r, err := engine.Bot.OrderManager.Submit(submit)
@@ -139,6 +171,23 @@ func (e Exchange) AccountInformation(exch string) (account.Holdings, error) {
 	return accountInfo, nil
 }
 
+// FundingHistory returns the deposits and withdrawals of the exchange account
+func (e Exchange) FundingHistory(exch string) ([]modules.FundHistory, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	history, err := ex.GetFundingHistory()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]modules.FundHistory, len(history))
+	for x := range history {
+		resp[x] = modules.FundHistory(history[x])
+	}
+	return resp, nil
+}
+
 // DepositAddress gets the address required to deposit funds for currency type
 func (e Exchange) DepositAddress(exch string, currencyCode currency.Code) (out string, err error) {
 	if currencyCode.IsEmpty() {
@@ -212,13 +261,30 @@ func (e Exchange) WithdrawalCryptoFunds(request *withdraw.Request) (string, erro
 	return resp.Exchange.ID, nil
 }
 
-// OHLCV returns open high low close volume candles for requested exchange/pair/asset/start & end time
-func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+// RecentTrades returns the most recent trades from the exchange for the
+// currency pair and asset type
+func (e Exchange) RecentTrades(exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
 	ex, err := e.GetExchange(exch)
 	if err != nil {
-		return kline.Item{}, err
+		return nil, err
+	}
+	return ex.GetRecentTrades(pair, item)
+}
+
+// HistoricTrades returns trades from the exchange for the currency pair and
+// asset type between the start and end times
+func (e Exchange) HistoricTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
 	}
-	ret, err := ex.GetHistoricCandlesExtended(pair, item, start, end, interval)
+	return ex.GetHistoricTrades(pair, item, start, end)
+}
+
+// OHLCV returns open high low close volume candles for requested exchange/pair/asset/start & end time
+// converting candles from a shorter supported interval when the exchange does not support the interval
+func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	ret, err := engine.Bot.GetResampledHistoricCandles(exch, pair, item, start, end, interval, true)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -231,3 +297,29 @@ func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start,
 
 	return ret, nil
 }
+
+// Bars returns bars built from trades saved to the database for the
+// requested exchange, pair, asset and time range
+func (e Exchange) Bars(exch string, pair currency.Pair, item asset.Item, start, end time.Time, cfg trade.BarConfig) (kline.Item, error) {
+	ret, err := trade.GetBarsInRange(exch, item.String(), pair.Base.String(), pair.Quote.String(), start, end, cfg)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret.FormatDates()
+	return ret, nil
+}
+
+// LiveBars returns bars built from trades received for the requested
+// exchange, pair and asset since the bars were first requested
+func (e Exchange) LiveBars(exch string, pair currency.Pair, item asset.Item, cfg trade.BarConfig) (kline.Item, error) {
+	_, err := e.GetExchange(exch)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret, err := trade.GetLiveBars(exch, pair, item, cfg)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret.FormatDates()
+	return ret, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/idoall/gocryptotrader/exchanges/asset"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
 )
 
 // change these if you wish to test another exchange and/or currency pair
@@ -200,6 +201,23 @@ func TestOHLCV(t *testing.T) {
 	}
 }
 
+func TestLiveBars(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	cfg := trade.BarConfig{Type: trade.TickBar, Threshold: 10}
+	_, err := exchangeTest.LiveBars("fake", cp, assetType, cfg)
+	if err == nil {
+		t.Error("expected error for unknown exchange")
+	}
+	bars, err := exchangeTest.LiveBars(exchName, cp, assetType, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bars.Exchange != exchName || len(bars.Candles) != 0 {
+		t.Errorf("unexpected response %+v", bars)
+	}
+}
+
 func setupEngine() (err error) {
 	engine.Bot, err = engine.NewFromSettings(&settings, nil)
 	if err != nil {
@@ -0,0 +1,74 @@
+package exchange
+
+import (
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/engine"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+)
+
+// Positions returns the exchange's derivatives positions for the pair, an
+// empty pair returns all open positions
+func (e Exchange) Positions(exch string, pair currency.Pair, item asset.Item) ([]futures.Position, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPositions(pair, item)
+}
+
+// SetLeverage sets the leverage used by the pair's positions
+func (e Exchange) SetLeverage(exch string, pair currency.Pair, item asset.Item, leverage float64) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetLeverage(pair, item, leverage)
+}
+
+// SetMarginType sets whether the pair's positions use isolated or crossed
+// margin
+func (e Exchange) SetMarginType(exch string, pair currency.Pair, item asset.Item, marginType futures.MarginType) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetMarginType(pair, item, marginType)
+}
+
+// FundingRates returns the pair's funding rates between the start and end
+// times
+func (e Exchange) FundingRates(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]futures.FundingRate, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFundingRateHistory(pair, item, start, end)
+}
+
+// PremiumIndex returns the pair's mark and index price with its latest
+// funding rate
+func (e Exchange) PremiumIndex(exch string, pair currency.Pair, item asset.Item) (*futures.PremiumIndex, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPremiumIndex(pair, item)
+}
+
+// SubmitFuturesOrder submits a derivatives order which may only reduce or
+// close a position via the order manager so it is tracked and conformed to
+// the exchange's limits
+func (e Exchange) SubmitFuturesOrder(submit *futures.Submit) (*order.SubmitResponse, error) {
+	if submit == nil {
+		return nil, order.ErrSubmissionIsNil
+	}
+	r, err := engine.Bot.OrderManager.SubmitFutures(submit)
+	if err != nil {
+		return nil, err
+	}
+	return &r.SubmitResponse, nil
+}
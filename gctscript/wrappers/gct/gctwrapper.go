@@ -1,10 +1,16 @@
 package gct
 
-import "github.com/idoall/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/gct/exchange"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/gct/notify"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/gct/state"
+)
 
 // Setup returns a Wrapper
 func Setup() *Wrapper {
 	return &Wrapper{
 		&exchange.Exchange{},
+		&state.State{},
+		&notify.Notifier{},
 	}
 }
@@ -1,8 +1,14 @@
 package gct
 
-import "github.com/idoall/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/gct/exchange"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/gct/notify"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/gct/state"
+)
 
 // Wrapper struct
 type Wrapper struct {
 	*exchange.Exchange
+	*state.State
+	*notify.Notifier
 }
@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/idoall/gocryptotrader/communications/base"
+	"github.com/idoall/gocryptotrader/engine"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// notificationsPerMinute is the number of notifications a script can
+	// send each minute, notificationBurst can be sent at once
+	notificationsPerMinute = 10
+	notificationBurst      = 5
+	// eventsPerSecond is the number of events a script can publish each
+	// second, eventBurst can be published at once
+	eventsPerSecond = 5
+	eventBurst      = 10
+	// websocketEvent is the websocket event name script events are published
+	// under
+	websocketEvent = "gctscript"
+)
+
+var (
+	errNilNotification   = errors.New("notification is nil")
+	errScriptNameUnset   = errors.New("script name not set")
+	errEmptyMessage      = errors.New("notification message is empty")
+	errEmptyEvent        = errors.New("event name is empty")
+	errCommsNotStarted   = errors.New("communications manager not started")
+	errRateLimitExceeded = errors.New("rate limit exceeded")
+)
+
+// Notifier implements the notify methods for Wrapper using the engine's
+// communications manager and websocket hub, each script is rate limited
+type Notifier struct {
+	m             sync.Mutex
+	notifications map[string]*rate.Limiter
+	events        map[string]*rate.Limiter
+}
+
+// Event is published to websocket clients when a script publishes an event
+type Event struct {
+	Script string      `json:"script"`
+	Event  string      `json:"event"`
+	Data   interface{} `json:"data"`
+	Time   time.Time   `json:"time"`
+}
+
+// Notify relays a script's notification to the enabled communication
+// services
+func (n *Notifier) Notify(notification *modules.Notification) error {
+	if notification == nil {
+		return errNilNotification
+	}
+	if notification.Script == "" {
+		return errScriptNameUnset
+	}
+	if notification.Message == "" {
+		return errEmptyMessage
+	}
+	if !engine.Bot.CommsManager.Started() {
+		return errCommsNotStarted
+	}
+	if !n.allowNotification(notification.Script) {
+		return fmt.Errorf("%s notification %w", notification.Script, errRateLimitExceeded)
+	}
+	engine.Bot.CommsManager.PushEvent(base.Event{
+		Type:    "GCTScript " + strings.ToUpper(string(notification.Severity)),
+		Message: notification.Script + ": " + notification.Message,
+	})
+	return nil
+}
+
+// PublishEvent broadcasts a script's event to websocket clients, data must be
+// JSON encodable
+func (n *Notifier) PublishEvent(script, event string, data interface{}) error {
+	if script == "" {
+		return errScriptNameUnset
+	}
+	if event == "" {
+		return errEmptyEvent
+	}
+	if !n.allowEvent(script) {
+		return fmt.Errorf("%s event %w", script, errRateLimitExceeded)
+	}
+	return engine.BroadcastWebsocketMessage(engine.WebsocketEvent{
+		Event: websocketEvent,
+		Data: Event{
+			Script: script,
+			Event:  event,
+			Data:   data,
+			Time:   time.Now(),
+		},
+	})
+}
+
+// ReleaseScript removes the rate limiters of a script which is no longer
+// running
+func (n *Notifier) ReleaseScript(script string) {
+	n.m.Lock()
+	delete(n.notifications, script)
+	delete(n.events, script)
+	n.m.Unlock()
+}
+
+func (n *Notifier) allowNotification(script string) bool {
+	n.m.Lock()
+	defer n.m.Unlock()
+	if n.notifications == nil {
+		n.notifications = make(map[string]*rate.Limiter)
+	}
+	l, ok := n.notifications[script]
+	if !ok {
+		l = rate.NewLimiter(rate.Every(time.Minute/notificationsPerMinute), notificationBurst)
+		n.notifications[script] = l
+	}
+	return l.Allow()
+}
+
+func (n *Notifier) allowEvent(script string) bool {
+	n.m.Lock()
+	defer n.m.Unlock()
+	if n.events == nil {
+		n.events = make(map[string]*rate.Limiter)
+	}
+	l, ok := n.events[script]
+	if !ok {
+		l = rate.NewLimiter(rate.Every(time.Second/eventsPerSecond), eventBurst)
+		n.events[script] = l
+	}
+	return l.Allow()
+}
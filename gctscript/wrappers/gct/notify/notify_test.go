@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/idoall/gocryptotrader/engine"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+)
+
+func TestMain(m *testing.M) {
+	engine.Bot = &engine.Engine{}
+	engine.StartWebsocketHandler()
+	os.Exit(m.Run())
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+	var n Notifier
+	err := n.Notify(nil)
+	if !errors.Is(err, errNilNotification) {
+		t.Errorf("received %v, expected %v", err, errNilNotification)
+	}
+	err = n.Notify(&modules.Notification{Message: "hello"})
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	err = n.Notify(&modules.Notification{Script: "script.gct"})
+	if !errors.Is(err, errEmptyMessage) {
+		t.Errorf("received %v, expected %v", err, errEmptyMessage)
+	}
+	err = n.Notify(&modules.Notification{
+		Script:   "script.gct",
+		Severity: modules.SeverityInfo,
+		Message:  "hello",
+	})
+	if !errors.Is(err, errCommsNotStarted) {
+		t.Errorf("received %v, expected %v", err, errCommsNotStarted)
+	}
+}
+
+func TestNotifyRateLimit(t *testing.T) {
+	t.Parallel()
+	var n Notifier
+	for i := 0; i < notificationBurst; i++ {
+		if !n.allowNotification("script.gct") {
+			t.Fatalf("notification %v should be allowed", i)
+		}
+	}
+	if n.allowNotification("script.gct") {
+		t.Error("notification should be rate limited")
+	}
+	if !n.allowNotification("other.gct") {
+		t.Error("notifications should be rate limited per script")
+	}
+}
+
+func TestPublishEvent(t *testing.T) {
+	t.Parallel()
+	var n Notifier
+	err := n.PublishEvent("", "signal", nil)
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	err = n.PublishEvent("script.gct", "", nil)
+	if !errors.Is(err, errEmptyEvent) {
+		t.Errorf("received %v, expected %v", err, errEmptyEvent)
+	}
+	data := map[string]interface{}{"side": "buy", "price": 100.0}
+	for i := 0; i < eventBurst; i++ {
+		err = n.PublishEvent("script.gct", "signal", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = n.PublishEvent("script.gct", "signal", data)
+	if !errors.Is(err, errRateLimitExceeded) {
+		t.Errorf("received %v, expected %v", err, errRateLimitExceeded)
+	}
+}
+
+func TestReleaseScript(t *testing.T) {
+	t.Parallel()
+	var n Notifier
+	n.ReleaseScript("script.gct")
+	for i := 0; i < eventBurst; i++ {
+		if !n.allowEvent("script.gct") {
+			t.Fatalf("event %v should be allowed", i)
+		}
+	}
+	n.allowNotification("script.gct")
+	n.allowNotification("other.gct")
+	n.ReleaseScript("script.gct")
+	if _, ok := n.events["script.gct"]; ok {
+		t.Error("expected released script's event limiter to be removed")
+	}
+	if _, ok := n.notifications["script.gct"]; ok {
+		t.Error("expected released script's notification limiter to be removed")
+	}
+	if _, ok := n.notifications["other.gct"]; !ok {
+		t.Error("expected other script's notification limiter to be kept")
+	}
+}
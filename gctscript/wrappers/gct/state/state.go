@@ -0,0 +1,50 @@
+package state
+
+import (
+	"errors"
+
+	"github.com/idoall/gocryptotrader/database/repository/scriptstate"
+)
+
+// State implements the script state methods for Wrapper using the database
+type State struct{}
+
+// GetState returns a script's saved value for a key
+func (s State) GetState(script, key string) (string, bool, error) {
+	d, err := scriptstate.Get(script, key)
+	if err != nil {
+		if errors.Is(err, scriptstate.ErrNoState) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return d.Value, true, nil
+}
+
+// SetState saves a script's value for a key
+func (s State) SetState(script, key, value string) error {
+	return scriptstate.Set(script, key, value)
+}
+
+// DeleteState removes a script's saved value for a key, removing a key
+// without a value is not an error
+func (s State) DeleteState(script, key string) error {
+	err := scriptstate.Delete(script, key)
+	if errors.Is(err, scriptstate.ErrNoState) {
+		return nil
+	}
+	return err
+}
+
+// StateKeys returns the keys a script has saved values for
+func (s State) StateKeys(script string) ([]string, error) {
+	all, err := scriptstate.GetAll(script)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(all))
+	for i := range all {
+		keys[i] = all[i].Key
+	}
+	return keys, nil
+}
@@ -0,0 +1,29 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/idoall/gocryptotrader/database"
+)
+
+func TestStateDatabaseDisabled(t *testing.T) {
+	t.Parallel()
+	var s State
+	_, _, err := s.GetState("script.gct", "key")
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+	err = s.SetState("script.gct", "key", "1")
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+	err = s.DeleteState("script.gct", "key")
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+	_, err = s.StateKeys("script.gct")
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+}
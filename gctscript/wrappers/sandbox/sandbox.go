@@ -0,0 +1,508 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/account"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/kline"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/orderbook"
+	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+	"github.com/idoall/gocryptotrader/portfolio/withdraw"
+)
+
+// Validate checks the limits are not negative and their pairs can be parsed
+func (l *Limits) Validate() error {
+	if l.MaxAllocs < 0 || l.MaxRuntime < 0 || l.MaxOrdersPerMinute < 0 || l.MaxNotional < 0 {
+		return errNegativeLimit
+	}
+	_, err := l.currencyPairs()
+	return err
+}
+
+func (l *Limits) currencyPairs() ([]currency.Pair, error) {
+	pairs := make([]currency.Pair, len(l.Pairs))
+	for i := range l.Pairs {
+		p, err := currency.NewPairFromString(l.Pairs[i])
+		if err != nil {
+			return nil, err
+		}
+		pairs[i] = p
+	}
+	return pairs, nil
+}
+
+// LimitsFile returns the path a script's limits are saved to
+func LimitsFile(script string) string {
+	return strings.TrimSuffix(script, filepath.Ext(script)) + limitsExt
+}
+
+// LoadLimits returns the limits saved for a script, nil is returned when the
+// script is unlimited
+func LoadLimits(script string) (*Limits, error) {
+	data, err := ioutil.ReadFile(LimitsFile(script))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var l Limits
+	err = json.Unmarshal(data, &l)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", LimitsFile(script), err)
+	}
+	return &l, l.Validate()
+}
+
+// SaveLimits saves the limits for a script, nil limits remove any saved for
+// the script so it is unlimited
+func SaveLimits(script string, l *Limits) error {
+	if l == nil {
+		err := os.Remove(LimitsFile(script))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	err := l.Validate()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(l, "", " ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(LimitsFile(script), data, 0644)
+}
+
+// New returns a wrapper restricting a script to its limits, violation is
+// called with each limit the script exceeds
+func New(w modules.GCT, script string, l *Limits, violation func(error)) (*Wrapper, error) {
+	if w == nil {
+		return nil, errNilWrapper
+	}
+	if script == "" {
+		return nil, errScriptNameUnset
+	}
+	if l == nil {
+		return nil, errNilLimits
+	}
+	err := l.Validate()
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := l.currencyPairs()
+	if err != nil {
+		return nil, err
+	}
+	return &Wrapper{
+		GCT:       w,
+		script:    script,
+		limits:    *l,
+		pairs:     pairs,
+		violation: violation,
+	}, nil
+}
+
+// violate records a limit the script has exceeded and returns its error
+func (w *Wrapper) violate(err error) error {
+	if w.violation != nil {
+		w.violation(err)
+	}
+	return err
+}
+
+func (w *Wrapper) exchangeAllowed(exch string) bool {
+	if len(w.limits.Exchanges) == 0 {
+		return true
+	}
+	for i := range w.limits.Exchanges {
+		if strings.EqualFold(w.limits.Exchanges[i], exch) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Wrapper) pairAllowed(pair currency.Pair) bool {
+	if len(w.pairs) == 0 {
+		return true
+	}
+	for i := range w.pairs {
+		if w.pairs[i].Equal(pair) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMarket returns an error when the exchange or pair is not allowed, an
+// empty pair is only checked against the allowed exchanges
+func (w *Wrapper) checkMarket(exch string, pair currency.Pair) error {
+	if !w.exchangeAllowed(exch) {
+		return w.violate(fmt.Errorf("%s %w", exch, errExchangeNotAllowed))
+	}
+	if !pair.IsEmpty() && !w.pairAllowed(pair) {
+		return w.violate(fmt.Errorf("%s %s %w", exch, pair, errPairNotAllowed))
+	}
+	return nil
+}
+
+// checkRequest checks the exchange and pairs of an order request, a request
+// for all pairs is limited to the allowed pairs
+func (w *Wrapper) checkRequest(exch string, request *order.GetOrdersRequest) (*order.GetOrdersRequest, error) {
+	err := w.checkMarket(exch, currency.Pair{})
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, nil
+	}
+	for i := range request.Pairs {
+		err = w.checkMarket(exch, request.Pairs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(request.Pairs) == 0 && len(w.pairs) > 0 {
+		r := *request
+		r.Pairs = w.pairs
+		return &r, nil
+	}
+	return request, nil
+}
+
+// checkOrder checks the market, order rate and notional of a submitted order,
+// orders which can only reduce a position are not checked against the
+// notional
+func (w *Wrapper) checkOrder(s *order.Submit, reduceOnly bool) error {
+	err := w.checkMarket(s.Exchange, s.Pair)
+	if err != nil {
+		return err
+	}
+	if w.limits.MaxNotional > 0 && !reduceOnly {
+		price := s.Price
+		if price == 0 {
+			tick, errTick := w.GCT.Ticker(s.Exchange, s.Pair, s.AssetType)
+			if errTick != nil {
+				return fmt.Errorf("%w: %v", errNoPrice, errTick)
+			}
+			price = tick.Last
+		}
+		if notional := price * s.Amount; notional > w.limits.MaxNotional {
+			return w.violate(fmt.Errorf("%s %s %v above %v %w",
+				s.Exchange, s.Pair, notional, w.limits.MaxNotional, errNotionalExceeded))
+		}
+	}
+	return w.countOrder()
+}
+
+// countOrder counts an order against the orders per minute limit
+func (w *Wrapper) countOrder() error {
+	if w.limits.MaxOrdersPerMinute == 0 {
+		return nil
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	now := time.Now()
+	minuteAgo := now.Add(-time.Minute)
+	i := 0
+	for i < len(w.orders) && !w.orders[i].After(minuteAgo) {
+		i++
+	}
+	w.orders = w.orders[i:]
+	if int64(len(w.orders)) >= w.limits.MaxOrdersPerMinute {
+		return w.violate(fmt.Errorf("%v %w", w.limits.MaxOrdersPerMinute, errOrderRateExceeded))
+	}
+	w.orders = append(w.orders, now)
+	return nil
+}
+
+// Exchanges returns the exchanges the script is allowed to use
+func (w *Wrapper) Exchanges(enabledOnly bool) []string {
+	all := w.GCT.Exchanges(enabledOnly)
+	if len(w.limits.Exchanges) == 0 {
+		return all
+	}
+	allowed := make([]string, 0, len(all))
+	for i := range all {
+		if w.exchangeAllowed(all[i]) {
+			allowed = append(allowed, all[i])
+		}
+	}
+	return allowed
+}
+
+// Orderbook returns the orderbook for an allowed market
+func (w *Wrapper) Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.Orderbook(exch, pair, item)
+}
+
+// Ticker returns the ticker for an allowed market
+func (w *Wrapper) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.Ticker(exch, pair, item)
+}
+
+// TickerHistory returns the ticker history for an allowed market
+func (w *Wrapper) TickerHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]ticker.Price, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.TickerHistory(exch, pair, item, limit, since)
+}
+
+// OrderbookHistory returns the orderbook history for an allowed market
+func (w *Wrapper) OrderbookHistory(exch string, pair currency.Pair, item asset.Item, limit int, since time.Time) ([]orderbook.TopOfBook, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.OrderbookHistory(exch, pair, item, limit, since)
+}
+
+// Pairs returns the pairs of an allowed exchange
+func (w *Wrapper) Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error) {
+	err := w.checkMarket(exch, currency.Pair{})
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.Pairs(exch, enabledOnly, item)
+}
+
+// QueryOrder returns an order on an allowed market
+func (w *Wrapper) QueryOrder(exch, orderID string, pair currency.Pair, item asset.Item) (*order.Detail, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.QueryOrder(exch, orderID, pair, item)
+}
+
+// OrderHistory returns the order history for allowed markets
+func (w *Wrapper) OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	request, err := w.checkRequest(exch, request)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.OrderHistory(exch, request)
+}
+
+// ActiveOrders returns the open orders for allowed markets
+func (w *Wrapper) ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	request, err := w.checkRequest(exch, request)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.ActiveOrders(exch, request)
+}
+
+// SubmitOrder submits an order within the script's limits
+func (w *Wrapper) SubmitOrder(s *order.Submit) (*order.SubmitResponse, error) {
+	if s != nil {
+		err := w.checkOrder(s, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return w.GCT.SubmitOrder(s)
+}
+
+// CancelOrder cancels an order on an allowed market
+func (w *Wrapper) CancelOrder(exch, orderID string, pair currency.Pair, item asset.Item) (bool, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return false, err
+	}
+	return w.GCT.CancelOrder(exch, orderID, pair, item)
+}
+
+// AccountInformation returns the holdings on an allowed exchange
+func (w *Wrapper) AccountInformation(exch string) (account.Holdings, error) {
+	err := w.checkMarket(exch, currency.Pair{})
+	if err != nil {
+		return account.Holdings{}, err
+	}
+	return w.GCT.AccountInformation(exch)
+}
+
+// FundingHistory returns the deposits and withdrawals on an allowed exchange
+func (w *Wrapper) FundingHistory(exch string) ([]modules.FundHistory, error) {
+	err := w.checkMarket(exch, currency.Pair{})
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.FundingHistory(exch)
+}
+
+// DepositAddress returns a deposit address on an allowed exchange
+func (w *Wrapper) DepositAddress(exch string, currencyCode currency.Code) (string, error) {
+	err := w.checkMarket(exch, currency.Pair{})
+	if err != nil {
+		return "", err
+	}
+	return w.GCT.DepositAddress(exch, currencyCode)
+}
+
+// WithdrawalFiatFunds withdraws fiat from an allowed exchange when the script
+// is permitted to withdraw
+func (w *Wrapper) WithdrawalFiatFunds(bankAccountID string, request *withdraw.Request) (string, error) {
+	err := w.checkWithdrawal(request)
+	if err != nil {
+		return "", err
+	}
+	return w.GCT.WithdrawalFiatFunds(bankAccountID, request)
+}
+
+// WithdrawalCryptoFunds withdraws crypto from an allowed exchange when the
+// script is permitted to withdraw
+func (w *Wrapper) WithdrawalCryptoFunds(request *withdraw.Request) (string, error) {
+	err := w.checkWithdrawal(request)
+	if err != nil {
+		return "", err
+	}
+	return w.GCT.WithdrawalCryptoFunds(request)
+}
+
+func (w *Wrapper) checkWithdrawal(request *withdraw.Request) error {
+	if !w.limits.AllowWithdrawals {
+		return w.violate(errWithdrawalDisabled)
+	}
+	if request == nil {
+		return nil
+	}
+	return w.checkMarket(request.Exchange, currency.Pair{})
+}
+
+// RecentTrades returns the recent trades for an allowed market
+func (w *Wrapper) RecentTrades(exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.RecentTrades(exch, pair, item)
+}
+
+// HistoricTrades returns the trades for an allowed market between two times
+func (w *Wrapper) HistoricTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.HistoricTrades(exch, pair, item, start, end)
+}
+
+// OHLCV returns the candles for an allowed market
+func (w *Wrapper) OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return w.GCT.OHLCV(exch, pair, item, start, end, interval)
+}
+
+// Bars returns the bars for an allowed market
+func (w *Wrapper) Bars(exch string, pair currency.Pair, item asset.Item, start, end time.Time, cfg trade.BarConfig) (kline.Item, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return w.GCT.Bars(exch, pair, item, start, end, cfg)
+}
+
+// LiveBars returns the live bars for an allowed market
+func (w *Wrapper) LiveBars(exch string, pair currency.Pair, item asset.Item, cfg trade.BarConfig) (kline.Item, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return w.GCT.LiveBars(exch, pair, item, cfg)
+}
+
+// Positions returns the positions for allowed markets, an empty pair returns
+// the open positions of allowed pairs
+func (w *Wrapper) Positions(exch string, pair currency.Pair, item asset.Item) ([]futures.Position, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := w.GCT.Positions(exch, pair, item)
+	if err != nil || !pair.IsEmpty() || len(w.pairs) == 0 {
+		return positions, err
+	}
+	allowed := positions[:0]
+	for i := range positions {
+		if w.pairAllowed(positions[i].Pair) {
+			allowed = append(allowed, positions[i])
+		}
+	}
+	return allowed, nil
+}
+
+// SetLeverage sets the leverage for an allowed market
+func (w *Wrapper) SetLeverage(exch string, pair currency.Pair, item asset.Item, leverage float64) error {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return err
+	}
+	return w.GCT.SetLeverage(exch, pair, item, leverage)
+}
+
+// SetMarginType sets the margin type for an allowed market
+func (w *Wrapper) SetMarginType(exch string, pair currency.Pair, item asset.Item, marginType futures.MarginType) error {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return err
+	}
+	return w.GCT.SetMarginType(exch, pair, item, marginType)
+}
+
+// FundingRates returns the funding rates for an allowed market
+func (w *Wrapper) FundingRates(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]futures.FundingRate, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.FundingRates(exch, pair, item, start, end)
+}
+
+// PremiumIndex returns the premium index for an allowed market
+func (w *Wrapper) PremiumIndex(exch string, pair currency.Pair, item asset.Item) (*futures.PremiumIndex, error) {
+	err := w.checkMarket(exch, pair)
+	if err != nil {
+		return nil, err
+	}
+	return w.GCT.PremiumIndex(exch, pair, item)
+}
+
+// SubmitFuturesOrder submits a derivatives order within the script's limits,
+// reduce only orders and position closes are not checked against the notional
+func (w *Wrapper) SubmitFuturesOrder(s *futures.Submit) (*order.SubmitResponse, error) {
+	if s != nil {
+		err := w.checkOrder(&s.Submit, s.ReduceOnly || s.ClosePosition)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return w.GCT.SubmitFuturesOrder(s)
+}
@@ -0,0 +1,231 @@
+package sandbox
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
+	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/idoall/gocryptotrader/portfolio/withdraw"
+)
+
+var testPair = currency.NewPair(currency.BTC, currency.USD)
+
+func TestLimitsFile(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "sandbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "strategy.gct")
+	if LimitsFile(script) != filepath.Join(dir, "strategy.limits.json") {
+		t.Errorf("received %v, expected limits file next to the script", LimitsFile(script))
+	}
+
+	l, err := LoadLimits(script)
+	if err != nil || l != nil {
+		t.Errorf("received %v %v, expected an unlimited script", l, err)
+	}
+	err = SaveLimits(script, &Limits{MaxOrdersPerMinute: -1})
+	if !errors.Is(err, errNegativeLimit) {
+		t.Errorf("received %v, expected %v", err, errNegativeLimit)
+	}
+	err = SaveLimits(script, &Limits{Pairs: []string{"BT"}})
+	if err == nil {
+		t.Error("expected an invalid pair to return an error")
+	}
+	saved := &Limits{
+		MaxAllocs:          1000,
+		MaxRuntime:         time.Second,
+		MaxOrdersPerMinute: 2,
+		MaxNotional:        100,
+		Exchanges:          []string{"binance"},
+		Pairs:              []string{"BTC-USDT"},
+	}
+	err = SaveLimits(script, saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err = LoadLimits(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.MaxRuntime != saved.MaxRuntime || l.Pairs[0] != saved.Pairs[0] {
+		t.Errorf("received %+v, expected %+v", l, saved)
+	}
+	err = SaveLimits(script, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err = LoadLimits(script)
+	if err != nil || l != nil {
+		t.Errorf("received %v %v, expected limits to be removed", l, err)
+	}
+	err = SaveLimits(script, nil)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, "script.gct", &Limits{}, nil)
+	if !errors.Is(err, errNilWrapper) {
+		t.Errorf("received %v, expected %v", err, errNilWrapper)
+	}
+	_, err = New(validator.Wrapper{}, "", &Limits{}, nil)
+	if !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v, expected %v", err, errScriptNameUnset)
+	}
+	_, err = New(validator.Wrapper{}, "script.gct", nil, nil)
+	if !errors.Is(err, errNilLimits) {
+		t.Errorf("received %v, expected %v", err, errNilLimits)
+	}
+}
+
+func testWrapper(t *testing.T, l *Limits) (w *Wrapper, violations *[]error) {
+	t.Helper()
+	violations = new([]error)
+	w, err := New(validator.Wrapper{}, "script.gct", l, func(err error) {
+		*violations = append(*violations, err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w, violations
+}
+
+func TestMarkets(t *testing.T) {
+	t.Parallel()
+	w, violations := testWrapper(t, &Limits{
+		Exchanges: []string{"bitstamp"},
+		Pairs:     []string{"BTC-USD"},
+	})
+	_, err := w.Ticker("Bitstamp", testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.Ticker("Binance", testPair, asset.Spot)
+	if !errors.Is(err, errExchangeNotAllowed) || !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("received %v, expected %v", err, errExchangeNotAllowed)
+	}
+	_, err = w.Orderbook("Bitstamp", currency.NewPair(currency.ETH, currency.USD), asset.Spot)
+	if !errors.Is(err, errPairNotAllowed) {
+		t.Errorf("received %v, expected %v", err, errPairNotAllowed)
+	}
+	_, err = w.AccountInformation("Binance")
+	if !errors.Is(err, errExchangeNotAllowed) {
+		t.Errorf("received %v, expected %v", err, errExchangeNotAllowed)
+	}
+	if len(*violations) != 3 {
+		t.Errorf("received %v violations, expected 3", len(*violations))
+	}
+
+	request, err := w.checkRequest("Bitstamp", &order.GetOrdersRequest{AssetType: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(request.Pairs) != 1 || !request.Pairs[0].Equal(testPair) {
+		t.Errorf("received %v, expected a request for all pairs to be limited to the allowed pairs", request.Pairs)
+	}
+	_, err = w.OrderHistory("Bitstamp", &order.GetOrdersRequest{
+		Pairs:     currency.Pairs{currency.NewPair(currency.ETH, currency.USD)},
+		AssetType: asset.Spot,
+	})
+	if !errors.Is(err, errPairNotAllowed) {
+		t.Errorf("received %v, expected %v", err, errPairNotAllowed)
+	}
+
+	positions, err := w.Positions("Bitstamp", currency.Pair{}, asset.Future)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 0 {
+		t.Errorf("received %v, expected positions of pairs which are not allowed to be removed", positions)
+	}
+}
+
+func TestOrders(t *testing.T) {
+	t.Parallel()
+	w, violations := testWrapper(t, &Limits{MaxOrdersPerMinute: 2, MaxNotional: 10000})
+	submit := &order.Submit{
+		Exchange:  "Bitstamp",
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Price:     5000,
+		Amount:    3,
+	}
+	_, err := w.SubmitOrder(submit)
+	if !errors.Is(err, errNotionalExceeded) {
+		t.Errorf("received %v, expected %v", err, errNotionalExceeded)
+	}
+	// market orders are priced at the last price
+	submit.Type = order.Market
+	submit.Price = 0
+	submit.Amount = 10001
+	_, err = w.SubmitOrder(submit)
+	if !errors.Is(err, errNotionalExceeded) {
+		t.Errorf("received %v, expected %v", err, errNotionalExceeded)
+	}
+	submit.Amount = 1
+	_, err = w.SubmitOrder(submit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// closing a position is not limited by the notional
+	_, err = w.SubmitFuturesOrder(&futures.Submit{
+		Submit: order.Submit{
+			Exchange:  "Bitstamp",
+			Pair:      testPair,
+			AssetType: asset.Future,
+			Type:      order.Market,
+		},
+		ClosePosition: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.SubmitOrder(submit)
+	if !errors.Is(err, errOrderRateExceeded) {
+		t.Errorf("received %v, expected %v", err, errOrderRateExceeded)
+	}
+	if len(*violations) != 3 {
+		t.Errorf("received %v violations, expected 3", len(*violations))
+	}
+
+	w.orders[0] = w.orders[0].Add(-time.Minute)
+	err = w.countOrder()
+	if err != nil {
+		t.Errorf("received %v, expected orders older than a minute to be dropped", err)
+	}
+}
+
+func TestWithdrawals(t *testing.T) {
+	t.Parallel()
+	w, _ := testWrapper(t, &Limits{Exchanges: []string{"Bitstamp"}})
+	request := &withdraw.Request{Exchange: "Bitstamp"}
+	_, err := w.WithdrawalCryptoFunds(request)
+	if !errors.Is(err, errWithdrawalDisabled) {
+		t.Errorf("received %v, expected %v", err, errWithdrawalDisabled)
+	}
+	_, err = w.WithdrawalFiatFunds("", request)
+	if !errors.Is(err, errWithdrawalDisabled) {
+		t.Errorf("received %v, expected %v", err, errWithdrawalDisabled)
+	}
+
+	w, _ = testWrapper(t, &Limits{Exchanges: []string{"Bitstamp"}, AllowWithdrawals: true})
+	_, err = w.WithdrawalCryptoFunds(&withdraw.Request{Exchange: "Binance"})
+	if !errors.Is(err, errExchangeNotAllowed) {
+		t.Errorf("received %v, expected %v", err, errExchangeNotAllowed)
+	}
+}
@@ -0,0 +1,62 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/idoall/gocryptotrader/currency"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
+)
+
+// limitsExt is appended to a script's name, less its extension, to name the
+// file its limits are saved to
+const limitsExt = ".limits.json"
+
+var (
+	// ErrLimitExceeded is returned when a script is stopped by one of its
+	// limits
+	ErrLimitExceeded = errors.New("script limit exceeded")
+
+	errNilWrapper         = errors.New("sandbox wrapper requires a wrapper")
+	errNilLimits          = errors.New("sandbox wrapper requires limits")
+	errScriptNameUnset    = errors.New("script name not set")
+	errNegativeLimit      = errors.New("limits cannot be negative")
+	errNoPrice            = errors.New("unable to price order to check its notional")
+	errExchangeNotAllowed = fmt.Errorf("%w: exchange not allowed", ErrLimitExceeded)
+	errPairNotAllowed     = fmt.Errorf("%w: currency pair not allowed", ErrLimitExceeded)
+	errOrderRateExceeded  = fmt.Errorf("%w: orders per minute", ErrLimitExceeded)
+	errNotionalExceeded   = fmt.Errorf("%w: order notional", ErrLimitExceeded)
+	errWithdrawalDisabled = fmt.Errorf("%w: withdrawals not permitted", ErrLimitExceeded)
+)
+
+// Limits are the resources and actions a script is restricted to, zero values
+// are unlimited and empty exchange and pair lists allow all
+type Limits struct {
+	// MaxAllocs is the maximum number of objects allocated per script run
+	MaxAllocs int64 `json:"max_allocs,omitempty"`
+	// MaxRuntime replaces the configured timeout of each script run
+	MaxRuntime time.Duration `json:"max_runtime,omitempty"`
+	// MaxOrdersPerMinute is the maximum number of orders submitted in any
+	// minute, spot and futures orders are counted together
+	MaxOrdersPerMinute int64 `json:"max_orders_per_minute,omitempty"`
+	// MaxNotional is the maximum value of an order in its quote currency
+	MaxNotional      float64  `json:"max_notional,omitempty"`
+	Exchanges        []string `json:"exchanges,omitempty"`
+	Pairs            []string `json:"pairs,omitempty"`
+	AllowWithdrawals bool     `json:"allow_withdrawals"`
+}
+
+// Wrapper restricts a script to its limits, calls within them are passed to
+// the wrapped GCT wrapper
+type Wrapper struct {
+	modules.GCT
+	script    string
+	limits    Limits
+	pairs     []currency.Pair
+	violation func(error)
+
+	m      sync.Mutex
+	orders []time.Time
+}
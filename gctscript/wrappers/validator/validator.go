@@ -1,16 +1,22 @@
 package validator
 
 import (
+	"encoding/json"
 	"math/rand"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/account"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
 	"github.com/idoall/gocryptotrader/exchanges/orderbook"
 	"github.com/idoall/gocryptotrader/exchanges/ticker"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/portfolio/withdraw"
 )
 
@@ -90,6 +96,43 @@ func (w Wrapper) Ticker(exch string, pair currency.Pair, item asset.Item) (*tick
 	}, nil
 }
 
+// TickerHistory validator for test execution/scripts
+func (w Wrapper) TickerHistory(exch string, pair currency.Pair, item asset.Item, _ int, _ time.Time) ([]ticker.Price, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []ticker.Price{
+		{
+			Last:         1,
+			High:         2,
+			Low:          3,
+			Bid:          4,
+			Ask:          5,
+			Volume:       6,
+			Pair:         pair,
+			ExchangeName: exch,
+			AssetType:    item,
+			LastUpdated:  time.Now(),
+		},
+	}, nil
+}
+
+// OrderbookHistory validator for test execution/scripts
+func (w Wrapper) OrderbookHistory(exch string, _ currency.Pair, _ asset.Item, _ int, _ time.Time) ([]orderbook.TopOfBook, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []orderbook.TopOfBook{
+		{
+			BidPrice:    1,
+			BidAmount:   1,
+			AskPrice:    1,
+			AskAmount:   1,
+			LastUpdated: time.Now(),
+		},
+	}, nil
+}
+
 // Pairs validator for test execution/scripts
 func (w Wrapper) Pairs(exch string, _ bool, _ asset.Item) (*currency.Pairs, error) {
 	if exch == exchError.String() {
@@ -145,6 +188,45 @@ func (w Wrapper) QueryOrder(exch, _ string, _ currency.Pair, _ asset.Item) (*ord
 	}, nil
 }
 
+// OrderHistory validator for test execution/scripts
+func (w Wrapper) OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	if exch == exchError.String() || request == nil {
+		return nil, errTestFailed
+	}
+	return validatorOrders(exch, order.Filled)
+}
+
+// ActiveOrders validator for test execution/scripts
+func (w Wrapper) ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	if exch == exchError.String() || request == nil {
+		return nil, errTestFailed
+	}
+	return validatorOrders(exch, order.Active)
+}
+
+func validatorOrders(exch string, status order.Status) ([]order.Detail, error) {
+	pair, err := currency.NewPairFromString("BTCAUD")
+	if err != nil {
+		return nil, err
+	}
+	return []order.Detail{
+		{
+			Exchange:        exch,
+			ID:              "1",
+			Pair:            pair,
+			AssetType:       asset.Spot,
+			Side:            order.Buy,
+			Type:            order.Limit,
+			Date:            time.Now(),
+			Status:          status,
+			Price:           1,
+			Amount:          2,
+			ExecutedAmount:  1,
+			RemainingAmount: 1,
+		},
+	}, nil
+}
+
 // SubmitOrder validator for test execution/scripts
 func (w Wrapper) SubmitOrder(o *order.Submit) (*order.SubmitResponse, error) {
 	if o == nil {
@@ -214,6 +296,25 @@ func (w Wrapper) AccountInformation(exch string) (account.Holdings, error) {
 	}, nil
 }
 
+// FundingHistory validator for test execution/scripts
+func (w Wrapper) FundingHistory(exch string) ([]modules.FundHistory, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []modules.FundHistory{
+		{
+			ExchangeName: exch,
+			Status:       "complete",
+			TransferID:   "1",
+			Timestamp:    time.Now(),
+			Currency:     "BTC",
+			Amount:       1,
+			Fee:          0.001,
+			TransferType: "deposit",
+		},
+	}, nil
+}
+
 // DepositAddress validator for test execution/scripts
 func (w Wrapper) DepositAddress(exch string, _ currency.Code) (string, error) {
 	if exch == exchError.String() {
@@ -241,6 +342,46 @@ func (w Wrapper) WithdrawalFiatFunds(_ string, r *withdraw.Request) (out string,
 	return "123", nil
 }
 
+// RecentTrades validator for test execution/scripts
+func (w Wrapper) RecentTrades(exch string, p currency.Pair, a asset.Item) ([]trade.Data, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return validatorTrades(exch, p, a, time.Now()), nil
+}
+
+// HistoricTrades validator for test execution/scripts
+func (w Wrapper) HistoricTrades(exch string, p currency.Pair, a asset.Item, start, end time.Time) ([]trade.Data, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if !start.Before(end) {
+		return nil, errTestFailed
+	}
+	return validatorTrades(exch, p, a, start), nil
+}
+
+func validatorTrades(exch string, p currency.Pair, a asset.Item, start time.Time) []trade.Data {
+	trades := make([]trade.Data, 200)
+	for x := range trades {
+		side := order.Buy
+		if x%2 == 1 {
+			side = order.Sell
+		}
+		trades[x] = trade.Data{
+			TID:          strconv.Itoa(x),
+			Exchange:     exch,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         side,
+			Price:        validatorLow + rand.Float64()*(validatorHigh-validatorLow), // nolint:gosec // no need to import crypo/rand
+			Amount:       validatorVol,
+			Timestamp:    start.Add(time.Duration(x) * time.Second),
+		}
+	}
+	return trades
+}
+
 // OHLCV returns open high low close volume candles for requested exchange/pair/asset/start & end time
 func (w Wrapper) OHLCV(exch string, p currency.Pair, a asset.Item, start, end time.Time, i kline.Interval) (kline.Item, error) {
 	if exch == exchError.String() {
@@ -278,3 +419,201 @@ func (w Wrapper) OHLCV(exch string, p currency.Pair, a asset.Item, start, end ti
 		Candles:  candles,
 	}, nil
 }
+
+// Bars returns bars for requested exchange/pair/asset/start & end time
+func (w Wrapper) Bars(exch string, p currency.Pair, a asset.Item, start, end time.Time, cfg trade.BarConfig) (kline.Item, error) {
+	if exch == exchError.String() {
+		return kline.Item{}, errTestFailed
+	}
+	return validatorBars(exch, p, a, start, &cfg)
+}
+
+// LiveBars returns live bars for requested exchange/pair/asset
+func (w Wrapper) LiveBars(exch string, p currency.Pair, a asset.Item, cfg trade.BarConfig) (kline.Item, error) {
+	if exch == exchError.String() {
+		return kline.Item{}, errTestFailed
+	}
+	return validatorBars(exch, p, a, time.Now(), &cfg)
+}
+
+func validatorBars(exch string, p currency.Pair, a asset.Item, start time.Time, cfg *trade.BarConfig) (kline.Item, error) {
+	return trade.ConvertTradesToBars(*cfg, validatorTrades(exch, p, a, start)...)
+}
+
+// GetState returns a script's saved value for a key
+func (w Wrapper) GetState(script, key string) (string, bool, error) {
+	if script == "" {
+		return "", false, errTestFailed
+	}
+	state.Lock()
+	defer state.Unlock()
+	v, ok := state.values[script][key]
+	return v, ok, nil
+}
+
+// SetState saves a script's value for a key
+func (w Wrapper) SetState(script, key, value string) error {
+	if script == "" {
+		return errTestFailed
+	}
+	state.Lock()
+	defer state.Unlock()
+	if state.values[script] == nil {
+		state.values[script] = make(map[string]string)
+	}
+	state.values[script][key] = value
+	return nil
+}
+
+// DeleteState removes a script's saved value for a key
+func (w Wrapper) DeleteState(script, key string) error {
+	if script == "" {
+		return errTestFailed
+	}
+	state.Lock()
+	defer state.Unlock()
+	delete(state.values[script], key)
+	return nil
+}
+
+// StateKeys returns the keys a script has saved values for
+func (w Wrapper) StateKeys(script string) ([]string, error) {
+	if script == "" {
+		return nil, errTestFailed
+	}
+	state.Lock()
+	defer state.Unlock()
+	keys := make([]string, 0, len(state.values[script]))
+	for k := range state.values[script] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Positions validator for test execution/scripts
+func (w Wrapper) Positions(exch string, pair currency.Pair, item asset.Item) ([]futures.Position, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if pair.IsEmpty() {
+		var err error
+		pair, err = currency.NewPairFromString("BTCUSDT")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []futures.Position{
+		{
+			Exchange:         exch,
+			Pair:             pair,
+			AssetType:        item,
+			Amount:           1,
+			EntryPrice:       validatorOpen,
+			MarkPrice:        validatorClose,
+			LiquidationPrice: validatorOpen / 2,
+			UnrealisedPNL:    validatorClose - validatorOpen,
+			Leverage:         2,
+			MarginType:       futures.Crossed,
+		},
+	}, nil
+}
+
+// SetLeverage validator for test execution/scripts
+func (w Wrapper) SetLeverage(exch string, _ currency.Pair, _ asset.Item, leverage float64) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	if leverage <= 0 {
+		return futures.ErrInvalidLeverage
+	}
+	return nil
+}
+
+// SetMarginType validator for test execution/scripts
+func (w Wrapper) SetMarginType(exch string, _ currency.Pair, _ asset.Item, marginType futures.MarginType) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	if !marginType.IsValid() {
+		return futures.ErrInvalidMarginType
+	}
+	return nil
+}
+
+// FundingRates validator for test execution/scripts
+func (w Wrapper) FundingRates(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]futures.FundingRate, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	var rates []futures.FundingRate
+	for t := start.Truncate(8 * time.Hour); !t.After(end); t = t.Add(8 * time.Hour) {
+		if t.Before(start) {
+			continue
+		}
+		rates = append(rates, futures.FundingRate{
+			Exchange:  exch,
+			Pair:      pair,
+			AssetType: item,
+			Rate:      0.0001,
+			Time:      t,
+		})
+	}
+	return rates, nil
+}
+
+// PremiumIndex validator for test execution/scripts
+func (w Wrapper) PremiumIndex(exch string, pair currency.Pair, item asset.Item) (*futures.PremiumIndex, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	now := time.Now()
+	return &futures.PremiumIndex{
+		Exchange:        exch,
+		Pair:            pair,
+		AssetType:       item,
+		MarkPrice:       validatorClose,
+		IndexPrice:      validatorClose,
+		FundingRate:     0.0001,
+		NextFundingTime: now.Truncate(8 * time.Hour).Add(8 * time.Hour),
+		Time:            now,
+	}, nil
+}
+
+// SubmitFuturesOrder validator for test execution/scripts
+func (w Wrapper) SubmitFuturesOrder(submit *futures.Submit) (*order.SubmitResponse, error) {
+	if submit == nil {
+		return nil, errTestFailed
+	}
+	if submit.Exchange == exchError.String() {
+		return nil, errTestFailed
+	}
+	err := submit.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       submit.Exchange,
+	}, nil
+}
+
+// Notify validator for test execution/scripts
+func (w Wrapper) Notify(n *modules.Notification) error {
+	if n == nil || n.Script == "" || n.Message == "" {
+		return errTestFailed
+	}
+	return nil
+}
+
+// ReleaseScript validator for test execution/scripts
+func (w Wrapper) ReleaseScript(_ string) {}
+
+// PublishEvent validator for test execution/scripts
+func (w Wrapper) PublishEvent(script, event string, data interface{}) error {
+	if script == "" || event == "" {
+		return errTestFailed
+	}
+	_, err := json.Marshal(data)
+	return err
+}
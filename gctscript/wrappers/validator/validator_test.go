@@ -1,13 +1,17 @@
 package validator
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/idoall/gocryptotrader/currency"
 	"github.com/idoall/gocryptotrader/exchanges/asset"
+	"github.com/idoall/gocryptotrader/exchanges/futures"
 	"github.com/idoall/gocryptotrader/exchanges/kline"
 	"github.com/idoall/gocryptotrader/exchanges/order"
+	"github.com/idoall/gocryptotrader/exchanges/trade"
+	"github.com/idoall/gocryptotrader/gctscript/modules"
 	"github.com/idoall/gocryptotrader/portfolio/withdraw"
 )
 
@@ -163,6 +167,77 @@ func TestWrapper_QueryOrder(t *testing.T) {
 	}
 }
 
+func TestWrapper_OrderHistory(t *testing.T) {
+	t.Parallel()
+
+	orders, err := testWrapper.OrderHistory(exchName, &order.GetOrdersRequest{AssetType: assetType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Status != order.Filled {
+		t.Errorf("expected one filled order received %v", orders)
+	}
+
+	_, err = testWrapper.OrderHistory(exchError.String(), &order.GetOrdersRequest{})
+	if err == nil {
+		t.Fatal("expected OrderHistory to return error on invalid name")
+	}
+}
+
+func TestWrapper_ActiveOrders(t *testing.T) {
+	t.Parallel()
+
+	orders, err := testWrapper.ActiveOrders(exchName, &order.GetOrdersRequest{AssetType: assetType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Status != order.Active {
+		t.Errorf("expected one active order received %v", orders)
+	}
+
+	_, err = testWrapper.ActiveOrders(exchName, nil)
+	if err == nil {
+		t.Fatal("expected ActiveOrders to return error on nil request")
+	}
+}
+
+func TestWrapper_FundingHistory(t *testing.T) {
+	t.Parallel()
+
+	_, err := testWrapper.FundingHistory(exchName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testWrapper.FundingHistory(exchError.String())
+	if err == nil {
+		t.Fatal("expected FundingHistory to return error on invalid name")
+	}
+}
+
+func TestWrapper_Trades(t *testing.T) {
+	t.Parallel()
+
+	trades, err := testWrapper.RecentTrades(exchName, currencyPair, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) == 0 {
+		t.Error("expected recent trades")
+	}
+
+	start := time.Now().Add(-time.Hour)
+	_, err = testWrapper.HistoricTrades(exchName, currencyPair, assetType, start, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testWrapper.HistoricTrades(exchError.String(), currencyPair, assetType, start, time.Now())
+	if err == nil {
+		t.Fatal("expected HistoricTrades to return error on invalid name")
+	}
+}
+
 func TestWrapper_SubmitOrder(t *testing.T) {
 	t.Parallel()
 	c, err := currency.NewPairDelimiter(pairs, delimiter)
@@ -209,6 +284,32 @@ func TestWrapper_Ticker(t *testing.T) {
 	}
 }
 
+func TestWrapper_TickerHistory(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.TickerHistory(exchName, currencyPair, assetType, 0, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testWrapper.TickerHistory(exchError.String(), currencyPair, asset.Spot, 0, time.Time{})
+	if err == nil {
+		t.Fatal("expected TickerHistory to return error with invalid name")
+	}
+}
+
+func TestWrapper_OrderbookHistory(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.OrderbookHistory(exchName, currencyPair, assetType, 0, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testWrapper.OrderbookHistory(exchError.String(), currencyPair, asset.Spot, 0, time.Time{})
+	if err == nil {
+		t.Fatal("expected OrderbookHistory to return error with invalid name")
+	}
+}
+
 func TestWrapper_WithdrawalCryptoFunds(t *testing.T) {
 	_, err := testWrapper.WithdrawalCryptoFunds(&withdraw.Request{Exchange: exchError.String()})
 	if err == nil {
@@ -247,3 +348,137 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_Bars(t *testing.T) {
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := trade.BarConfig{Type: trade.TickBar, Threshold: 10}
+	ret, err := testWrapper.Bars("test", c, asset.Spot, time.Now().Add(-24*time.Hour), time.Now(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret.Candles) != 20 {
+		t.Errorf("expected 20 bars received %v", len(ret.Candles))
+	}
+	_, err = testWrapper.Bars(exchError.String(), c, asset.Spot, time.Now().Add(-24*time.Hour), time.Now(), cfg)
+	if err == nil {
+		t.Fatal("expected Bars to return error with invalid name")
+	}
+	_, err = testWrapper.LiveBars("test", c, asset.Spot, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.LiveBars(exchError.String(), c, asset.Spot, cfg)
+	if err == nil {
+		t.Fatal("expected LiveBars to return error with invalid name")
+	}
+}
+
+func TestWrapper_State(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.SetState("", "key", "1")
+	if err == nil {
+		t.Error("expected error when script name unset")
+	}
+	err = testWrapper.SetState("validator.gct", "key", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, found, err := testWrapper.GetState("validator.gct", "key")
+	if err != nil || !found || v != "1" {
+		t.Errorf("received %v %v %v, expected saved value", v, found, err)
+	}
+	keys, err := testWrapper.StateKeys("validator.gct")
+	if err != nil || len(keys) != 1 {
+		t.Errorf("received %v %v, expected one key", keys, err)
+	}
+	err = testWrapper.DeleteState("validator.gct", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, found, err = testWrapper.GetState("validator.gct", "key")
+	if err != nil || found {
+		t.Errorf("received %v %v, expected deleted value", found, err)
+	}
+}
+
+func TestWrapper_Futures(t *testing.T) {
+	t.Parallel()
+
+	positions, err := testWrapper.Positions(exchName, currency.Pair{}, asset.Future)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 1 || positions[0].Pair.IsEmpty() {
+		t.Errorf("expected one position received %v", positions)
+	}
+	_, err = testWrapper.Positions(exchError.String(), currencyPair, asset.Future)
+	if err == nil {
+		t.Fatal("expected Positions to return error on invalid name")
+	}
+
+	err = testWrapper.SetLeverage(exchName, currencyPair, asset.Future, 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received %v, expected %v", err, futures.ErrInvalidLeverage)
+	}
+	err = testWrapper.SetMarginType(exchName, currencyPair, asset.Future, futures.Isolated)
+	if err != nil {
+		t.Error(err)
+	}
+
+	start := time.Unix(1609459200, 0)
+	rates, err := testWrapper.FundingRates(exchName, currencyPair, asset.Future, start, start.Add(time.Hour*24))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 4 {
+		t.Errorf("received %v funding rates, expected 4", len(rates))
+	}
+	_, err = testWrapper.PremiumIndex(exchError.String(), currencyPair, asset.Future)
+	if err == nil {
+		t.Fatal("expected PremiumIndex to return error on invalid name")
+	}
+
+	_, err = testWrapper.SubmitFuturesOrder(&futures.Submit{
+		Submit: order.Submit{
+			Exchange:  exchName,
+			Pair:      currencyPair,
+			AssetType: asset.Future,
+		},
+		ClosePosition: true,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = testWrapper.SubmitFuturesOrder(nil)
+	if err == nil {
+		t.Fatal("expected SubmitFuturesOrder to return error on nil submission")
+	}
+}
+
+func TestWrapper_Notify(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.Notify(&modules.Notification{
+		Script:   "script.gct",
+		Severity: modules.SeverityWarning,
+		Message:  "hello",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	err = testWrapper.Notify(nil)
+	if err == nil {
+		t.Fatal("expected Notify to return error on nil notification")
+	}
+
+	err = testWrapper.PublishEvent("script.gct", "signal", map[string]interface{}{"side": "buy"})
+	if err != nil {
+		t.Error(err)
+	}
+	err = testWrapper.PublishEvent("script.gct", "signal", make(chan int))
+	if err == nil {
+		t.Fatal("expected PublishEvent to return error on data that cannot be encoded")
+	}
+}
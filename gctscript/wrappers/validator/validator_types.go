@@ -2,6 +2,7 @@ package validator
 
 import (
 	"errors"
+	"sync"
 	"sync/atomic"
 
 	objects "github.com/d5/tengo/v2"
@@ -15,6 +16,13 @@ var (
 		Value: "",
 	}
 	errTestFailed = errors.New("test failed")
+
+	// state holds script state in memory as scripts under test execution must
+	// not write to the database
+	state = struct {
+		sync.Mutex
+		values map[string]map[string]string
+	}{values: make(map[string]map[string]string)}
 )
 
 // Wrapper for validator interface
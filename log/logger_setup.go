@@ -150,6 +150,7 @@ func init() {
 	WebsocketMgr = registerNewSubLogger("WEBSOCKET")
 	EventMgr = registerNewSubLogger("EVENT")
 	DispatchMgr = registerNewSubLogger("DISPATCH")
+	DataHistoryMgr = registerNewSubLogger("DATAHISTORY")
 
 	RequestSys = registerNewSubLogger("REQUESTER")
 	ExchangeSys = registerNewSubLogger("EXCHANGE")
@@ -17,6 +17,7 @@ var (
 	WebsocketMgr     *subLogger
 	EventMgr         *subLogger
 	DispatchMgr      *subLogger
+	DataHistoryMgr   *subLogger
 
 	RequestSys  *subLogger
 	ExchangeSys *subLogger
@@ -45,6 +45,9 @@ func main() {
 	flag.BoolVar(&settings.Verbose, "verbose", false, "increases logging verbosity for GoCryptoTrader")
 	flag.BoolVar(&settings.EnableExchangeSyncManager, "syncmanager", true, "enables to exchange sync manager")
 	flag.BoolVar(&settings.EnableWebsocketRoutine, "websocketroutine", true, "enables the websocket routine for all loaded exchanges")
+	flag.BoolVar(&settings.EnableOrderbookStaleMonitor, "orderbookstalemonitor", true, "enables the orderbook staleness monitor to alert on orderbooks which stop receiving updates")
+	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager to process jobs which fetch and save historic candle and trade data")
+	flag.DurationVar(&settings.DataHistoryManagerDelay, "datahistorymanagerdelay", engine.DataHistoryManagerCheckInterval, "sets the data history managers delay between processing job batches")
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
 	flag.BoolVar(&settings.EnableEventManager, "eventmanager", true, "enables the event manager")
 	flag.BoolVar(&settings.EnableOrderManager, "ordermanager", true, "enables the order manager")